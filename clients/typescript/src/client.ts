@@ -0,0 +1,1269 @@
+// Generado por `api ts-client` a partir de /openapi.json. No editar a mano:
+// los cambios se pierden al regenerar.
+
+import type {
+  APIClient,
+  APIClientCredentials,
+  Alert,
+  ApiClientInput,
+  AttendanceRestriction,
+  BulkPlayerReport,
+  CleanSheets,
+  ClockInput,
+  CompetitionDivision,
+  CompetitionStats,
+  ConflictResolutionInput,
+  Division,
+  DivisionInput,
+  Draw,
+  DrawOptions,
+  ErrorResponse,
+  Fixture,
+  FixtureImportReport,
+  ForfeitInput,
+  Guest,
+  GuestPromotion,
+  GuestPromotionInput,
+  ImportReport,
+  InboundEmailInput,
+  Incident,
+  IncidentInput,
+  Injury,
+  InjuryInput,
+  JerseyNumberInput,
+  Lineup,
+  LineupInput,
+  Match,
+  MatchClock,
+  MatchEvent,
+  MatchEventInput,
+  MatchForfeit,
+  MatchInput,
+  MatchMedia,
+  MatchPrediction,
+  MatchReferee,
+  MatchRefereesInput,
+  MatchUpdateInput,
+  MediaInput,
+  MediaOrderInput,
+  NameCheck,
+  Pitch,
+  PitchInput,
+  Player,
+  PlayerInput,
+  PlayerRolesInput,
+  PlayerUpdateInput,
+  PromotionReport,
+  PromotionsInput,
+  ProvisionalResult,
+  PurgeReport,
+  RateLimitStatus,
+  Referee,
+  RefereeInput,
+  Registration,
+  RegistrationInput,
+  ResultEmailReport,
+  RoundSummary,
+  RulesInput,
+  Sanction,
+  SanctionInput,
+  Scoreboard,
+  SearchResult,
+  Season,
+  SeasonInput,
+  SeedingSuggestion,
+  ServiceStatus,
+  SplitOptions,
+  SplitResult,
+  Sponsor,
+  SponsorInput,
+  Staff,
+  StaffInput,
+  Stage,
+  StageInput,
+  Standing,
+  SubMatchInput,
+  Substitution,
+  SubstitutionInput,
+  SyncBatchInput,
+  SyncBatchResponse,
+  SyncConflict,
+  Tag,
+  TagInput,
+  Team,
+  TeamInput,
+  TeamRating,
+  TeamUpdateInput,
+  Timetable,
+  TimetableOptions,
+  TokenResponse,
+  TopAssister,
+  TopScorer,
+  Tournament,
+  TournamentAnalytics,
+  TournamentInput,
+  TournamentRules,
+  TournamentUpdateInput,
+  Transfer,
+  Venue,
+  VenueInput,
+  VersionInfo,
+} from "./types";
+
+/** Parámetros de query; los undefined no se envían */
+export type Query = Record<string, string | number | boolean | undefined>;
+
+export interface ClientOptions {
+  /** URL base de la API, p. ej. https://torneos.example.com */
+  baseUrl: string;
+  /** Token de organizador o de acceso de /oauth/token, sin "Bearer " */
+  token?: string;
+  /** fetch a usar; por defecto el global */
+  fetch?: typeof fetch;
+}
+
+/** Respuesta con un código de error; body trae {"error": "..."} si vino JSON */
+export class ApiError extends Error {
+  constructor(
+    readonly status: number,
+    readonly body: ErrorResponse | undefined,
+  ) {
+    super(body?.error ?? `HTTP ${status}`);
+  }
+}
+
+export class ApiClient {
+  constructor(private readonly options: ClientOptions) {}
+
+  /** Envía la petición y lanza ApiError si la respuesta no es 2xx */
+  protected async send(
+    method: string,
+    path: string,
+    query: Query | undefined,
+    body: unknown,
+    extraHeaders?: Record<string, string>,
+  ): Promise<Response> {
+    const url = new URL(path, this.options.baseUrl);
+    for (const [key, value] of Object.entries(query ?? {})) {
+      if (value !== undefined) {
+        url.searchParams.set(key, String(value));
+      }
+    }
+
+    const headers: Record<string, string> = { ...extraHeaders };
+    if (this.options.token) {
+      headers["Authorization"] = `Bearer ${this.options.token}`;
+    }
+    let payload: BodyInit | undefined;
+    if (body === undefined || typeof body === "string" || body instanceof URLSearchParams || body instanceof Blob || body instanceof FormData) {
+      payload = body;
+    } else {
+      payload = JSON.stringify(body);
+      headers["Content-Type"] = "application/json";
+    }
+
+    const response = await (this.options.fetch ?? fetch)(url, { method, headers, body: payload });
+    if (!response.ok) {
+      let error: ErrorResponse | undefined;
+      try {
+        error = (await response.json()) as ErrorResponse;
+      } catch {
+        error = undefined;
+      }
+      throw new ApiError(response.status, error);
+    }
+    return response;
+  }
+
+  /** Envía la petición y decodifica la respuesta JSON */
+  protected async request<T>(
+    method: string,
+    path: string,
+    query: Query | undefined,
+    body: unknown,
+    extraHeaders?: Record<string, string>,
+  ): Promise<T> {
+    const response = await this.send(method, path, query, body, extraHeaders);
+    return (await response.json()) as T;
+  }
+
+  /** Borra una división (DELETE /api/divisions/{id}) */
+  deleteDivisionsById(id: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/divisions/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Borra un partido (DELETE /api/matches/{id}) */
+  deleteMatchesById(id: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/matches/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Anula un evento (DELETE /api/matches/{id}/events/{eventId}) */
+  deleteMatchesByIdEventsByEventId(id: string, eventId: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/matches/${encodeURIComponent(id)}/events/${encodeURIComponent(eventId)}`, query, undefined);
+  }
+
+  /** Anula el walkover (DELETE /api/matches/{id}/forfeit) */
+  deleteMatchesByIdForfeit(id: string, query?: Query): Promise<MatchForfeit> {
+    return this.request<MatchForfeit>("DELETE", `/api/matches/${encodeURIComponent(id)}/forfeit`, query, undefined);
+  }
+
+  /** Quita un elemento de la galería (DELETE /api/matches/{id}/media/{mediaId}) */
+  deleteMatchesByIdMediaByMediaId(id: string, mediaId: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/matches/${encodeURIComponent(id)}/media/${encodeURIComponent(mediaId)}`, query, undefined);
+  }
+
+  /** Anula un cambio (DELETE /api/matches/{id}/substitutions/{substitutionId}) */
+  deleteMatchesByIdSubstitutionsBySubstitutionId(id: string, substitutionId: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/matches/${encodeURIComponent(id)}/substitutions/${encodeURIComponent(substitutionId)}`, query, undefined);
+  }
+
+  /** Quita la etiqueta del partido (DELETE /api/matches/{id}/tags/{tagId}) */
+  deleteMatchesByIdTagsByTagId(id: string, tagId: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/matches/${encodeURIComponent(id)}/tags/${encodeURIComponent(tagId)}`, query, undefined);
+  }
+
+  /** Borra un jugador (DELETE /api/players/{id}) */
+  deletePlayersById(id: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/players/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Borra una lesión (DELETE /api/players/{id}/injuries/{injuryId}) */
+  deletePlayersByIdInjuriesByInjuryId(id: string, injuryId: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/players/${encodeURIComponent(id)}/injuries/${encodeURIComponent(injuryId)}`, query, undefined);
+  }
+
+  /** Quita la etiqueta del jugador (DELETE /api/players/{id}/tags/{tagId}) */
+  deletePlayersByIdTagsByTagId(id: string, tagId: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/players/${encodeURIComponent(id)}/tags/${encodeURIComponent(tagId)}`, query, undefined);
+  }
+
+  /** Borra un árbitro (DELETE /api/referees/{id}) */
+  deleteRefereesById(id: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/referees/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Borra una temporada (DELETE /api/seasons/{id}) */
+  deleteSeasonsById(id: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/seasons/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Borra una etiqueta (DELETE /api/tags/{id}) */
+  deleteTagsById(id: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/tags/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Borra un equipo (DELETE /api/teams/{id}) */
+  deleteTeamsById(id: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/teams/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Quita un jugador de la plantilla (DELETE /api/teams/{id}/players/{playerId}) */
+  deleteTeamsByIdPlayersByPlayerId(id: string, playerId: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/teams/${encodeURIComponent(id)}/players/${encodeURIComponent(playerId)}`, query, undefined);
+  }
+
+  /** Quita un miembro del cuerpo técnico (DELETE /api/teams/{id}/staff/{staffId}) */
+  deleteTeamsByIdStaffByStaffId(id: string, staffId: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/teams/${encodeURIComponent(id)}/staff/${encodeURIComponent(staffId)}`, query, undefined);
+  }
+
+  /** Quita la etiqueta del equipo (DELETE /api/teams/{id}/tags/{tagId}) */
+  deleteTeamsByIdTagsByTagId(id: string, tagId: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/teams/${encodeURIComponent(id)}/tags/${encodeURIComponent(tagId)}`, query, undefined);
+  }
+
+  /** Borra un torneo (DELETE /api/tournaments/{id}) */
+  deleteTournamentsById(id: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/tournaments/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Da de baja un fichaje (DELETE /api/tournaments/{id}/registrations/{playerId}) */
+  deleteTournamentsByIdRegistrationsByPlayerId(id: string, playerId: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/tournaments/${encodeURIComponent(id)}/registrations/${encodeURIComponent(playerId)}`, query, undefined);
+  }
+
+  /** Revoca una sanción (DELETE /api/tournaments/{id}/sanctions/{sanctionId}) */
+  deleteTournamentsByIdSanctionsBySanctionId(id: string, sanctionId: string, query?: Query): Promise<Sanction> {
+    return this.request<Sanction>("DELETE", `/api/tournaments/${encodeURIComponent(id)}/sanctions/${encodeURIComponent(sanctionId)}`, query, undefined);
+  }
+
+  /** Quita un patrocinador (DELETE /api/tournaments/{id}/sponsors/{sponsorId}) */
+  deleteTournamentsByIdSponsorsBySponsorId(id: string, sponsorId: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/tournaments/${encodeURIComponent(id)}/sponsors/${encodeURIComponent(sponsorId)}`, query, undefined);
+  }
+
+  /** Borra una fase (DELETE /api/tournaments/{id}/stages/{stageId}) */
+  deleteTournamentsByIdStagesByStageId(id: string, stageId: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/tournaments/${encodeURIComponent(id)}/stages/${encodeURIComponent(stageId)}`, query, undefined);
+  }
+
+  /** Da de baja un equipo (DELETE /api/tournaments/{id}/teams/{teamId}) */
+  deleteTournamentsByIdTeamsByTeamId(id: string, teamId: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/tournaments/${encodeURIComponent(id)}/teams/${encodeURIComponent(teamId)}`, query, undefined);
+  }
+
+  /** Borra una sede (DELETE /api/venues/{id}) */
+  deleteVenuesById(id: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/venues/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Borra una cancha (DELETE /api/venues/{id}/pitches/{pitchId}) */
+  deleteVenuesByIdPitchesByPitchId(id: string, pitchId: string, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("DELETE", `/api/venues/${encodeURIComponent(id)}/pitches/${encodeURIComponent(pitchId)}`, query, undefined);
+  }
+
+  /** Lista las alertas para organizadores (GET /api/admin/alerts) */
+  getAdminAlerts(query?: Query): Promise<Alert[] | null> {
+    return this.request<Alert[] | null>("GET", `/api/admin/alerts`, query, undefined);
+  }
+
+  /** Lista las aplicaciones de la API pública (GET /api/admin/clients) */
+  getAdminClients(query?: Query): Promise<APIClient[] | null> {
+    return this.request<APIClient[] | null>("GET", `/api/admin/clients`, query, undefined);
+  }
+
+  /** Lista los conflictos de sincronización (?status=open) (GET /api/conflicts) */
+  getConflicts(query?: Query): Promise<SyncConflict[] | null> {
+    return this.request<SyncConflict[] | null>("GET", `/api/conflicts`, query, undefined);
+  }
+
+  /** Obtiene un conflicto (GET /api/conflicts/{id}) */
+  getConflictsById(id: string, query?: Query): Promise<SyncConflict> {
+    return this.request<SyncConflict>("GET", `/api/conflicts/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Lista las divisiones de la más alta a la más baja (GET /api/divisions) */
+  getDivisions(query?: Query): Promise<Division[] | null> {
+    return this.request<Division[] | null>("GET", `/api/divisions`, query, undefined);
+  }
+
+  /** Obtiene una división (GET /api/divisions/{id}) */
+  getDivisionsById(id: string, query?: Query): Promise<Division> {
+    return this.request<Division>("GET", `/api/divisions/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Swagger UI sobre /openapi.json (GET /docs) */
+  getDocs(query?: Query): Promise<Response> {
+    return this.send("GET", `/docs`, query, undefined);
+  }
+
+  /** Health check (GET /health) */
+  getHealth(query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("GET", `/health`, query, undefined);
+  }
+
+  /** Lista los partidos (paginado, ?tag=, ?season=) (GET /api/matches) */
+  getMatches(query?: Query): Promise<Match[] | null> {
+    return this.request<Match[] | null>("GET", `/api/matches`, query, undefined);
+  }
+
+  /** Obtiene un partido (GET /api/matches/{id}) */
+  getMatchesById(id: string, query?: Query): Promise<Match> {
+    return this.request<Match>("GET", `/api/matches/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Reloj del partido (GET /api/matches/{id}/clock) */
+  getMatchesByIdClock(id: string, query?: Query): Promise<MatchClock> {
+    return this.request<MatchClock>("GET", `/api/matches/${encodeURIComponent(id)}/clock`, query, undefined);
+  }
+
+  /** Eventos del partido (GET /api/matches/{id}/events) */
+  getMatchesByIdEvents(id: string, query?: Query): Promise<MatchEvent[] | null> {
+    return this.request<MatchEvent[] | null>("GET", `/api/matches/${encodeURIComponent(id)}/events`, query, undefined);
+  }
+
+  /** Obtiene un evento (GET /api/matches/{id}/events/{eventId}) */
+  getMatchesByIdEventsByEventId(id: string, eventId: string, query?: Query): Promise<MatchEvent> {
+    return this.request<MatchEvent>("GET", `/api/matches/${encodeURIComponent(id)}/events/${encodeURIComponent(eventId)}`, query, undefined);
+  }
+
+  /** Walkover del partido (GET /api/matches/{id}/forfeit) */
+  getMatchesByIdForfeit(id: string, query?: Query): Promise<MatchForfeit[] | null> {
+    return this.request<MatchForfeit[] | null>("GET", `/api/matches/${encodeURIComponent(id)}/forfeit`, query, undefined);
+  }
+
+  /** Alineaciones del partido (GET /api/matches/{id}/lineups) */
+  getMatchesByIdLineups(id: string, query?: Query): Promise<Lineup[] | null> {
+    return this.request<Lineup[] | null>("GET", `/api/matches/${encodeURIComponent(id)}/lineups`, query, undefined);
+  }
+
+  /** Galería del partido (GET /api/matches/{id}/media) */
+  getMatchesByIdMedia(id: string, query?: Query): Promise<MatchMedia[] | null> {
+    return this.request<MatchMedia[] | null>("GET", `/api/matches/${encodeURIComponent(id)}/media`, query, undefined);
+  }
+
+  /** Obtiene un elemento de la galería (GET /api/matches/{id}/media/{mediaId}) */
+  getMatchesByIdMediaByMediaId(id: string, mediaId: string, query?: Query): Promise<MatchMedia> {
+    return this.request<MatchMedia>("GET", `/api/matches/${encodeURIComponent(id)}/media/${encodeURIComponent(mediaId)}`, query, undefined);
+  }
+
+  /** Predicción del resultado (GET /api/matches/{id}/prediction) */
+  getMatchesByIdPrediction(id: string, query?: Query): Promise<MatchPrediction> {
+    return this.request<MatchPrediction>("GET", `/api/matches/${encodeURIComponent(id)}/prediction`, query, undefined);
+  }
+
+  /** Terna arbitral del partido (GET /api/matches/{id}/referees) */
+  getMatchesByIdReferees(id: string, query?: Query): Promise<MatchReferee[] | null> {
+    return this.request<MatchReferee[] | null>("GET", `/api/matches/${encodeURIComponent(id)}/referees`, query, undefined);
+  }
+
+  /** Actualizaciones en vivo del partido (SSE) (GET /api/matches/{id}/stream) */
+  getMatchesByIdStream(id: string, query?: Query): Promise<Response> {
+    return this.send("GET", `/api/matches/${encodeURIComponent(id)}/stream`, query, undefined);
+  }
+
+  /** Partidos de la serie (GET /api/matches/{id}/submatches) */
+  getMatchesByIdSubmatches(id: string, query?: Query): Promise<Match[] | null> {
+    return this.request<Match[] | null>("GET", `/api/matches/${encodeURIComponent(id)}/submatches`, query, undefined);
+  }
+
+  /** Cambios del partido (GET /api/matches/{id}/substitutions) */
+  getMatchesByIdSubstitutions(id: string, query?: Query): Promise<Substitution[] | null> {
+    return this.request<Substitution[] | null>("GET", `/api/matches/${encodeURIComponent(id)}/substitutions`, query, undefined);
+  }
+
+  /** Obtiene un cambio (GET /api/matches/{id}/substitutions/{substitutionId}) */
+  getMatchesByIdSubstitutionsBySubstitutionId(id: string, substitutionId: string, query?: Query): Promise<Substitution> {
+    return this.request<Substitution>("GET", `/api/matches/${encodeURIComponent(id)}/substitutions/${encodeURIComponent(substitutionId)}`, query, undefined);
+  }
+
+  /** Etiquetas del partido (GET /api/matches/{id}/tags) */
+  getMatchesByIdTags(id: string, query?: Query): Promise<Tag[] | null> {
+    return this.request<Tag[] | null>("GET", `/api/matches/${encodeURIComponent(id)}/tags`, query, undefined);
+  }
+
+  /** Consumo del límite de peticiones del token (GET /api/me/limits) */
+  getMeLimits(query?: Query): Promise<RateLimitStatus> {
+    return this.request<RateLimitStatus>("GET", `/api/me/limits`, query, undefined);
+  }
+
+  /** Este documento OpenAPI (GET /openapi.json) */
+  getOpenapiJson(query?: Query): Promise<Response> {
+    return this.send("GET", `/openapi.json`, query, undefined);
+  }
+
+  /** Lista los jugadores (paginado, ?tag=) (GET /api/players) */
+  getPlayers(query?: Query): Promise<Player[] | null> {
+    return this.request<Player[] | null>("GET", `/api/players`, query, undefined);
+  }
+
+  /** Obtiene un jugador (GET /api/players/{id}) */
+  getPlayersById(id: string, query?: Query): Promise<Player> {
+    return this.request<Player>("GET", `/api/players/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Lesiones del jugador (GET /api/players/{id}/injuries) */
+  getPlayersByIdInjuries(id: string, query?: Query): Promise<Injury[] | null> {
+    return this.request<Injury[] | null>("GET", `/api/players/${encodeURIComponent(id)}/injuries`, query, undefined);
+  }
+
+  /** Obtiene una lesión (GET /api/players/{id}/injuries/{injuryId}) */
+  getPlayersByIdInjuriesByInjuryId(id: string, injuryId: string, query?: Query): Promise<Injury> {
+    return this.request<Injury>("GET", `/api/players/${encodeURIComponent(id)}/injuries/${encodeURIComponent(injuryId)}`, query, undefined);
+  }
+
+  /** Etiquetas del jugador (GET /api/players/{id}/tags) */
+  getPlayersByIdTags(id: string, query?: Query): Promise<Tag[] | null> {
+    return this.request<Tag[] | null>("GET", `/api/players/${encodeURIComponent(id)}/tags`, query, undefined);
+  }
+
+  /** Historial de pases del jugador (GET /api/players/{id}/transfers) */
+  getPlayersByIdTransfers(id: string, query?: Query): Promise<Transfer[] | null> {
+    return this.request<Transfer[] | null>("GET", `/api/players/${encodeURIComponent(id)}/transfers`, query, undefined);
+  }
+
+  /** Lista los resultados recibidos por email (?status=pending) (GET /api/provisional-results) */
+  getProvisionalResults(query?: Query): Promise<ProvisionalResult[] | null> {
+    return this.request<ProvisionalResult[] | null>("GET", `/api/provisional-results`, query, undefined);
+  }
+
+  /** Obtiene un resultado provisorio (GET /api/provisional-results/{id}) */
+  getProvisionalResultsById(id: string, query?: Query): Promise<ProvisionalResult> {
+    return this.request<ProvisionalResult>("GET", `/api/provisional-results/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Ranking Elo de los equipos (GET /api/ratings) */
+  getRatings(query?: Query): Promise<TeamRating[] | null> {
+    return this.request<TeamRating[] | null>("GET", `/api/ratings`, query, undefined);
+  }
+
+  /** Readiness para el balanceador (GET /ready) */
+  getReady(query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("GET", `/ready`, query, undefined);
+  }
+
+  /** Lista los árbitros (GET /api/referees) */
+  getReferees(query?: Query): Promise<Referee[] | null> {
+    return this.request<Referee[] | null>("GET", `/api/referees`, query, undefined);
+  }
+
+  /** Obtiene un árbitro (GET /api/referees/{id}) */
+  getRefereesById(id: string, query?: Query): Promise<Referee> {
+    return this.request<Referee>("GET", `/api/referees/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Busca jugadores, equipos y torneos por nombre (GET /api/search) */
+  getSearch(query?: Query): Promise<SearchResult[] | null> {
+    return this.request<SearchResult[] | null>("GET", `/api/search`, query, undefined);
+  }
+
+  /** Lista las temporadas (GET /api/seasons) */
+  getSeasons(query?: Query): Promise<Season[] | null> {
+    return this.request<Season[] | null>("GET", `/api/seasons`, query, undefined);
+  }
+
+  /** Obtiene una temporada (GET /api/seasons/{id}) */
+  getSeasonsById(id: string, query?: Query): Promise<Season> {
+    return this.request<Season>("GET", `/api/seasons/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Estado de los servicios (GET /api/status) */
+  getStatus(query?: Query): Promise<ServiceStatus> {
+    return this.request<ServiceStatus>("GET", `/api/status`, query, undefined);
+  }
+
+  /** Lista las etiquetas (GET /api/tags) */
+  getTags(query?: Query): Promise<Tag[] | null> {
+    return this.request<Tag[] | null>("GET", `/api/tags`, query, undefined);
+  }
+
+  /** Obtiene una etiqueta (GET /api/tags/{id}) */
+  getTagsById(id: string, query?: Query): Promise<Tag> {
+    return this.request<Tag>("GET", `/api/tags/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Lista los equipos (paginado, ?tag=) (GET /api/teams) */
+  getTeams(query?: Query): Promise<Team[] | null> {
+    return this.request<Team[] | null>("GET", `/api/teams`, query, undefined);
+  }
+
+  /** Obtiene un equipo (GET /api/teams/{id}) */
+  getTeamsById(id: string, query?: Query): Promise<Team> {
+    return this.request<Team>("GET", `/api/teams/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Jugadores invitados del equipo (GET /api/teams/{id}/guests) */
+  getTeamsByIdGuests(id: string, query?: Query): Promise<Guest[] | null> {
+    return this.request<Guest[] | null>("GET", `/api/teams/${encodeURIComponent(id)}/guests`, query, undefined);
+  }
+
+  /** Plantilla del equipo (GET /api/teams/{id}/players) */
+  getTeamsByIdPlayers(id: string, query?: Query): Promise<Player[] | null> {
+    return this.request<Player[] | null>("GET", `/api/teams/${encodeURIComponent(id)}/players`, query, undefined);
+  }
+
+  /** Rating Elo e historial del equipo (GET /api/teams/{id}/rating) */
+  getTeamsByIdRating(id: string, query?: Query): Promise<TeamRating> {
+    return this.request<TeamRating>("GET", `/api/teams/${encodeURIComponent(id)}/rating`, query, undefined);
+  }
+
+  /** Cuerpo técnico del equipo (GET /api/teams/{id}/staff) */
+  getTeamsByIdStaff(id: string, query?: Query): Promise<Staff[] | null> {
+    return this.request<Staff[] | null>("GET", `/api/teams/${encodeURIComponent(id)}/staff`, query, undefined);
+  }
+
+  /** Obtiene un miembro del cuerpo técnico (GET /api/teams/{id}/staff/{staffId}) */
+  getTeamsByIdStaffByStaffId(id: string, staffId: string, query?: Query): Promise<Staff> {
+    return this.request<Staff>("GET", `/api/teams/${encodeURIComponent(id)}/staff/${encodeURIComponent(staffId)}`, query, undefined);
+  }
+
+  /** Etiquetas del equipo (GET /api/teams/{id}/tags) */
+  getTeamsByIdTags(id: string, query?: Query): Promise<Tag[] | null> {
+    return this.request<Tag[] | null>("GET", `/api/teams/${encodeURIComponent(id)}/tags`, query, undefined);
+  }
+
+  /** Comprueba si un nombre de equipo está libre (GET /api/teams/check-name) */
+  getTeamsCheckName(query?: Query): Promise<NameCheck> {
+    return this.request<NameCheck>("GET", `/api/teams/check-name`, query, undefined);
+  }
+
+  /** Lista los torneos (paginado, ?season=) (GET /api/tournaments) */
+  getTournaments(query?: Query): Promise<Tournament[] | null> {
+    return this.request<Tournament[] | null>("GET", `/api/tournaments`, query, undefined);
+  }
+
+  /** Obtiene un torneo (GET /api/tournaments/{id}) */
+  getTournamentsById(id: string, query?: Query): Promise<Tournament> {
+    return this.request<Tournament>("GET", `/api/tournaments/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Analíticas del torneo (GET /api/tournaments/{id}/analytics) */
+  getTournamentsByIdAnalytics(id: string, query?: Query): Promise<TournamentAnalytics> {
+    return this.request<TournamentAnalytics>("GET", `/api/tournaments/${encodeURIComponent(id)}/analytics`, query, undefined);
+  }
+
+  /** Tabla de asistencias (GET /api/tournaments/{id}/assists) */
+  getTournamentsByIdAssists(id: string, query?: Query): Promise<TopAssister[] | null> {
+    return this.request<TopAssister[] | null>("GET", `/api/tournaments/${encodeURIComponent(id)}/assists`, query, undefined);
+  }
+
+  /** Vallas invictas (GET /api/tournaments/{id}/cleansheets) */
+  getTournamentsByIdCleansheets(id: string, query?: Query): Promise<CleanSheets> {
+    return this.request<CleanSheets>("GET", `/api/tournaments/${encodeURIComponent(id)}/cleansheets`, query, undefined);
+  }
+
+  /** Categorías de la competición (GET /api/tournaments/{id}/divisions) */
+  getTournamentsByIdDivisions(id: string, query?: Query): Promise<CompetitionDivision[] | null> {
+    return this.request<CompetitionDivision[] | null>("GET", `/api/tournaments/${encodeURIComponent(id)}/divisions`, query, undefined);
+  }
+
+  /** Estadísticas por categoría (GET /api/tournaments/{id}/divisions/stats) */
+  getTournamentsByIdDivisionsStats(id: string, query?: Query): Promise<CompetitionStats> {
+    return this.request<CompetitionStats>("GET", `/api/tournaments/${encodeURIComponent(id)}/divisions/stats`, query, undefined);
+  }
+
+  /** Sorteos del torneo (GET /api/tournaments/{id}/draws) */
+  getTournamentsByIdDraws(id: string, query?: Query): Promise<Draw[] | null> {
+    return this.request<Draw[] | null>("GET", `/api/tournaments/${encodeURIComponent(id)}/draws`, query, undefined);
+  }
+
+  /** Obtiene un sorteo (GET /api/tournaments/{id}/draws/{drawId}) */
+  getTournamentsByIdDrawsByDrawId(id: string, drawId: string, query?: Query): Promise<Draw> {
+    return this.request<Draw>("GET", `/api/tournaments/${encodeURIComponent(id)}/draws/${encodeURIComponent(drawId)}`, query, undefined);
+  }
+
+  /** Reproduce el sorteo bola a bola (SSE) (GET /api/tournaments/{id}/draws/{drawId}/stream) */
+  getTournamentsByIdDrawsByDrawIdStream(id: string, drawId: string, query?: Query): Promise<Response> {
+    return this.send("GET", `/api/tournaments/${encodeURIComponent(id)}/draws/${encodeURIComponent(drawId)}/stream`, query, undefined);
+  }
+
+  /** Exporta el fixture (?format=csv|json) (GET /api/tournaments/{id}/fixtures/export) */
+  getTournamentsByIdFixturesExport(id: string, query?: Query): Promise<Fixture[] | null> {
+    return this.request<Fixture[] | null>("GET", `/api/tournaments/${encodeURIComponent(id)}/fixtures/export`, query, undefined);
+  }
+
+  /** Fichajes del torneo (GET /api/tournaments/{id}/registrations) */
+  getTournamentsByIdRegistrations(id: string, query?: Query): Promise<Registration[] | null> {
+    return this.request<Registration[] | null>("GET", `/api/tournaments/${encodeURIComponent(id)}/registrations`, query, undefined);
+  }
+
+  /** Jornadas del torneo (GET /api/tournaments/{id}/rounds) */
+  getTournamentsByIdRounds(id: string, query?: Query): Promise<RoundSummary[] | null> {
+    return this.request<RoundSummary[] | null>("GET", `/api/tournaments/${encodeURIComponent(id)}/rounds`, query, undefined);
+  }
+
+  /** Partidos de una jornada (GET /api/tournaments/{id}/rounds/{round}/matches) */
+  getTournamentsByIdRoundsByRoundMatches(id: string, round: string, query?: Query): Promise<Match[] | null> {
+    return this.request<Match[] | null>("GET", `/api/tournaments/${encodeURIComponent(id)}/rounds/${encodeURIComponent(round)}/matches`, query, undefined);
+  }
+
+  /** Reglamento del torneo (GET /api/tournaments/{id}/rules) */
+  getTournamentsByIdRules(id: string, query?: Query): Promise<TournamentRules> {
+    return this.request<TournamentRules>("GET", `/api/tournaments/${encodeURIComponent(id)}/rules`, query, undefined);
+  }
+
+  /** Sanciones del torneo (GET /api/tournaments/{id}/sanctions) */
+  getTournamentsByIdSanctions(id: string, query?: Query): Promise<Sanction[] | null> {
+    return this.request<Sanction[] | null>("GET", `/api/tournaments/${encodeURIComponent(id)}/sanctions`, query, undefined);
+  }
+
+  /** Obtiene una sanción (GET /api/tournaments/{id}/sanctions/{sanctionId}) */
+  getTournamentsByIdSanctionsBySanctionId(id: string, sanctionId: string, query?: Query): Promise<Sanction> {
+    return this.request<Sanction>("GET", `/api/tournaments/${encodeURIComponent(id)}/sanctions/${encodeURIComponent(sanctionId)}`, query, undefined);
+  }
+
+  /** Sugiere bombos según torneos anteriores (GET /api/tournaments/{id}/seeding) */
+  getTournamentsByIdSeeding(id: string, query?: Query): Promise<SeedingSuggestion> {
+    return this.request<SeedingSuggestion>("GET", `/api/tournaments/${encodeURIComponent(id)}/seeding`, query, undefined);
+  }
+
+  /** Patrocinadores del torneo (GET /api/tournaments/{id}/sponsors) */
+  getTournamentsByIdSponsors(id: string, query?: Query): Promise<Sponsor[] | null> {
+    return this.request<Sponsor[] | null>("GET", `/api/tournaments/${encodeURIComponent(id)}/sponsors`, query, undefined);
+  }
+
+  /** Patrocinadores vigentes (?placement=) (GET /api/tournaments/{id}/sponsors/active) */
+  getTournamentsByIdSponsorsActive(id: string, query?: Query): Promise<Sponsor[] | null> {
+    return this.request<Sponsor[] | null>("GET", `/api/tournaments/${encodeURIComponent(id)}/sponsors/active`, query, undefined);
+  }
+
+  /** Obtiene un patrocinador (GET /api/tournaments/{id}/sponsors/{sponsorId}) */
+  getTournamentsByIdSponsorsBySponsorId(id: string, sponsorId: string, query?: Query): Promise<Sponsor> {
+    return this.request<Sponsor>("GET", `/api/tournaments/${encodeURIComponent(id)}/sponsors/${encodeURIComponent(sponsorId)}`, query, undefined);
+  }
+
+  /** Fases del torneo (GET /api/tournaments/{id}/stages) */
+  getTournamentsByIdStages(id: string, query?: Query): Promise<Stage[] | null> {
+    return this.request<Stage[] | null>("GET", `/api/tournaments/${encodeURIComponent(id)}/stages`, query, undefined);
+  }
+
+  /** Obtiene una fase (GET /api/tournaments/{id}/stages/{stageId}) */
+  getTournamentsByIdStagesByStageId(id: string, stageId: string, query?: Query): Promise<Stage> {
+    return this.request<Stage>("GET", `/api/tournaments/${encodeURIComponent(id)}/stages/${encodeURIComponent(stageId)}`, query, undefined);
+  }
+
+  /** Partidos de la fase (GET /api/tournaments/{id}/stages/{stageId}/matches) */
+  getTournamentsByIdStagesByStageIdMatches(id: string, stageId: string, query?: Query): Promise<Match[] | null> {
+    return this.request<Match[] | null>("GET", `/api/tournaments/${encodeURIComponent(id)}/stages/${encodeURIComponent(stageId)}/matches`, query, undefined);
+  }
+
+  /** Tabla de posiciones (?round=) (GET /api/tournaments/{id}/standings) */
+  getTournamentsByIdStandings(id: string, query?: Query): Promise<Standing[] | null> {
+    return this.request<Standing[] | null>("GET", `/api/tournaments/${encodeURIComponent(id)}/standings`, query, undefined);
+  }
+
+  /** Equipos inscritos (GET /api/tournaments/{id}/teams) */
+  getTournamentsByIdTeams(id: string, query?: Query): Promise<Team[] | null> {
+    return this.request<Team[] | null>("GET", `/api/tournaments/${encodeURIComponent(id)}/teams`, query, undefined);
+  }
+
+  /** Tabla de goleadores (GET /api/tournaments/{id}/topscorers) */
+  getTournamentsByIdTopscorers(id: string, query?: Query): Promise<TopScorer[] | null> {
+    return this.request<TopScorer[] | null>("GET", `/api/tournaments/${encodeURIComponent(id)}/topscorers`, query, undefined);
+  }
+
+  /** Planilla Excel con tabla, fixture y goleadores (GET /api/tournaments/{id}/workbook.xlsx) */
+  getTournamentsByIdWorkbookXlsx(id: string, query?: Query): Promise<Response> {
+    return this.send("GET", `/api/tournaments/${encodeURIComponent(id)}/workbook.xlsx`, query, undefined);
+  }
+
+  /** Comprueba si un nombre de torneo está libre (GET /api/tournaments/check-name) */
+  getTournamentsCheckName(query?: Query): Promise<NameCheck> {
+    return this.request<NameCheck>("GET", `/api/tournaments/check-name`, query, undefined);
+  }
+
+  /** Lista los partidos (paginado, ?tag=, ?season=) (GET /api/v1/matches) */
+  getV1Matches(query?: Query): Promise<Match[] | null> {
+    return this.request<Match[] | null>("GET", `/api/v1/matches`, query, undefined);
+  }
+
+  /** Obtiene un partido (GET /api/v1/matches/{id}) */
+  getV1MatchesById(id: string, query?: Query): Promise<Match> {
+    return this.request<Match>("GET", `/api/v1/matches/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Reloj del partido (GET /api/v1/matches/{id}/clock) */
+  getV1MatchesByIdClock(id: string, query?: Query): Promise<MatchClock> {
+    return this.request<MatchClock>("GET", `/api/v1/matches/${encodeURIComponent(id)}/clock`, query, undefined);
+  }
+
+  /** Eventos del partido (GET /api/v1/matches/{id}/events) */
+  getV1MatchesByIdEvents(id: string, query?: Query): Promise<MatchEvent[] | null> {
+    return this.request<MatchEvent[] | null>("GET", `/api/v1/matches/${encodeURIComponent(id)}/events`, query, undefined);
+  }
+
+  /** Obtiene un evento (GET /api/v1/matches/{id}/events/{eventId}) */
+  getV1MatchesByIdEventsByEventId(id: string, eventId: string, query?: Query): Promise<MatchEvent> {
+    return this.request<MatchEvent>("GET", `/api/v1/matches/${encodeURIComponent(id)}/events/${encodeURIComponent(eventId)}`, query, undefined);
+  }
+
+  /** Walkover del partido (GET /api/v1/matches/{id}/forfeit) */
+  getV1MatchesByIdForfeit(id: string, query?: Query): Promise<MatchForfeit[] | null> {
+    return this.request<MatchForfeit[] | null>("GET", `/api/v1/matches/${encodeURIComponent(id)}/forfeit`, query, undefined);
+  }
+
+  /** Alineaciones del partido (GET /api/v1/matches/{id}/lineups) */
+  getV1MatchesByIdLineups(id: string, query?: Query): Promise<Lineup[] | null> {
+    return this.request<Lineup[] | null>("GET", `/api/v1/matches/${encodeURIComponent(id)}/lineups`, query, undefined);
+  }
+
+  /** Galería del partido (GET /api/v1/matches/{id}/media) */
+  getV1MatchesByIdMedia(id: string, query?: Query): Promise<MatchMedia[] | null> {
+    return this.request<MatchMedia[] | null>("GET", `/api/v1/matches/${encodeURIComponent(id)}/media`, query, undefined);
+  }
+
+  /** Obtiene un elemento de la galería (GET /api/v1/matches/{id}/media/{mediaId}) */
+  getV1MatchesByIdMediaByMediaId(id: string, mediaId: string, query?: Query): Promise<MatchMedia> {
+    return this.request<MatchMedia>("GET", `/api/v1/matches/${encodeURIComponent(id)}/media/${encodeURIComponent(mediaId)}`, query, undefined);
+  }
+
+  /** Predicción del resultado (GET /api/v1/matches/{id}/prediction) */
+  getV1MatchesByIdPrediction(id: string, query?: Query): Promise<MatchPrediction> {
+    return this.request<MatchPrediction>("GET", `/api/v1/matches/${encodeURIComponent(id)}/prediction`, query, undefined);
+  }
+
+  /** Terna arbitral del partido (GET /api/v1/matches/{id}/referees) */
+  getV1MatchesByIdReferees(id: string, query?: Query): Promise<MatchReferee[] | null> {
+    return this.request<MatchReferee[] | null>("GET", `/api/v1/matches/${encodeURIComponent(id)}/referees`, query, undefined);
+  }
+
+  /** Actualizaciones en vivo del partido (SSE) (GET /api/v1/matches/{id}/stream) */
+  getV1MatchesByIdStream(id: string, query?: Query): Promise<Response> {
+    return this.send("GET", `/api/v1/matches/${encodeURIComponent(id)}/stream`, query, undefined);
+  }
+
+  /** Partidos de la serie (GET /api/v1/matches/{id}/submatches) */
+  getV1MatchesByIdSubmatches(id: string, query?: Query): Promise<Match[] | null> {
+    return this.request<Match[] | null>("GET", `/api/v1/matches/${encodeURIComponent(id)}/submatches`, query, undefined);
+  }
+
+  /** Cambios del partido (GET /api/v1/matches/{id}/substitutions) */
+  getV1MatchesByIdSubstitutions(id: string, query?: Query): Promise<Substitution[] | null> {
+    return this.request<Substitution[] | null>("GET", `/api/v1/matches/${encodeURIComponent(id)}/substitutions`, query, undefined);
+  }
+
+  /** Obtiene un cambio (GET /api/v1/matches/{id}/substitutions/{substitutionId}) */
+  getV1MatchesByIdSubstitutionsBySubstitutionId(id: string, substitutionId: string, query?: Query): Promise<Substitution> {
+    return this.request<Substitution>("GET", `/api/v1/matches/${encodeURIComponent(id)}/substitutions/${encodeURIComponent(substitutionId)}`, query, undefined);
+  }
+
+  /** Etiquetas del partido (GET /api/v1/matches/{id}/tags) */
+  getV1MatchesByIdTags(id: string, query?: Query): Promise<Tag[] | null> {
+    return this.request<Tag[] | null>("GET", `/api/v1/matches/${encodeURIComponent(id)}/tags`, query, undefined);
+  }
+
+  /** Tabla de posiciones (?round=) (GET /api/v1/tournaments/{id}/standings) */
+  getV1TournamentsByIdStandings(id: string, query?: Query): Promise<Standing[] | null> {
+    return this.request<Standing[] | null>("GET", `/api/v1/tournaments/${encodeURIComponent(id)}/standings`, query, undefined);
+  }
+
+  /** Lista las sedes (GET /api/venues) */
+  getVenues(query?: Query): Promise<Venue[] | null> {
+    return this.request<Venue[] | null>("GET", `/api/venues`, query, undefined);
+  }
+
+  /** Obtiene una sede (GET /api/venues/{id}) */
+  getVenuesById(id: string, query?: Query): Promise<Venue> {
+    return this.request<Venue>("GET", `/api/venues/${encodeURIComponent(id)}`, query, undefined);
+  }
+
+  /** Lista las canchas de la sede (GET /api/venues/{id}/pitches) */
+  getVenuesByIdPitches(id: string, query?: Query): Promise<Pitch[] | null> {
+    return this.request<Pitch[] | null>("GET", `/api/venues/${encodeURIComponent(id)}/pitches`, query, undefined);
+  }
+
+  /** Obtiene una cancha (GET /api/venues/{id}/pitches/{pitchId}) */
+  getVenuesByIdPitchesByPitchId(id: string, pitchId: string, query?: Query): Promise<Pitch> {
+    return this.request<Pitch>("GET", `/api/venues/${encodeURIComponent(id)}/pitches/${encodeURIComponent(pitchId)}`, query, undefined);
+  }
+
+  /** Marcador de la sede para pantallas (GET /api/venues/{id}/scoreboard) */
+  getVenuesByIdScoreboard(id: string, query?: Query): Promise<Scoreboard> {
+    return this.request<Scoreboard>("GET", `/api/venues/${encodeURIComponent(id)}/scoreboard`, query, undefined);
+  }
+
+  /** Marcador de la sede por Server-Sent Events (GET /api/venues/{id}/scoreboard/stream) */
+  getVenuesByIdScoreboardStream(id: string, query?: Query): Promise<Response> {
+    return this.send("GET", `/api/venues/${encodeURIComponent(id)}/scoreboard/stream`, query, undefined);
+  }
+
+  /** Versión del binario desplegado (GET /version) */
+  getVersion(query?: Query): Promise<VersionInfo> {
+    return this.request<VersionInfo>("GET", `/version`, query, undefined);
+  }
+
+  /** Actualizaciones en vivo por WebSocket (?match_id=) (GET /ws) */
+  getWs(query?: Query): Promise<Response> {
+    return this.send("GET", `/ws`, query, undefined);
+  }
+
+  /** Descarta una alerta (POST /api/admin/alerts/{id}/dismiss) */
+  postAdminAlertsByIdDismiss(id: string, body?: BodyInit, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("POST", `/api/admin/alerts/${encodeURIComponent(id)}/dismiss`, query, body);
+  }
+
+  /** Registra una aplicación de la API pública (POST /api/admin/clients) */
+  postAdminClients(body: ApiClientInput, query?: Query): Promise<APIClientCredentials> {
+    return this.request<APIClientCredentials>("POST", `/api/admin/clients`, query, body);
+  }
+
+  /** Revoca una aplicación y sus tokens (POST /api/admin/clients/{id}/revoke) */
+  postAdminClientsByIdRevoke(id: string, body?: BodyInit, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("POST", `/api/admin/clients/${encodeURIComponent(id)}/revoke`, query, body);
+  }
+
+  /** Abre un incidente en la página de estado (POST /api/admin/incidents) */
+  postAdminIncidents(body: IncidentInput, query?: Query, idempotencyKey?: string): Promise<Incident> {
+    return this.request<Incident>("POST", `/api/admin/incidents`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Resuelve un incidente (POST /api/admin/incidents/{id}/resolve) */
+  postAdminIncidentsByIdResolve(id: string, body?: BodyInit, query?: Query): Promise<Incident> {
+    return this.request<Incident>("POST", `/api/admin/incidents/${encodeURIComponent(id)}/resolve`, query, body);
+  }
+
+  /** Borra los datos de prueba (?dry_run=true) (POST /api/admin/test-data/purge) */
+  postAdminTestDataPurge(body?: BodyInit, query?: Query): Promise<PurgeReport> {
+    return this.request<PurgeReport>("POST", `/api/admin/test-data/purge`, query, body);
+  }
+
+  /** Resuelve un conflicto (POST /api/conflicts/{id}/resolve) */
+  postConflictsByIdResolve(id: string, body: ConflictResolutionInput, query?: Query): Promise<SyncConflict> {
+    return this.request<SyncConflict>("POST", `/api/conflicts/${encodeURIComponent(id)}/resolve`, query, body);
+  }
+
+  /** Crea una división (POST /api/divisions) */
+  postDivisions(body: DivisionInput, query?: Query, idempotencyKey?: string): Promise<Division> {
+    return this.request<Division>("POST", `/api/divisions`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Aplica los ascensos y descensos del cierre de temporada (POST /api/divisions/promotions) */
+  postDivisionsPromotions(body: PromotionsInput, query?: Query): Promise<PromotionReport> {
+    return this.request<PromotionReport>("POST", `/api/divisions/promotions`, query, body);
+  }
+
+  /** Importa jugadores desde un CSV (?dry_run=true) (POST /api/import/players) */
+  postImportPlayers(body?: BodyInit, query?: Query): Promise<ImportReport> {
+    return this.request<ImportReport>("POST", `/api/import/players`, query, body);
+  }
+
+  /** Importa equipos desde un CSV (?dry_run=true) (POST /api/import/teams) */
+  postImportTeams(body?: BodyInit, query?: Query): Promise<ImportReport> {
+    return this.request<ImportReport>("POST", `/api/import/teams`, query, body);
+  }
+
+  /** Webhook de correos de resultados (POST /api/inbound/email) */
+  postInboundEmail(body: InboundEmailInput, query?: Query): Promise<ResultEmailReport> {
+    return this.request<ResultEmailReport>("POST", `/api/inbound/email`, query, body);
+  }
+
+  /** Crea un partido (POST /api/matches) */
+  postMatches(body: MatchInput, query?: Query, idempotencyKey?: string): Promise<Match> {
+    return this.request<Match>("POST", `/api/matches`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Acción end_period del reloj (POST /api/matches/{id}/clock/end_period) */
+  postMatchesByIdClockEndPeriod(id: string, body?: BodyInit, query?: Query): Promise<MatchClock> {
+    return this.request<MatchClock>("POST", `/api/matches/${encodeURIComponent(id)}/clock/end_period`, query, body);
+  }
+
+  /** Acción finish del reloj (POST /api/matches/{id}/clock/finish) */
+  postMatchesByIdClockFinish(id: string, body?: BodyInit, query?: Query): Promise<MatchClock> {
+    return this.request<MatchClock>("POST", `/api/matches/${encodeURIComponent(id)}/clock/finish`, query, body);
+  }
+
+  /** Acción pause del reloj (POST /api/matches/{id}/clock/pause) */
+  postMatchesByIdClockPause(id: string, body?: BodyInit, query?: Query): Promise<MatchClock> {
+    return this.request<MatchClock>("POST", `/api/matches/${encodeURIComponent(id)}/clock/pause`, query, body);
+  }
+
+  /** Acción resume del reloj (POST /api/matches/{id}/clock/resume) */
+  postMatchesByIdClockResume(id: string, body?: BodyInit, query?: Query): Promise<MatchClock> {
+    return this.request<MatchClock>("POST", `/api/matches/${encodeURIComponent(id)}/clock/resume`, query, body);
+  }
+
+  /** Acción start del reloj (POST /api/matches/{id}/clock/start) */
+  postMatchesByIdClockStart(id: string, body?: BodyInit, query?: Query): Promise<MatchClock> {
+    return this.request<MatchClock>("POST", `/api/matches/${encodeURIComponent(id)}/clock/start`, query, body);
+  }
+
+  /** Acción stoppage del reloj (POST /api/matches/{id}/clock/stoppage) */
+  postMatchesByIdClockStoppage(id: string, body: ClockInput, query?: Query): Promise<MatchClock> {
+    return this.request<MatchClock>("POST", `/api/matches/${encodeURIComponent(id)}/clock/stoppage`, query, body);
+  }
+
+  /** Registra un gol, tarjeta u otro evento (POST /api/matches/{id}/events) */
+  postMatchesByIdEvents(id: string, body: MatchEventInput, query?: Query, idempotencyKey?: string): Promise<MatchEvent> {
+    return this.request<MatchEvent>("POST", `/api/matches/${encodeURIComponent(id)}/events`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Da el partido por walkover (POST /api/matches/{id}/forfeit) */
+  postMatchesByIdForfeit(id: string, body: ForfeitInput, query?: Query): Promise<MatchForfeit> {
+    return this.request<MatchForfeit>("POST", `/api/matches/${encodeURIComponent(id)}/forfeit`, query, body);
+  }
+
+  /** Guarda la alineación de un equipo (POST /api/matches/{id}/lineups) */
+  postMatchesByIdLineups(id: string, body: LineupInput, query?: Query): Promise<Lineup> {
+    return this.request<Lineup>("POST", `/api/matches/${encodeURIComponent(id)}/lineups`, query, body);
+  }
+
+  /** Agrega una foto o video (POST /api/matches/{id}/media) */
+  postMatchesByIdMedia(id: string, body: MediaInput, query?: Query, idempotencyKey?: string): Promise<MatchMedia> {
+    return this.request<MatchMedia>("POST", `/api/matches/${encodeURIComponent(id)}/media`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Agrega un partido a la serie (POST /api/matches/{id}/submatches) */
+  postMatchesByIdSubmatches(id: string, body: SubMatchInput, query?: Query, idempotencyKey?: string): Promise<Match> {
+    return this.request<Match>("POST", `/api/matches/${encodeURIComponent(id)}/submatches`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Registra un cambio (POST /api/matches/{id}/substitutions) */
+  postMatchesByIdSubstitutions(id: string, body: SubstitutionInput, query?: Query, idempotencyKey?: string): Promise<Substitution> {
+    return this.request<Substitution>("POST", `/api/matches/${encodeURIComponent(id)}/substitutions`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Emite un token de acceso (client credentials) (POST /oauth/token) */
+  postOauthToken(body?: BodyInit, query?: Query): Promise<TokenResponse> {
+    return this.request<TokenResponse>("POST", `/oauth/token`, query, body);
+  }
+
+  /** Crea un jugador (POST /api/players) */
+  postPlayers(body: PlayerInput, query?: Query, idempotencyKey?: string): Promise<Player> {
+    return this.request<Player>("POST", `/api/players`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Crea un lote de jugadores en una transacción (POST /api/players/bulk) */
+  postPlayersBulk(body: PlayerInput[] | null, query?: Query, idempotencyKey?: string): Promise<BulkPlayerReport> {
+    return this.request<BulkPlayerReport>("POST", `/api/players/bulk`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Registra una lesión (POST /api/players/{id}/injuries) */
+  postPlayersByIdInjuries(id: string, body: InjuryInput, query?: Query, idempotencyKey?: string): Promise<Injury> {
+    return this.request<Injury>("POST", `/api/players/${encodeURIComponent(id)}/injuries`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Confirma el resultado y lo carga en el partido (POST /api/provisional-results/{id}/confirm) */
+  postProvisionalResultsByIdConfirm(id: string, body?: BodyInit, query?: Query): Promise<ProvisionalResult> {
+    return this.request<ProvisionalResult>("POST", `/api/provisional-results/${encodeURIComponent(id)}/confirm`, query, body);
+  }
+
+  /** Rechaza el resultado (POST /api/provisional-results/{id}/reject) */
+  postProvisionalResultsByIdReject(id: string, body?: BodyInit, query?: Query): Promise<ProvisionalResult> {
+    return this.request<ProvisionalResult>("POST", `/api/provisional-results/${encodeURIComponent(id)}/reject`, query, body);
+  }
+
+  /** Recalcula el ranking Elo desde cero (POST /api/ratings/recalculate) */
+  postRatingsRecalculate(body?: BodyInit, query?: Query): Promise<TeamRating[] | null> {
+    return this.request<TeamRating[] | null>("POST", `/api/ratings/recalculate`, query, body);
+  }
+
+  /** Registra un árbitro (POST /api/referees) */
+  postReferees(body: RefereeInput, query?: Query, idempotencyKey?: string): Promise<Referee> {
+    return this.request<Referee>("POST", `/api/referees`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Crea una temporada (POST /api/seasons) */
+  postSeasons(body: SeasonInput, query?: Query, idempotencyKey?: string): Promise<Season> {
+    return this.request<Season>("POST", `/api/seasons`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Aplica un lote de operaciones cargadas sin conexión (POST /api/sync/batch) */
+  postSyncBatch(body: SyncBatchInput, query?: Query): Promise<SyncBatchResponse> {
+    return this.request<SyncBatchResponse>("POST", `/api/sync/batch`, query, body);
+  }
+
+  /** Crea una etiqueta (POST /api/tags) */
+  postTags(body: TagInput, query?: Query, idempotencyKey?: string): Promise<Tag> {
+    return this.request<Tag>("POST", `/api/tags`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Crea un equipo (POST /api/teams) */
+  postTeams(body: TeamInput, query?: Query, idempotencyKey?: string): Promise<Team> {
+    return this.request<Team>("POST", `/api/teams`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Pasa un invitado a la plantilla (POST /api/teams/{id}/guests/promote) */
+  postTeamsByIdGuestsPromote(id: string, body: GuestPromotionInput, query?: Query): Promise<GuestPromotion> {
+    return this.request<GuestPromotion>("POST", `/api/teams/${encodeURIComponent(id)}/guests/promote`, query, body);
+  }
+
+  /** Agrega un jugador a la plantilla (POST /api/teams/{id}/players/{playerId}) */
+  postTeamsByIdPlayersByPlayerId(id: string, playerId: string, body?: BodyInit, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("POST", `/api/teams/${encodeURIComponent(id)}/players/${encodeURIComponent(playerId)}`, query, body);
+  }
+
+  /** Agrega un miembro del cuerpo técnico (POST /api/teams/{id}/staff) */
+  postTeamsByIdStaff(id: string, body: StaffInput, query?: Query, idempotencyKey?: string): Promise<Staff> {
+    return this.request<Staff>("POST", `/api/teams/${encodeURIComponent(id)}/staff`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Crea un torneo (POST /api/tournaments) */
+  postTournaments(body: TournamentInput, query?: Query, idempotencyKey?: string): Promise<Tournament> {
+    return this.request<Tournament>("POST", `/api/tournaments`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Recalcula las analíticas (POST /api/tournaments/{id}/analytics/refresh) */
+  postTournamentsByIdAnalyticsRefresh(id: string, body?: BodyInit, query?: Query): Promise<TournamentAnalytics> {
+    return this.request<TournamentAnalytics>("POST", `/api/tournaments/${encodeURIComponent(id)}/analytics/refresh`, query, body);
+  }
+
+  /** Archiva el torneo (POST /api/tournaments/{id}/archive) */
+  postTournamentsByIdArchive(id: string, body?: BodyInit, query?: Query): Promise<Tournament> {
+    return this.request<Tournament>("POST", `/api/tournaments/${encodeURIComponent(id)}/archive`, query, body);
+  }
+
+  /** Pasa el torneo a cancelled (POST /api/tournaments/{id}/cancel) */
+  postTournamentsByIdCancel(id: string, body?: BodyInit, query?: Query): Promise<Tournament> {
+    return this.request<Tournament>("POST", `/api/tournaments/${encodeURIComponent(id)}/cancel`, query, body);
+  }
+
+  /** Pasa el torneo a completed (POST /api/tournaments/{id}/complete) */
+  postTournamentsByIdComplete(id: string, body?: BodyInit, query?: Query): Promise<Tournament> {
+    return this.request<Tournament>("POST", `/api/tournaments/${encodeURIComponent(id)}/complete`, query, body);
+  }
+
+  /** Realiza un sorteo (POST /api/tournaments/{id}/draws) */
+  postTournamentsByIdDraws(id: string, body: DrawOptions, query?: Query, idempotencyKey?: string): Promise<Draw> {
+    return this.request<Draw>("POST", `/api/tournaments/${encodeURIComponent(id)}/draws`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Importa un fixture (POST /api/tournaments/{id}/fixtures/import) */
+  postTournamentsByIdFixturesImport(id: string, body: Fixture[] | null, query?: Query): Promise<FixtureImportReport> {
+    return this.request<FixtureImportReport>("POST", `/api/tournaments/${encodeURIComponent(id)}/fixtures/import`, query, body);
+  }
+
+  /** Pasa el torneo a registration_open (POST /api/tournaments/{id}/open-registration) */
+  postTournamentsByIdOpenRegistration(id: string, body?: BodyInit, query?: Query): Promise<Tournament> {
+    return this.request<Tournament>("POST", `/api/tournaments/${encodeURIComponent(id)}/open-registration`, query, body);
+  }
+
+  /** Ficha un jugador (POST /api/tournaments/{id}/registrations) */
+  postTournamentsByIdRegistrations(id: string, body: RegistrationInput, query?: Query, idempotencyKey?: string): Promise<Registration> {
+    return this.request<Registration>("POST", `/api/tournaments/${encodeURIComponent(id)}/registrations`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Restaura un torneo archivado (POST /api/tournaments/{id}/restore) */
+  postTournamentsByIdRestore(id: string, body?: BodyInit, query?: Query): Promise<Tournament> {
+    return this.request<Tournament>("POST", `/api/tournaments/${encodeURIComponent(id)}/restore`, query, body);
+  }
+
+  /** Asigna horarios y canchas a la jornada (POST /api/tournaments/{id}/rounds/{round}/timetable) */
+  postTournamentsByIdRoundsByRoundTimetable(id: string, round: string, body: TimetableOptions, query?: Query): Promise<Timetable> {
+    return this.request<Timetable>("POST", `/api/tournaments/${encodeURIComponent(id)}/rounds/${encodeURIComponent(round)}/timetable`, query, body);
+  }
+
+  /** Registra una sanción (POST /api/tournaments/{id}/sanctions) */
+  postTournamentsByIdSanctions(id: string, body: SanctionInput, query?: Query, idempotencyKey?: string): Promise<Sanction> {
+    return this.request<Sanction>("POST", `/api/tournaments/${encodeURIComponent(id)}/sanctions`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Divide la liga en grupos de campeonato y descenso (POST /api/tournaments/{id}/split) */
+  postTournamentsByIdSplit(id: string, body: SplitOptions, query?: Query): Promise<SplitResult> {
+    return this.request<SplitResult>("POST", `/api/tournaments/${encodeURIComponent(id)}/split`, query, body);
+  }
+
+  /** Agrega un patrocinador (POST /api/tournaments/{id}/sponsors) */
+  postTournamentsByIdSponsors(id: string, body: SponsorInput, query?: Query, idempotencyKey?: string): Promise<Sponsor> {
+    return this.request<Sponsor>("POST", `/api/tournaments/${encodeURIComponent(id)}/sponsors`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Crea una fase (POST /api/tournaments/{id}/stages) */
+  postTournamentsByIdStages(id: string, body: StageInput, query?: Query, idempotencyKey?: string): Promise<Stage> {
+    return this.request<Stage>("POST", `/api/tournaments/${encodeURIComponent(id)}/stages`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Pasa los clasificados a la fase siguiente (POST /api/tournaments/{id}/stages/advance) */
+  postTournamentsByIdStagesAdvance(id: string, body?: BodyInit, query?: Query): Promise<Stage[] | null> {
+    return this.request<Stage[] | null>("POST", `/api/tournaments/${encodeURIComponent(id)}/stages/advance`, query, body);
+  }
+
+  /** Pasa el torneo a in_progress (POST /api/tournaments/{id}/start) */
+  postTournamentsByIdStart(id: string, body?: BodyInit, query?: Query): Promise<Tournament> {
+    return this.request<Tournament>("POST", `/api/tournaments/${encodeURIComponent(id)}/start`, query, body);
+  }
+
+  /** Inscribe un equipo (POST /api/tournaments/{id}/teams/{teamId}) */
+  postTournamentsByIdTeamsByTeamId(id: string, teamId: string, body?: BodyInit, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("POST", `/api/tournaments/${encodeURIComponent(id)}/teams/${encodeURIComponent(teamId)}`, query, body);
+  }
+
+  /** Crea una sede (POST /api/venues) */
+  postVenues(body: VenueInput, query?: Query, idempotencyKey?: string): Promise<Venue> {
+    return this.request<Venue>("POST", `/api/venues`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Agrega una cancha a la sede (POST /api/venues/{id}/pitches) */
+  postVenuesByIdPitches(id: string, body: PitchInput, query?: Query, idempotencyKey?: string): Promise<Pitch> {
+    return this.request<Pitch>("POST", `/api/venues/${encodeURIComponent(id)}/pitches`, query, body, idempotencyKey ? { "Idempotency-Key": idempotencyKey } : undefined);
+  }
+
+  /** Modifica una división (PUT /api/divisions/{id}) */
+  putDivisionsById(id: string, body: DivisionInput, query?: Query): Promise<Division> {
+    return this.request<Division>("PUT", `/api/divisions/${encodeURIComponent(id)}`, query, body);
+  }
+
+  /** Modifica un partido (marcador, estado) (PUT /api/matches/{id}) */
+  putMatchesById(id: string, body: MatchUpdateInput, query?: Query): Promise<Match> {
+    return this.request<Match>("PUT", `/api/matches/${encodeURIComponent(id)}`, query, body);
+  }
+
+  /** Modifica un elemento de la galería (PUT /api/matches/{id}/media/{mediaId}) */
+  putMatchesByIdMediaByMediaId(id: string, mediaId: string, body: MediaInput, query?: Query): Promise<MatchMedia> {
+    return this.request<MatchMedia>("PUT", `/api/matches/${encodeURIComponent(id)}/media/${encodeURIComponent(mediaId)}`, query, body);
+  }
+
+  /** Reordena la galería (PUT /api/matches/{id}/media/order) */
+  putMatchesByIdMediaOrder(id: string, body: MediaOrderInput, query?: Query): Promise<MatchMedia[] | null> {
+    return this.request<MatchMedia[] | null>("PUT", `/api/matches/${encodeURIComponent(id)}/media/order`, query, body);
+  }
+
+  /** Designa la terna arbitral del partido (PUT /api/matches/{id}/referees) */
+  putMatchesByIdReferees(id: string, body: MatchRefereesInput, query?: Query): Promise<MatchReferee[] | null> {
+    return this.request<MatchReferee[] | null>("PUT", `/api/matches/${encodeURIComponent(id)}/referees`, query, body);
+  }
+
+  /** Etiqueta el partido (PUT /api/matches/{id}/tags/{tagId}) */
+  putMatchesByIdTagsByTagId(id: string, tagId: string, body?: BodyInit, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("PUT", `/api/matches/${encodeURIComponent(id)}/tags/${encodeURIComponent(tagId)}`, query, body);
+  }
+
+  /** Modifica un jugador (PUT /api/players/{id}) */
+  putPlayersById(id: string, body: PlayerUpdateInput, query?: Query): Promise<Player> {
+    return this.request<Player>("PUT", `/api/players/${encodeURIComponent(id)}`, query, body);
+  }
+
+  /** Modifica una lesión (PUT /api/players/{id}/injuries/{injuryId}) */
+  putPlayersByIdInjuriesByInjuryId(id: string, injuryId: string, body: InjuryInput, query?: Query): Promise<Injury> {
+    return this.request<Injury>("PUT", `/api/players/${encodeURIComponent(id)}/injuries/${encodeURIComponent(injuryId)}`, query, body);
+  }
+
+  /** Etiqueta el jugador (PUT /api/players/{id}/tags/{tagId}) */
+  putPlayersByIdTagsByTagId(id: string, tagId: string, body?: BodyInit, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("PUT", `/api/players/${encodeURIComponent(id)}/tags/${encodeURIComponent(tagId)}`, query, body);
+  }
+
+  /** Modifica un árbitro (PUT /api/referees/{id}) */
+  putRefereesById(id: string, body: RefereeInput, query?: Query): Promise<Referee> {
+    return this.request<Referee>("PUT", `/api/referees/${encodeURIComponent(id)}`, query, body);
+  }
+
+  /** Modifica una temporada (PUT /api/seasons/{id}) */
+  putSeasonsById(id: string, body: SeasonInput, query?: Query): Promise<Season> {
+    return this.request<Season>("PUT", `/api/seasons/${encodeURIComponent(id)}`, query, body);
+  }
+
+  /** Modifica una etiqueta (PUT /api/tags/{id}) */
+  putTagsById(id: string, body: TagInput, query?: Query): Promise<Tag> {
+    return this.request<Tag>("PUT", `/api/tags/${encodeURIComponent(id)}`, query, body);
+  }
+
+  /** Modifica un equipo (PUT /api/teams/{id}) */
+  putTeamsById(id: string, body: TeamUpdateInput, query?: Query): Promise<Team> {
+    return this.request<Team>("PUT", `/api/teams/${encodeURIComponent(id)}`, query, body);
+  }
+
+  /** Cambia el dorsal de un jugador (PUT /api/teams/{id}/players/{playerId}) */
+  putTeamsByIdPlayersByPlayerId(id: string, playerId: string, body: JerseyNumberInput, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("PUT", `/api/teams/${encodeURIComponent(id)}/players/${encodeURIComponent(playerId)}`, query, body);
+  }
+
+  /** Asigna capitán y otros roles (PUT /api/teams/{id}/players/{playerId}/roles) */
+  putTeamsByIdPlayersByPlayerIdRoles(id: string, playerId: string, body: PlayerRolesInput, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("PUT", `/api/teams/${encodeURIComponent(id)}/players/${encodeURIComponent(playerId)}/roles`, query, body);
+  }
+
+  /** Modifica un miembro del cuerpo técnico (PUT /api/teams/{id}/staff/{staffId}) */
+  putTeamsByIdStaffByStaffId(id: string, staffId: string, body: StaffInput, query?: Query): Promise<Staff> {
+    return this.request<Staff>("PUT", `/api/teams/${encodeURIComponent(id)}/staff/${encodeURIComponent(staffId)}`, query, body);
+  }
+
+  /** Etiqueta el equipo (PUT /api/teams/{id}/tags/{tagId}) */
+  putTeamsByIdTagsByTagId(id: string, tagId: string, body?: BodyInit, query?: Query): Promise<Record<string, string> | null> {
+    return this.request<Record<string, string> | null>("PUT", `/api/teams/${encodeURIComponent(id)}/tags/${encodeURIComponent(tagId)}`, query, body);
+  }
+
+  /** Modifica un torneo (PUT /api/tournaments/{id}) */
+  putTournamentsById(id: string, body: TournamentUpdateInput, query?: Query): Promise<Tournament> {
+    return this.request<Tournament>("PUT", `/api/tournaments/${encodeURIComponent(id)}`, query, body);
+  }
+
+  /** Cambia la restricción de público del torneo (PUT /api/tournaments/{id}/attendance) */
+  putTournamentsByIdAttendance(id: string, body: AttendanceRestriction, query?: Query): Promise<TournamentRules> {
+    return this.request<TournamentRules>("PUT", `/api/tournaments/${encodeURIComponent(id)}/attendance`, query, body);
+  }
+
+  /** Cambia el reglamento (PUT /api/tournaments/{id}/rules) */
+  putTournamentsByIdRules(id: string, body: RulesInput, query?: Query): Promise<TournamentRules> {
+    return this.request<TournamentRules>("PUT", `/api/tournaments/${encodeURIComponent(id)}/rules`, query, body);
+  }
+
+  /** Modifica un patrocinador (PUT /api/tournaments/{id}/sponsors/{sponsorId}) */
+  putTournamentsByIdSponsorsBySponsorId(id: string, sponsorId: string, body: SponsorInput, query?: Query): Promise<Sponsor> {
+    return this.request<Sponsor>("PUT", `/api/tournaments/${encodeURIComponent(id)}/sponsors/${encodeURIComponent(sponsorId)}`, query, body);
+  }
+
+  /** Modifica una sede (PUT /api/venues/{id}) */
+  putVenuesById(id: string, body: VenueInput, query?: Query): Promise<Venue> {
+    return this.request<Venue>("PUT", `/api/venues/${encodeURIComponent(id)}`, query, body);
+  }
+
+  /** Cambia la restricción de público de la sede (PUT /api/venues/{id}/attendance) */
+  putVenuesByIdAttendance(id: string, body: AttendanceRestriction, query?: Query): Promise<Venue> {
+    return this.request<Venue>("PUT", `/api/venues/${encodeURIComponent(id)}/attendance`, query, body);
+  }
+
+  /** Modifica una cancha (PUT /api/venues/{id}/pitches/{pitchId}) */
+  putVenuesByIdPitchesByPitchId(id: string, pitchId: string, body: PitchInput, query?: Query): Promise<Pitch> {
+    return this.request<Pitch>("PUT", `/api/venues/${encodeURIComponent(id)}/pitches/${encodeURIComponent(pitchId)}`, query, body);
+  }
+}
@@ -0,0 +1,1122 @@
+// Generado por `api ts-client` a partir de /openapi.json. No editar a mano:
+// los cambios se pierden al regenerar.
+
+export interface APIClient {
+  created_at: string;
+  id: string;
+  name: string;
+  rate_limit_per_minute: number;
+  revoked_at?: string | null;
+  scopes: string[] | null;
+}
+
+export interface APIClientCredentials {
+  client_secret: string;
+  created_at: string;
+  id: string;
+  name: string;
+  rate_limit_per_minute: number;
+  revoked_at?: string | null;
+  scopes: string[] | null;
+}
+
+export interface Alert {
+  detected_at: string;
+  dismissed_at?: string | null;
+  entity_id: string;
+  id: string;
+  message: string;
+  tournament_id?: string | null;
+  type: string;
+}
+
+export interface ApiClientInput {
+  name?: string;
+  rate_limit_per_minute?: number;
+  scopes?: string[] | null;
+}
+
+export interface AttendanceRestriction {
+  closed_doors?: boolean;
+  max_spectators?: number;
+  reason?: string;
+}
+
+export interface BulkPlayerReport {
+  created: number;
+  results: BulkPlayerResult[] | null;
+}
+
+export interface BulkPlayerResult {
+  error?: string;
+  index: number;
+  player?: Player;
+  status: string;
+}
+
+export interface CheckIn {
+  checked_in_at?: string;
+  match_id?: string;
+  player_id?: string;
+  team_id?: string;
+}
+
+export interface CleanSheets {
+  goalkeepers: GoalkeeperCleanSheet[] | null;
+  teams: TeamCleanSheet[] | null;
+}
+
+export interface ClockInput {
+  minutes?: number;
+}
+
+export interface CompetitionDivision {
+  division?: Division;
+  leader?: Standing;
+  teams: number;
+  tournament: Tournament;
+}
+
+export interface CompetitionStats {
+  competition_id: string;
+  divisions: DivisionAnalytics[] | null;
+  top_scorers: TopScorer[] | null;
+  totals: TournamentAnalytics;
+}
+
+export interface ConflictResolutionInput {
+  resolution?: string;
+}
+
+export interface DependencyHealth {
+  error?: string;
+  latency_ms: number;
+  name: string;
+  status: string;
+}
+
+export interface Division {
+  created_at: string;
+  id: string;
+  level: number;
+  name: string;
+  promoted: number;
+  relegated: number;
+}
+
+export interface DivisionAnalytics {
+  analytics: TournamentAnalytics;
+  division_id?: string | null;
+  division_name?: string;
+  tournament_id: string;
+  tournament_name: string;
+}
+
+export interface DivisionInput {
+  level?: number;
+  name?: string;
+  promoted?: number;
+  relegated?: number;
+}
+
+export interface DivisionMove {
+  already_registered?: boolean;
+  from_division_id: string;
+  from_tournament_id: string;
+  movement: string;
+  position: number;
+  team_id: string;
+  team_name: string;
+  to_division_id: string;
+  to_tournament_id: string;
+}
+
+export interface Draw {
+  created_at: string;
+  group_count?: number;
+  id: string;
+  mode: string;
+  picks: DrawPick[] | null;
+  seed: number;
+  tournament_id: string;
+}
+
+export interface DrawOptions {
+  group_count?: number;
+  mode?: string;
+  pots?: (string[] | null)[] | null;
+  seed?: number | null;
+}
+
+export interface DrawPick {
+  order: number;
+  pot: number;
+  slot: string;
+  team_id: string;
+}
+
+export interface ErrorResponse {
+  error: string;
+  error_description?: string;
+}
+
+export interface Fixture {
+  away: string;
+  date: string;
+  home: string;
+  pitch?: string;
+  round: number;
+  venue: string;
+}
+
+export interface FixtureConflict {
+  fixture: Fixture;
+  reason: string;
+  row: number;
+}
+
+export interface FixtureImportReport {
+  conflicts: FixtureConflict[] | null;
+  dry_run: boolean;
+  imported: number;
+  matches: Match[] | null;
+  tournament_id: string;
+}
+
+export interface ForfeitInput {
+  awarded_goals?: number;
+  forfeiting_team_id?: string;
+  reason?: string;
+}
+
+export interface GoalkeeperCleanSheet {
+  clean_sheets: number;
+  goals_conceded: number;
+  played: number;
+  player_id: string;
+  player_name: string;
+  position: number;
+  team_id: string;
+  team_name: string;
+}
+
+export interface Guest {
+  goals: number;
+  matches: number;
+  name: string;
+}
+
+export interface GuestPromotion {
+  events: number;
+  lineups: number;
+  name: string;
+  player_id: string;
+}
+
+export interface GuestPromotionInput {
+  name?: string;
+  player_id?: string;
+}
+
+export interface ImportRejection {
+  line: number;
+  reason: string;
+  record: Record<string, string> | null;
+}
+
+export interface ImportReport {
+  dry_run: boolean;
+  entity: string;
+  imported: number;
+  players?: Player[] | null;
+  rejected: ImportRejection[] | null;
+  teams?: Team[] | null;
+}
+
+export interface InboundEmailInput {
+  body?: string;
+  sender?: string;
+}
+
+export interface Incident {
+  id: string;
+  message?: string;
+  resolved_at?: string | null;
+  severity: string;
+  started_at: string;
+  title: string;
+}
+
+export interface IncidentInput {
+  message?: string;
+  severity?: string;
+  title?: string;
+}
+
+export interface Injury {
+  created_at: string;
+  expected_return?: string | null;
+  id: string;
+  player_id: string;
+  start_date: string;
+  type: string;
+}
+
+export interface InjuryInput {
+  expected_return?: string;
+  start_date?: string;
+  type?: string;
+}
+
+export interface JerseyNumberInput {
+  jersey_number?: number | null;
+}
+
+export interface Lineup {
+  bench: string[] | null;
+  created_at: string;
+  formation: string;
+  guests: string[] | null;
+  match_id: string;
+  starting: string[] | null;
+  team_id: string;
+}
+
+export interface LineupInput {
+  bench?: string[] | null;
+  formation?: string;
+  guests?: string[] | null;
+  starting?: string[] | null;
+  team_id?: string;
+}
+
+export interface Match {
+  attendance?: MatchAttendance;
+  broadcaster?: string;
+  created_at: string;
+  date: string;
+  decided_by?: string;
+  extra_time_team1?: number | null;
+  extra_time_team2?: number | null;
+  goal_scored_team1: number;
+  goal_scored_team2: number;
+  id: string;
+  is_friendly?: boolean;
+  match_number: number;
+  odds?: MatchOdds;
+  parent_match_id?: string | null;
+  penalties_team1?: number | null;
+  penalties_team2?: number | null;
+  pitch_id?: string | null;
+  referees?: MatchReferee[] | null;
+  result_confirmed_at?: string | null;
+  result_embargoed_until?: string | null;
+  result_type: string;
+  round?: number;
+  stage_id?: string | null;
+  stream_embargo_until?: string | null;
+  stream_url?: string;
+  team1?: Team;
+  team1_id: string;
+  team2?: Team;
+  team2_id: string;
+  tournament_id?: string | null;
+  updated_at: string;
+  venue_id?: string | null;
+  winner_id?: string | null;
+}
+
+export interface MatchAttendance {
+  capacity?: number;
+  closed_doors: boolean;
+  max_spectators?: number;
+  reasons?: string[] | null;
+}
+
+export interface MatchClock {
+  added_minute?: number;
+  duration_minutes: number;
+  elapsed_ms: number;
+  match_id: string;
+  minute: number;
+  period: number;
+  running_since?: string | null;
+  status: string;
+  stoppage_minutes: number;
+  updated_at: string;
+}
+
+export interface MatchEvent {
+  assist_player_id?: string | null;
+  created_at: string;
+  guest_name?: string;
+  id: string;
+  match_id: string;
+  minute: number;
+  player_id?: string | null;
+  team_id: string;
+  type: string;
+}
+
+export interface MatchEventInput {
+  assist_player_id?: string;
+  created_at?: string;
+  guest_name?: string;
+  id?: string;
+  minute?: number;
+  player_id?: string;
+  team_id?: string;
+  type?: string;
+}
+
+export interface MatchForfeit {
+  awarded_at: string;
+  awarded_goals: number;
+  awarded_team_id: string;
+  forfeiting_team_id: string;
+  id: string;
+  match_id: string;
+  previous_extra_time_team1?: number | null;
+  previous_extra_time_team2?: number | null;
+  previous_goals_team1: number;
+  previous_goals_team2: number;
+  previous_penalties_team1?: number | null;
+  previous_penalties_team2?: number | null;
+  reason: string;
+  revoked_at?: string | null;
+}
+
+export interface MatchInput {
+  broadcaster?: string;
+  created_at?: string;
+  date?: string;
+  extra_time_team1?: number | null;
+  extra_time_team2?: number | null;
+  goal_scored_team1?: number;
+  goal_scored_team2?: number;
+  id?: string;
+  is_friendly?: boolean;
+  match_number?: number;
+  penalties_team1?: number | null;
+  penalties_team2?: number | null;
+  pitch_id?: string;
+  result_confirmed?: boolean;
+  round?: number;
+  stage_id?: string;
+  stream_embargo_until?: string;
+  stream_url?: string;
+  team1_id?: string;
+  team2_id?: string;
+  tournament_id?: string;
+  venue_id?: string;
+}
+
+export interface MatchMedia {
+  caption?: string;
+  created_at: string;
+  id: string;
+  match_id: string;
+  position: number;
+  type: string;
+  url: string;
+}
+
+export interface MatchOdds {
+  computed_at: string;
+  draw: number;
+  label: string;
+  model: string;
+  team1_form: string;
+  team1_win: number;
+  team2_form: string;
+  team2_win: number;
+}
+
+export interface MatchPrediction {
+  computed_at: string;
+  draw: number;
+  match_id: string;
+  team1: TeamOutlook;
+  team1_win: number;
+  team2: TeamOutlook;
+  team2_win: number;
+}
+
+export interface MatchReferee {
+  name: string;
+  referee_id: string;
+  role: string;
+}
+
+export interface MatchRefereesInput {
+  assistant_ids?: string[] | null;
+  main_referee_id?: string | null;
+}
+
+export interface MatchUpdateInput {
+  broadcaster?: string;
+  date?: string;
+  extra_time_team1?: number | null;
+  extra_time_team2?: number | null;
+  goal_scored_team1?: number;
+  goal_scored_team2?: number;
+  is_friendly?: boolean;
+  match_number?: number;
+  penalties_team1?: number | null;
+  penalties_team2?: number | null;
+  pitch_id?: string;
+  result_confirmed?: boolean;
+  round?: number;
+  stage_id?: string;
+  stream_embargo_until?: string;
+  stream_url?: string;
+  team1_id?: string;
+  team2_id?: string;
+  tournament_id?: string;
+  updated_at?: string;
+  venue_id?: string;
+}
+
+export interface MatchdayGoals {
+  average_goals: number;
+  goals: number;
+  matches: number;
+  round: number;
+}
+
+export interface MediaInput {
+  caption?: string;
+  type?: string;
+  url?: string;
+}
+
+export interface MediaOrderInput {
+  ids?: string[] | null;
+}
+
+export interface Money {
+  amount: number;
+  currency: string;
+  formatted?: string;
+}
+
+export interface NameCheck {
+  available: boolean;
+  conflict_id?: string | null;
+  name: string;
+}
+
+export interface Pitch {
+  created_at: string;
+  id: string;
+  name: string;
+  venue_id: string;
+}
+
+export interface PitchInput {
+  name?: string;
+}
+
+export interface Player {
+  created_at: string;
+  date_birth: string;
+  id: string;
+  is_test?: boolean;
+  jersey_number?: number | null;
+  name: string;
+  nationality?: string;
+  position: string;
+  preferred_foot: string;
+  roles?: string[] | null;
+}
+
+export interface PlayerInput {
+  date_birth?: string;
+  is_test?: boolean;
+  name?: string;
+  nationality?: string;
+  position?: string;
+  preferred_foot?: string;
+}
+
+export interface PlayerRolesInput {
+  roles?: string[] | null;
+}
+
+export interface PlayerUpdateInput {
+  date_birth?: string;
+  name?: string;
+  nationality?: string;
+  position?: string;
+  preferred_foot?: string;
+}
+
+export interface PromotionReport {
+  moves: DivisionMove[] | null;
+  next_season_id: string;
+  season_id: string;
+}
+
+export interface PromotionsInput {
+  next_season_id?: string;
+  season_id?: string;
+}
+
+export interface ProvisionalResult {
+  created_at: string;
+  goal_scored_team1: number;
+  goal_scored_team2: number;
+  id: string;
+  match_id: string;
+  raw_line?: string;
+  referee_id?: string | null;
+  reviewed_at?: string | null;
+  sender?: string;
+  source: string;
+  status: string;
+}
+
+export interface PurgeReport {
+  dry_run: boolean;
+  matches: number;
+  players: number;
+  teams: number;
+  tournaments: number;
+}
+
+export interface RateLimitStatus {
+  client_id: string;
+  limit: number;
+  remaining: number;
+  reset_at: string;
+  scopes: string[] | null;
+  token_expires_at: string;
+}
+
+export interface RatingChange {
+  delta: number;
+  match_id: string;
+  opponent_id: string;
+  played_at: string;
+  rating_after: number;
+  rating_before: number;
+  team_id: string;
+}
+
+export interface Referee {
+  created_at: string;
+  email?: string;
+  id: string;
+  license_number: string;
+  name: string;
+}
+
+export interface RefereeInput {
+  email?: string;
+  license_number?: string;
+  name?: string;
+}
+
+export interface Registration {
+  player_id: string;
+  player_name?: string;
+  registered_at: string;
+  team_id: string;
+  tournament_id: string;
+}
+
+export interface RegistrationInput {
+  player_id?: string;
+  team_id?: string;
+}
+
+export interface RejectedLine {
+  error: string;
+  line: string;
+}
+
+export interface ResultEmailReport {
+  created: ProvisionalResult[] | null;
+  rejected: RejectedLine[] | null;
+  sender: string;
+}
+
+export interface RoundSummary {
+  first_date: string;
+  last_date: string;
+  matches: number;
+  round: number;
+}
+
+export interface RulesInput {
+  attendance?: AttendanceRestriction;
+  country?: string;
+  knockout_tiebreak?: string;
+  match_duration_minutes?: number;
+  max_foreign_players?: number | null;
+  max_squad_size?: number;
+  min_squad_size?: number;
+  registration_fee?: Money;
+}
+
+export interface Sanction {
+  decided_at: string;
+  fine?: Money;
+  id: string;
+  matches?: number;
+  player_id?: string | null;
+  points?: number;
+  reason: string;
+  revoked_at?: string | null;
+  round?: number;
+  team_id?: string | null;
+  tournament_id: string;
+  type: string;
+}
+
+export interface SanctionInput {
+  fine?: Money;
+  matches?: number;
+  player_id?: string;
+  points?: number;
+  reason?: string;
+  round?: number;
+  team_id?: string;
+  type?: string;
+}
+
+export interface Scoreboard {
+  generated_at: string;
+  live: ScoreboardMatch[] | null;
+  next: ScoreboardMatch[] | null;
+  venue_id: string;
+  venue_name: string;
+}
+
+export interface ScoreboardMatch {
+  attendance?: MatchAttendance;
+  elapsed_minutes?: number;
+  kickoff: string;
+  match_id: string;
+  round?: number;
+  score?: string;
+  team1: string;
+  team2: string;
+}
+
+export interface SearchResult {
+  detail?: string;
+  id: string;
+  name: string;
+  type: string;
+}
+
+export interface Season {
+  created_at: string;
+  ends_on: string;
+  id: string;
+  name: string;
+  starts_on: string;
+}
+
+export interface SeasonInput {
+  ends_on?: string;
+  name?: string;
+  starts_on?: string;
+}
+
+export interface SeedRanking {
+  rank: number;
+  score: number;
+  team_id: string;
+  team_name: string;
+}
+
+export interface SeedingSuggestion {
+  pots: (string[] | null)[] | null;
+  rankings: SeedRanking[] | null;
+  sources: string[] | null;
+  tournament_id: string;
+}
+
+export interface ServiceStatus {
+  checked_at: string;
+  dependencies: DependencyHealth[] | null;
+  incidents: Incident[] | null;
+  started_at: string;
+  status: string;
+  uptime_seconds: number;
+}
+
+export interface SplitGroup {
+  name?: string;
+  size?: number;
+}
+
+export interface SplitGroupResult {
+  matches: number;
+  standings: Standing[] | null;
+  tournament: Tournament;
+}
+
+export interface SplitOptions {
+  after_round?: number;
+  carry_over?: string;
+  days_between_rounds?: number;
+  double_round_robin?: boolean;
+  groups?: SplitGroup[] | null;
+  start_date?: string;
+}
+
+export interface SplitResult {
+  carry_over: string;
+  groups: SplitGroupResult[] | null;
+  tournament_id: string;
+}
+
+export interface Sponsor {
+  created_at: string;
+  ends_at: string;
+  id: string;
+  link_url: string;
+  logo_url: string;
+  name: string;
+  placement: string;
+  starts_at: string;
+  tournament_id: string;
+}
+
+export interface SponsorInput {
+  ends_at?: string;
+  link_url?: string;
+  logo_url?: string;
+  name?: string;
+  placement?: string;
+  starts_at?: string;
+}
+
+export interface Staff {
+  created_at: string;
+  email?: string;
+  id: string;
+  name: string;
+  phone?: string;
+  role: string;
+  team_id: string;
+}
+
+export interface StaffInput {
+  email?: string;
+  name?: string;
+  phone?: string;
+  role?: string;
+}
+
+export interface Stage {
+  created_at: string;
+  id: string;
+  name: string;
+  position: number;
+  status: string;
+  tournament_id: string;
+}
+
+export interface StageInput {
+  name?: string;
+  position?: number;
+}
+
+export interface Standing {
+  carried_points?: number;
+  deducted_points?: number;
+  drawn: number;
+  goal_difference: number;
+  goals_against: number;
+  goals_for: number;
+  lost: number;
+  played: number;
+  points: number;
+  position: number;
+  position_change?: number;
+  previous_position?: number;
+  team_id: string;
+  team_name: string;
+  won: number;
+}
+
+export interface SubMatchInput {
+  date?: string;
+  goal_scored_team1?: number;
+  goal_scored_team2?: number;
+}
+
+export interface Substitution {
+  created_at: string;
+  id: string;
+  match_id: string;
+  minute: number;
+  player_in_id: string;
+  player_out_id: string;
+  team_id: string;
+}
+
+export interface SubstitutionInput {
+  minute?: number;
+  player_in_id?: string;
+  player_out_id?: string;
+  team_id?: string;
+}
+
+export interface SyncBatchInput {
+  operations?: SyncOperation[] | null;
+}
+
+export interface SyncBatchResponse {
+  results: SyncOpResult[] | null;
+}
+
+export interface SyncConflict {
+  client_match: Match;
+  created_at: string;
+  id: string;
+  kind: string;
+  match_id: string;
+  resolution?: string;
+  resolved_at?: string | null;
+  status: string;
+}
+
+export interface SyncOpResult {
+  error?: string;
+  match_id: string;
+  op_id: string;
+  status: string;
+  type: string;
+}
+
+export interface SyncOperation {
+  check_in?: CheckIn;
+  event?: MatchEvent;
+  match_id?: string;
+  op_id?: string;
+  score?: SyncScore;
+  type?: string;
+}
+
+export interface SyncScore {
+  goal_scored_team1?: number;
+  goal_scored_team2?: number;
+}
+
+export interface Tag {
+  color?: string;
+  created_at: string;
+  id: string;
+  name: string;
+}
+
+export interface TagInput {
+  color?: string;
+  name?: string;
+}
+
+export interface Team {
+  created_at: string;
+  id: string;
+  is_test?: boolean;
+  name: string;
+  players?: Player[] | null;
+}
+
+export interface TeamCleanSheet {
+  clean_sheets: number;
+  goals_conceded: number;
+  played: number;
+  position: number;
+  team_id: string;
+  team_name: string;
+}
+
+export interface TeamConstraint {
+  not_after?: string | null;
+  not_before?: string | null;
+  team_id?: string;
+}
+
+export interface TeamInput {
+  is_test?: boolean;
+  name?: string;
+}
+
+export interface TeamOutlook {
+  adjusted_rating: number;
+  form: string;
+  form_points: number;
+  rating: number;
+  team_id: string;
+}
+
+export interface TeamRating {
+  history?: RatingChange[] | null;
+  matches: number;
+  position?: number;
+  rating: number;
+  team_id: string;
+  team_name?: string;
+  updated_at: string;
+}
+
+export interface TeamUpdateInput {
+  name?: string;
+}
+
+export interface Timetable {
+  entries: TimetableEntry[] | null;
+  gap_minutes: number;
+  round: number;
+  tournament_id: string;
+  unassigned: UnassignedMatch[] | null;
+}
+
+export interface TimetableEntry {
+  kickoff: string;
+  match_id: string;
+  pitch: string;
+  pitch_id?: string | null;
+  team1_id: string;
+  team2_id: string;
+}
+
+export interface TimetableOptions {
+  match_duration_minutes?: number;
+  min_rest_minutes?: number;
+  pitches?: string[] | null;
+  slots?: string[] | null;
+  team_constraints?: TeamConstraint[] | null;
+  venue_id?: string | null;
+}
+
+export interface TokenResponse {
+  access_token: string;
+  expires_in: number;
+  scope: string;
+  token_type: string;
+}
+
+export interface TopAssister {
+  assists: number;
+  player_id: string;
+  player_name: string;
+  position: number;
+  team_id: string;
+  team_name: string;
+}
+
+export interface TopScorer {
+  goals: number;
+  guest?: boolean;
+  penalties: number;
+  player_id?: string | null;
+  player_name: string;
+  position: number;
+  team_id: string;
+  team_name: string;
+}
+
+export interface Tournament {
+  archived_at?: string | null;
+  created_at: string;
+  division_id?: string | null;
+  id: string;
+  is_test?: boolean;
+  name: string;
+  parent_tournament_id?: string | null;
+  results_delay_minutes: number;
+  season_id?: string | null;
+  status: string;
+  teams?: Team[] | null;
+}
+
+export interface TournamentAnalytics {
+  average_goals: number;
+  away_win_rate: number;
+  away_wins: number;
+  computed_at: string;
+  draw_rate: number;
+  draws: number;
+  goals: number;
+  goals_per_matchday: MatchdayGoals[] | null;
+  home_win_rate: number;
+  home_wins: number;
+  matches: number;
+  tournament_id: string;
+}
+
+export interface TournamentInput {
+  division_id?: string;
+  is_test?: boolean;
+  name?: string;
+  parent_tournament_id?: string;
+  results_delay_minutes?: number;
+  season_id?: string;
+}
+
+export interface TournamentRules {
+  attendance?: AttendanceRestriction;
+  country?: string;
+  knockout_tiebreak: string;
+  match_duration_minutes: number;
+  max_foreign_players?: number | null;
+  max_squad_size: number;
+  min_squad_size: number;
+  registration_fee?: Money;
+  tournament_id: string;
+  updated_at: string;
+}
+
+export interface TournamentUpdateInput {
+  division_id?: string;
+  name?: string;
+  parent_tournament_id?: string;
+  results_delay_minutes?: number;
+  season_id?: string;
+}
+
+export interface Transfer {
+  from_team_id?: string | null;
+  from_team_name?: string;
+  id: string;
+  player_id: string;
+  to_team_id: string;
+  to_team_name?: string;
+  transferred_at: string;
+}
+
+export interface UnassignedMatch {
+  match_id: string;
+  reason: string;
+}
+
+export interface Venue {
+  address: string;
+  attendance?: AttendanceRestriction;
+  capacity: number;
+  created_at: string;
+  id: string;
+  name: string;
+}
+
+export interface VenueInput {
+  address?: string;
+  attendance?: AttendanceRestriction;
+  capacity?: number;
+  name?: string;
+}
+
+export interface VersionInfo {
+  build_time: string;
+  commit: string;
+  go_version: string;
+  modified?: boolean;
+  schema_version: number;
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/app"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/demo"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+)
+
+// isDemoMode indica si el binario se invocó con `--demo`: la API corre en
+// memoria, sin PostgreSQL, con datos de ejemplo
+func isDemoMode() bool {
+	for _, arg := range os.Args[1:] {
+		if arg == "--demo" || arg == "-demo" {
+			return true
+		}
+	}
+	return false
+}
+
+// demoOptions arma las opciones del modo demo. Con DEMO_DATA_FILE los datos
+// se leen de ese archivo (si existe) y se guardan en él cada
+// DEMO_SAVE_SECONDS segundos y al detenerse; sin él se pierden al salir.
+func demoOptions() ([]app.Option, error) {
+	store := repository.NewMemoryStore()
+	path := os.Getenv("DEMO_DATA_FILE")
+
+	loaded := false
+	if path != "" {
+		err := store.Load(path)
+		switch {
+		case err == nil:
+			loaded = true
+			log.Printf("🧪 Demo mode: data loaded from %s", path)
+		case !errors.Is(err, os.ErrNotExist):
+			return nil, err
+		}
+	}
+	if !loaded {
+		if err := demo.Seed(store, time.Now()); err != nil {
+			return nil, fmt.Errorf("failed to seed demo data: %w", err)
+		}
+		log.Println("🧪 Demo mode: in-memory storage with sample data")
+	}
+
+	opts := []app.Option{app.WithMemoryStore(store)}
+	if path != "" {
+		seconds, _ := strconv.Atoi(os.Getenv("DEMO_SAVE_SECONDS"))
+		if seconds <= 0 {
+			seconds = 30
+		}
+		opts = append(opts, app.WithMemoryPersistence(path, time.Duration(seconds)*time.Second))
+	}
+	return opts, nil
+}
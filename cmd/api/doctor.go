@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/doctor"
+	"github.com/cgonzalezvera/football-tournament-api-native/pkg/database"
+)
+
+// runDoctor ejecuta `api doctor`: imprime el reporte de chequeos y devuelve
+// el código de salida (0 si todo pasó, 1 si algo falló)
+func runDoctor() int {
+	fmt.Println("🩺 Tournament API doctor")
+
+	db, err := database.Open(database.NewConfigFromEnv())
+	if err != nil {
+		fmt.Printf("[%s] %-20s %s\n", doctor.StatusFail, "database", err)
+		return 1
+	}
+	defer db.Close()
+
+	report := doctor.Run(context.Background(), db, doctor.NewOptionsFromEnv())
+	for _, result := range report.Results {
+		fmt.Printf("[%s] %-20s %s\n", result.Status, result.Name, result.Detail)
+	}
+
+	if !report.OK() {
+		fmt.Println("❌ Some checks failed")
+		return 1
+	}
+	fmt.Println("✅ All checks passed")
+	return 0
+}
+
+// isDoctorCommand indica si el binario se invocó como `api doctor`
+func isDoctorCommand() bool {
+	return len(os.Args) > 1 && os.Args[1] == "doctor"
+}
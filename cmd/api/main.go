@@ -1,104 +1,79 @@
 package main
 
 import (
+	"context"
 	"log"
-	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
-	"github.com/cgonzalezvera/football-tournament-api-native/internal/handler"
-	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
-	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
-	"github.com/cgonzalezvera/football-tournament-api-native/pkg/database"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/app"
 )
 
 func main() {
-	// Configurar logging
-	log.SetFlags(log.LstdFlags | log.Lshortfile)
-	log.Println("🚀 Starting Tournament API...")
-
-	// Conectar a la base de datos
-	dbConfig := database.NewConfigFromEnv()
-	db, err := database.NewConnection(dbConfig)
-	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+	// Subcomando de diagnóstico: `api doctor`
+	if isDoctorCommand() {
+		os.Exit(runDoctor())
 	}
-	defer db.Close()
-
-	// Inicializar repositorios (Data Access Layer)
-	playerRepo := repository.NewPostgresPlayerRepository(db)
-	teamRepo := repository.NewPostgresTeamRepository(db)
-	tournamentRepo := repository.NewPostgresTournamentRepository(db)
-	matchRepo := repository.NewPostgresMatchRepository(db)
 
-	// Inicializar casos de uso (Business Logic Layer)
-	playerUC := usecase.NewPlayerUseCase(playerRepo)
-	teamUC := usecase.NewTeamUseCase(teamRepo, playerRepo)
-	tournamentUC := usecase.NewTournamentUseCase(tournamentRepo, teamRepo)
-	matchUC := usecase.NewMatchUseCase(matchRepo, teamRepo)
-
-	// Inicializar handlers (Presentation Layer)
-	playerHandler := handler.NewPlayerHandler(playerUC)
-	teamHandler := handler.NewTeamHandler(teamUC)
-	tournamentHandler := handler.NewTournamentHandler(tournamentUC)
-	matchHandler := handler.NewMatchHandler(matchUC)
-
-	// Configurar rutas (equivalente a app.MapControllers() en C#)
-	mux := http.NewServeMux()
-
-	// Rutas de jugadores
-	mux.Handle("/api/players", enableCORS(playerHandler))
-	mux.Handle("/api/players/", enableCORS(playerHandler))
-
-	// Rutas de equipos
-	mux.Handle("/api/teams", enableCORS(teamHandler))
-	mux.Handle("/api/teams/", enableCORS(teamHandler))
-
-	// Rutas de torneos
-	mux.Handle("/api/tournaments", enableCORS(tournamentHandler))
-	mux.Handle("/api/tournaments/", enableCORS(tournamentHandler))
+	// Restaurar un torneo archivado: `api restore <tournament-id>`
+	if isRestoreCommand() {
+		os.Exit(runRestore(os.Args[2:]))
+	}
 
-	// Rutas de partidos
-	mux.Handle("/api/matches", enableCORS(matchHandler))
-	mux.Handle("/api/matches/", enableCORS(matchHandler))
+	// Cifrar o volver a cifrar los datos personales: `api rotate-pii`
+	if isRotatePIICommand() {
+		os.Exit(runRotatePII())
+	}
 
-	// Ruta de health check
-	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte(`{"status":"healthy","service":"tournament-api"}`))
-	})
+	// Set de datos de estrés: `api stress-data [-scale 0.1] [-out file.json]`
+	if isStressDataCommand() {
+		os.Exit(runStressData(os.Args[2:]))
+	}
 
-	// Obtener puerto desde variable de entorno
-	port := os.Getenv("API_PORT")
-	if port == "" {
-		port = "8080"
+	// Cliente de TypeScript desde OpenAPI: `api ts-client [-out clients/typescript]`
+	if isTSClientCommand() {
+		os.Exit(runTSClient(os.Args[2:]))
 	}
 
-	// Iniciar servidor HTTP
-	serverAddr := ":" + port
-	log.Printf("🌐 Server listening on http://localhost%s", serverAddr)
-	log.Printf("📚 Health check: http://localhost%s/health", serverAddr)
-	log.Printf("📋 API Base URL: http://localhost%s/api", serverAddr)
+	// Configurar logging
+	log.SetFlags(log.LstdFlags | log.Lshortfile)
+	log.Println("🚀 Starting Tournament API...")
 
-	if err := http.ListenAndServe(serverAddr, mux); err != nil {
-		log.Fatalf("Server failed to start: %v", err)
+	// Modo demo: `api --demo` corre en memoria con datos de ejemplo
+	var opts []app.Option
+	if isDemoMode() {
+		demoOpts, err := demoOptions()
+		if err != nil {
+			log.Fatalf("Failed to prepare demo mode: %v", err)
+		}
+		opts = demoOpts
 	}
-}
 
-// enableCORS es un middleware para habilitar CORS
-// En C# esto sería similar a app.UseCors() en Program.cs
-func enableCORS(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+	// Construir la aplicación (repositorios, casos de uso y handlers)
+	application, err := app.New(opts...)
+	if err != nil {
+		log.Fatalf("Failed to build application: %v", err)
+	}
 
-		// Manejar preflight request
-		if r.Method == "OPTIONS" {
-			w.WriteHeader(http.StatusOK)
-			return
+	// Detener ordenadamente al recibir SIGINT/SIGTERM
+	go func() {
+		stop := make(chan os.Signal, 1)
+		signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+		<-stop
+
+		log.Println("🛑 Shutting down...")
+		// El drenaje no descuenta tiempo a las peticiones en curso
+		ctx, cancel := context.WithTimeout(context.Background(), application.DrainDelay()+15*time.Second)
+		defer cancel()
+		if err := application.Stop(ctx); err != nil {
+			log.Printf("Shutdown error: %v", err)
 		}
+	}()
 
-		next.ServeHTTP(w, r)
-	})
+	if err := application.Start(); err != nil {
+		log.Fatalf("Server failed to start: %v", err)
+	}
+	log.Println("👋 Server stopped")
 }
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/cgonzalezvera/football-tournament-api-native/pkg/database"
+	"github.com/google/uuid"
+)
+
+// runRestore ejecuta `api restore <tournament-id>`: devuelve un torneo
+// archivado (y sus partidos) a las tablas activas sin levantar el servidor
+func runRestore(args []string) int {
+	if len(args) != 1 {
+		fmt.Println("usage: api restore <tournament-id>")
+		return 2
+	}
+	id, err := uuid.Parse(args[0])
+	if err != nil {
+		fmt.Printf("❌ Invalid tournament UUID: %v\n", err)
+		return 2
+	}
+
+	db, err := database.NewConnection(database.NewConfigFromEnv())
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return 1
+	}
+	defer db.Close()
+
+	// Restaurar no lee jugadores, así que no hace falta el llavero de PII
+	tournaments := usecase.NewTournamentUseCase(
+		repository.NewPostgresTournamentRepository(db),
+		repository.NewPostgresTeamRepository(db, nil),
+		repository.NewPostgresSeasonRepository(db),
+		repository.NewPostgresTournamentRulesRepository(db),
+		repository.NewPostgresRegistrationRepository(db),
+		repository.NewPostgresStandingsSnapshotRepository(db),
+	)
+	tournament, err := tournaments.RestoreTournament(id)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return 1
+	}
+
+	fmt.Printf("✅ Tournament %q restored\n", tournament.Name)
+	return 0
+}
+
+// isRestoreCommand indica si el binario se invocó como `api restore`
+func isRestoreCommand() bool {
+	return len(os.Args) > 1 && os.Args[1] == "restore"
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/fieldcrypt"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/cgonzalezvera/football-tournament-api-native/pkg/database"
+)
+
+// runRotatePII ejecuta `api rotate-pii`: cifra con la clave vigente de
+// PII_ENCRYPTION_KEYS los datos personales guardados en claro o con claves
+// anteriores. Al terminar, las claves anteriores se pueden quitar de la lista.
+func runRotatePII() int {
+	keyring, err := fieldcrypt.ParseKeyring(os.Getenv("PII_ENCRYPTION_KEYS"))
+	if err != nil {
+		fmt.Printf("❌ Invalid PII_ENCRYPTION_KEYS: %v\n", err)
+		return 2
+	}
+
+	db, err := database.NewConnection(database.NewConfigFromEnv())
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return 1
+	}
+	defer db.Close()
+
+	rotated, err := repository.RotatePlayerPII(db, keyring)
+	if err != nil {
+		fmt.Printf("❌ Rotation stopped after %d players: %v\n", rotated, err)
+		return 1
+	}
+
+	fmt.Printf("✅ %d players encrypted with key %q\n", rotated, keyring.CurrentKeyID())
+	return 0
+}
+
+// isRotatePIICommand indica si el binario se invocó como `api rotate-pii`
+func isRotatePIICommand() bool {
+	return len(os.Args) > 1 && os.Args[1] == "rotate-pii"
+}
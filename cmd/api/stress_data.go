@@ -0,0 +1,120 @@
+package main
+
+import (
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/fieldcrypt"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/stress"
+	"github.com/cgonzalezvera/football-tournament-api-native/pkg/database"
+)
+
+// runStressData ejecuta `api stress-data`: genera el set de estrés en la base
+// configurada (DB_*) o, con -out, en un archivo que el modo demo puede cargar
+// con DEMO_DATA_FILE
+func runStressData(args []string) int {
+	flags := flag.NewFlagSet("stress-data", flag.ContinueOnError)
+	scale := flags.Float64("scale", 1, "fraction of the full dataset (50 tournaments, 1k teams, 20k players, 200k matches)")
+	workers := flags.Int("workers", 8, "concurrent writers")
+	seed := flags.Int64("seed", 1, "random seed for squads and results")
+	out := flags.String("out", "", "write an in-memory dataset to this JSON file instead of PostgreSQL")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *scale <= 0 {
+		fmt.Println("❌ -scale must be positive")
+		return 2
+	}
+
+	var repos stress.Repositories
+	var store *repository.MemoryStore
+	if *out != "" {
+		store = repository.NewMemoryStore()
+		repos = memoryStressRepositories(store)
+	} else {
+		keyring, err := fieldcrypt.ParseKeyring(os.Getenv("PII_ENCRYPTION_KEYS"))
+		if err != nil {
+			fmt.Printf("❌ Invalid PII_ENCRYPTION_KEYS: %v\n", err)
+			return 2
+		}
+
+		db, err := database.NewConnection(database.NewConfigFromEnv())
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return 1
+		}
+		defer db.Close()
+		db.SetMaxOpenConns(*workers)
+		repos = postgresStressRepositories(db, keyring)
+	}
+
+	size := stress.FullSize.Scale(*scale)
+	fmt.Printf("🏗️  Generating %d tournaments, %d teams, %d players and %d matches\n", size.Tournaments, size.Teams, size.Players, size.Matches)
+
+	generator := stress.NewGenerator(repos, size, *workers, *seed)
+	generator.Progress = stressProgress()
+	summary, err := generator.Run()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		fmt.Println("   Partial data is marked as test data; purge it with POST /api/admin/test-data/purge")
+		return 1
+	}
+
+	if store != nil {
+		if err := store.Save(*out); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return 1
+		}
+		fmt.Printf("💾 Saved to %s (serve it with DEMO_DATA_FILE=%s api --demo)\n", *out, *out)
+	}
+	fmt.Printf("✅ Run %s: %d tournaments, %d teams, %d players, %d matches and %d events in %s\n",
+		summary.Tag, summary.Size.Tournaments, summary.Size.Teams, summary.Size.Players, summary.Size.Matches, summary.Events, summary.Elapsed.Round(100*time.Millisecond))
+	return 0
+}
+
+// stressProgress imprime el avance de cada etapa cada 10%
+func stressProgress() func(stage string, done, total int) {
+	var mu sync.Mutex
+	printed := map[string]int{}
+	return func(stage string, done, total int) {
+		mu.Lock()
+		defer mu.Unlock()
+		// Los workers avisan en desorden: solo se imprime cada decena nueva
+		decile := done * 10 / total
+		if decile <= printed[stage] {
+			return
+		}
+		printed[stage] = decile
+		fmt.Printf("   %-12s %3d%%\n", stage, decile*10)
+	}
+}
+
+func postgresStressRepositories(db *sql.DB, keyring *fieldcrypt.Keyring) stress.Repositories {
+	return stress.Repositories{
+		Players:     repository.NewPostgresPlayerRepository(db, keyring),
+		Teams:       repository.NewPostgresTeamRepository(db, keyring),
+		Tournaments: repository.NewPostgresTournamentRepository(db),
+		Matches:     repository.NewPostgresMatchRepository(db),
+		Events:      repository.NewPostgresMatchEventRepository(db),
+	}
+}
+
+func memoryStressRepositories(store *repository.MemoryStore) stress.Repositories {
+	return stress.Repositories{
+		Players:     repository.NewMemoryPlayerRepository(store),
+		Teams:       repository.NewMemoryTeamRepository(store),
+		Tournaments: repository.NewMemoryTournamentRepository(store),
+		Matches:     repository.NewMemoryMatchRepository(store),
+		Events:      repository.NewMemoryMatchEventRepository(store),
+	}
+}
+
+// isStressDataCommand indica si el binario se invocó como `api stress-data`
+func isStressDataCommand() bool {
+	return len(os.Args) > 1 && os.Args[1] == "stress-data"
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/app"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/tsclient"
+)
+
+// runTSClient ejecuta `api ts-client`: arma la aplicación en memoria (sin
+// base de datos), toma su documento OpenAPI y escribe los tipos y el cliente
+// de TypeScript en el paquete de -out
+func runTSClient(args []string) int {
+	flags := flag.NewFlagSet("ts-client", flag.ContinueOnError)
+	out := flags.String("out", "clients/typescript", "directory of the npm package")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	// Armar la aplicación no necesita logs
+	log.SetOutput(io.Discard)
+	application, err := app.New(app.WithMemoryStore(repository.NewMemoryStore()))
+	log.SetOutput(os.Stderr)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return 1
+	}
+
+	files, err := tsclient.Generate(application.OpenAPI())
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return 1
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		content := files[name]
+		path := filepath.Join(*out, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return 1
+		}
+		if err := os.WriteFile(path, content, 0o644); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return 1
+		}
+		fmt.Printf("📝 %s\n", path)
+	}
+	fmt.Printf("✅ Publish with: cd %s && npm version patch && npm publish\n", *out)
+	return 0
+}
+
+func isTSClientCommand() bool {
+	return len(os.Args) > 1 && os.Args[1] == "ts-client"
+}
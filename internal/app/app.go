@@ -0,0 +1,320 @@
+// Package app es la raíz de composición: crea repositorios, casos de uso y
+// handlers, y gestiona el ciclo de vida del servidor y de los subsistemas.
+package app
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/buildinfo"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/fieldcrypt"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/handler"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/jobs"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/realtime"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/cgonzalezvera/football-tournament-api-native/migrations"
+	"github.com/cgonzalezvera/football-tournament-api-native/pkg/database"
+)
+
+// Component es un subsistema con procesos en segundo plano (jobs, caches...)
+// que se inicia y se detiene junto con la aplicación
+type Component interface {
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+}
+
+// App contiene la aplicación ya cableada
+type App struct {
+	db             *sql.DB
+	ownsDB         bool
+	addr           string
+	organizerToken string
+	escapeHTML     bool
+	// inboundEmailKey autentica el webhook de emails de resultados
+	inboundEmailKey string
+	// piiKeys son las claves de cifrado de los datos personales ("" = sin cifrar)
+	piiKeys string
+	// adminAllowedIPs son las redes que pueden usar /api/admin/* y los DELETE
+	// ("" = sin restricción)
+	adminAllowedIPs string
+	allowlist       *handler.IPAllowlist
+	// analyticsInterval es cada cuánto se recalculan las analíticas (0 = nunca)
+	analyticsInterval time.Duration
+	// alertsInterval es cada cuánto se recalculan las alertas (0 = nunca)
+	alertsInterval time.Duration
+	// maxSquadSize es el tope global de jugadores por plantel (0 = sin tope)
+	maxSquadSize int
+	// archiveKeepSeasons son las temporadas que quedan activas (0 = no archivar)
+	archiveKeepSeasons int
+	// drainDelay es cuánto se sigue atendiendo tras marcar la instancia como
+	// no lista, para que el balanceador deje de enviarle tráfico
+	drainDelay time.Duration
+	// skipSchemaCheck permite arrancar aunque falten migraciones
+	skipSchemaCheck bool
+	// memory guarda los datos en memoria en lugar de PostgreSQL (modo demo)
+	memory *repository.MemoryStore
+	// memoryFile es el archivo donde se persiste memory ("" = no persistir)
+	memoryFile string
+	// memorySaveInterval es cada cuánto se guarda memory en memoryFile
+	memorySaveInterval time.Duration
+	// ready indica si /ready responde 200
+	ready         atomic.Bool
+	repoOverrides []func(*Repositories)
+	components    []Component
+	hub           *realtime.Hub
+	// startedAt es el inicio del proceso, base del uptime de /api/status
+	startedAt time.Time
+
+	repos   Repositories
+	router  *handler.Router
+	handler http.Handler
+	server  *http.Server
+}
+
+// New construye la aplicación. Sin opciones toma la configuración de las
+// variables de entorno, igual que antes hacía main.go.
+func New(opts ...Option) (*App, error) {
+	analyticsMinutes, err := strconv.Atoi(getEnv("ANALYTICS_REFRESH_MINUTES", "15"))
+	if err != nil {
+		analyticsMinutes = 15
+	}
+	alertsMinutes, err := strconv.Atoi(getEnv("ALERTS_REFRESH_MINUTES", "15"))
+	if err != nil {
+		alertsMinutes = 15
+	}
+	archiveKeepSeasons, _ := strconv.Atoi(os.Getenv("ARCHIVE_KEEP_SEASONS"))
+	drainSeconds, _ := strconv.Atoi(os.Getenv("SHUTDOWN_DRAIN_SECONDS"))
+	maxSquadSize, _ := strconv.Atoi(os.Getenv("MAX_SQUAD_SIZE"))
+	a := &App{
+		addr:               ":" + getEnv("API_PORT", "8080"),
+		organizerToken:     os.Getenv("ORGANIZER_TOKEN"),
+		escapeHTML:         os.Getenv("ESCAPE_HTML_INPUT") == "true",
+		inboundEmailKey:    os.Getenv("INBOUND_EMAIL_KEY"),
+		piiKeys:            os.Getenv("PII_ENCRYPTION_KEYS"),
+		adminAllowedIPs:    os.Getenv("ADMIN_ALLOWED_IPS"),
+		analyticsInterval:  time.Duration(analyticsMinutes) * time.Minute,
+		alertsInterval:     time.Duration(alertsMinutes) * time.Minute,
+		archiveKeepSeasons: archiveKeepSeasons,
+		maxSquadSize:       maxSquadSize,
+		drainDelay:         time.Duration(drainSeconds) * time.Second,
+		skipSchemaCheck:    os.Getenv("SKIP_SCHEMA_CHECK") == "true",
+		startedAt:          time.Now().UTC(),
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	// Conectar a la base de datos si no se inyectó una conexión; el modo
+	// demo no usa base de datos
+	if a.db == nil && a.memory == nil {
+		db, err := database.NewConnection(database.NewConfigFromEnv())
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to database: %w", err)
+		}
+		a.db = db
+		a.ownsDB = true
+	}
+
+	// Los repositorios de jugadores y equipos cifran los datos personales;
+	// cada aplicación usa su propio llavero
+	var keyring *fieldcrypt.Keyring
+	if a.piiKeys != "" {
+		if keyring, err = fieldcrypt.ParseKeyring(a.piiKeys); err != nil {
+			return nil, fmt.Errorf("invalid PII_ENCRYPTION_KEYS: %w", err)
+		}
+	}
+
+	if a.adminAllowedIPs != "" {
+		if a.allowlist, err = handler.ParseIPAllowlist(a.adminAllowedIPs); err != nil {
+			return nil, fmt.Errorf("invalid ADMIN_ALLOWED_IPS: %w", err)
+		}
+	}
+
+	// Inicializar repositorios (Data Access Layer)
+	if a.memory != nil {
+		a.repos = newMemoryRepositories(a.memory)
+	} else {
+		a.repos = Repositories{
+			Players:            repository.NewPostgresPlayerRepository(a.db, keyring),
+			Teams:              repository.NewPostgresTeamRepository(a.db, keyring),
+			Tournaments:        repository.NewPostgresTournamentRepository(a.db),
+			Matches:            repository.NewPostgresMatchRepository(a.db),
+			Draws:              repository.NewPostgresDrawRepository(a.db),
+			Sponsors:           repository.NewPostgresSponsorRepository(a.db),
+			MatchEvents:        repository.NewPostgresMatchEventRepository(a.db),
+			Substitutions:      repository.NewPostgresSubstitutionRepository(a.db),
+			Lineups:            repository.NewPostgresLineupRepository(a.db),
+			Stats:              repository.NewPostgresStatsRepository(a.db),
+			SyncConflicts:      repository.NewPostgresSyncConflictRepository(a.db),
+			Referees:           repository.NewPostgresRefereeRepository(a.db),
+			Sync:               repository.NewPostgresSyncRepository(a.db),
+			Venues:             repository.NewPostgresVenueRepository(a.db),
+			Seasons:            repository.NewPostgresSeasonRepository(a.db),
+			Stages:             repository.NewPostgresStageRepository(a.db),
+			ProvisionalResults: repository.NewPostgresProvisionalResultRepository(a.db),
+			Analytics:          repository.NewPostgresAnalyticsRepository(a.db),
+			Ratings:            repository.NewPostgresRatingRepository(a.db),
+			TestData:           repository.NewPostgresTestDataRepository(a.db),
+			Transfers:          repository.NewPostgresTransferRepository(a.db),
+			Injuries:           repository.NewPostgresInjuryRepository(a.db),
+			Pitches:            repository.NewPostgresPitchRepository(a.db),
+			Alerts:             repository.NewPostgresAlertRepository(a.db),
+			Tags:               repository.NewPostgresTagRepository(a.db),
+			Staff:              repository.NewPostgresStaffRepository(a.db),
+			Guests:             repository.NewPostgresGuestRepository(a.db),
+			Media:              repository.NewPostgresMediaRepository(a.db),
+			TournamentRules:    repository.NewPostgresTournamentRulesRepository(a.db),
+			Registrations:      repository.NewPostgresRegistrationRepository(a.db),
+			MatchClocks:        repository.NewPostgresMatchClockRepository(a.db),
+			Incidents:          repository.NewPostgresIncidentRepository(a.db),
+			StandingsSnapshots: repository.NewPostgresStandingsSnapshotRepository(a.db),
+			Divisions:          repository.NewPostgresDivisionRepository(a.db),
+			APIClients:         repository.NewPostgresAPIClientRepository(a.db),
+			MatchForfeits:      repository.NewPostgresMatchForfeitRepository(a.db),
+			Sanctions:          repository.NewPostgresSanctionRepository(a.db),
+			Idempotency:        repository.NewPostgresIdempotencyRepository(a.db),
+		}
+	}
+	for _, override := range a.repoOverrides {
+		override(&a.repos)
+	}
+
+	// En modo demo los datos se guardan en disco cada tanto. Se registra
+	// antes que el resto para detenerse último y guardar lo que hicieron.
+	if a.memory != nil && a.memoryFile != "" {
+		a.components = append(a.components, jobs.NewMemorySaveJob(a.memory, a.memoryFile, a.memorySaveInterval))
+	}
+
+	// El hub de actualizaciones en vivo se detiene con la aplicación
+	a.hub = realtime.NewHub()
+	a.components = append(a.components, a.hub)
+
+	a.handler = a.routes()
+	a.server = &http.Server{
+		Addr:    a.addr,
+		Handler: a.handler,
+	}
+	// Shutdown no espera a las conexiones secuestradas (WebSocket) pero sí a
+	// los streams SSE, que no terminan solos: cerrar el hub los finaliza
+	a.server.RegisterOnShutdown(func() {
+		a.hub.Stop(context.Background())
+	})
+
+	return a, nil
+}
+
+// Handler devuelve el router completo, útil para pruebas con httptest
+func (a *App) Handler() http.Handler {
+	return a.handler
+}
+
+// OpenAPI devuelve el documento de /openapi.json sin pasar por HTTP; lo usa
+// `api ts-client` para generar el cliente de TypeScript
+func (a *App) OpenAPI() handler.OpenAPIDocument {
+	return handler.NewOpenAPIDocument(a.router.Routes(), buildinfo.Get().Commit)
+}
+
+// DrainDelay devuelve cuánto espera Stop antes de cerrar el servidor
+func (a *App) DrainDelay() time.Duration {
+	return a.drainDelay
+}
+
+// Start verifica que el esquema de la base esté al día, inicia los
+// subsistemas y el servidor HTTP. Bloquea hasta que el servidor se detiene;
+// tras un Stop ordenado devuelve nil.
+func (a *App) Start() error {
+	ctx := context.Background()
+	if err := a.checkSchema(ctx); err != nil {
+		return err
+	}
+
+	for _, component := range a.components {
+		if err := component.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start component: %w", err)
+		}
+	}
+
+	log.Printf("🌐 Server listening on http://localhost%s", a.addr)
+	log.Printf("📚 Health check: http://localhost%s/health", a.addr)
+	log.Printf("📋 API Base URL: http://localhost%s/api", a.addr)
+
+	a.ready.Store(true)
+	if err := a.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// Stop marca la instancia como no lista y, tras el drainDelay, detiene el
+// servidor esperando las peticiones en curso, luego los subsistemas en orden
+// inverso y por último la conexión a la base de datos
+func (a *App) Stop(ctx context.Context) error {
+	var errs []error
+
+	// Durante el drenaje /ready responde 503 pero se siguen atendiendo
+	// peticiones, así el balanceador saca la instancia sin cortar tráfico
+	if a.ready.Swap(false) && a.drainDelay > 0 {
+		log.Printf("🚰 Draining for %s before shutdown", a.drainDelay)
+		select {
+		case <-time.After(a.drainDelay):
+		case <-ctx.Done():
+		}
+	}
+
+	if err := a.server.Shutdown(ctx); err != nil {
+		errs = append(errs, err)
+	}
+
+	for i := len(a.components) - 1; i >= 0; i-- {
+		if err := a.components[i].Stop(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if a.ownsDB {
+		if err := a.db.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkSchema se niega a servir contra una base a la que le faltan
+// migraciones de este binario: el código nuevo fallaría en las consultas
+func (a *App) checkSchema(ctx context.Context) error {
+	if a.skipSchemaCheck || a.db == nil {
+		return nil
+	}
+
+	pending, err := migrations.Pending(ctx, a.db)
+	if err != nil {
+		return fmt.Errorf("failed to check schema version: %w", err)
+	}
+	if len(pending) > 0 {
+		missing := make([]string, len(pending))
+		for i, migration := range pending {
+			missing[i] = migration.String()
+		}
+		return fmt.Errorf("database schema is behind this build, missing migrations: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// getEnv obtiene una variable de entorno o retorna un valor por defecto
+func getEnv(key, defaultValue string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
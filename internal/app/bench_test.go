@@ -0,0 +1,145 @@
+package app_test
+
+// Benchmarks de las rutas más usadas de la API (listado de partidos, tabla
+// de posiciones) sobre los datos del modo demo, sin base de datos.
+// TestAllocBudgets compara las asignaciones por petición con un presupuesto,
+// para que una funcionalidad nueva no duplique sin querer el costo de cada
+// petición:
+//
+//	go test ./internal/app -run Alloc -bench .
+//
+// Con -stress los benchmarks miden sobre una fracción del set de estrés;
+// los presupuestos valen solo para los datos de demo, así que el test de
+// asignaciones se omite:
+//
+//	go test ./internal/app -run Alloc -bench . -args -stress 0.01
+
+import (
+	"flag"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/app"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/demo"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/stress"
+	"github.com/google/uuid"
+)
+
+var stressScale = flag.Float64("stress", 0, "measure against this fraction of the stress dataset instead of the demo league")
+
+// allocRuns son las peticiones que promedia testing.AllocsPerRun
+const allocRuns = 100
+
+// benchCase es una ruta a medir con su presupuesto de asignaciones por
+// petición (incluye las del request y el recorder de httptest)
+type benchCase struct {
+	name      string
+	path      string
+	maxAllocs float64
+}
+
+// benchCases son las rutas calientes con sus presupuestos: alrededor de 1,5
+// veces lo medido con los datos de demo.Seed, así que duplicar las
+// asignaciones de una ruta hace fallar el test. Si una mejora las baja mucho
+// conviene ajustar el presupuesto para no perder sensibilidad.
+func benchCases(tournamentID uuid.UUID) []benchCase {
+	return []benchCase{
+		{name: "match list", path: "/api/matches", maxAllocs: 500},
+		{name: "round matches", path: "/api/tournaments/" + tournamentID.String() + "/rounds/1/matches", maxAllocs: 110},
+		{name: "standings", path: "/api/tournaments/" + tournamentID.String() + "/standings", maxAllocs: 90},
+		{name: "standings by round", path: "/api/tournaments/" + tournamentID.String() + "/standings?round=2", maxAllocs: 90},
+	}
+}
+
+// newBenchHandler arma la aplicación en memoria con los datos de demo (o
+// los de estrés con -stress) y devuelve su handler y el primer torneo
+func newBenchHandler(tb testing.TB) (http.Handler, uuid.UUID) {
+	tb.Helper()
+
+	store := repository.NewMemoryStore()
+	if *stressScale > 0 {
+		repos := stress.Repositories{
+			Players:     repository.NewMemoryPlayerRepository(store),
+			Teams:       repository.NewMemoryTeamRepository(store),
+			Tournaments: repository.NewMemoryTournamentRepository(store),
+			Matches:     repository.NewMemoryMatchRepository(store),
+			Events:      repository.NewMemoryMatchEventRepository(store),
+		}
+		size := stress.FullSize.Scale(*stressScale)
+		if _, err := stress.NewGenerator(repos, size, runtime.NumCPU(), 1).Run(); err != nil {
+			tb.Fatalf("failed to generate data: %v", err)
+		}
+	} else if err := demo.Seed(store, time.Now()); err != nil {
+		tb.Fatalf("failed to seed data: %v", err)
+	}
+	tournaments, err := repository.NewMemoryTournamentRepository(store).GetAll(false)
+	if err != nil || len(tournaments) == 0 {
+		tb.Fatal("seeded data has no tournament")
+	}
+
+	// Los logs de cada petición ensuciarían el reporte
+	previous := log.Writer()
+	log.SetOutput(io.Discard)
+	tb.Cleanup(func() { log.SetOutput(previous) })
+	application, err := app.New(app.WithMemoryStore(store))
+	if err != nil {
+		tb.Fatalf("failed to build application: %v", err)
+	}
+	return application.Handler(), tournaments[0].ID
+}
+
+// serve hace un GET a path y devuelve el código de respuesta
+func serve(handler http.Handler, path string) int {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+	return recorder.Code
+}
+
+// TestAllocBudgets falla si alguna ruta caliente asigna más que su
+// presupuesto. Las asignaciones se cuentan con testing.AllocsPerRun, que es
+// estable entre máquinas, así que el chequeo sirve para CI aunque los
+// runners varíen.
+func TestAllocBudgets(t *testing.T) {
+	if *stressScale > 0 {
+		t.Skip("budgets apply to the demo league only")
+	}
+	handler, tournamentID := newBenchHandler(t)
+
+	for _, c := range benchCases(tournamentID) {
+		t.Run(c.name, func(t *testing.T) {
+			// Una respuesta de error asigna mucho menos y pasaría el presupuesto
+			if status := serve(handler, c.path); status != http.StatusOK {
+				t.Fatalf("GET %s returned %d", c.path, status)
+			}
+			allocs := testing.AllocsPerRun(allocRuns, func() { serve(handler, c.path) })
+			if allocs > c.maxAllocs {
+				t.Errorf("%.0f allocs/op, budget %.0f", allocs, c.maxAllocs)
+			}
+			t.Logf("%.0f allocs/op (budget %.0f)", allocs, c.maxAllocs)
+		})
+	}
+}
+
+// BenchmarkRoutes mide tiempo y memoria de cada ruta caliente; los tiempos
+// son solo informativos
+func BenchmarkRoutes(b *testing.B) {
+	handler, tournamentID := newBenchHandler(b)
+
+	for _, c := range benchCases(tournamentID) {
+		b.Run(c.name, func(b *testing.B) {
+			if status := serve(handler, c.path); status != http.StatusOK {
+				b.Fatalf("GET %s returned %d", c.path, status)
+			}
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				serve(handler, c.path)
+			}
+		})
+	}
+}
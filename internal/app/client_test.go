@@ -0,0 +1,121 @@
+package app_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/pkg/client"
+	"github.com/cgonzalezvera/football-tournament-api-native/pkg/tournament"
+	"github.com/google/uuid"
+)
+
+// TestClientStreamMatchFollowsSSE sigue un partido con el cliente: los
+// cambios llegan por /stream aunque el intervalo de consulta sea de una hora,
+// y el borrado termina con ErrNotFound
+func TestClientStreamMatchFollowsSSE(t *testing.T) {
+	handler, tournamentID := newBenchHandler(t)
+	var teams []uuid.UUID
+	for id := range standingsByTeam(t, handler, tournamentID) {
+		teams = append(teams, id)
+	}
+	match := createMatch(t, handler, "/api/matches", map[string]any{
+		"tournament_id": tournamentID,
+		"round":         1,
+		"match_number":  998,
+		"date":          time.Now().UTC().AddDate(0, 0, 7).Format(time.RFC3339),
+		"team1_id":      teams[0],
+		"team2_id":      teams[1],
+	})
+	matchPath := "/api/matches/" + match.ID.String()
+
+	var streamed atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == matchPath+"/stream" {
+			streamed.Store(true)
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var scores []int
+	err := client.New(server.URL).StreamMatch(ctx, match.ID, time.Hour, func(m *tournament.Match) error {
+		scores = append(scores, m.GoalScoredTeam1)
+		switch len(scores) {
+		case 1:
+			go request(handler, http.MethodPut, matchPath, map[string]any{
+				"tournament_id":     tournamentID,
+				"round":             1,
+				"match_number":      998,
+				"date":              m.Date.Format(time.RFC3339),
+				"team1_id":          teams[0],
+				"team2_id":          teams[1],
+				"goal_scored_team1": 2,
+			})
+		case 2:
+			go request(handler, http.MethodDelete, matchPath, nil)
+		}
+		return nil
+	})
+
+	if !errors.Is(err, client.ErrNotFound) {
+		t.Fatalf("StreamMatch returned %v, want ErrNotFound after the delete", err)
+	}
+	if len(scores) != 2 || scores[0] != 0 || scores[1] != 2 {
+		t.Fatalf("got scores %v, want [0 2]", scores)
+	}
+	if !streamed.Load() {
+		t.Fatal("StreamMatch did not open the SSE stream")
+	}
+}
+
+// TestClientCreateRetriesWithSameKey reintenta un alta que recibió 429: el
+// reintento espera el Retry-After y reenvía la misma Idempotency-Key
+func TestClientCreateRetriesWithSameKey(t *testing.T) {
+	var keys []string
+	var times []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		times = append(times, time.Now())
+		if len(keys) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"error": "Rate limit exceeded"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(tournament.Team{ID: uuid.New(), Name: "Argentina"})
+	}))
+	defer server.Close()
+
+	c := client.New(server.URL, client.WithRetries(2, time.Millisecond))
+	team, err := c.CreateTeam(context.Background(), "Argentina")
+	if err != nil {
+		t.Fatalf("CreateTeam failed: %v", err)
+	}
+	if team.Name != "Argentina" {
+		t.Fatalf("got team %q", team.Name)
+	}
+
+	if len(keys) != 2 || keys[0] == "" || keys[0] != keys[1] {
+		t.Fatalf("got Idempotency-Keys %q, want the same key on both attempts", keys)
+	}
+	if wait := times[1].Sub(times[0]); wait < 900*time.Millisecond {
+		t.Fatalf("retried after %v, want Retry-After (1s)", wait)
+	}
+	if _, err := c.CreateTeam(context.Background(), "Argentina"); err != nil {
+		t.Fatalf("second CreateTeam failed: %v", err)
+	}
+	if keys[2] == keys[0] {
+		t.Fatalf("a second create reused the key %q", keys[2])
+	}
+}
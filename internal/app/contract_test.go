@@ -0,0 +1,206 @@
+package app_test
+
+// Tests de contrato: las respuestas reales de los casos golden se validan
+// contra el documento de /openapi.json, para que el spec no se separe de lo
+// que devuelven los handlers. Se comprueba que la ruta esté documentada, que
+// un 401/403/409/422/429/503 figure entre las respuestas de la operación y que
+// el cuerpo cumpla el esquema: tipos, formatos, campos requeridos y ningún
+// campo que el esquema no declare.
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/handler"
+	"github.com/google/uuid"
+)
+
+// declaredStatuses son los códigos que cada operación debe listar
+// explícitamente cuando los devuelve; el resto cae en "default"
+var declaredStatuses = map[int]bool{
+	http.StatusUnauthorized:        true,
+	http.StatusForbidden:           true,
+	http.StatusConflict:            true,
+	http.StatusUnprocessableEntity: true,
+	http.StatusTooManyRequests:     true,
+	http.StatusServiceUnavailable:  true,
+}
+
+func TestResponsesMatchOpenAPI(t *testing.T) {
+	handlerUnderTest, f := newGoldenHandler(t)
+
+	var doc handler.OpenAPIDocument
+	recorder := goldenRequest(handlerUnderTest, f, goldenCase{method: http.MethodGet, path: "/openapi.json"})
+	if err := json.Unmarshal(recorder.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("failed to decode /openapi.json: %v", err)
+	}
+
+	for _, c := range goldenCases(f) {
+		t.Run(c.name, func(t *testing.T) {
+			recorder := goldenRequest(handlerUnderTest, f, c)
+			operation, ok := doc.Paths[c.route][strings.ToLower(c.method)]
+			if !ok {
+				// Un método sin registrar lo rechaza el router con 405 y el
+				// formato de error de cualquier otra operación de la ruta
+				if recorder.Code != http.StatusMethodNotAllowed || len(doc.Paths[c.route]) == 0 {
+					t.Fatalf("%s %s is not in the spec", c.method, c.route)
+				}
+				for _, other := range doc.Paths[c.route] {
+					operation = other
+					break
+				}
+			}
+
+			var response handler.OpenAPIResponse
+			switch {
+			case recorder.Code >= 200 && recorder.Code < 300:
+				response, ok = operation.Responses["2XX"]
+				if !ok {
+					t.Fatalf("%s %s returned %d but the spec has no 2XX response", c.method, c.route, recorder.Code)
+				}
+			case declaredStatuses[recorder.Code]:
+				declared, ok := operation.Responses[strconv.Itoa(recorder.Code)]
+				if !ok {
+					t.Errorf("%s %s returned %d, which the spec does not declare", c.method, c.route, recorder.Code)
+				}
+				// Sin cuerpo propio, la respuesta declarada es un error
+				response = declared
+				if declared.Content == nil {
+					response = operation.Responses["default"]
+				}
+			default:
+				response = operation.Responses["default"]
+			}
+			media, ok := response.Content["application/json"]
+			if !ok || media.Schema == nil {
+				t.Fatalf("%s %s returned %d without a documented JSON schema", c.method, c.route, recorder.Code)
+			}
+			decoder := json.NewDecoder(bytes.NewReader(recorder.Body.Bytes()))
+			decoder.UseNumber()
+			var body any
+			if err := decoder.Decode(&body); err != nil {
+				t.Fatalf("response is not JSON: %v", err)
+			}
+
+			v := schemaValidator{schemas: doc.Components.Schemas}
+			v.validate(media.Schema, body, "$")
+			for _, problem := range v.problems {
+				t.Errorf("%s %s (%d): %s", c.method, c.path, recorder.Code, problem)
+			}
+		})
+	}
+}
+
+// schemaValidator valida un JSON contra el subconjunto de JSON Schema que
+// genera handler.NewOpenAPIDocument
+type schemaValidator struct {
+	schemas  map[string]*handler.OpenAPISchema
+	problems []string
+}
+
+func (v *schemaValidator) fail(path, format string, args ...any) {
+	v.problems = append(v.problems, path+": "+fmt.Sprintf(format, args...))
+}
+
+func (v *schemaValidator) validate(schema *handler.OpenAPISchema, value any, path string) {
+	if schema.Ref != "" {
+		name := strings.TrimPrefix(schema.Ref, "#/components/schemas/")
+		resolved, ok := v.schemas[name]
+		if !ok {
+			v.fail(path, "unknown schema %s", schema.Ref)
+			return
+		}
+		schema = resolved
+	}
+	if schema.Type == "" {
+		return
+	}
+	if value == nil {
+		if !schema.Nullable {
+			v.fail(path, "null for non-nullable %s", schema.Type)
+		}
+		return
+	}
+
+	switch schema.Type {
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			v.fail(path, "want object, got %T", value)
+			return
+		}
+		v.validateObject(schema, object, path)
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			v.fail(path, "want array, got %T", value)
+			return
+		}
+		for i, item := range items {
+			v.validate(schema.Items, item, fmt.Sprintf("%s[%d]", path, i))
+		}
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			v.fail(path, "want string, got %T", value)
+			return
+		}
+		switch schema.Format {
+		case "date-time":
+			if _, err := time.Parse(time.RFC3339Nano, s); err != nil {
+				v.fail(path, "%q is not a date-time", s)
+			}
+		case "uuid":
+			if _, err := uuid.Parse(s); err != nil {
+				v.fail(path, "%q is not a uuid", s)
+			}
+		}
+	case "integer":
+		n, ok := value.(json.Number)
+		if _, err := n.Int64(); !ok || err != nil {
+			v.fail(path, "want integer, got %v", value)
+		}
+	case "number":
+		if _, ok := value.(json.Number); !ok {
+			v.fail(path, "want number, got %T", value)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			v.fail(path, "want boolean, got %T", value)
+		}
+	}
+}
+
+func (v *schemaValidator) validateObject(schema *handler.OpenAPISchema, object map[string]any, path string) {
+	if schema.AdditionalProperties != nil {
+		for key, item := range object {
+			v.validate(schema.AdditionalProperties, item, path+"."+key)
+		}
+		return
+	}
+	for _, name := range schema.Required {
+		if _, ok := object[name]; !ok {
+			v.fail(path, "missing required field %q", name)
+		}
+	}
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		property, ok := schema.Properties[key]
+		if !ok {
+			v.fail(path, "field %q is not in the schema", key)
+			continue
+		}
+		v.validate(property, object[key], path+"."+key)
+	}
+}
@@ -0,0 +1,429 @@
+package app_test
+
+// Tests de archivos golden: cada caso hace una petición a la aplicación en
+// memoria y compara el código y el cuerpo JSON con testdata/golden/<caso>.json,
+// así cualquier cambio del contrato externo (campos, nombres, errores) se ve
+// en el diff. Los datos salen de demo.Seed con una fecha y unos IDs fijos;
+// las horas que dependen del reloj (created_at y demás) se reemplazan por
+// "<now>". Tras un cambio intencional se regeneran con:
+//
+//	go test ./internal/app -run Golden -update
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/app"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/demo"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata/golden with the current responses")
+
+// goldenNow es la fecha con que se siembran los datos; queda en el pasado,
+// así que todas las jornadas se ven como ya jugadas o vencidas
+var goldenNow = time.Date(2024, time.March, 13, 12, 0, 0, 0, time.UTC)
+
+const goldenOrganizerToken = "golden-organizer-token"
+
+// missingID no existe en los datos sembrados
+var missingID = uuid.MustParse("00000000-0000-4000-8000-000000000000")
+
+// Quién hace la petición de un caso
+const (
+	asPublic    = ""
+	asOrganizer = "organizer"
+	asClient    = "client"
+)
+
+// goldenCase es una petición con su archivo golden. route es el patrón de
+// la tabla de rutas que cubre, para comprobar que ninguna ruta GET quede
+// sin caso.
+type goldenCase struct {
+	name   string
+	route  string
+	method string
+	path   string
+	body   string
+	as     string
+}
+
+// goldenFixture son los IDs de los datos sembrados que usan los casos
+type goldenFixture struct {
+	tournament, team, player, match, event, venue, referee, season uuid.UUID
+	clientToken                                                    string
+}
+
+// skippedGoldenRoutes no devuelven JSON (streams, páginas, binarios) o
+// dependen del binario (/version, /openapi.json)
+var skippedGoldenRoutes = map[string]bool{
+	"/api/matches/{id}/stream":                    true,
+	"/api/v1/matches/{id}/stream":                 true,
+	"/api/venues/{id}/scoreboard/stream":          true,
+	"/api/tournaments/{id}/draws/{drawId}/stream": true,
+	"/api/tournaments/{id}/workbook.xlsx":         true,
+	"/ws":                                         true,
+	"/docs":                                       true,
+	"/openapi.json":                               true,
+	"/version":                                    true,
+}
+
+func goldenCases(f goldenFixture) []goldenCase {
+	tournament := "/api/tournaments/" + f.tournament.String()
+	team := "/api/teams/" + f.team.String()
+	player := "/api/players/" + f.player.String()
+	match := "/api/matches/" + f.match.String()
+	v1Match := "/api/v1/matches/" + f.match.String()
+	venue := "/api/venues/" + f.venue.String()
+	missing := missingID.String()
+
+	get := func(name, route, path string) goldenCase {
+		return goldenCase{name: name, route: route, method: http.MethodGet, path: path}
+	}
+	organizer := func(c goldenCase) goldenCase {
+		c.as = asOrganizer
+		return c
+	}
+	client := func(c goldenCase) goldenCase {
+		c.as = asClient
+		return c
+	}
+
+	return []goldenCase{
+		get("health", "/health", "/health"),
+		get("ready", "/ready", "/ready"),
+		get("status", "/api/status", "/api/status"),
+		get("search", "/api/search", "/api/search?q=Demo"),
+
+		organizer(get("admin_alerts", "/api/admin/alerts", "/api/admin/alerts")),
+		organizer(get("admin_clients", "/api/admin/clients", "/api/admin/clients")),
+		organizer(get("conflicts", "/api/conflicts", "/api/conflicts")),
+		organizer(get("conflict_not_found", "/api/conflicts/{id}", "/api/conflicts/"+missing)),
+
+		get("divisions", "/api/divisions", "/api/divisions"),
+		get("division_not_found", "/api/divisions/{id}", "/api/divisions/"+missing),
+		get("seasons", "/api/seasons", "/api/seasons"),
+		get("season", "/api/seasons/{id}", "/api/seasons/"+f.season.String()),
+		get("referees", "/api/referees", "/api/referees"),
+		get("referee", "/api/referees/{id}", "/api/referees/"+f.referee.String()),
+		get("ratings", "/api/ratings", "/api/ratings"),
+		get("tags", "/api/tags", "/api/tags"),
+		get("tag_not_found", "/api/tags/{id}", "/api/tags/"+missing),
+		organizer(get("provisional_results", "/api/provisional-results", "/api/provisional-results")),
+		organizer(get("provisional_result_not_found", "/api/provisional-results/{id}", "/api/provisional-results/"+missing)),
+
+		get("matches", "/api/matches", "/api/matches?page_size=3"),
+		get("match", "/api/matches/{id}", match),
+		get("match_clock", "/api/matches/{id}/clock", match+"/clock"),
+		get("match_events", "/api/matches/{id}/events", match+"/events"),
+		get("match_event", "/api/matches/{id}/events/{eventId}", match+"/events/"+f.event.String()),
+		get("match_forfeit", "/api/matches/{id}/forfeit", match+"/forfeit"),
+		get("match_lineups", "/api/matches/{id}/lineups", match+"/lineups"),
+		get("match_media", "/api/matches/{id}/media", match+"/media"),
+		get("match_media_not_found", "/api/matches/{id}/media/{mediaId}", match+"/media/"+missing),
+		get("match_prediction", "/api/matches/{id}/prediction", match+"/prediction"),
+		get("match_referees", "/api/matches/{id}/referees", match+"/referees"),
+		get("match_submatches", "/api/matches/{id}/submatches", match+"/submatches"),
+		get("match_substitutions", "/api/matches/{id}/substitutions", match+"/substitutions"),
+		get("match_substitution_not_found", "/api/matches/{id}/substitutions/{substitutionId}", match+"/substitutions/"+missing),
+		get("match_tags", "/api/matches/{id}/tags", match+"/tags"),
+
+		get("players", "/api/players", "/api/players?page_size=3"),
+		get("player", "/api/players/{id}", player),
+		get("player_injuries", "/api/players/{id}/injuries", player+"/injuries"),
+		get("player_injury_not_found", "/api/players/{id}/injuries/{injuryId}", player+"/injuries/"+missing),
+		get("player_tags", "/api/players/{id}/tags", player+"/tags"),
+		get("player_transfers", "/api/players/{id}/transfers", player+"/transfers"),
+
+		get("teams", "/api/teams", "/api/teams?page_size=3"),
+		get("team_check_name", "/api/teams/check-name", "/api/teams/check-name?name="+url.QueryEscape("Nuevo Equipo")),
+		get("team", "/api/teams/{id}", team),
+		get("team_guests", "/api/teams/{id}/guests", team+"/guests"),
+		get("team_players", "/api/teams/{id}/players", team+"/players"),
+		get("team_rating", "/api/teams/{id}/rating", team+"/rating"),
+		get("team_staff", "/api/teams/{id}/staff", team+"/staff"),
+		get("team_staff_not_found", "/api/teams/{id}/staff/{staffId}", team+"/staff/"+missing),
+		get("team_tags", "/api/teams/{id}/tags", team+"/tags"),
+
+		get("tournaments", "/api/tournaments", "/api/tournaments"),
+		get("tournament_check_name", "/api/tournaments/check-name", "/api/tournaments/check-name?name="+url.QueryEscape("Liga Demo")),
+		get("tournament", "/api/tournaments/{id}", tournament),
+		get("tournament_analytics", "/api/tournaments/{id}/analytics", tournament+"/analytics"),
+		get("tournament_assists", "/api/tournaments/{id}/assists", tournament+"/assists"),
+		get("tournament_cleansheets", "/api/tournaments/{id}/cleansheets", tournament+"/cleansheets"),
+		get("tournament_divisions", "/api/tournaments/{id}/divisions", tournament+"/divisions"),
+		get("tournament_divisions_stats", "/api/tournaments/{id}/divisions/stats", tournament+"/divisions/stats"),
+		get("tournament_draws", "/api/tournaments/{id}/draws", tournament+"/draws"),
+		get("tournament_draw_not_found", "/api/tournaments/{id}/draws/{drawId}", tournament+"/draws/"+missing),
+		get("tournament_registrations", "/api/tournaments/{id}/registrations", tournament+"/registrations"),
+		get("tournament_rounds", "/api/tournaments/{id}/rounds", tournament+"/rounds"),
+		get("tournament_round_matches", "/api/tournaments/{id}/rounds/{round}/matches", tournament+"/rounds/1/matches"),
+		get("tournament_rules", "/api/tournaments/{id}/rules", tournament+"/rules"),
+		get("tournament_sanctions", "/api/tournaments/{id}/sanctions", tournament+"/sanctions"),
+		get("tournament_sanction_not_found", "/api/tournaments/{id}/sanctions/{sanctionId}", tournament+"/sanctions/"+missing),
+		get("tournament_seeding", "/api/tournaments/{id}/seeding", tournament+"/seeding"),
+		get("tournament_sponsors", "/api/tournaments/{id}/sponsors", tournament+"/sponsors"),
+		get("tournament_sponsors_active", "/api/tournaments/{id}/sponsors/active", tournament+"/sponsors/active"),
+		get("tournament_sponsor_not_found", "/api/tournaments/{id}/sponsors/{sponsorId}", tournament+"/sponsors/"+missing),
+		get("tournament_stages", "/api/tournaments/{id}/stages", tournament+"/stages"),
+		get("tournament_stage_not_found", "/api/tournaments/{id}/stages/{stageId}", tournament+"/stages/"+missing),
+		get("tournament_stage_matches_not_found", "/api/tournaments/{id}/stages/{stageId}/matches", tournament+"/stages/"+missing+"/matches"),
+		get("tournament_standings", "/api/tournaments/{id}/standings", tournament+"/standings"),
+		get("tournament_fixtures_export", "/api/tournaments/{id}/fixtures/export", tournament+"/fixtures/export"),
+		get("tournament_standings_round", "/api/tournaments/{id}/standings", tournament+"/standings?round=2"),
+		get("tournament_teams", "/api/tournaments/{id}/teams", tournament+"/teams"),
+		get("tournament_topscorers", "/api/tournaments/{id}/topscorers", tournament+"/topscorers"),
+
+		get("venues", "/api/venues", "/api/venues"),
+		get("venue", "/api/venues/{id}", venue),
+		get("venue_pitches", "/api/venues/{id}/pitches", venue+"/pitches"),
+		get("venue_pitch_not_found", "/api/venues/{id}/pitches/{pitchId}", venue+"/pitches/"+missing),
+		get("venue_scoreboard", "/api/venues/{id}/scoreboard", venue+"/scoreboard"),
+
+		// La API pública con un token de cliente
+		client(get("v1_matches", "/api/v1/matches", "/api/v1/matches?page_size=3")),
+		client(get("v1_match", "/api/v1/matches/{id}", v1Match)),
+		client(get("v1_match_clock", "/api/v1/matches/{id}/clock", v1Match+"/clock")),
+		client(get("v1_match_events", "/api/v1/matches/{id}/events", v1Match+"/events")),
+		client(get("v1_match_event", "/api/v1/matches/{id}/events/{eventId}", v1Match+"/events/"+f.event.String())),
+		client(get("v1_match_forfeit", "/api/v1/matches/{id}/forfeit", v1Match+"/forfeit")),
+		client(get("v1_match_lineups", "/api/v1/matches/{id}/lineups", v1Match+"/lineups")),
+		client(get("v1_match_media", "/api/v1/matches/{id}/media", v1Match+"/media")),
+		client(get("v1_match_media_not_found", "/api/v1/matches/{id}/media/{mediaId}", v1Match+"/media/"+missing)),
+		client(get("v1_match_prediction", "/api/v1/matches/{id}/prediction", v1Match+"/prediction")),
+		client(get("v1_match_referees", "/api/v1/matches/{id}/referees", v1Match+"/referees")),
+		client(get("v1_match_submatches", "/api/v1/matches/{id}/submatches", v1Match+"/submatches")),
+		client(get("v1_match_substitutions", "/api/v1/matches/{id}/substitutions", v1Match+"/substitutions")),
+		client(get("v1_match_substitution_not_found", "/api/v1/matches/{id}/substitutions/{substitutionId}", v1Match+"/substitutions/"+missing)),
+		client(get("v1_match_tags", "/api/v1/matches/{id}/tags", v1Match+"/tags")),
+		client(get("me_limits", "/api/me/limits", "/api/me/limits")),
+		client(get("v1_standings", "/api/v1/tournaments/{id}/standings", "/api/v1/tournaments/"+f.tournament.String()+"/standings")),
+
+		// Errores: el formato {"error": "..."} también es contrato
+		get("error_invalid_uuid", "/api/teams/{id}", "/api/teams/not-a-uuid"),
+		get("error_team_not_found", "/api/teams/{id}", "/api/teams/"+missing),
+		get("error_match_not_found", "/api/matches/{id}", "/api/matches/"+missing),
+		get("error_invalid_round", "/api/tournaments/{id}/standings", tournament+"/standings?round=abc"),
+		get("error_admin_without_token", "/api/admin/alerts", "/api/admin/alerts"),
+		get("error_v1_without_token", "/api/v1/matches", "/api/v1/matches"),
+		{name: "error_invalid_payload", route: "/api/teams", method: http.MethodPost, path: "/api/teams", body: "{"},
+		{name: "error_match_same_teams", route: "/api/matches", method: http.MethodPost, path: "/api/matches",
+			body: `{"date": "2024-03-20T16:00:00Z", "team1_id": "` + f.team.String() + `", "team2_id": "` + f.team.String() + `"}`},
+		{name: "error_method_not_allowed", route: "/api/status", method: http.MethodDelete, path: "/api/status"},
+		{name: "error_oauth_unsupported_grant", route: "/oauth/token", method: http.MethodPost, path: "/oauth/token", body: "grant_type=password"},
+	}
+}
+
+// newGoldenHandler arma la aplicación en memoria con los datos de demo a
+// fecha goldenNow. Los UUID salen de una fuente fija mientras se siembra,
+// así los IDs (y el orden de lo que se ordena por ID) no cambian entre
+// corridas.
+func newGoldenHandler(t *testing.T) (http.Handler, goldenFixture) {
+	t.Helper()
+
+	uuid.SetRand(rand.New(rand.NewSource(1)))
+	t.Cleanup(func() { uuid.SetRand(nil) })
+
+	store := repository.NewMemoryStore()
+	if err := demo.Seed(store, goldenNow); err != nil {
+		t.Fatalf("failed to seed data: %v", err)
+	}
+
+	previous := log.Writer()
+	log.SetOutput(io.Discard)
+	t.Cleanup(func() { log.SetOutput(previous) })
+	application, err := app.New(app.WithMemoryStore(store), app.WithOrganizerToken(goldenOrganizerToken))
+	if err != nil {
+		t.Fatalf("failed to build application: %v", err)
+	}
+	handler := application.Handler()
+
+	var f goldenFixture
+	tournaments, _ := repository.NewMemoryTournamentRepository(store).GetAll(false)
+	teams, _ := repository.NewMemoryTeamRepository(store).GetAll()
+	venues, _ := repository.NewMemoryVenueRepository(store).GetAll()
+	referees, _ := repository.NewMemoryRefereeRepository(store).GetAll()
+	seasons, _ := repository.NewMemorySeasonRepository(store).GetAll()
+	if len(tournaments) == 0 || len(teams) == 0 || len(venues) == 0 || len(referees) == 0 || len(seasons) == 0 {
+		t.Fatal("seeded data is incomplete")
+	}
+	f.tournament, f.venue, f.referee, f.season = tournaments[0].ID, venues[0].ID, referees[0].ID, seasons[0].ID
+	sort.Slice(teams, func(i, j int) bool { return teams[i].Name < teams[j].Name })
+	f.team = teams[0].ID
+
+	// El primer partido de la primera jornada tiene resultado y eventos
+	matches, _ := repository.NewMemoryMatchRepository(store).GetByTournament(f.tournament)
+	sort.Slice(matches, func(i, j int) bool { return matches[i].MatchNumber < matches[j].MatchNumber })
+	for _, m := range matches {
+		if m.Round != 1 {
+			continue
+		}
+		events, _ := repository.NewMemoryMatchEventRepository(store).GetByMatch(m.ID)
+		if len(events) > 0 {
+			f.match, f.event = m.ID, events[0].ID
+			if events[0].PlayerID != nil {
+				f.player = *events[0].PlayerID
+			}
+			break
+		}
+	}
+	if f.event == uuid.Nil || f.player == uuid.Nil {
+		t.Fatal("seeded data has no goal in the first round")
+	}
+
+	// Un cliente de la API pública para las rutas /api/v1
+	registered := goldenRequest(handler, f, goldenCase{
+		method: http.MethodPost, path: "/api/admin/clients", as: asOrganizer,
+		body: `{"name": "golden", "scopes": ["` + domain.ScopeReadMatches + `", "` + domain.ScopeReadStandings + `"]}`,
+	})
+	var credentials domain.APIClientCredentials
+	if err := json.Unmarshal(registered.Body.Bytes(), &credentials); err != nil {
+		t.Fatalf("failed to register API client: %d %s", registered.Code, registered.Body)
+	}
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {credentials.ID.String()},
+		"client_secret": {credentials.ClientSecret},
+	}
+	issued := goldenRequest(handler, f, goldenCase{method: http.MethodPost, path: "/oauth/token", body: form.Encode()})
+	var token domain.TokenResponse
+	if err := json.Unmarshal(issued.Body.Bytes(), &token); err != nil || token.AccessToken == "" {
+		t.Fatalf("failed to issue token: %d %s", issued.Code, issued.Body)
+	}
+	f.clientToken = token.AccessToken
+	return handler, f
+}
+
+// goldenRequest hace la petición del caso con las credenciales que pide
+func goldenRequest(handler http.Handler, f goldenFixture, c goldenCase) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(c.method, c.path, strings.NewReader(c.body))
+	if strings.HasPrefix(c.path, "/oauth/") {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	} else {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	switch c.as {
+	case asOrganizer:
+		req.Header.Set("Authorization", "Bearer "+goldenOrganizerToken)
+	case asClient:
+		req.Header.Set("Authorization", "Bearer "+f.clientToken)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	return recorder
+}
+
+// normalizeGolden devuelve el código y el cuerpo indentados, con las horas
+// posteriores a since (las que dependen del reloj) reemplazadas por "<now>"
+func normalizeGolden(t *testing.T, recorder *httptest.ResponseRecorder, since time.Time) []byte {
+	t.Helper()
+
+	var body any = recorder.Body.String()
+	if strings.HasPrefix(recorder.Header().Get("Content-Type"), "application/json") {
+		decoder := json.NewDecoder(bytes.NewReader(recorder.Body.Bytes()))
+		decoder.UseNumber()
+		if err := decoder.Decode(&body); err != nil {
+			t.Fatalf("response is not valid JSON: %v\n%s", err, recorder.Body)
+		}
+		body = replaceClockTimes(body, since)
+	}
+
+	var out bytes.Buffer
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(map[string]any{"status": recorder.Code, "body": body}); err != nil {
+		t.Fatalf("failed to encode golden: %v", err)
+	}
+	return out.Bytes()
+}
+
+func replaceClockTimes(value any, since time.Time) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = replaceClockTimes(item, since)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = replaceClockTimes(item, since)
+		}
+	case string:
+		if at, err := time.Parse(time.RFC3339Nano, v); err == nil && !at.Before(since) {
+			return "<now>"
+		}
+	}
+	return value
+}
+
+// TestGoldenResponses compara cada respuesta con su archivo golden
+func TestGoldenResponses(t *testing.T) {
+	// Las horas del reloj de la corrida son posteriores a goldenNow y a
+	// todos los partidos sembrados
+	since := time.Now().Add(-time.Hour)
+	handler, f := newGoldenHandler(t)
+
+	for _, c := range goldenCases(f) {
+		t.Run(c.name, func(t *testing.T) {
+			got := normalizeGolden(t, goldenRequest(handler, f, c), since)
+			path := filepath.Join("testdata", "golden", c.name+".json")
+			if *update {
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("missing golden file (run with -update): %v", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s %s differs from %s (run with -update if the change is intended)\ngot:\n%s", c.method, c.path, path, got)
+			}
+		})
+	}
+}
+
+// TestGoldenCoverage falla si una ruta GET con respuesta JSON no tiene caso
+func TestGoldenCoverage(t *testing.T) {
+	handler, f := newGoldenHandler(t)
+
+	var doc struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	recorder := goldenRequest(handler, f, goldenCase{method: http.MethodGet, path: "/openapi.json"})
+	if err := json.Unmarshal(recorder.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("failed to decode /openapi.json: %v", err)
+	}
+
+	covered := make(map[string]bool)
+	for _, c := range goldenCases(f) {
+		if c.method == http.MethodGet {
+			covered[c.route] = true
+		}
+	}
+	for pattern, operations := range doc.Paths {
+		if _, ok := operations["get"]; ok && !covered[pattern] && !skippedGoldenRoutes[pattern] {
+			t.Errorf("GET %s has no golden case", pattern)
+		}
+	}
+}
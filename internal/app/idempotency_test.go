@@ -0,0 +1,55 @@
+package app_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestIdempotencyKeysPerCaller usa la misma Idempotency-Key desde dos
+// clientes: cada uno crea su jugador, y solo el que reutiliza su propia clave
+// con otro cuerpo recibe 422
+func TestIdempotencyKeysPerCaller(t *testing.T) {
+	handler, _ := newBenchHandler(t)
+	const key = "6f1c2a9e-4b7d-4e0a-9d3f-2c8b5e7a1f40"
+
+	post := func(authorization, name string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(map[string]any{
+			"name":       name,
+			"date_birth": "2001-05-14T00:00:00Z",
+			"position":   "forward",
+		})
+		req := httptest.NewRequest(http.MethodPost, "/api/players", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Idempotency-Key", key)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	first := post("Bearer app-a", "Lionel Gómez")
+	if first.Code != http.StatusCreated {
+		t.Fatalf("first caller returned %d: %s", first.Code, first.Body)
+	}
+	second := post("Bearer app-b", "Diego Pérez")
+	if second.Code != http.StatusCreated || second.Header().Get("Idempotent-Replayed") != "" {
+		t.Fatalf("second caller with the same key returned %d (replayed %q): %s",
+			second.Code, second.Header().Get("Idempotent-Replayed"), second.Body)
+	}
+	if bytes.Equal(first.Body.Bytes(), second.Body.Bytes()) {
+		t.Fatal("second caller received the first caller's response")
+	}
+
+	retry := post("Bearer app-a", "Lionel Gómez")
+	if retry.Code != http.StatusCreated || retry.Header().Get("Idempotent-Replayed") != "true" {
+		t.Fatalf("retry returned %d (replayed %q)", retry.Code, retry.Header().Get("Idempotent-Replayed"))
+	}
+	if reused := post("Bearer app-b", "Otro Nombre"); reused.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("reusing a key with another body returned %d, want 422", reused.Code)
+	}
+}
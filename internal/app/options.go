@@ -0,0 +1,250 @@
+package app
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+)
+
+// Option configura la aplicación al construirla (patrón "functional options").
+// En C# esto sería parecido a configurar servicios en builder.Services.
+type Option func(*App)
+
+// Repositories agrupa todos los repositorios que usa la aplicación.
+// Por defecto se crean las implementaciones de PostgreSQL.
+type Repositories struct {
+	Players       repository.PlayerRepository
+	Teams         repository.TeamRepository
+	Tournaments   repository.TournamentRepository
+	Matches       repository.MatchRepository
+	Draws         repository.DrawRepository
+	Sponsors      repository.SponsorRepository
+	MatchEvents   repository.MatchEventRepository
+	Substitutions repository.SubstitutionRepository
+	Lineups       repository.LineupRepository
+	Stats         repository.StatsRepository
+	SyncConflicts repository.SyncConflictRepository
+	Referees      repository.RefereeRepository
+	Sync          repository.SyncRepository
+	Venues        repository.VenueRepository
+	Seasons       repository.SeasonRepository
+	Stages        repository.StageRepository
+	// ProvisionalResults guarda los resultados recibidos por email
+	ProvisionalResults repository.ProvisionalResultRepository
+	// Analytics guarda las estadísticas agregadas precalculadas
+	Analytics repository.AnalyticsRepository
+	Ratings   repository.RatingRepository
+	// TestData purga las entidades marcadas como prueba
+	TestData repository.TestDataRepository
+	// Transfers lee el historial de pases de los jugadores
+	Transfers repository.TransferRepository
+	Injuries  repository.InjuryRepository
+	// Pitches guarda las canchas de cada sede
+	Pitches repository.PitchRepository
+	// Alerts guarda las tareas pendientes de los organizadores
+	Alerts repository.AlertRepository
+	Tags   repository.TagRepository
+	// Staff guarda el cuerpo técnico de los equipos
+	Staff repository.StaffRepository
+	// Guests reasigna los invitados sin ficha a jugadores inscriptos
+	Guests repository.GuestRepository
+	// Media guarda la galería de fotos y videos de cada partido
+	Media repository.MediaRepository
+	// TournamentRules guarda las reglas de plantel y duración de cada torneo
+	TournamentRules repository.TournamentRulesRepository
+	// Registrations guarda con qué equipo está fichado cada jugador en cada torneo
+	Registrations repository.RegistrationRepository
+	// MatchClocks guarda el reloj de cada partido
+	MatchClocks repository.MatchClockRepository
+	// Incidents guarda las notas de la página de estado
+	Incidents repository.IncidentRepository
+	// StandingsSnapshots guarda la tabla de cada jornada completa
+	StandingsSnapshots repository.StandingsSnapshotRepository
+	// Divisions guarda la pirámide de ligas para ascensos y descensos
+	Divisions repository.DivisionRepository
+	// APIClients guarda las aplicaciones de terceros y sus tokens
+	APIClients repository.APIClientRepository
+	// MatchForfeits guarda el historial de partidos adjudicados por walkover
+	MatchForfeits repository.MatchForfeitRepository
+	// Sanctions guarda las decisiones disciplinarias de cada torneo
+	Sanctions repository.SanctionRepository
+	// Idempotency guarda las respuestas de los POST con Idempotency-Key
+	Idempotency repository.IdempotencyRepository
+}
+
+// WithDB usa una conexión ya abierta en lugar de conectarse con las variables
+// de entorno. La aplicación no la cierra al detenerse.
+func WithDB(db *sql.DB) Option {
+	return func(a *App) {
+		a.db = db
+	}
+}
+
+// WithAddr define la dirección de escucha del servidor HTTP (p. ej. ":8080")
+func WithAddr(addr string) Option {
+	return func(a *App) {
+		a.addr = addr
+	}
+}
+
+// WithOrganizerToken define el token de los organizadores
+func WithOrganizerToken(token string) Option {
+	return func(a *App) {
+		a.organizerToken = token
+	}
+}
+
+// WithInboundEmailKey define la clave del webhook de emails de resultados
+// (signing key de Mailgun o token del relay propio)
+func WithInboundEmailKey(key string) Option {
+	return func(a *App) {
+		a.inboundEmailKey = key
+	}
+}
+
+// WithAnalyticsInterval define cada cuánto recalcula el job las analíticas
+// de los torneos; con 0 el job no corre
+func WithAnalyticsInterval(interval time.Duration) Option {
+	return func(a *App) {
+		a.analyticsInterval = interval
+	}
+}
+
+// WithAlertsInterval define cada cuánto revisa el job las alertas de los
+// organizadores; con 0 el job no corre
+func WithAlertsInterval(interval time.Duration) Option {
+	return func(a *App) {
+		a.alertsInterval = interval
+	}
+}
+
+// WithArchiveKeepSeasons activa el archivo diario de los torneos de las
+// temporadas anteriores a las keepSeasons más recientes
+func WithArchiveKeepSeasons(keepSeasons int) Option {
+	return func(a *App) {
+		a.archiveKeepSeasons = keepSeasons
+	}
+}
+
+// WithPIIEncryptionKeys cifra los datos personales de los jugadores con las
+// claves indicadas ("id:base64,id:base64"; la primera es la vigente)
+func WithPIIEncryptionKeys(keys string) Option {
+	return func(a *App) {
+		a.piiKeys = keys
+	}
+}
+
+// WithAdminAllowedIPs limita /api/admin/* y las peticiones DELETE a las
+// redes indicadas ("203.0.113.0/24,198.51.100.7"); vacía no restringe
+func WithAdminAllowedIPs(cidrs string) Option {
+	return func(a *App) {
+		a.adminAllowedIPs = cidrs
+	}
+}
+
+// WithMaxSquadSize define el tope de jugadores por plantel para todos los
+// equipos; con 0 solo aplican los topes de las reglas de cada torneo
+func WithMaxSquadSize(maxSquadSize int) Option {
+	return func(a *App) {
+		a.maxSquadSize = maxSquadSize
+	}
+}
+
+// WithDrainDelay define cuánto sigue atendiendo la aplicación tras marcarse
+// como no lista al detenerse
+func WithDrainDelay(delay time.Duration) Option {
+	return func(a *App) {
+		a.drainDelay = delay
+	}
+}
+
+// WithSchemaCheck activa o desactiva la verificación de migraciones al iniciar
+func WithSchemaCheck(enabled bool) Option {
+	return func(a *App) {
+		a.skipSchemaCheck = !enabled
+	}
+}
+
+// WithHTMLEscaping escapa el HTML de los nombres y textos libres recibidos
+func WithHTMLEscaping(enabled bool) Option {
+	return func(a *App) {
+		a.escapeHTML = enabled
+	}
+}
+
+// WithRepositories permite reemplazar repositorios concretos, por ejemplo por
+// dobles de prueba, después de crear los de PostgreSQL
+func WithRepositories(override func(repos *Repositories)) Option {
+	return func(a *App) {
+		a.repoOverrides = append(a.repoOverrides, override)
+	}
+}
+
+// WithComponent registra un subsistema cuyo ciclo de vida gestiona la aplicación
+func WithComponent(component Component) Option {
+	return func(a *App) {
+		a.components = append(a.components, component)
+	}
+}
+
+// WithMemoryStore guarda todos los datos en store en lugar de PostgreSQL
+// (modo demo): no se abre ninguna conexión ni se verifican las migraciones
+func WithMemoryStore(store *repository.MemoryStore) Option {
+	return func(a *App) {
+		a.memory = store
+	}
+}
+
+// WithMemoryPersistence guarda el store del modo demo en path cada interval
+// (si hubo cambios) y una última vez al detenerse
+func WithMemoryPersistence(path string, interval time.Duration) Option {
+	return func(a *App) {
+		a.memoryFile = path
+		a.memorySaveInterval = interval
+	}
+}
+
+// newMemoryRepositories crea todos los repositorios sobre el mismo store
+func newMemoryRepositories(store *repository.MemoryStore) Repositories {
+	return Repositories{
+		Players:            repository.NewMemoryPlayerRepository(store),
+		Teams:              repository.NewMemoryTeamRepository(store),
+		Tournaments:        repository.NewMemoryTournamentRepository(store),
+		Matches:            repository.NewMemoryMatchRepository(store),
+		Draws:              repository.NewMemoryDrawRepository(store),
+		Sponsors:           repository.NewMemorySponsorRepository(store),
+		MatchEvents:        repository.NewMemoryMatchEventRepository(store),
+		Substitutions:      repository.NewMemorySubstitutionRepository(store),
+		Lineups:            repository.NewMemoryLineupRepository(store),
+		Stats:              repository.NewMemoryStatsRepository(store),
+		SyncConflicts:      repository.NewMemorySyncConflictRepository(store),
+		Referees:           repository.NewMemoryRefereeRepository(store),
+		Sync:               repository.NewMemorySyncRepository(store),
+		Venues:             repository.NewMemoryVenueRepository(store),
+		Seasons:            repository.NewMemorySeasonRepository(store),
+		Stages:             repository.NewMemoryStageRepository(store),
+		ProvisionalResults: repository.NewMemoryProvisionalResultRepository(store),
+		Analytics:          repository.NewMemoryAnalyticsRepository(store),
+		Ratings:            repository.NewMemoryRatingRepository(store),
+		TestData:           repository.NewMemoryTestDataRepository(store),
+		Transfers:          repository.NewMemoryTransferRepository(store),
+		Injuries:           repository.NewMemoryInjuryRepository(store),
+		Pitches:            repository.NewMemoryPitchRepository(store),
+		Alerts:             repository.NewMemoryAlertRepository(store),
+		Tags:               repository.NewMemoryTagRepository(store),
+		Staff:              repository.NewMemoryStaffRepository(store),
+		Guests:             repository.NewMemoryGuestRepository(store),
+		Media:              repository.NewMemoryMediaRepository(store),
+		TournamentRules:    repository.NewMemoryTournamentRulesRepository(store),
+		Registrations:      repository.NewMemoryRegistrationRepository(store),
+		MatchClocks:        repository.NewMemoryMatchClockRepository(store),
+		Incidents:          repository.NewMemoryIncidentRepository(store),
+		StandingsSnapshots: repository.NewMemoryStandingsSnapshotRepository(store),
+		Divisions:          repository.NewMemoryDivisionRepository(store),
+		APIClients:         repository.NewMemoryAPIClientRepository(store),
+		MatchForfeits:      repository.NewMemoryMatchForfeitRepository(store),
+		Sanctions:          repository.NewMemorySanctionRepository(store),
+		Idempotency:        repository.NewMemoryIdempotencyRepository(store),
+	}
+}
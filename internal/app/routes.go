@@ -0,0 +1,245 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/buildinfo"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/handler"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/jobs"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/cgonzalezvera/football-tournament-api-native/migrations"
+)
+
+// scoreboardCacheTTL es cuánto se reutiliza el marcador de una sede si no
+// cambia ningún partido
+const scoreboardCacheTTL = 15 * time.Second
+
+// routes crea los casos de uso y handlers y registra las rutas
+// (equivalente a app.MapControllers() en C#)
+func (a *App) routes() http.Handler {
+	repos := a.repos
+
+	// Inicializar casos de uso (Business Logic Layer)
+	playerUC := usecase.NewPlayerUseCase(repos.Players, repos.Transfers)
+	teamUC := usecase.NewTeamUseCase(repos.Teams, repos.Players, repos.Transfers, repos.TournamentRules, a.maxSquadSize)
+	tournamentUC := usecase.NewTournamentUseCase(repos.Tournaments, repos.Teams, repos.Seasons, repos.TournamentRules, repos.Registrations, repos.StandingsSnapshots)
+	ratingUC := usecase.NewRatingUseCase(repos.Ratings, repos.Matches, repos.Teams, repos.Tournaments)
+	// Las pantallas de las sedes comparten un marcador cacheado por sede
+	scoreboard := usecase.NewCachedScoreboard(
+		usecase.NewScoreboardUseCase(repos.Venues, repos.Matches, repos.Teams, repos.Tournaments, repos.TournamentRules),
+		scoreboardCacheTTL,
+	)
+	// Cada marcador guardado invalida las pantallas, se difunde en vivo y
+	// dispara el recálculo de ratings
+	ratingJob := jobs.NewRatingJob(ratingUC)
+	standingsJob := jobs.NewStandingsJob(tournamentUC)
+	publisher := usecase.MatchPublishers{scoreboard, a.hub, ratingJob, standingsJob}
+	matchUC := usecase.NewMatchUseCase(repos.Matches, repos.Teams, repos.Tournaments, repos.SyncConflicts, repos.Referees, repos.Venues, repos.Pitches, repos.Seasons, repos.Stages, repos.TournamentRules, publisher)
+	fixtureUC := usecase.NewFixtureUseCase(repos.Tournaments, repos.Teams, repos.Matches, repos.Venues, repos.Pitches, repos.TournamentRules)
+	drawUC := usecase.NewDrawUseCase(repos.Draws, repos.Tournaments)
+	sponsorUC := usecase.NewSponsorUseCase(repos.Sponsors, repos.Tournaments)
+	matchEventUC := usecase.NewMatchEventUseCase(repos.MatchEvents, repos.Matches, repos.Teams, repos.Tournaments, repos.Registrations, repos.MatchClocks, repos.TournamentRules, publisher)
+	substitutionUC := usecase.NewSubstitutionUseCase(repos.Substitutions, repos.Matches, repos.Teams, repos.Lineups)
+	lineupUC := usecase.NewLineupUseCase(repos.Lineups, repos.Matches, repos.Teams, repos.Injuries, repos.Registrations)
+	statsUC := usecase.NewStatsUseCase(repos.Stats, repos.Tournaments)
+	refereeUC := usecase.NewRefereeUseCase(repos.Referees, repos.Matches)
+	venueUC := usecase.NewVenueUseCase(repos.Venues, repos.Pitches)
+	seasonUC := usecase.NewSeasonUseCase(repos.Seasons)
+	provisionalResultUC := usecase.NewProvisionalResultUseCase(repos.ProvisionalResults, repos.Referees, repos.Matches, matchUC)
+	stageUC := usecase.NewStageUseCase(repos.Stages, repos.Tournaments, repos.Matches)
+	syncUC := usecase.NewSyncUseCase(repos.Sync, repos.Matches, repos.MatchEvents, repos.Teams, repos.Tournaments, repos.Registrations, repos.MatchClocks, repos.TournamentRules, publisher)
+	analyticsUC := usecase.NewAnalyticsUseCase(repos.Analytics, repos.Tournaments, repos.Matches)
+	injuryUC := usecase.NewInjuryUseCase(repos.Injuries, repos.Players)
+	testDataUC := usecase.NewTestDataUseCase(repos.TestData, ratingUC)
+	predictionUC := usecase.NewPredictionUseCase(repos.Matches, repos.Ratings)
+	alertUC := usecase.NewAlertUseCase(repos.Alerts)
+	tagUC := usecase.NewTagUseCase(repos.Tags, repos.Teams, repos.Players, repos.Matches)
+	staffUC := usecase.NewStaffUseCase(repos.Staff, repos.Teams)
+	guestUC := usecase.NewGuestUseCase(repos.Guests, repos.Teams)
+	mediaUC := usecase.NewMediaUseCase(repos.Media, repos.Matches)
+	forfeitUC := usecase.NewMatchForfeitUseCase(repos.MatchForfeits, repos.Matches, repos.Tournaments, publisher)
+	clockUC := usecase.NewMatchClockUseCase(repos.MatchClocks, repos.Matches, repos.Tournaments, repos.TournamentRules, publisher)
+	registrationUC := usecase.NewRegistrationUseCase(repos.Registrations, repos.Tournaments, repos.Teams)
+	divisionUC := usecase.NewDivisionUseCase(repos.Divisions, repos.Tournaments, repos.Seasons, tournamentUC)
+	competitionUC := usecase.NewCompetitionUseCase(repos.Tournaments, repos.Divisions, tournamentUC, analyticsUC, statsUC)
+	apiClientUC := usecase.NewAPIClientUseCase(repos.APIClients)
+	idempotencyUC := usecase.NewIdempotencyUseCase(repos.Idempotency)
+	sanctionUC := usecase.NewSanctionUseCase(repos.Sanctions, repos.Tournaments, repos.Players)
+	searchUC := usecase.NewSearchUseCase(repos.Players, repos.Teams, repos.Tournaments)
+	importUC := usecase.NewImportUseCase(teamUC, teamUC, playerUC)
+	workbookUC := usecase.NewWorkbookUseCase(tournamentUC, matchUC, statsUC, venueUC)
+	statusUC := usecase.NewStatusUseCase(repos.Incidents, a.startedAt, a.dependencyChecks()...)
+
+	// Jobs en segundo plano
+	a.components = append(a.components,
+		jobs.NewAnalyticsJob(analyticsUC, a.analyticsInterval),
+		jobs.NewArchiveJob(tournamentUC, a.archiveKeepSeasons),
+		ratingJob,
+		standingsJob,
+		jobs.NewAlertJob(alertUC, a.alertsInterval),
+	)
+
+	// Inicializar handlers (Presentation Layer)
+	handler.SetHTMLEscaping(a.escapeHTML)
+	organizerAuth := handler.NewOrganizerAuth(a.organizerToken)
+	tagHandler := handler.NewTagHandler(tagUC, tagUC)
+	publicAPIHandler := handler.NewPublicAPIHandler(apiClientUC)
+
+	// Cada handler declara sus rutas; el router las registra con su rol y
+	// su límite y responde OPTIONS, 405 y /openapi.json con la misma tabla
+	var routes []handler.Route
+	for _, h := range []interface{ Routes() []handler.Route }{
+		handler.NewPlayerHandler(playerUC, playerUC, tagHandler),
+		handler.NewInjuryHandler(injuryUC, injuryUC),
+		handler.NewTeamHandler(teamUC, teamUC, tagHandler),
+		// Planillas CSV de equipos y jugadores
+		handler.NewImportHandler(importUC),
+		handler.NewStaffHandler(staffUC, staffUC),
+		handler.NewGuestHandler(guestUC, guestUC),
+		// Ranking Elo de equipos
+		handler.NewRatingHandler(ratingUC, ratingUC),
+		// Etiquetas de equipos, jugadores y partidos
+		tagHandler,
+		handler.NewSeasonHandler(seasonUC, seasonUC),
+		// Divisiones y ascensos/descensos entre temporadas
+		handler.NewDivisionHandler(divisionUC, divisionUC),
+		handler.NewTournamentHandler(tournamentUC, tournamentUC, organizerAuth),
+		handler.NewFixtureHandler(fixtureUC, fixtureUC, predictionUC, matchUC),
+		handler.NewDrawHandler(drawUC, drawUC),
+		handler.NewSponsorHandler(sponsorUC, sponsorUC),
+		handler.NewStageHandler(stageUC, stageUC),
+		handler.NewStatsHandler(statsUC, organizerAuth),
+		handler.NewWorkbookHandler(workbookUC, organizerAuth),
+		handler.NewAnalyticsHandler(analyticsUC, analyticsUC),
+		handler.NewRegistrationHandler(registrationUC, registrationUC),
+		handler.NewCompetitionHandler(competitionUC, organizerAuth),
+		handler.NewSanctionHandler(sanctionUC, sanctionUC),
+		handler.NewMatchHandler(matchUC, matchUC, organizerAuth, tagHandler, predictionUC),
+		handler.NewMatchEventHandler(matchEventUC, matchEventUC, organizerAuth),
+		handler.NewSubstitutionHandler(substitutionUC, substitutionUC),
+		handler.NewLineupHandler(lineupUC, lineupUC),
+		handler.NewRefereeHandler(refereeUC, refereeUC),
+		handler.NewMatchStreamHandler(matchUC, clockUC, organizerAuth, a.hub),
+		handler.NewPredictionHandler(predictionUC),
+		handler.NewMediaHandler(mediaUC, mediaUC),
+		handler.NewMatchClockHandler(clockUC, clockUC),
+		handler.NewMatchForfeitHandler(forfeitUC, forfeitUC),
+		handler.NewVenueHandler(venueUC, venueUC),
+		handler.NewPitchHandler(venueUC, venueUC),
+		handler.NewScoreboardHandler(scoreboard, a.hub, scoreboard.TTL()),
+		// Conflictos de sincronización offline y sincronización por lotes de
+		// las tablets de planilleros
+		handler.NewSyncConflictHandler(matchUC, matchUC),
+		handler.NewSyncHandler(syncUC),
+		// Resultados enviados por email por los árbitros
+		handler.NewInboundEmailHandler(provisionalResultUC, a.inboundEmailKey),
+		handler.NewProvisionalResultHandler(provisionalResultUC, provisionalResultUC),
+		// Tokens para la API de datos de terceros (OAuth2 client credentials)
+		handler.NewOAuthHandler(apiClientUC),
+		// Estado del servicio para la página de estado pública
+		handler.NewStatusHandler(statusUC),
+		// Alertas, incidentes y mantenimiento de los organizadores (purga de datos de prueba)
+		handler.NewAdminHandler(testDataUC, alertUC, alertUC, statusUC, apiClientUC, apiClientUC),
+		// Marcadores y eventos en vivo por WebSocket
+		handler.NewLiveHandler(a.hub),
+		// Buscador único de jugadores, equipos y torneos
+		handler.NewSearchHandler(searchUC),
+	} {
+		routes = append(routes, h.Routes()...)
+	}
+
+	router := handler.NewRouter(organizerAuth, publicAPIHandler, idempotencyUC)
+	a.router = router
+	router.Handle(routes...)
+	// API de datos para aplicaciones de terceros: copias de las lecturas
+	// con token, alcance y límite por cliente
+	router.Handle(publicAPIHandler.Routes(routes)...)
+	router.Handle(a.serviceRoutes()...)
+	router.Handle(handler.Route{
+		Method: http.MethodGet, Pattern: "/openapi.json", Handler: router.OpenAPI(buildinfo.Get().Commit),
+		Summary: "Este documento OpenAPI",
+	}, handler.Route{
+		Method: http.MethodGet, Pattern: "/docs", Handler: handler.Docs,
+		Summary: "Swagger UI sobre /openapi.json",
+	})
+
+	// Sin ADMIN_ALLOWED_IPS la lista es nil y no restringe nada
+	return a.allowlist.Protect(router)
+}
+
+// serviceRoutes son las rutas de operación del servicio: health check,
+// readiness y versión
+func (a *App) serviceRoutes() []handler.Route {
+	return []handler.Route{
+		{Method: http.MethodGet, Pattern: "/health", Handler: health, Summary: "Health check", Response: map[string]string{}},
+		{Method: http.MethodGet, Pattern: "/ready", Handler: a.readiness, Summary: "Readiness para el balanceador", Response: map[string]string{}, Unavailable: true},
+		{Method: http.MethodGet, Pattern: "/version", Handler: version, Summary: "Versión del binario desplegado", Response: versionInfo{}},
+	}
+}
+
+// health es el health check
+func health(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"status":"healthy","service":"tournament-api"}`))
+}
+
+// readiness responde 503 mientras arranca, durante el drenaje previo al
+// apagado o si no hay conexión con la base (el modo demo no tiene base)
+func (a *App) readiness(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if !a.ready.Load() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"status":"draining"}`))
+		return
+	}
+	if a.db != nil {
+		if err := a.db.PingContext(r.Context()); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"status":"database unavailable"}`))
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"status":"ready"}`))
+}
+
+// versionInfo es la respuesta de /version
+type versionInfo struct {
+	buildinfo.Info
+	SchemaVersion int `json:"schema_version"`
+}
+
+// version informa la versión del binario y del esquema
+func version(w http.ResponseWriter, r *http.Request) {
+	info := versionInfo{Info: buildinfo.Get()}
+	info.SchemaVersion, _ = migrations.Latest()
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(info)
+}
+
+// dependencyChecks son los chequeos de salud que informa /api/status; el
+// modo demo no tiene dependencias externas
+func (a *App) dependencyChecks() []usecase.DependencyCheck {
+	if a.db == nil {
+		return nil
+	}
+	return []usecase.DependencyCheck{
+		{Name: "database", Check: a.db.PingContext},
+		{Name: "schema", Check: func(ctx context.Context) error {
+			pending, err := migrations.Pending(ctx, a.db)
+			if err != nil {
+				return err
+			}
+			if len(pending) > 0 {
+				return fmt.Errorf("%d pending migrations", len(pending))
+			}
+			return nil
+		}},
+	}
+}
@@ -0,0 +1,104 @@
+package app_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// request hace una petición con body JSON (nil para ninguno) y devuelve la
+// respuesta grabada
+func request(handler http.Handler, method, path string, body any) *httptest.ResponseRecorder {
+	var payload bytes.Buffer
+	if body != nil {
+		json.NewEncoder(&payload).Encode(body)
+	}
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(method, path, &payload)
+	req.Header.Set("Content-Type", "application/json")
+	handler.ServeHTTP(recorder, req)
+	return recorder
+}
+
+// standingsByTeam devuelve la tabla del torneo indexada por equipo
+func standingsByTeam(t *testing.T, handler http.Handler, tournamentID uuid.UUID) map[uuid.UUID]domain.Standing {
+	t.Helper()
+	recorder := request(handler, http.MethodGet, "/api/tournaments/"+tournamentID.String()+"/standings", nil)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("GET standings returned %d: %s", recorder.Code, recorder.Body)
+	}
+	var standings []domain.Standing
+	if err := json.Unmarshal(recorder.Body.Bytes(), &standings); err != nil {
+		t.Fatalf("failed to decode standings: %v", err)
+	}
+	byTeam := make(map[uuid.UUID]domain.Standing, len(standings))
+	for _, s := range standings {
+		byTeam[s.TeamID] = s
+	}
+	return byTeam
+}
+
+// TestStandingsCountOnlyConfirmedResults carga un partido con fecha pasada
+// y sin resultado confirmado: no debe sumar en la tabla (ni como 0-0) hasta
+// que el organizador lo confirme
+func TestStandingsCountOnlyConfirmedResults(t *testing.T) {
+	handler, tournamentID := newBenchHandler(t)
+
+	before := standingsByTeam(t, handler, tournamentID)
+	var teams []uuid.UUID
+	for id := range before {
+		teams = append(teams, id)
+	}
+	if len(teams) < 2 {
+		t.Fatal("seeded tournament has fewer than two teams")
+	}
+
+	input := map[string]any{
+		"tournament_id": tournamentID,
+		"round":         1,
+		"match_number":  999,
+		"date":          time.Now().UTC().AddDate(0, 0, -2).Format(time.RFC3339),
+		"team1_id":      teams[0],
+		"team2_id":      teams[1],
+	}
+	created := request(handler, http.MethodPost, "/api/matches", input)
+	if created.Code != http.StatusCreated {
+		t.Fatalf("POST /api/matches returned %d: %s", created.Code, created.Body)
+	}
+	var match domain.Match
+	if err := json.Unmarshal(created.Body.Bytes(), &match); err != nil {
+		t.Fatalf("failed to decode match: %v", err)
+	}
+
+	pending := standingsByTeam(t, handler, tournamentID)
+	for _, id := range teams[:2] {
+		if pending[id].Played != before[id].Played || pending[id].Points != before[id].Points {
+			t.Errorf("team %s: unconfirmed past match counted (played %d -> %d, points %d -> %d)",
+				id, before[id].Played, pending[id].Played, before[id].Points, pending[id].Points)
+		}
+	}
+
+	input["goal_scored_team1"] = 2
+	input["result_confirmed"] = true
+	updated := request(handler, http.MethodPut, "/api/matches/"+match.ID.String(), input)
+	if updated.Code != http.StatusOK {
+		t.Fatalf("PUT /api/matches/%s returned %d: %s", match.ID, updated.Code, updated.Body)
+	}
+
+	confirmed := standingsByTeam(t, handler, tournamentID)
+	if got, want := confirmed[teams[0]].Played, before[teams[0]].Played+1; got != want {
+		t.Errorf("winner played = %d, want %d", got, want)
+	}
+	if got, want := confirmed[teams[0]].Points, before[teams[0]].Points+domain.PointsWin; got != want {
+		t.Errorf("winner points = %d, want %d", got, want)
+	}
+	if got, want := confirmed[teams[1]].Played, before[teams[1]].Played+1; got != want {
+		t.Errorf("loser played = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,77 @@
+package app_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// TestSubMatchNumbersAfterDelete borra un mini-juego intermedio y agrega
+// otro: el nuevo no puede repetir el número del último
+func TestSubMatchNumbersAfterDelete(t *testing.T) {
+	handler, tournamentID := newBenchHandler(t)
+
+	var teams []uuid.UUID
+	for id := range standingsByTeam(t, handler, tournamentID) {
+		teams = append(teams, id)
+	}
+	if len(teams) < 2 {
+		t.Fatal("seeded tournament has fewer than two teams")
+	}
+
+	date := time.Now().UTC().AddDate(0, 0, 7).Format(time.RFC3339)
+	parent := createMatch(t, handler, "/api/matches", map[string]any{
+		"tournament_id": tournamentID,
+		"round":         1,
+		"match_number":  999,
+		"date":          date,
+		"team1_id":      teams[0],
+		"team2_id":      teams[1],
+	})
+
+	subPath := "/api/matches/" + parent.ID.String() + "/submatches"
+	var subMatches []domain.Match
+	for i := 0; i < 3; i++ {
+		subMatches = append(subMatches, createMatch(t, handler, subPath, map[string]any{"date": date}))
+	}
+
+	deleted := request(handler, http.MethodDelete, "/api/matches/"+subMatches[1].ID.String(), nil)
+	if deleted.Code != http.StatusOK {
+		t.Fatalf("DELETE sub-match returned %d: %s", deleted.Code, deleted.Body)
+	}
+	added := createMatch(t, handler, subPath, map[string]any{"date": date})
+	if added.MatchNumber != 4 {
+		t.Errorf("new sub-match number = %d, want 4", added.MatchNumber)
+	}
+
+	listed := request(handler, http.MethodGet, subPath, nil)
+	var remaining []domain.Match
+	if err := json.Unmarshal(listed.Body.Bytes(), &remaining); err != nil {
+		t.Fatalf("failed to decode sub-matches: %v", err)
+	}
+	seen := make(map[int]bool)
+	for _, m := range remaining {
+		if seen[m.MatchNumber] {
+			t.Errorf("sub-match number %d is repeated", m.MatchNumber)
+		}
+		seen[m.MatchNumber] = true
+	}
+}
+
+// createMatch hace el POST y devuelve el partido creado
+func createMatch(t *testing.T, handler http.Handler, path string, input map[string]any) domain.Match {
+	t.Helper()
+	recorder := request(handler, http.MethodPost, path, input)
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("POST %s returned %d: %s", path, recorder.Code, recorder.Body)
+	}
+	var match domain.Match
+	if err := json.Unmarshal(recorder.Body.Bytes(), &match); err != nil {
+		t.Fatalf("failed to decode match: %v", err)
+	}
+	return match
+}
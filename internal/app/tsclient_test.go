@@ -0,0 +1,42 @@
+package app_test
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/app"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/tsclient"
+)
+
+// TestTypeScriptClientUpToDate falla si clients/typescript no coincide con
+// lo que genera el documento OpenAPI actual: un DTO nuevo o cambiado tiene
+// que viajar con el cliente regenerado
+func TestTypeScriptClientUpToDate(t *testing.T) {
+	previous := log.Writer()
+	log.SetOutput(io.Discard)
+	t.Cleanup(func() { log.SetOutput(previous) })
+	application, err := app.New(app.WithMemoryStore(repository.NewMemoryStore()))
+	if err != nil {
+		t.Fatalf("failed to build application: %v", err)
+	}
+
+	files, err := tsclient.Generate(application.OpenAPI())
+	if err != nil {
+		t.Fatalf("failed to generate TypeScript client: %v", err)
+	}
+	for name, want := range files {
+		path := filepath.Join("..", "..", "clients", "typescript", name)
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("missing %s (run go run ./cmd/api ts-client): %v", path, err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date: run go run ./cmd/api ts-client", path)
+		}
+	}
+}
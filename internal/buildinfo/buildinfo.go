@@ -0,0 +1,60 @@
+// Package buildinfo identifica el binario en ejecución (commit y fecha de
+// compilación) para /version y los diagnósticos de despliegue.
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// Commit y BuildTime se completan al compilar con -ldflags:
+//
+//	go build -ldflags "-X github.com/cgonzalezvera/football-tournament-api-native/internal/buildinfo.Commit=$(git rev-parse HEAD) \
+//	  -X github.com/cgonzalezvera/football-tournament-api-native/internal/buildinfo.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)" ./cmd/api
+//
+// Si no se indican, se usa la información de VCS que Go embebe al compilar
+// dentro de un repositorio git.
+var (
+	Commit    string
+	BuildTime string
+)
+
+// Info describe el binario
+type Info struct {
+	Commit    string `json:"commit"`
+	BuildTime string `json:"build_time"`
+	// Modified indica que se compiló con cambios sin commitear
+	Modified  bool   `json:"modified,omitempty"`
+	GoVersion string `json:"go_version"`
+}
+
+// Get devuelve la información del binario; los datos desconocidos quedan
+// como "unknown"
+func Get() Info {
+	info := Info{Commit: Commit, BuildTime: BuildTime, GoVersion: runtime.Version()}
+
+	if build, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range build.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if info.Commit == "" {
+					info.Commit = setting.Value
+				}
+			case "vcs.time":
+				if info.BuildTime == "" {
+					info.BuildTime = setting.Value
+				}
+			case "vcs.modified":
+				info.Modified = setting.Value == "true"
+			}
+		}
+	}
+
+	if info.Commit == "" {
+		info.Commit = "unknown"
+	}
+	if info.BuildTime == "" {
+		info.BuildTime = "unknown"
+	}
+	return info
+}
@@ -0,0 +1,274 @@
+// Package demo carga los datos de ejemplo del modo demo (`api --demo`): una
+// liga con equipos, planteles, fixture y algunas jornadas ya jugadas, para
+// probar la API sin configurar nada.
+package demo
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// teamNames son los equipos de la liga de ejemplo; la cantidad debe ser par
+// para que el fixture no tenga fechas libres
+var teamNames = []string{
+	"Atlético Ribera",
+	"Deportivo Los Álamos",
+	"Club Social Norte",
+	"Unión San Martín",
+	"Sporting Puerto Viejo",
+	"Racing del Sur",
+}
+
+// squad son las posiciones de cada plantel en el orden de los dorsales; el
+// primero es el arquero titular
+var squad = []string{
+	domain.PositionGoalkeeper,
+	domain.PositionDefender,
+	domain.PositionDefender,
+	domain.PositionDefender,
+	domain.PositionDefender,
+	domain.PositionMidfielder,
+	domain.PositionMidfielder,
+	domain.PositionMidfielder,
+	domain.PositionForward,
+	domain.PositionForward,
+	domain.PositionForward,
+	domain.PositionGoalkeeper,
+}
+
+// playedRounds son las jornadas que ya se jugaron al momento de cargar
+const playedRounds = 3
+
+var firstNames = []string{"Juan", "Martín", "Lucas", "Diego", "Pablo", "Nicolás", "Santiago", "Matías", "Tomás", "Facundo", "Gonzalo", "Emiliano"}
+var lastNames = []string{"García", "Fernández", "López", "Martínez", "Gómez", "Díaz", "Pérez", "Romero", "Sosa", "Álvarez", "Torres", "Ruiz", "Benítez", "Acosta", "Medina", "Herrera"}
+
+// Seed carga la liga de ejemplo en store. Los resultados son siempre los
+// mismos; las fechas se calculan a partir de now para que las primeras
+// jornadas ya estén jugadas y las demás queden por jugar.
+func Seed(store *repository.MemoryStore, now time.Time) error {
+	s := &seeder{
+		players:     repository.NewMemoryPlayerRepository(store),
+		teams:       repository.NewMemoryTeamRepository(store),
+		tournaments: repository.NewMemoryTournamentRepository(store),
+		matches:     repository.NewMemoryMatchRepository(store),
+		events:      repository.NewMemoryMatchEventRepository(store),
+		lineups:     repository.NewMemoryLineupRepository(store),
+		seasons:     repository.NewMemorySeasonRepository(store),
+		venues:      repository.NewMemoryVenueRepository(store),
+		referees:    repository.NewMemoryRefereeRepository(store),
+		random:      rand.New(rand.NewSource(1)),
+		now:         now.UTC().Truncate(time.Hour),
+	}
+	return s.seed()
+}
+
+type seeder struct {
+	players     repository.PlayerRepository
+	teams       repository.TeamRepository
+	tournaments repository.TournamentRepository
+	matches     repository.MatchRepository
+	events      repository.MatchEventRepository
+	lineups     repository.LineupRepository
+	seasons     repository.SeasonRepository
+	venues      repository.VenueRepository
+	referees    repository.RefereeRepository
+	random      *rand.Rand
+	now         time.Time
+}
+
+// demoTeam es un equipo cargado con su plantel en el orden de squad
+type demoTeam struct {
+	team    *domain.Team
+	players []*domain.Player
+}
+
+func (s *seeder) seed() error {
+	year := s.now.Year()
+	season := domain.NewSeason(
+		fmt.Sprintf("Temporada %d", year),
+		time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC),
+	)
+	if err := s.seasons.Create(season); err != nil {
+		return fmt.Errorf("seed season: %w", err)
+	}
+
+	venue := domain.NewVenue("Estadio Municipal", "Av. Principal 1200", 8000)
+	if err := s.venues.Create(venue); err != nil {
+		return fmt.Errorf("seed venue: %w", err)
+	}
+
+	referee := domain.NewReferee("Ricardo Ibáñez", "ARB-0042")
+	if err := s.referees.Create(referee); err != nil {
+		return fmt.Errorf("seed referee: %w", err)
+	}
+
+	tournament := domain.NewTournament("Liga Demo")
+	tournament.SeasonID = &season.ID
+	tournament.Status = domain.TournamentInProgress
+	if err := s.tournaments.Create(tournament); err != nil {
+		return fmt.Errorf("seed tournament: %w", err)
+	}
+
+	teams := make([]demoTeam, len(teamNames))
+	for i, name := range teamNames {
+		team, err := s.seedTeam(name, i)
+		if err != nil {
+			return err
+		}
+		if err := s.tournaments.AddTeam(tournament.ID, team.team.ID); err != nil {
+			return fmt.Errorf("seed tournament team: %w", err)
+		}
+		teams[i] = team
+	}
+
+	return s.seedFixture(tournament.ID, venue.ID, referee.ID, teams)
+}
+
+func (s *seeder) seedTeam(name string, index int) (demoTeam, error) {
+	team := domain.NewTeam(name)
+	if err := s.teams.Create(team); err != nil {
+		return demoTeam{}, fmt.Errorf("seed team %s: %w", name, err)
+	}
+
+	result := demoTeam{team: team}
+	for i, position := range squad {
+		// Los nombres rotan con el índice del equipo para no repetirse
+		first := firstNames[(i+index*5)%len(firstNames)]
+		last := lastNames[(i*3+index*7)%len(lastNames)]
+		player := domain.NewPlayer(first+" "+last, time.Date(1990+s.random.Intn(15), time.Month(1+s.random.Intn(12)), 1+s.random.Intn(28), 0, 0, 0, 0, time.UTC))
+		player.Position = position
+		player.PreferredFoot = []string{domain.FootRight, domain.FootRight, domain.FootLeft, domain.FootBoth}[s.random.Intn(4)]
+		player.Nationality = "AR"
+		if err := s.players.Create(player); err != nil {
+			return demoTeam{}, fmt.Errorf("seed player: %w", err)
+		}
+		if err := s.teams.AddPlayer(team.ID, player.ID, domain.NewTransfer(player.ID, nil, team.ID)); err != nil {
+			return demoTeam{}, fmt.Errorf("seed team player: %w", err)
+		}
+		number := i + 1
+		if err := s.teams.SetJerseyNumber(team.ID, player.ID, &number); err != nil {
+			return demoTeam{}, fmt.Errorf("seed jersey number: %w", err)
+		}
+		result.players = append(result.players, player)
+	}
+	return result, nil
+}
+
+// seedFixture arma un todos contra todos con el método del círculo: el
+// primer equipo queda fijo y el resto rota una posición por jornada. Las
+// jornadas se juegan los domingos a las 16 y las primeras playedRounds ya
+// tienen resultado.
+func (s *seeder) seedFixture(tournamentID, venueID, refereeID uuid.UUID, teams []demoTeam) error {
+	rounds := len(teams) - 1
+	lastSunday := s.now.AddDate(0, 0, -int(s.now.Weekday()))
+	lastSunday = time.Date(lastSunday.Year(), lastSunday.Month(), lastSunday.Day(), 16, 0, 0, 0, time.UTC)
+	// La última jornada jugada tiene que haber terminado
+	if lastSunday.Add(time.Duration(len(teams)) * time.Hour).After(s.now) {
+		lastSunday = lastSunday.AddDate(0, 0, -7)
+	}
+
+	order := make([]int, len(teams))
+	for i := range order {
+		order[i] = i
+	}
+
+	matchNumber := 0
+	for round := 1; round <= rounds; round++ {
+		date := lastSunday.AddDate(0, 0, 7*(round-playedRounds))
+		for i := 0; i < len(teams)/2; i++ {
+			home, away := teams[order[i]], teams[order[len(order)-1-i]]
+			// Se alterna la localía del equipo fijo para que no sea siempre local
+			if i == 0 && round%2 == 0 {
+				home, away = away, home
+			}
+			matchNumber++
+			match, err := s.seedMatch(tournamentID, venueID, round, matchNumber, date.Add(time.Duration(i)*2*time.Hour), home, away, round <= playedRounds)
+			if err != nil {
+				return err
+			}
+			assignment := []domain.MatchReferee{{RefereeID: refereeID, Role: domain.RefereeRoleMain}}
+			if err := s.referees.SetMatchReferees(match.ID, assignment); err != nil {
+				return fmt.Errorf("seed match referee: %w", err)
+			}
+		}
+		// Rotar todos menos el primero
+		last := order[len(order)-1]
+		copy(order[2:], order[1:len(order)-1])
+		order[1] = last
+	}
+	return nil
+}
+
+func (s *seeder) seedMatch(tournamentID, venueID uuid.UUID, round, number int, date time.Time, home, away demoTeam, played bool) (*domain.Match, error) {
+	goals1, goals2 := 0, 0
+	if played {
+		goals1, goals2 = s.random.Intn(4), s.random.Intn(3)
+	}
+
+	match := domain.NewMatch(number, date, home.team.ID, away.team.ID, goals1, goals2)
+	match.TournamentID = &tournamentID
+	match.VenueID = &venueID
+	match.Round = round
+	if played {
+		match.ConfirmResult(date)
+	}
+	if err := s.matches.Create(match); err != nil {
+		return nil, fmt.Errorf("seed match %d: %w", number, err)
+	}
+	if !played {
+		return match, nil
+	}
+
+	for _, side := range []struct {
+		team  demoTeam
+		goals int
+	}{{home, goals1}, {away, goals2}} {
+		if err := s.seedLineup(match.ID, side.team); err != nil {
+			return nil, err
+		}
+		if err := s.seedGoals(match.ID, side.team, side.goals); err != nil {
+			return nil, err
+		}
+	}
+	return match, nil
+}
+
+// seedLineup alinea a los once primeros del plantel (arquero primero) y deja
+// al arquero suplente en el banco
+func (s *seeder) seedLineup(matchID uuid.UUID, team demoTeam) error {
+	var starting, bench []uuid.UUID
+	for i, player := range team.players {
+		if i < 11 {
+			starting = append(starting, player.ID)
+		} else {
+			bench = append(bench, player.ID)
+		}
+	}
+	if err := s.lineups.Save(domain.NewLineup(matchID, team.team.ID, "4-3-3", starting, bench)); err != nil {
+		return fmt.Errorf("seed lineup: %w", err)
+	}
+	return nil
+}
+
+// seedGoals reparte los goles entre los delanteros y volantes, con la
+// asistencia de otro jugador de campo
+func (s *seeder) seedGoals(matchID uuid.UUID, team demoTeam, goals int) error {
+	for i := 0; i < goals; i++ {
+		scorer := team.players[5+s.random.Intn(6)]
+		assist := team.players[1+s.random.Intn(10)]
+		event := domain.NewMatchEvent(matchID, domain.EventGoal, 1+s.random.Intn(90), team.team.ID, &scorer.ID)
+		if assist.ID != scorer.ID {
+			event.AssistPlayerID = &assist.ID
+		}
+		if err := s.events.Create(event); err != nil {
+			return fmt.Errorf("seed goal: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,258 @@
+// Package doctor verifica la configuración de un despliegue (base de datos,
+// esquema, índices, rutas de almacenamiento y servicios externos) y arma un
+// reporte de pass/fail para diagnosticar problemas rápido.
+package doctor
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/migrations"
+)
+
+// Estados de un chequeo
+const (
+	StatusPass = "PASS"
+	StatusFail = "FAIL"
+	StatusSkip = "SKIP"
+)
+
+// requiredIndexes son los índices de los que dependen las consultas frecuentes
+var requiredIndexes = []string{
+	"idx_matches_date",
+	"idx_matches_tournament_round",
+	"idx_matches_parent",
+	"idx_team_players_team",
+	"idx_tournament_teams_tournament",
+	"idx_sponsors_active",
+	"idx_match_events_match",
+	"idx_team_players_jersey",
+	"idx_matches_active_date",
+}
+
+// Result es el resultado de un chequeo
+type Result struct {
+	Name   string
+	Status string
+	Detail string
+}
+
+// Report agrupa los resultados de todos los chequeos
+type Report struct {
+	Results []Result
+}
+
+// OK indica si ningún chequeo falló (los omitidos no cuentan)
+func (r *Report) OK() bool {
+	for _, result := range r.Results {
+		if result.Status == StatusFail {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Report) add(name, status, detail string) {
+	r.Results = append(r.Results, Result{Name: name, Status: status, Detail: detail})
+}
+
+// Options indica qué verificar además de la base de datos. Los valores vacíos
+// se reportan como omitidos.
+type Options struct {
+	StoragePaths []string
+	SMTPAddr     string
+	RedisAddr    string
+	Timeout      time.Duration
+}
+
+// NewOptionsFromEnv lee STORAGE_PATHS (separadas por coma), SMTP_ADDR y REDIS_ADDR
+func NewOptionsFromEnv() Options {
+	var paths []string
+	for _, path := range strings.Split(os.Getenv("STORAGE_PATHS"), ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			paths = append(paths, path)
+		}
+	}
+	return Options{
+		StoragePaths: paths,
+		SMTPAddr:     os.Getenv("SMTP_ADDR"),
+		RedisAddr:    os.Getenv("REDIS_ADDR"),
+		Timeout:      5 * time.Second,
+	}
+}
+
+// Run ejecuta todos los chequeos
+func Run(ctx context.Context, db *sql.DB, options Options) *Report {
+	report := &Report{}
+
+	dbOK := checkDatabase(ctx, db, options.Timeout, report)
+	if dbOK {
+		checkSchemaVersion(ctx, db, report)
+		checkIndexes(ctx, db, report)
+	} else {
+		report.add("schema version", StatusSkip, "database unreachable")
+		report.add("indexes", StatusSkip, "database unreachable")
+	}
+
+	checkStoragePaths(options.StoragePaths, report)
+	checkSMTP(options.SMTPAddr, options.Timeout, report)
+	checkRedis(options.RedisAddr, options.Timeout, report)
+
+	return report
+}
+
+func checkDatabase(ctx context.Context, db *sql.DB, timeout time.Duration, report *Report) bool {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := db.PingContext(ctx); err != nil {
+		report.add("database", StatusFail, err.Error())
+		return false
+	}
+
+	var version string
+	if err := db.QueryRowContext(ctx, `SHOW server_version`).Scan(&version); err != nil {
+		report.add("database", StatusPass, "connected")
+		return true
+	}
+	report.add("database", StatusPass, "connected to PostgreSQL "+version)
+	return true
+}
+
+// checkSchemaVersion compara las migraciones registradas en la base con las
+// que trae el binario
+func checkSchemaVersion(ctx context.Context, db *sql.DB, report *Report) {
+	latest, err := migrations.Latest()
+	if err != nil {
+		report.add("schema version", StatusFail, err.Error())
+		return
+	}
+
+	pending, err := migrations.Pending(ctx, db)
+	if err != nil {
+		report.add("schema version", StatusFail, err.Error())
+		return
+	}
+
+	if len(pending) > 0 {
+		missing := make([]string, len(pending))
+		for i, migration := range pending {
+			missing[i] = migration.String()
+		}
+		report.add("schema version", StatusFail, "missing migrations: "+strings.Join(missing, ", "))
+		return
+	}
+	report.add("schema version", StatusPass, fmt.Sprintf("up to date (version %d)", latest))
+}
+
+func checkIndexes(ctx context.Context, db *sql.DB, report *Report) {
+	rows, err := db.QueryContext(ctx, `SELECT indexname FROM pg_indexes WHERE schemaname = current_schema()`)
+	if err != nil {
+		report.add("indexes", StatusFail, err.Error())
+		return
+	}
+	defer rows.Close()
+
+	existing := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			report.add("indexes", StatusFail, err.Error())
+			return
+		}
+		existing[name] = true
+	}
+	if err := rows.Err(); err != nil {
+		report.add("indexes", StatusFail, err.Error())
+		return
+	}
+
+	var missing []string
+	for _, name := range requiredIndexes {
+		if !existing[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		report.add("indexes", StatusFail, "missing: "+strings.Join(missing, ", "))
+		return
+	}
+	report.add("indexes", StatusPass, fmt.Sprintf("%d required indexes present", len(requiredIndexes)))
+}
+
+// checkStoragePaths verifica que se pueda escribir en cada ruta configurada
+func checkStoragePaths(paths []string, report *Report) {
+	if len(paths) == 0 {
+		report.add("storage paths", StatusSkip, "STORAGE_PATHS not set")
+		return
+	}
+
+	for _, path := range paths {
+		name := "storage " + path
+		file, err := os.CreateTemp(path, ".doctor-*")
+		if err != nil {
+			report.add(name, StatusFail, err.Error())
+			continue
+		}
+		file.Close()
+		os.Remove(filepath.Clean(file.Name()))
+		report.add(name, StatusPass, "writable")
+	}
+}
+
+func checkSMTP(addr string, timeout time.Duration, report *Report) {
+	if addr == "" {
+		report.add("smtp", StatusSkip, "SMTP_ADDR not set")
+		return
+	}
+
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		report.add("smtp", StatusFail, err.Error())
+		return
+	}
+	defer conn.Close()
+
+	// El servidor saluda con un código 220
+	conn.SetDeadline(time.Now().Add(timeout))
+	greeting, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil || !strings.HasPrefix(greeting, "220") {
+		report.add("smtp", StatusFail, fmt.Sprintf("unexpected greeting from %s: %q", addr, strings.TrimSpace(greeting)))
+		return
+	}
+	report.add("smtp", StatusPass, "reachable at "+addr)
+}
+
+func checkRedis(addr string, timeout time.Duration, report *Report) {
+	if addr == "" {
+		report.add("redis", StatusSkip, "REDIS_ADDR not set")
+		return
+	}
+
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		report.add("redis", StatusFail, err.Error())
+		return
+	}
+	defer conn.Close()
+
+	// Cualquier respuesta RESP (+PONG o -NOAUTH) confirma que es un Redis
+	conn.SetDeadline(time.Now().Add(timeout))
+	if _, err := conn.Write([]byte("PING\r\n")); err != nil {
+		report.add("redis", StatusFail, err.Error())
+		return
+	}
+	reply, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil || (!strings.HasPrefix(reply, "+") && !strings.HasPrefix(reply, "-")) {
+		report.add("redis", StatusFail, fmt.Sprintf("unexpected reply from %s: %q", addr, strings.TrimSpace(reply)))
+		return
+	}
+	report.add("redis", StatusPass, "reachable at "+addr)
+}
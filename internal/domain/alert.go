@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tipos de alerta para los organizadores
+const (
+	// AlertMissingResult: un partido sin resultado confirmado pasado el plazo
+	AlertMissingResult = "missing_result"
+	// AlertShortSquad: un equipo de un torneo abierto o en curso con menos
+	// jugadores que MinSquadSize
+	AlertShortSquad = "short_squad"
+	// AlertUnconfirmedResult: un resultado provisorio pendiente de revisión
+	AlertUnconfirmedResult = "unconfirmed_result"
+)
+
+const (
+	// MissingResultGrace es cuánto se espera tras el inicio de un partido
+	// antes de avisar que no tiene resultado
+	MissingResultGrace = 24 * time.Hour
+	// MinSquadSize es la cantidad mínima de jugadores de un plantel
+	MinSquadSize = 11
+)
+
+// Alert es una tarea pendiente de los organizadores. EntityID es el partido,
+// el equipo o el resultado provisorio según el tipo; una alerta por entidad.
+type Alert struct {
+	ID           uuid.UUID  `json:"id"`
+	Type         string     `json:"type"`
+	EntityID     uuid.UUID  `json:"entity_id"`
+	TournamentID *uuid.UUID `json:"tournament_id,omitempty"`
+	Message      string     `json:"message"`
+	DetectedAt   time.Time  `json:"detected_at"`
+	// DismissedAt indica que un organizador la descartó; no se vuelve a
+	// mostrar mientras siga vigente
+	DismissedAt *time.Time `json:"dismissed_at,omitempty"`
+}
+
+func newAlert(alertType string, entityID uuid.UUID, tournamentID *uuid.UUID, message string) Alert {
+	return Alert{
+		ID:           uuid.New(),
+		Type:         alertType,
+		EntityID:     entityID,
+		TournamentID: tournamentID,
+		Message:      message,
+		DetectedAt:   time.Now().UTC(),
+	}
+}
+
+// NewMissingResultAlert avisa que el partido no tiene resultado cargado
+func NewMissingResultAlert(match *Match, team1, team2 string) Alert {
+	message := fmt.Sprintf("Match %d (%s vs %s) kicked off at %s and has no confirmed result",
+		match.MatchNumber, team1, team2, match.Date.UTC().Format(time.RFC3339))
+	return newAlert(AlertMissingResult, match.ID, match.TournamentID, message)
+}
+
+// NewShortSquadAlert avisa que el equipo no llega al plantel mínimo
+func NewShortSquadAlert(teamID, tournamentID uuid.UUID, teamName string, players int) Alert {
+	message := fmt.Sprintf("Team %s has %d players (minimum %d)", teamName, players, MinSquadSize)
+	return newAlert(AlertShortSquad, teamID, &tournamentID, message)
+}
+
+// NewUnconfirmedResultAlert avisa que un resultado provisorio espera revisión
+func NewUnconfirmedResultAlert(result *ProvisionalResult, matchNumber int, tournamentID *uuid.UUID) Alert {
+	message := fmt.Sprintf("Result %d-%d for match %d (%s) is waiting for confirmation",
+		result.GoalScoredTeam1, result.GoalScoredTeam2, matchNumber, result.Source)
+	return newAlert(AlertUnconfirmedResult, result.ID, tournamentID, message)
+}
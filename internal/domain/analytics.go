@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MatchdayGoals resume los goles de una jornada
+type MatchdayGoals struct {
+	Round        int     `json:"round"`
+	Matches      int     `json:"matches"`
+	Goals        int     `json:"goals"`
+	AverageGoals float64 `json:"average_goals"`
+}
+
+// TournamentAnalytics son las estadísticas agregadas de un torneo. Las
+// calcula periódicamente un job y se guardan ya calculadas, así que
+// ComputedAt indica su antigüedad. El local es team1 y el visitante team2.
+type TournamentAnalytics struct {
+	TournamentID uuid.UUID `json:"tournament_id"`
+	Matches      int       `json:"matches"`
+	Goals        int       `json:"goals"`
+	AverageGoals float64   `json:"average_goals"`
+	HomeWins     int       `json:"home_wins"`
+	AwayWins     int       `json:"away_wins"`
+	Draws        int       `json:"draws"`
+	HomeWinRate  float64   `json:"home_win_rate"`
+	AwayWinRate  float64   `json:"away_win_rate"`
+	DrawRate     float64   `json:"draw_rate"`
+	// GoalsPerMatchday es la evolución de los goles a lo largo de la temporada
+	GoalsPerMatchday []MatchdayGoals `json:"goals_per_matchday"`
+	ComputedAt       time.Time       `json:"computed_at"`
+}
+
+// NewTournamentAnalytics agrega los partidos ya jugados antes de now. Los
+// goles incluyen la prórroga; un partido definido por penales cuenta como
+// empate. Los partidos sin jornada se agrupan en la jornada 0. Los walkover
+// se omiten para que sus goles administrativos no alteren los promedios.
+func NewTournamentAnalytics(tournamentID uuid.UUID, matches []Match, now time.Time) *TournamentAnalytics {
+	analytics := &TournamentAnalytics{
+		TournamentID:     tournamentID,
+		GoalsPerMatchday: []MatchdayGoals{},
+		ComputedAt:       now,
+	}
+
+	byRound := make(map[int]*MatchdayGoals)
+	for i := range matches {
+		match := &matches[i]
+		if match.Date.After(now) || match.IsForfeit() {
+			continue
+		}
+
+		goals1, goals2 := match.TotalGoals()
+		analytics.Matches++
+		analytics.Goals += goals1 + goals2
+		switch {
+		case goals1 > goals2:
+			analytics.HomeWins++
+		case goals2 > goals1:
+			analytics.AwayWins++
+		default:
+			analytics.Draws++
+		}
+
+		matchday, ok := byRound[match.Round]
+		if !ok {
+			matchday = &MatchdayGoals{Round: match.Round}
+			byRound[match.Round] = matchday
+		}
+		matchday.Matches++
+		matchday.Goals += goals1 + goals2
+	}
+
+	for _, matchday := range byRound {
+		matchday.AverageGoals = ratio(matchday.Goals, matchday.Matches)
+		analytics.GoalsPerMatchday = append(analytics.GoalsPerMatchday, *matchday)
+	}
+	sort.Slice(analytics.GoalsPerMatchday, func(i, j int) bool {
+		return analytics.GoalsPerMatchday[i].Round < analytics.GoalsPerMatchday[j].Round
+	})
+
+	analytics.ComputeRates()
+	return analytics
+}
+
+// ComputeRates recalcula los promedios a partir de los totales
+func (a *TournamentAnalytics) ComputeRates() {
+	a.AverageGoals = ratio(a.Goals, a.Matches)
+	a.HomeWinRate = ratio(a.HomeWins, a.Matches)
+	a.AwayWinRate = ratio(a.AwayWins, a.Matches)
+	a.DrawRate = ratio(a.Draws, a.Matches)
+}
+
+// ratio divide redondeando a dos decimales; sin partidos devuelve 0
+func ratio(value, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(value)/float64(total)*100) / 100
+}
+
+// MergeAnalytics suma las estadísticas de varios torneos en una sola bajo
+// tournamentID. ComputedAt es la del cálculo más antiguo, que es la que
+// marca la antigüedad del total.
+func MergeAnalytics(tournamentID uuid.UUID, parts []TournamentAnalytics, now time.Time) *TournamentAnalytics {
+	merged := &TournamentAnalytics{
+		TournamentID:     tournamentID,
+		GoalsPerMatchday: []MatchdayGoals{},
+		ComputedAt:       now,
+	}
+
+	byRound := make(map[int]*MatchdayGoals)
+	for _, part := range parts {
+		merged.Matches += part.Matches
+		merged.Goals += part.Goals
+		merged.HomeWins += part.HomeWins
+		merged.AwayWins += part.AwayWins
+		merged.Draws += part.Draws
+		if part.ComputedAt.Before(merged.ComputedAt) {
+			merged.ComputedAt = part.ComputedAt
+		}
+
+		for _, day := range part.GoalsPerMatchday {
+			matchday, ok := byRound[day.Round]
+			if !ok {
+				matchday = &MatchdayGoals{Round: day.Round}
+				byRound[day.Round] = matchday
+			}
+			matchday.Matches += day.Matches
+			matchday.Goals += day.Goals
+		}
+	}
+
+	for _, matchday := range byRound {
+		matchday.AverageGoals = ratio(matchday.Goals, matchday.Matches)
+		merged.GoalsPerMatchday = append(merged.GoalsPerMatchday, *matchday)
+	}
+	sort.Slice(merged.GoalsPerMatchday, func(i, j int) bool {
+		return merged.GoalsPerMatchday[i].Round < merged.GoalsPerMatchday[j].Round
+	})
+
+	merged.ComputeRates()
+	return merged
+}
@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Alcances que puede pedir una aplicación de terceros
+const (
+	ScopeReadMatches   = "read:matches"
+	ScopeReadStandings = "read:standings"
+)
+
+const (
+	// DefaultClientRateLimit son las peticiones por minuto de un cliente que
+	// no indica su límite
+	DefaultClientRateLimit = 60
+	// APITokenLifetime es la vigencia de un token de acceso
+	APITokenLifetime = time.Hour
+)
+
+// IsValidScope indica si el alcance existe
+func IsValidScope(scope string) bool {
+	return scope == ScopeReadMatches || scope == ScopeReadStandings
+}
+
+// APIClient es una aplicación de terceros registrada por un organizador.
+// Obtiene tokens con el flujo client credentials de OAuth2 usando su ID y
+// secreto; del secreto solo se guarda el hash.
+type APIClient struct {
+	ID                 uuid.UUID  `json:"id"`
+	Name               string     `json:"name"`
+	Scopes             []string   `json:"scopes"`
+	RateLimitPerMinute int        `json:"rate_limit_per_minute"`
+	SecretHash         string     `json:"-"`
+	CreatedAt          time.Time  `json:"created_at"`
+	RevokedAt          *time.Time `json:"revoked_at,omitempty"`
+}
+
+// NewAPIClient crea un cliente; rateLimit <= 0 usa DefaultClientRateLimit
+func NewAPIClient(name string, scopes []string, rateLimit int) *APIClient {
+	if rateLimit <= 0 {
+		rateLimit = DefaultClientRateLimit
+	}
+	return &APIClient{
+		ID:                 uuid.New(),
+		Name:               name,
+		Scopes:             scopes,
+		RateLimitPerMinute: rateLimit,
+		CreatedAt:          time.Now().UTC(),
+	}
+}
+
+// HasScope indica si el cliente puede pedir el alcance
+func (c *APIClient) HasScope(scope string) bool {
+	return containsScope(c.Scopes, scope)
+}
+
+// APIClientCredentials es el cliente recién registrado junto con su secreto,
+// que solo se muestra esta vez
+type APIClientCredentials struct {
+	APIClient
+	ClientSecret string `json:"client_secret"`
+}
+
+// APIToken es un token de acceso emitido a un cliente. RateLimitPerMinute se
+// copia del cliente al validarlo.
+type APIToken struct {
+	TokenHash          string    `json:"-"`
+	ClientID           uuid.UUID `json:"client_id"`
+	Scopes             []string  `json:"scopes"`
+	ExpiresAt          time.Time `json:"expires_at"`
+	RateLimitPerMinute int       `json:"rate_limit_per_minute"`
+}
+
+// HasScope indica si el token habilita el alcance
+func (t *APIToken) HasScope(scope string) bool {
+	return containsScope(t.Scopes, scope)
+}
+
+func containsScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// TokenResponse es la respuesta del endpoint de tokens (RFC 6749, sección 5.1)
+type TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+	Scope       string `json:"scope"`
+}
+
+// Códigos de error del endpoint de tokens (RFC 6749, sección 5.2)
+const (
+	OAuthInvalidRequest       = "invalid_request"
+	OAuthInvalidClient        = "invalid_client"
+	OAuthInvalidScope         = "invalid_scope"
+	OAuthUnsupportedGrantType = "unsupported_grant_type"
+)
+
+// OAuthError es un rechazo del endpoint de tokens con su código estándar
+type OAuthError struct {
+	Code        string
+	Description string
+}
+
+func (e *OAuthError) Error() string {
+	return e.Description
+}
+
+// RateLimitStatus es el consumo del cliente en la ventana de un minuto en
+// curso, para que las aplicaciones se regulen solas en lugar de reintentar
+type RateLimitStatus struct {
+	ClientID  uuid.UUID `json:"client_id"`
+	Scopes    []string  `json:"scopes"`
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	// ResetAt es cuándo empieza la próxima ventana
+	ResetAt   time.Time `json:"reset_at"`
+	ExpiresAt time.Time `json:"token_expires_at"`
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// AttendanceRestriction limita el público de una sede o de un torneo (por
+// ejemplo, por una medida sanitaria o una sanción). El valor cero no limita.
+type AttendanceRestriction struct {
+	// MaxSpectators es el tope de espectadores; 0 no pone tope
+	MaxSpectators int `json:"max_spectators,omitempty"`
+	// ClosedDoors juega sin público
+	ClosedDoors bool   `json:"closed_doors,omitempty"`
+	Reason      string `json:"reason,omitempty"`
+}
+
+// Validate comprueba que el tope no sea negativo
+func (a AttendanceRestriction) Validate() error {
+	if a.MaxSpectators < 0 {
+		return fmt.Errorf("max_spectators cannot be negative")
+	}
+	return nil
+}
+
+// MatchAttendance es el público permitido en un partido: combina la
+// restricción de la sede, la del torneo y la capacidad de la sede
+type MatchAttendance struct {
+	ClosedDoors bool `json:"closed_doors"`
+	// MaxSpectators es el tope efectivo (el menor de los que apliquen)
+	MaxSpectators int `json:"max_spectators,omitempty"`
+	// Capacity es la capacidad de la sede, si se conoce
+	Capacity int `json:"capacity,omitempty"`
+	// Reasons son los motivos de la sede y del torneo, en ese orden
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+// NewMatchAttendance combina las restricciones; venue y rules pueden ser
+// nil. Devuelve nil si ninguna restricción limita el público: la capacidad
+// sola no es una restricción.
+func NewMatchAttendance(venue *Venue, rules *TournamentRules) *MatchAttendance {
+	// Un arreglo fijo y no un slice: la mayoría de los partidos no tiene
+	// restricciones y así no se asigna memoria por cada uno
+	var restrictions [2]AttendanceRestriction
+	capacity := 0
+	if venue != nil {
+		restrictions[0] = venue.Attendance
+		capacity = venue.Capacity
+	}
+	if rules != nil {
+		restrictions[1] = rules.Attendance
+	}
+
+	attendance := MatchAttendance{Capacity: capacity}
+	restricted := false
+	for _, restriction := range restrictions {
+		if restriction == (AttendanceRestriction{}) {
+			continue
+		}
+		restricted = true
+		attendance.ClosedDoors = attendance.ClosedDoors || restriction.ClosedDoors
+		if restriction.MaxSpectators > 0 && (attendance.MaxSpectators == 0 || restriction.MaxSpectators < attendance.MaxSpectators) {
+			attendance.MaxSpectators = restriction.MaxSpectators
+		}
+		if restriction.Reason != "" {
+			attendance.Reasons = append(attendance.Reasons, restriction.Reason)
+		}
+	}
+	if !restricted {
+		return nil
+	}
+
+	if attendance.ClosedDoors {
+		attendance.MaxSpectators = 0
+	} else if capacity > 0 && attendance.MaxSpectators > capacity {
+		attendance.MaxSpectators = capacity
+	}
+	return &attendance
+}
+
+// HasAttendance indica si al partido le corresponden las restricciones
+// vigentes: las que se cambian durante la temporada solo valen para los
+// partidos en juego o por jugar
+func (m *Match) HasAttendance(now time.Time) bool {
+	return m.ParentMatchID == nil && m.Date.After(now.Add(-ScoreboardLiveWindow))
+}
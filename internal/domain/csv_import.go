@@ -0,0 +1,44 @@
+package domain
+
+// Entidades que se importan desde CSV
+const (
+	ImportTeams   = "teams"
+	ImportPlayers = "players"
+)
+
+// MaxImportRows limita las filas de un archivo importado
+const MaxImportRows = 2000
+
+// ImportRow es una fila de un CSV importado. Line es su línea en el archivo
+// (la 1 es el encabezado) y Fields sus columnas por nombre, ya sin espacios
+// sobrantes.
+type ImportRow struct {
+	Line   int
+	Fields map[string]string
+	// Error lo completa quien lee el archivo cuando la fila ya no es
+	// válida (un texto demasiado largo); la fila se rechaza con ese motivo
+	Error string
+}
+
+// ImportRejection es una fila que no se importó y el motivo
+type ImportRejection struct {
+	Line   int               `json:"line"`
+	Record map[string]string `json:"record"`
+	Reason string            `json:"reason"`
+}
+
+// ImportReport es el resultado de importar un CSV: las filas válidas se
+// crean aunque otras se rechacen, como en la importación de fixtures
+type ImportReport struct {
+	Entity   string            `json:"entity"`
+	DryRun   bool              `json:"dry_run"`
+	Imported int               `json:"imported"`
+	Teams    []Team            `json:"teams,omitempty"`
+	Players  []Player          `json:"players,omitempty"`
+	Rejected []ImportRejection `json:"rejected"`
+}
+
+// Reject agrega la fila a las rechazadas
+func (r *ImportReport) Reject(row ImportRow, reason string) {
+	r.Rejected = append(r.Rejected, ImportRejection{Line: row.Line, Record: row.Fields, Reason: reason})
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Division es una categoría de la pirámide de ligas. Level 1 es la más alta;
+// cada temporada sus Promoted primeros suben a la división de arriba y sus
+// Relegated últimos bajan a la de abajo.
+type Division struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Level     int       `json:"level"`
+	Promoted  int       `json:"promoted"`
+	Relegated int       `json:"relegated"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewDivision crea una nueva división
+func NewDivision(name string, level, promoted, relegated int) *Division {
+	return &Division{
+		ID:        uuid.New(),
+		Name:      name,
+		Level:     level,
+		Promoted:  promoted,
+		Relegated: relegated,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// Movimientos de un equipo al cerrar la temporada
+const (
+	MovementPromoted  = "promoted"
+	MovementRelegated = "relegated"
+	MovementStayed    = "stayed"
+)
+
+// DivisionMove es el destino de un equipo en la temporada siguiente
+type DivisionMove struct {
+	TeamID           uuid.UUID `json:"team_id"`
+	TeamName         string    `json:"team_name"`
+	Position         int       `json:"position"`
+	Movement         string    `json:"movement"`
+	FromDivisionID   uuid.UUID `json:"from_division_id"`
+	ToDivisionID     uuid.UUID `json:"to_division_id"`
+	FromTournamentID uuid.UUID `json:"from_tournament_id"`
+	ToTournamentID   uuid.UUID `json:"to_tournament_id"`
+	// AlreadyRegistered indica que el equipo ya estaba inscripto en destino
+	AlreadyRegistered bool `json:"already_registered,omitempty"`
+}
+
+// PromotionReport resume los ascensos y descensos aplicados entre dos temporadas
+type PromotionReport struct {
+	SeasonID     uuid.UUID      `json:"season_id"`
+	NextSeasonID uuid.UUID      `json:"next_season_id"`
+	Moves        []DivisionMove `json:"moves"`
+}
+
+// CompetitionDivision es uno de los torneos de una competición con varias
+// categorías: el torneo hijo, su división y quién lidera la tabla
+type CompetitionDivision struct {
+	Tournament Tournament `json:"tournament"`
+	Division   *Division  `json:"division,omitempty"`
+	Teams      int        `json:"teams"`
+	Leader     *Standing  `json:"leader,omitempty"`
+}
+
+// DivisionAnalytics son las estadísticas de una de las categorías
+type DivisionAnalytics struct {
+	TournamentID   uuid.UUID            `json:"tournament_id"`
+	TournamentName string               `json:"tournament_name"`
+	DivisionID     *uuid.UUID           `json:"division_id,omitempty"`
+	DivisionName   string               `json:"division_name,omitempty"`
+	Analytics      *TournamentAnalytics `json:"analytics"`
+}
+
+// CompetitionStats agrega las estadísticas de todas las categorías de una
+// competición. Totals suma los partidos de todas; TopScorers es la tabla de
+// goleadores conjunta.
+type CompetitionStats struct {
+	CompetitionID uuid.UUID            `json:"competition_id"`
+	Totals        *TournamentAnalytics `json:"totals"`
+	Divisions     []DivisionAnalytics  `json:"divisions"`
+	TopScorers    []TopScorer          `json:"top_scorers"`
+}
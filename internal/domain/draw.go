@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Modos de sorteo
+const (
+	DrawModeGroups  = "groups"
+	DrawModeBracket = "bracket"
+)
+
+// DrawPick es una extracción del sorteo: un equipo de un bombo asignado a un
+// grupo ("A", "B", ...) o a una posición de la llave ("1", "2", ...)
+type DrawPick struct {
+	Order  int       `json:"order"`
+	TeamID uuid.UUID `json:"team_id"`
+	Pot    int       `json:"pot"`
+	Slot   string    `json:"slot"`
+}
+
+// Draw es un sorteo registrado. Con la misma semilla y los mismos bombos el
+// resultado es siempre el mismo, lo que permite auditarlo.
+type Draw struct {
+	ID           uuid.UUID  `json:"id"`
+	TournamentID uuid.UUID  `json:"tournament_id"`
+	Mode         string     `json:"mode"`
+	GroupCount   int        `json:"group_count,omitempty"`
+	Seed         int64      `json:"seed"`
+	Picks        []DrawPick `json:"picks"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// DrawOptions son los parámetros de un sorteo: bombos ordenados por cabeza de serie
+type DrawOptions struct {
+	Mode       string        `json:"mode"`
+	GroupCount int           `json:"group_count"`
+	Pots       [][]uuid.UUID `json:"pots"`
+	Seed       *int64        `json:"seed,omitempty"`
+}
+
+// NewDraw crea un sorteo vacío para el torneo
+func NewDraw(tournamentID uuid.UUID, mode string, groupCount int, seed int64) *Draw {
+	return &Draw{
+		ID:           uuid.New(),
+		TournamentID: tournamentID,
+		Mode:         mode,
+		GroupCount:   groupCount,
+		Seed:         seed,
+		Picks:        []DrawPick{},
+		CreatedAt:    time.Now().UTC(),
+	}
+}
+
+// SeedRanking es la posición sugerida de un equipo según su historial
+type SeedRanking struct {
+	Rank     int       `json:"rank"`
+	TeamID   uuid.UUID `json:"team_id"`
+	TeamName string    `json:"team_name"`
+	Score    float64   `json:"score"`
+}
+
+// SeedingSuggestion propone bombos listos para usar en DrawOptions.Pots
+type SeedingSuggestion struct {
+	TournamentID uuid.UUID     `json:"tournament_id"`
+	Sources      []uuid.UUID   `json:"sources"`
+	Rankings     []SeedRanking `json:"rankings"`
+	Pots         [][]uuid.UUID `json:"pots"`
+}
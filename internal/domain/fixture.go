@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Fixture es una fila del formato de intercambio de calendarios usado por las
+// federaciones locales (round, date, home, away, venue, pitch). Los equipos,
+// las sedes y las canchas se identifican por nombre, no por ID.
+type Fixture struct {
+	Round int       `json:"round"`
+	Date  time.Time `json:"date"`
+	Home  string    `json:"home"`
+	Away  string    `json:"away"`
+	Venue string    `json:"venue"`
+	// Pitch es la cancha dentro de la sede; opcional
+	Pitch string `json:"pitch,omitempty"`
+}
+
+// RoundSummary resume una jornada del torneo: cuántos partidos tiene y
+// entre qué fechas se juegan
+type RoundSummary struct {
+	Round     int       `json:"round"`
+	Matches   int       `json:"matches"`
+	FirstDate time.Time `json:"first_date"`
+	LastDate  time.Time `json:"last_date"`
+}
+
+// FixtureConflict describe una fila que no se pudo importar y el motivo
+type FixtureConflict struct {
+	Row     int     `json:"row"`
+	Fixture Fixture `json:"fixture"`
+	Reason  string  `json:"reason"`
+}
+
+// FixtureImportReport es el resultado de una importación de fixture
+type FixtureImportReport struct {
+	TournamentID uuid.UUID         `json:"tournament_id"`
+	DryRun       bool              `json:"dry_run"`
+	Imported     int               `json:"imported"`
+	Matches      []Match           `json:"matches"`
+	Conflicts    []FixtureConflict `json:"conflicts"`
+}
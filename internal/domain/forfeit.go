@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tipos de resultado de un partido (Match.ResultType)
+const (
+	// ResultPlayed es un partido decidido en la cancha
+	ResultPlayed = "played"
+	// ResultForfeit es un partido adjudicado administrativamente (walkover)
+	ResultForfeit = "forfeit"
+)
+
+// DefaultForfeitGoals son los goles que se adjudican al ganador de un
+// walkover si el organizador no indica otros (el clásico 3-0)
+const DefaultForfeitGoals = 3
+
+// MatchForfeit registra la adjudicación de un partido por walkover. Guarda
+// el resultado que tenía el partido para poder auditarla y revocarla; una
+// adjudicación revocada queda en el historial con RevokedAt.
+type MatchForfeit struct {
+	ID               uuid.UUID `json:"id"`
+	MatchID          uuid.UUID `json:"match_id"`
+	ForfeitingTeamID uuid.UUID `json:"forfeiting_team_id"`
+	AwardedTeamID    uuid.UUID `json:"awarded_team_id"`
+	AwardedGoals     int       `json:"awarded_goals"`
+	Reason           string    `json:"reason"`
+	// Previous* es el resultado del partido antes de adjudicarlo
+	PreviousGoalsTeam1     int        `json:"previous_goals_team1"`
+	PreviousGoalsTeam2     int        `json:"previous_goals_team2"`
+	PreviousExtraTimeTeam1 *int       `json:"previous_extra_time_team1,omitempty"`
+	PreviousExtraTimeTeam2 *int       `json:"previous_extra_time_team2,omitempty"`
+	PreviousPenaltiesTeam1 *int       `json:"previous_penalties_team1,omitempty"`
+	PreviousPenaltiesTeam2 *int       `json:"previous_penalties_team2,omitempty"`
+	AwardedAt              time.Time  `json:"awarded_at"`
+	RevokedAt              *time.Time `json:"revoked_at,omitempty"`
+}
+
+// NewMatchForfeit adjudica el partido al rival de forfeitingTeamID por
+// awardedGoals a 0 (DefaultForfeitGoals si es 0). Guarda el resultado
+// actual del partido.
+func NewMatchForfeit(match *Match, forfeitingTeamID uuid.UUID, awardedGoals int, reason string) (*MatchForfeit, error) {
+	var awardedTeamID uuid.UUID
+	switch forfeitingTeamID {
+	case match.Team1ID:
+		awardedTeamID = match.Team2ID
+	case match.Team2ID:
+		awardedTeamID = match.Team1ID
+	default:
+		return nil, fmt.Errorf("forfeiting team must be one of the match teams")
+	}
+	if awardedGoals == 0 {
+		awardedGoals = DefaultForfeitGoals
+	}
+	if awardedGoals < 0 {
+		return nil, fmt.Errorf("awarded_goals cannot be negative")
+	}
+	if reason == "" {
+		return nil, fmt.Errorf("reason is required")
+	}
+
+	return &MatchForfeit{
+		ID:                     uuid.New(),
+		MatchID:                match.ID,
+		ForfeitingTeamID:       forfeitingTeamID,
+		AwardedTeamID:          awardedTeamID,
+		AwardedGoals:           awardedGoals,
+		Reason:                 reason,
+		PreviousGoalsTeam1:     match.GoalScoredTeam1,
+		PreviousGoalsTeam2:     match.GoalScoredTeam2,
+		PreviousExtraTimeTeam1: match.ExtraTimeTeam1,
+		PreviousExtraTimeTeam2: match.ExtraTimeTeam2,
+		PreviousPenaltiesTeam1: match.PenaltiesTeam1,
+		PreviousPenaltiesTeam2: match.PenaltiesTeam2,
+		AwardedAt:              time.Now().UTC(),
+	}, nil
+}
+
+// Apply pone en el partido el resultado administrativo: sin prórroga ni
+// penales, y el marcador a favor del equipo adjudicado
+func (f *MatchForfeit) Apply(match *Match) {
+	match.ResultType = ResultForfeit
+	match.GoalScoredTeam1, match.GoalScoredTeam2 = 0, 0
+	if f.AwardedTeamID == match.Team1ID {
+		match.GoalScoredTeam1 = f.AwardedGoals
+	} else {
+		match.GoalScoredTeam2 = f.AwardedGoals
+	}
+	match.ExtraTimeTeam1, match.ExtraTimeTeam2 = nil, nil
+	match.PenaltiesTeam1, match.PenaltiesTeam2 = nil, nil
+}
+
+// Restore devuelve al partido el resultado que tenía antes de adjudicarlo
+func (f *MatchForfeit) Restore(match *Match) {
+	match.ResultType = ResultPlayed
+	match.GoalScoredTeam1, match.GoalScoredTeam2 = f.PreviousGoalsTeam1, f.PreviousGoalsTeam2
+	match.ExtraTimeTeam1, match.ExtraTimeTeam2 = f.PreviousExtraTimeTeam1, f.PreviousExtraTimeTeam2
+	match.PenaltiesTeam1, match.PenaltiesTeam2 = f.PreviousPenaltiesTeam1, f.PreviousPenaltiesTeam2
+}
@@ -0,0 +1,21 @@
+package domain
+
+import "github.com/google/uuid"
+
+// Guest es un jugador invitado sin ficha: solo se conoce su nombre. Aparece
+// en los eventos (GuestName) y en las alineaciones (Lineup.Guests) del equipo
+// hasta que se lo asocia a un jugador inscripto.
+type Guest struct {
+	Name    string `json:"name"`
+	Matches int    `json:"matches"`
+	Goals   int    `json:"goals"`
+}
+
+// GuestPromotion resume el pase de un invitado a un jugador con ficha
+type GuestPromotion struct {
+	Name     string    `json:"name"`
+	PlayerID uuid.UUID `json:"player_id"`
+	// Events y Lineups son las filas reasignadas al jugador
+	Events  int `json:"events"`
+	Lineups int `json:"lineups"`
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Claves de idempotencia: un cliente que reintenta un POST con la misma
+// cabecera Idempotency-Key recibe la respuesta original en lugar de crear
+// el recurso otra vez (pensado para las apps móviles con mala señal)
+const (
+	// IdempotencyKeyTTL es cuánto se guarda la respuesta de una clave
+	IdempotencyKeyTTL = 24 * time.Hour
+	// IdempotencyLockTimeout libera una clave que quedó en curso, p. ej.
+	// porque el servidor se reinició a mitad de la petición
+	IdempotencyLockTimeout = time.Minute
+	// MaxIdempotencyKeyLength es el largo máximo de la cabecera
+	MaxIdempotencyKeyLength = 255
+)
+
+var (
+	// ErrIdempotencyKeyInUse indica que la petición original con esa clave
+	// todavía no terminó
+	ErrIdempotencyKeyInUse = errors.New("a request with this Idempotency-Key is still in progress")
+	// ErrIdempotencyKeyReused indica que la clave ya se usó con otra petición
+	// (otra ruta, otras credenciales u otro cuerpo)
+	ErrIdempotencyKeyReused = errors.New("Idempotency-Key was already used with a different request")
+)
+
+// IdempotencyRecord es una clave usada y la respuesta que dio. Caller
+// identifica a quien la envió: cada cliente tiene su propio espacio de
+// claves, así la misma clave de dos clientes no choca. Fingerprint resume la
+// petición (método, ruta, credenciales y cuerpo); StatusCode es 0 mientras
+// la petición original sigue en curso.
+type IdempotencyRecord struct {
+	Caller      string    `json:"caller"`
+	Key         string    `json:"key"`
+	Fingerprint string    `json:"fingerprint"`
+	StatusCode  int       `json:"status_code"`
+	ContentType string    `json:"content_type,omitempty"`
+	Body        []byte    `json:"body,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// Completed indica si ya se guardó la respuesta
+func (r *IdempotencyRecord) Completed() bool {
+	return r.StatusCode != 0
+}
+
+// Replaceable indica si una petición nueva puede tomar la clave: la
+// respuesta guardada venció o la reserva quedó colgada
+func (r *IdempotencyRecord) Replaceable(now time.Time) bool {
+	if !r.ExpiresAt.After(now) {
+		return true
+	}
+	return !r.Completed() && !r.CreatedAt.After(now.Add(-IdempotencyLockTimeout))
+}
+
+// ValidateIdempotencyKey revisa la cabecera: texto ASCII visible, sin
+// espacios, de hasta MaxIdempotencyKeyLength caracteres
+func ValidateIdempotencyKey(key string) error {
+	if key == "" || len(key) > MaxIdempotencyKeyLength {
+		return fmt.Errorf("Idempotency-Key must have between 1 and %d characters", MaxIdempotencyKeyLength)
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] <= ' ' || key[i] > '~' {
+			return fmt.Errorf("Idempotency-Key must be printable ASCII without spaces")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tipos de lesión
+const (
+	InjuryMuscle     = "muscle"
+	InjuryLigament   = "ligament"
+	InjuryFracture   = "fracture"
+	InjuryConcussion = "concussion"
+	InjuryIllness    = "illness"
+	InjuryOther      = "other"
+)
+
+// Injury es una lesión de un jugador. Sin ExpectedReturn se considera que
+// sigue lesionado hasta que se cargue la fecha de regreso.
+type Injury struct {
+	ID             uuid.UUID  `json:"id"`
+	PlayerID       uuid.UUID  `json:"player_id"`
+	Type           string     `json:"type"`
+	StartDate      time.Time  `json:"start_date"`
+	ExpectedReturn *time.Time `json:"expected_return,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// NewInjury crea una nueva lesión
+func NewInjury(playerID uuid.UUID, injuryType string, startDate time.Time, expectedReturn *time.Time) *Injury {
+	return &Injury{
+		ID:             uuid.New(),
+		PlayerID:       playerID,
+		Type:           injuryType,
+		StartDate:      startDate,
+		ExpectedReturn: expectedReturn,
+		CreatedAt:      time.Now().UTC(),
+	}
+}
+
+// IsActive indica si el jugador está lesionado en el instante dado
+func (i *Injury) IsActive(at time.Time) bool {
+	if at.Before(i.StartDate) {
+		return false
+	}
+	return i.ExpectedReturn == nil || at.Before(*i.ExpectedReturn)
+}
+
+// IsValidInjuryType indica si el tipo de lesión es conocido
+func IsValidInjuryType(injuryType string) bool {
+	switch injuryType {
+	case InjuryMuscle, InjuryLigament, InjuryFracture, InjuryConcussion, InjuryIllness, InjuryOther:
+		return true
+	}
+	return false
+}
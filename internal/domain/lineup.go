@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Lineup es la alineación de un equipo para un partido: titulares en el orden
+// de la formación (arquero primero) y suplentes
+type Lineup struct {
+	MatchID   uuid.UUID   `json:"match_id"`
+	TeamID    uuid.UUID   `json:"team_id"`
+	Formation string      `json:"formation"`
+	Starting  []uuid.UUID `json:"starting"`
+	Bench     []uuid.UUID `json:"bench"`
+	// Guests son los nombres de invitados sin ficha; no cuentan en la formación
+	Guests    []string  `json:"guests"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewLineup crea una nueva alineación
+func NewLineup(matchID, teamID uuid.UUID, formation string, starting, bench []uuid.UUID) *Lineup {
+	if bench == nil {
+		bench = []uuid.UUID{}
+	}
+	return &Lineup{
+		MatchID:   matchID,
+		TeamID:    teamID,
+		Formation: formation,
+		Starting:  starting,
+		Bench:     bench,
+		Guests:    []string{},
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// FormationSize devuelve la cantidad de titulares de una formación como
+// "4-4-2" (jugadores de campo más el arquero)
+func FormationSize(formation string) (int, error) {
+	lines := strings.Split(formation, "-")
+	if len(lines) < 2 {
+		return 0, fmt.Errorf("invalid formation: %s", formation)
+	}
+
+	total := 1
+	for _, line := range lines {
+		n, err := strconv.Atoi(line)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid formation: %s", formation)
+		}
+		total += n
+	}
+	return total, nil
+}
+
+// Has indica si el jugador está en la alineación y si es titular
+func (l *Lineup) Has(playerID uuid.UUID) (inLineup bool, starter bool) {
+	for _, id := range l.Starting {
+		if id == playerID {
+			return true, true
+		}
+	}
+	for _, id := range l.Bench {
+		if id == playerID {
+			return true, false
+		}
+	}
+	return false, false
+}
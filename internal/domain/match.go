@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,21 +9,75 @@ import (
 
 // Match representa un partido entre dos equipos
 type Match struct {
-	ID              uuid.UUID `json:"id"`
-	MatchNumber     int       `json:"match_number"`
-	Date            time.Time `json:"date"`
-	Team1ID         uuid.UUID `json:"team1_id"`
-	Team2ID         uuid.UUID `json:"team2_id"`
-	GoalScoredTeam1 int       `json:"goal_scored_team1"`
-	GoalScoredTeam2 int       `json:"goal_scored_team2"`
-	CreatedAt       time.Time `json:"created_at"`
+	ID            uuid.UUID  `json:"id"`
+	TournamentID  *uuid.UUID `json:"tournament_id,omitempty"`
+	ParentMatchID *uuid.UUID `json:"parent_match_id,omitempty"`
+	VenueID       *uuid.UUID `json:"venue_id,omitempty"`
+	// PitchID es la cancha de la sede; requiere VenueID
+	PitchID *uuid.UUID `json:"pitch_id,omitempty"`
+	StageID *uuid.UUID `json:"stage_id,omitempty"`
+	// StreamURL y Broadcaster indican dónde se transmite el partido. Hasta
+	// StreamEmbargoUntil el enlace se oculta al público.
+	StreamURL          string     `json:"stream_url,omitempty"`
+	Broadcaster        string     `json:"broadcaster,omitempty"`
+	StreamEmbargoUntil *time.Time `json:"stream_embargo_until,omitempty"`
+	Round              int        `json:"round,omitempty"`
+	MatchNumber        int        `json:"match_number"`
+	Date               time.Time  `json:"date"`
+	Team1ID            uuid.UUID  `json:"team1_id"`
+	Team2ID            uuid.UUID  `json:"team2_id"`
+	GoalScoredTeam1    int        `json:"goal_scored_team1"`
+	GoalScoredTeam2    int        `json:"goal_scored_team2"`
+	// ExtraTime* son los goles marcados solo en la prórroga y Penalties* la
+	// tanda de penales; nil si el partido no los tuvo
+	ExtraTimeTeam1 *int `json:"extra_time_team1,omitempty"`
+	ExtraTimeTeam2 *int `json:"extra_time_team2,omitempty"`
+	PenaltiesTeam1 *int `json:"penalties_team1,omitempty"`
+	PenaltiesTeam2 *int `json:"penalties_team2,omitempty"`
+	// WinnerID y DecidedBy se calculan a partir del resultado; un empate
+	// no tiene ganador
+	WinnerID  *uuid.UUID `json:"winner_id,omitempty"`
+	DecidedBy string     `json:"decided_by,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	// UpdatedAt es la versión del partido. Un cliente que edita sin conexión
+	// la reenvía para que el servidor detecte ediciones concurrentes.
+	UpdatedAt time.Time `json:"updated_at"`
+	// IsFriendly marca un amistoso: se registra pero no cuenta para la tabla
+	// de posiciones ni para las tablas de jugadores
+	IsFriendly bool `json:"is_friendly,omitempty"`
+	// ResultType indica si el resultado se jugó o se adjudicó por walkover;
+	// solo cambia al adjudicar o revocar (ver MatchForfeit)
+	ResultType string `json:"result_type"`
+	// ResultConfirmedAt es cuándo el marcador pasó a ser definitivo: lo
+	// confirmó el organizador, se cerró el reloj o se aceptó un resultado
+	// recibido por email. Hasta entonces el partido no cuenta para la tabla
+	// ni para el ranking aunque su fecha haya pasado.
+	ResultConfirmedAt *time.Time `json:"result_confirmed_at,omitempty"`
+	// ResultEmbargoedUntil indica que el marcador está oculto para el público
+	ResultEmbargoedUntil *time.Time `json:"result_embargoed_until,omitempty"`
 	// Relaciones opcionales
 	Team1 *Team `json:"team1,omitempty"`
 	Team2 *Team `json:"team2,omitempty"`
+	// Referees son los árbitros designados: el principal primero
+	Referees []MatchReferee `json:"referees,omitempty"`
+	// Odds son las probabilidades del modelo; solo en partidos por jugar
+	Odds *MatchOdds `json:"odds,omitempty"`
+	// Attendance es el público permitido; solo si hay restricciones y el
+	// partido está en juego o por jugar
+	Attendance *MatchAttendance `json:"attendance,omitempty"`
 }
 
+// Formas en que se decide un partido (Match.DecidedBy)
+const (
+	DecidedInRegularTime = "regular_time"
+	DecidedInExtraTime   = "extra_time"
+	DecidedOnPenalties   = "penalties"
+	DecidedByForfeit     = "forfeit"
+)
+
 // NewMatch crea un nuevo partido
 func NewMatch(matchNumber int, date time.Time, team1ID, team2ID uuid.UUID, goals1, goals2 int) *Match {
+	now := time.Now().UTC()
 	return &Match{
 		ID:              uuid.New(),
 		MatchNumber:     matchNumber,
@@ -31,6 +86,154 @@ func NewMatch(matchNumber int, date time.Time, team1ID, team2ID uuid.UUID, goals
 		Team2ID:         team2ID,
 		GoalScoredTeam1: goals1,
 		GoalScoredTeam2: goals2,
-		CreatedAt:       time.Now().UTC(),
+		ResultType:      ResultPlayed,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
+
+// SameContent indica si dos partidos tienen los mismos datos editables.
+// Sirve para reconocer el reenvío de una creación ya aplicada.
+func (m *Match) SameContent(other *Match) bool {
+	return sameOptionalID(m.TournamentID, other.TournamentID) &&
+		sameOptionalID(m.ParentMatchID, other.ParentMatchID) &&
+		sameOptionalID(m.VenueID, other.VenueID) &&
+		sameOptionalID(m.PitchID, other.PitchID) &&
+		sameOptionalID(m.StageID, other.StageID) &&
+		m.StreamURL == other.StreamURL &&
+		m.Broadcaster == other.Broadcaster &&
+		sameOptionalTime(m.StreamEmbargoUntil, other.StreamEmbargoUntil) &&
+		m.IsFriendly == other.IsFriendly &&
+		m.Round == other.Round &&
+		m.MatchNumber == other.MatchNumber &&
+		m.Date.Equal(other.Date) &&
+		m.Team1ID == other.Team1ID &&
+		m.Team2ID == other.Team2ID &&
+		m.GoalScoredTeam1 == other.GoalScoredTeam1 &&
+		m.GoalScoredTeam2 == other.GoalScoredTeam2 &&
+		sameOptionalInt(m.ExtraTimeTeam1, other.ExtraTimeTeam1) &&
+		sameOptionalInt(m.ExtraTimeTeam2, other.ExtraTimeTeam2) &&
+		sameOptionalInt(m.PenaltiesTeam1, other.PenaltiesTeam1) &&
+		sameOptionalInt(m.PenaltiesTeam2, other.PenaltiesTeam2) &&
+		m.HasFinalResult() == other.HasFinalResult()
+}
+
+// SameResult indica si los dos partidos tienen el mismo marcador, prórroga
+// y penales
+func (m *Match) SameResult(other *Match) bool {
+	return m.GoalScoredTeam1 == other.GoalScoredTeam1 &&
+		m.GoalScoredTeam2 == other.GoalScoredTeam2 &&
+		sameOptionalInt(m.ExtraTimeTeam1, other.ExtraTimeTeam1) &&
+		sameOptionalInt(m.ExtraTimeTeam2, other.ExtraTimeTeam2) &&
+		sameOptionalInt(m.PenaltiesTeam1, other.PenaltiesTeam1) &&
+		sameOptionalInt(m.PenaltiesTeam2, other.PenaltiesTeam2)
+}
+
+// IsForfeit indica si el resultado fue adjudicado por walkover
+func (m *Match) IsForfeit() bool {
+	return m.ResultType == ResultForfeit
+}
+
+// ConfirmResult marca el marcador como definitivo; una confirmación
+// anterior conserva su fecha
+func (m *Match) ConfirmResult(at time.Time) {
+	if m.ResultConfirmedAt == nil {
+		m.ResultConfirmedAt = &at
+	}
+}
+
+// HasFinalResult indica si el marcador es definitivo: se confirmó o se
+// adjudicó por walkover
+func (m *Match) HasFinalResult() bool {
+	return m.ResultConfirmedAt != nil || m.IsForfeit()
+}
+
+// ResultCountsAt indica si el resultado entra en la tabla de posiciones y
+// en el ranking a la hora now: tiene que ser definitivo y, con un embargo de
+// delay desde el inicio del partido, haberse levantado. Un walkover cuenta
+// desde que se adjudica. La consulta de posiciones de PostgreSQL repite
+// esta regla.
+func (m *Match) ResultCountsAt(now time.Time, delay time.Duration) bool {
+	if m.IsForfeit() {
+		return true
+	}
+	return m.ResultConfirmedAt != nil && !m.Date.Add(delay).After(now)
+}
+
+// HasExtraTime indica si el partido tuvo prórroga
+func (m *Match) HasExtraTime() bool {
+	return m.ExtraTimeTeam1 != nil || m.ExtraTimeTeam2 != nil
+}
+
+// HasPenalties indica si el partido se definió (o se definirá) por penales
+func (m *Match) HasPenalties() bool {
+	return m.PenaltiesTeam1 != nil || m.PenaltiesTeam2 != nil
+}
+
+// TotalGoals suma los goles del tiempo reglamentario y de la prórroga;
+// los penales no cuentan como goles
+func (m *Match) TotalGoals() (int, int) {
+	goals1, goals2 := m.GoalScoredTeam1, m.GoalScoredTeam2
+	if m.ExtraTimeTeam1 != nil {
+		goals1 += *m.ExtraTimeTeam1
+	}
+	if m.ExtraTimeTeam2 != nil {
+		goals2 += *m.ExtraTimeTeam2
+	}
+	return goals1, goals2
+}
+
+func sameOptionalID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
 	}
+	return *a == *b
+}
+
+func sameOptionalInt(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func sameOptionalTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+// HideStream quita el enlace de la transmisión si sigue embargado; el
+// público sabe quién transmite pero no dónde verlo todavía
+func (m *Match) HideStream(now time.Time) {
+	if m.StreamEmbargoUntil != nil && now.Before(*m.StreamEmbargoUntil) {
+		m.StreamURL = ""
+	}
+}
+
+// HideResult oculta el marcador hasta la fecha indicada
+func (m *Match) HideResult(until time.Time) {
+	m.GoalScoredTeam1 = 0
+	m.GoalScoredTeam2 = 0
+	m.ExtraTimeTeam1, m.ExtraTimeTeam2 = nil, nil
+	m.PenaltiesTeam1, m.PenaltiesTeam2 = nil, nil
+	m.WinnerID = nil
+	m.DecidedBy = ""
+	m.ResultEmbargoedUntil = &until
+}
+
+// MarshalJSON emite los goles como null cuando el resultado está embargado,
+// para no confundir un marcador oculto con un 0-0
+func (m Match) MarshalJSON() ([]byte, error) {
+	type alias Match
+	if m.ResultEmbargoedUntil == nil {
+		return json.Marshal(alias(m))
+	}
+
+	return json.Marshal(struct {
+		alias
+		GoalScoredTeam1 *int `json:"goal_scored_team1"`
+		GoalScoredTeam2 *int `json:"goal_scored_team2"`
+	}{alias: alias(m)})
 }
@@ -0,0 +1,205 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Estados del reloj de un partido
+const (
+	ClockNotStarted = "not_started"
+	ClockRunning    = "running"
+	ClockPaused     = "paused"
+	// ClockBreak es el entretiempo (o el descanso antes de la prórroga)
+	ClockBreak    = "break"
+	ClockFinished = "finished"
+)
+
+// Acciones sobre el reloj de un partido
+const (
+	ClockActionStart     = "start"
+	ClockActionPause     = "pause"
+	ClockActionResume    = "resume"
+	ClockActionStoppage  = "stoppage"
+	ClockActionEndPeriod = "end_period"
+	ClockActionFinish    = "finish"
+)
+
+// Los períodos 1 y 2 son los tiempos reglamentarios; 3 y 4, la prórroga
+const (
+	RegulationPeriods  = 2
+	MaxClockPeriods    = 4
+	ExtraPeriodMinutes = 15
+)
+
+// MatchClock es el reloj de un partido. El tiempo jugado del período se
+// guarda al pausar; mientras corre se suma lo transcurrido desde
+// RunningSince, así el reloj no depende de un proceso que lo actualice.
+type MatchClock struct {
+	MatchID uuid.UUID `json:"match_id"`
+	Status  string    `json:"status"`
+	// Period es el período en juego o el último jugado (0 antes de empezar)
+	Period int `json:"period"`
+	// DurationMinutes es la duración reglamentaria, tomada de las reglas
+	// del torneo al empezar el partido
+	DurationMinutes int `json:"duration_minutes"`
+	// ElapsedMs es el tiempo jugado del período hasta la última pausa
+	ElapsedMs    int64      `json:"elapsed_ms"`
+	RunningSince *time.Time `json:"running_since,omitempty"`
+	// StoppageMinutes es el descuento anunciado para el período
+	StoppageMinutes int       `json:"stoppage_minutes"`
+	UpdatedAt       time.Time `json:"updated_at"`
+	// Minute y AddedMinute son el minuto actual ("45+2" es 45 y 2); se
+	// calculan al leer el reloj
+	Minute      int `json:"minute"`
+	AddedMinute int `json:"added_minute,omitempty"`
+}
+
+// NewMatchClock crea el reloj detenido de un partido
+func NewMatchClock(matchID uuid.UUID, durationMinutes int) *MatchClock {
+	return &MatchClock{
+		MatchID:         matchID,
+		Status:          ClockNotStarted,
+		DurationMinutes: durationMinutes,
+		UpdatedAt:       time.Now().UTC(),
+	}
+}
+
+// IsValidClockAction indica si la acción es conocida
+func IsValidClockAction(action string) bool {
+	switch action {
+	case ClockActionStart, ClockActionPause, ClockActionResume, ClockActionStoppage, ClockActionEndPeriod, ClockActionFinish:
+		return true
+	}
+	return false
+}
+
+// Elapsed es el tiempo jugado del período en curso
+func (c *MatchClock) Elapsed(now time.Time) time.Duration {
+	elapsed := time.Duration(c.ElapsedMs) * time.Millisecond
+	if c.Status == ClockRunning && c.RunningSince != nil && now.After(*c.RunningSince) {
+		elapsed += now.Sub(*c.RunningSince)
+	}
+	return elapsed
+}
+
+// periodBounds devuelve el minuto en que empieza el período y su duración
+func (c *MatchClock) periodBounds() (int, int) {
+	half := c.DurationMinutes / 2
+	switch c.Period {
+	case 1:
+		return 0, half
+	case 2:
+		return half, c.DurationMinutes - half
+	default:
+		return c.DurationMinutes + (c.Period-3)*ExtraPeriodMinutes, ExtraPeriodMinutes
+	}
+}
+
+// MinuteAt calcula el minuto del partido: el primer minuto de juego es el
+// 1 y pasado el tiempo del período se cuenta como descuento (45+2)
+func (c *MatchClock) MinuteAt(now time.Time) (int, int) {
+	if c.Period == 0 {
+		return 0, 0
+	}
+	start, length := c.periodBounds()
+	played := int(c.Elapsed(now)/time.Minute) + 1
+	if c.Status == ClockBreak || c.Status == ClockFinished {
+		// Un período cerrado justo al cumplir el tiempo no suma un minuto
+		played = int((c.Elapsed(now) + time.Minute - 1) / time.Minute)
+	}
+	if played <= length {
+		return start + played, 0
+	}
+	return start + length, played - length
+}
+
+// TotalMinute es el minuto actual contando el descuento (45+2 es 47): el
+// máximo que puede tener un evento cargado ahora
+func (c *MatchClock) TotalMinute(now time.Time) int {
+	minute, added := c.MinuteAt(now)
+	return minute + added
+}
+
+// At devuelve una copia con el minuto calculado al instante indicado
+func (c *MatchClock) At(now time.Time) MatchClock {
+	snapshot := *c
+	snapshot.Minute, snapshot.AddedMinute = c.MinuteAt(now)
+	return snapshot
+}
+
+// Apply ejecuta una acción sobre el reloj. stoppageMinutes solo se usa en
+// ClockActionStoppage.
+func (c *MatchClock) Apply(action string, stoppageMinutes int, now time.Time) error {
+	switch action {
+	case ClockActionStart:
+		if c.Status != ClockNotStarted && c.Status != ClockBreak {
+			return fmt.Errorf("clock can only start a period before kickoff or during a break")
+		}
+		if c.Period >= MaxClockPeriods {
+			return fmt.Errorf("all %d periods have been played", MaxClockPeriods)
+		}
+		c.Period++
+		c.ElapsedMs = 0
+		c.StoppageMinutes = 0
+		c.Status = ClockRunning
+		c.RunningSince = &now
+
+	case ClockActionPause:
+		if c.Status != ClockRunning {
+			return fmt.Errorf("clock is not running")
+		}
+		c.stop(now)
+		c.Status = ClockPaused
+
+	case ClockActionResume:
+		if c.Status != ClockPaused {
+			return fmt.Errorf("clock is not paused")
+		}
+		c.Status = ClockRunning
+		c.RunningSince = &now
+
+	case ClockActionStoppage:
+		if c.Status != ClockRunning && c.Status != ClockPaused {
+			return fmt.Errorf("stoppage time can only be added during a period")
+		}
+		if stoppageMinutes < 0 || stoppageMinutes > 30 {
+			return fmt.Errorf("stoppage minutes must be between 0 and 30")
+		}
+		c.StoppageMinutes = stoppageMinutes
+
+	case ClockActionEndPeriod:
+		if c.Status != ClockRunning && c.Status != ClockPaused {
+			return fmt.Errorf("no period is being played")
+		}
+		c.stop(now)
+		c.Status = ClockBreak
+		if c.Period == MaxClockPeriods {
+			c.Status = ClockFinished
+		}
+
+	case ClockActionFinish:
+		if c.Status == ClockNotStarted || c.Status == ClockFinished {
+			return fmt.Errorf("clock is not in play")
+		}
+		if c.Period < RegulationPeriods {
+			return fmt.Errorf("match cannot finish before period %d", RegulationPeriods)
+		}
+		c.stop(now)
+		c.Status = ClockFinished
+
+	default:
+		return fmt.Errorf("invalid clock action: %s", action)
+	}
+
+	c.UpdatedAt = now
+	return nil
+}
+
+// stop acumula el tiempo corrido desde RunningSince
+func (c *MatchClock) stop(now time.Time) {
+	c.ElapsedMs = c.Elapsed(now).Milliseconds()
+	c.RunningSince = nil
+}
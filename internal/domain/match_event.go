@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tipos de evento de partido
+const (
+	EventGoal        = "goal"
+	EventPenaltyGoal = "penalty_goal"
+	EventOwnGoal     = "own_goal"
+	EventYellowCard  = "yellow_card"
+	EventRedCard     = "red_card"
+)
+
+// MatchEvent es un suceso de un partido en un minuto dado. TeamID es el
+// equipo del jugador, también en los autogoles (que suman al rival).
+type MatchEvent struct {
+	ID       uuid.UUID  `json:"id"`
+	MatchID  uuid.UUID  `json:"match_id"`
+	Type     string     `json:"type"`
+	Minute   int        `json:"minute"`
+	TeamID   uuid.UUID  `json:"team_id"`
+	PlayerID *uuid.UUID `json:"player_id,omitempty"`
+	// GuestName identifica a un invitado sin ficha cuando no hay PlayerID
+	GuestName string `json:"guest_name,omitempty"`
+	// AssistPlayerID es quien dio el pase de gol (solo en goles de jugada)
+	AssistPlayerID *uuid.UUID `json:"assist_player_id,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// NewMatchEvent crea un nuevo evento de partido
+func NewMatchEvent(matchID uuid.UUID, eventType string, minute int, teamID uuid.UUID, playerID *uuid.UUID) *MatchEvent {
+	return &MatchEvent{
+		ID:        uuid.New(),
+		MatchID:   matchID,
+		Type:      eventType,
+		Minute:    minute,
+		TeamID:    teamID,
+		PlayerID:  playerID,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// IsValidEventType indica si el tipo de evento es conocido
+func IsValidEventType(eventType string) bool {
+	switch eventType {
+	case EventGoal, EventPenaltyGoal, EventOwnGoal, EventYellowCard, EventRedCard:
+		return true
+	}
+	return false
+}
+
+// IsGoal indica si el evento cambia el marcador
+func (e *MatchEvent) IsGoal() bool {
+	return e.Type == EventGoal || e.Type == EventPenaltyGoal || e.Type == EventOwnGoal
+}
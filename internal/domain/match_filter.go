@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Estados de un partido según su hora de inicio, con la misma ventana que
+// el marcador de las sedes (ScoreboardLiveWindow)
+const (
+	MatchScheduled = "scheduled"
+	MatchLive      = "live"
+	MatchFinished  = "finished"
+)
+
+// IsValidMatchStatus indica si el estado es conocido
+func IsValidMatchStatus(status string) bool {
+	switch status {
+	case MatchScheduled, MatchLive, MatchFinished:
+		return true
+	}
+	return false
+}
+
+// MatchStatusAt es el estado de un partido que empieza en date, a la hora now
+func MatchStatusAt(date, now time.Time) string {
+	switch {
+	case date.After(now):
+		return MatchScheduled
+	case date.Before(now.Add(-ScoreboardLiveWindow)):
+		return MatchFinished
+	}
+	return MatchLive
+}
+
+// MatchFilter son los filtros del listado de partidos; los campos vacíos no
+// filtran. Los repositorios lo traducen a su consulta para no cargar todos
+// los partidos.
+type MatchFilter struct {
+	// TeamID son los partidos en que juega el equipo (local o visitante)
+	TeamID       *uuid.UUID
+	TournamentID *uuid.UUID
+	SeasonID     *uuid.UUID
+	// From y To limitan la fecha de inicio (ambos inclusive)
+	From *time.Time
+	To   *time.Time
+	// Status es MatchScheduled, MatchLive o MatchFinished a la hora Now
+	Status string
+	Now    time.Time
+	// IncludeArchived incluye los partidos de torneos archivados
+	IncludeArchived bool
+}
+
+// Validate revisa el estado y el rango de fechas
+func (f MatchFilter) Validate() error {
+	if f.Status != "" && !IsValidMatchStatus(f.Status) {
+		return fmt.Errorf("status must be one of %s, %s, %s", MatchScheduled, MatchLive, MatchFinished)
+	}
+	if f.From != nil && f.To != nil && f.From.After(*f.To) {
+		return fmt.Errorf("from must not be after to")
+	}
+	return nil
+}
+
+// StatusBounds traduce Status a un rango de fechas de inicio: después de
+// after (exclusivo) y hasta notAfter (inclusive); nil no limita
+func (f MatchFilter) StatusBounds() (after, notAfter *time.Time) {
+	now := f.Now
+	liveSince := now.Add(-ScoreboardLiveWindow)
+	switch f.Status {
+	case MatchScheduled:
+		return &now, nil
+	case MatchLive:
+		return &liveSince, &now
+	case MatchFinished:
+		return nil, &liveSince
+	}
+	return nil, nil
+}
+
+// Keep indica si el partido cumple los filtros propios del partido; el
+// torneo archivado y la temporada los resuelve cada repositorio
+func (f MatchFilter) Keep(match *Match) bool {
+	if f.TeamID != nil && match.Team1ID != *f.TeamID && match.Team2ID != *f.TeamID {
+		return false
+	}
+	if f.TournamentID != nil && (match.TournamentID == nil || *match.TournamentID != *f.TournamentID) {
+		return false
+	}
+	if f.From != nil && match.Date.Before(*f.From) {
+		return false
+	}
+	if f.To != nil && match.Date.After(*f.To) {
+		return false
+	}
+
+	after, notAfter := f.StatusBounds()
+	if after != nil && !match.Date.After(*after) {
+		return false
+	}
+	if notAfter != nil && match.Date.After(*notAfter) {
+		return false
+	}
+	return true
+}
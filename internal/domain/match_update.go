@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tipos de actualización en vivo de un partido
+const (
+	MatchUpdateScore        = "match_updated"
+	MatchUpdateDeleted      = "match_deleted"
+	MatchUpdateEventCreated = "event_created"
+	MatchUpdateEventDeleted = "event_deleted"
+	MatchUpdateClock        = "clock_updated"
+)
+
+// MatchUpdate es un cambio de un partido que se difunde en tiempo real
+// a los clientes conectados
+type MatchUpdate struct {
+	Type    string      `json:"type"`
+	MatchID uuid.UUID   `json:"match_id"`
+	Match   *Match      `json:"match,omitempty"`
+	Event   *MatchEvent `json:"event,omitempty"`
+	// Clock trae el minuto actual; los clientes lo avanzan localmente
+	// mientras Clock.Status es running
+	Clock *MatchClock `json:"clock,omitempty"`
+	At    time.Time   `json:"at"`
+}
+
+// NewMatchUpdate crea una actualización con la hora actual
+func NewMatchUpdate(updateType string, matchID uuid.UUID) MatchUpdate {
+	return MatchUpdate{
+		Type:    updateType,
+		MatchID: matchID,
+		At:      time.Now().UTC(),
+	}
+}
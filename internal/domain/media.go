@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tipos de contenido de la galería de un partido
+const (
+	MediaPhoto = "photo"
+	MediaVideo = "video"
+)
+
+// MatchMedia es una foto o video de la galería de un partido. Se guarda
+// solo el enlace; el archivo vive en un servicio externo.
+type MatchMedia struct {
+	ID      uuid.UUID `json:"id"`
+	MatchID uuid.UUID `json:"match_id"`
+	Type    string    `json:"type"`
+	URL     string    `json:"url"`
+	Caption string    `json:"caption,omitempty"`
+	// Position ordena la galería, empezando en 1
+	Position  int       `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewMatchMedia crea un elemento de la galería; la posición la asigna el caso de uso
+func NewMatchMedia(matchID uuid.UUID, mediaType, url, caption string) *MatchMedia {
+	return &MatchMedia{
+		ID:        uuid.New(),
+		MatchID:   matchID,
+		Type:      mediaType,
+		URL:       url,
+		Caption:   caption,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// IsValidMediaType indica si el tipo de contenido es conocido
+func IsValidMediaType(mediaType string) bool {
+	return mediaType == MediaPhoto || mediaType == MediaVideo
+}
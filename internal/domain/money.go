@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Money es un importe en unidades menores de la moneda (centavos): 1.500,50
+// € es {Amount: 150050, Currency: "EUR"}. Se guarda como entero para que
+// las sumas no arrastren errores de redondeo, como un decimal en C#.
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+	// Formatted es el importe escrito según el idioma de la petición; solo
+	// se completa en las respuestas (ver Format)
+	Formatted string `json:"formatted,omitempty"`
+}
+
+// currency describe una moneda ISO 4217 aceptada
+type currency struct {
+	// Digits son los decimales de la unidad menor (0 para CLP o JPY)
+	Digits int
+	// Symbol es el símbolo que no se confunde con otra moneda y Local el
+	// que se usa en su país (US$ y $, ARS y $)
+	Symbol string
+	Local  string
+}
+
+// currencies son las monedas aceptadas en multas y cuotas
+var currencies = map[string]currency{
+	"ARS": {2, "ARS", "$"},
+	"BOB": {2, "Bs", "Bs"},
+	"BRL": {2, "R$", "R$"},
+	"CHF": {2, "CHF", "CHF"},
+	"CLP": {0, "CLP", "$"},
+	"COP": {2, "COP", "$"},
+	"EUR": {2, "€", "€"},
+	"GBP": {2, "£", "£"},
+	"JPY": {0, "¥", "¥"},
+	"MXN": {2, "MXN", "$"},
+	"PEN": {2, "S/", "S/"},
+	"PYG": {0, "₲", "₲"},
+	"USD": {2, "US$", "$"},
+	"UYU": {2, "UYU", "$"},
+}
+
+// Validate comprueba que la moneda sea conocida y el importe positivo
+func (m Money) Validate() error {
+	if _, ok := currencies[m.Currency]; !ok {
+		return fmt.Errorf("unsupported currency: %q", m.Currency)
+	}
+	if m.Amount <= 0 {
+		return fmt.Errorf("amount must be positive (in minor units)")
+	}
+	return nil
+}
+
+// DefaultLocale es el idioma de los importes si la petición no pide otro
+const DefaultLocale = "es"
+
+// moneyLocale es cómo escribe los importes un idioma
+type moneyLocale struct {
+	Decimal string
+	Group   string
+	// SymbolFirst pone el símbolo antes del número ($ 1.500,50) y
+	// SymbolSpace los separa con un espacio
+	SymbolFirst bool
+	SymbolSpace bool
+	// Home es la moneda del país, la única que se escribe con su símbolo
+	// local (ver currency)
+	Home string
+}
+
+// moneyLocales son los idiomas con formato propio; el resto usa el de su
+// idioma base (es-ES → es) o DefaultLocale
+var moneyLocales = map[string]moneyLocale{
+	"es":    {Decimal: ",", Group: ".", SymbolSpace: true, Home: "EUR"},
+	"es-AR": {Decimal: ",", Group: ".", SymbolFirst: true, SymbolSpace: true, Home: "ARS"},
+	"es-CL": {Decimal: ",", Group: ".", SymbolFirst: true, Home: "CLP"},
+	"es-CO": {Decimal: ",", Group: ".", SymbolFirst: true, SymbolSpace: true, Home: "COP"},
+	"es-MX": {Decimal: ".", Group: ",", SymbolFirst: true, Home: "MXN"},
+	"es-UY": {Decimal: ",", Group: ".", SymbolFirst: true, SymbolSpace: true, Home: "UYU"},
+	"en":    {Decimal: ".", Group: ",", SymbolFirst: true, Home: "USD"},
+	"en-GB": {Decimal: ".", Group: ",", SymbolFirst: true, Home: "GBP"},
+	"pt":    {Decimal: ",", Group: ".", SymbolFirst: true, SymbolSpace: true, Home: "BRL"},
+	"fr":    {Decimal: ",", Group: " ", SymbolSpace: true, Home: "EUR"},
+	"de":    {Decimal: ",", Group: ".", SymbolSpace: true, Home: "EUR"},
+}
+
+// ResolveLocale elige el formato para una etiqueta de idioma (es-AR, en_US,
+// pt-br): la etiqueta exacta, su idioma base o DefaultLocale
+func ResolveLocale(tag string) string {
+	tag = strings.ReplaceAll(strings.TrimSpace(tag), "_", "-")
+	language, region, _ := strings.Cut(tag, "-")
+	language = strings.ToLower(language)
+	if region != "" {
+		if full := language + "-" + strings.ToUpper(region); moneyLocales[full] != (moneyLocale{}) {
+			return full
+		}
+	}
+	if moneyLocales[language] != (moneyLocale{}) {
+		return language
+	}
+	return DefaultLocale
+}
+
+// Format escribe el importe en el idioma (ver ResolveLocale): 1.500,50 € en
+// es, €1,500.50 en en y $ 1.500,50 para ARS en es-AR
+func (m Money) Format(locale string) string {
+	format := moneyLocales[ResolveLocale(locale)]
+	cur, ok := currencies[m.Currency]
+	if !ok {
+		cur = currency{Digits: 2, Symbol: m.Currency, Local: m.Currency}
+	}
+	symbol := cur.Symbol
+	if m.Currency == format.Home {
+		symbol = cur.Local
+	}
+
+	amount := m.Amount
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	digits := strconv.FormatInt(amount, 10)
+	if len(digits) <= cur.Digits {
+		digits = strings.Repeat("0", cur.Digits-len(digits)+1) + digits
+	}
+	whole, fraction := digits[:len(digits)-cur.Digits], digits[len(digits)-cur.Digits:]
+
+	var grouped strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			grouped.WriteString(format.Group)
+		}
+		grouped.WriteRune(digit)
+	}
+	number := grouped.String()
+	if fraction != "" {
+		number += format.Decimal + fraction
+	}
+
+	space := ""
+	if format.SymbolSpace || strings.Trim(symbol, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") == "" {
+		// Un código (ARS, CHF) siempre va separado del número
+		space = " "
+	}
+	if format.SymbolFirst {
+		return sign + symbol + space + number
+	}
+	return sign + number + space + symbol
+}
@@ -0,0 +1,12 @@
+package domain
+
+import "github.com/google/uuid"
+
+// NameCheck es el resultado de verificar si un nombre está disponible.
+// Los formularios lo consultan mientras el usuario escribe.
+type NameCheck struct {
+	Name      string `json:"name"`
+	Available bool   `json:"available"`
+	// ConflictID es el registro que ya usa el nombre
+	ConflictID *uuid.UUID `json:"conflict_id,omitempty"`
+}
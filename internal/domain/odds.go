@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+// OddsModel identifica el modelo de MatchOdds: rating Elo corregido por la
+// forma reciente (ver PredictMatch)
+const OddsModel = "elo_form"
+
+// OddsLabel es el aviso que acompaña a MatchOdds en las respuestas
+const OddsLabel = "Model-based estimate from Elo ratings and recent form; not betting odds"
+
+// MatchOdds es la versión compacta de MatchPrediction que viaja en los
+// partidos por jugar, para las páginas de previa. Son probabilidades de un
+// modelo, no cuotas de apuestas: Model y Label lo dejan explícito.
+type MatchOdds struct {
+	Team1Win float64 `json:"team1_win"`
+	Draw     float64 `json:"draw"`
+	Team2Win float64 `json:"team2_win"`
+	// Team1Form y Team2Form son los últimos resultados de cada equipo
+	// (W/D/L, del más reciente al más antiguo)
+	Team1Form  string    `json:"team1_form"`
+	Team2Form  string    `json:"team2_form"`
+	Model      string    `json:"model"`
+	Label      string    `json:"label"`
+	ComputedAt time.Time `json:"computed_at"`
+}
+
+// Odds resume la predicción para incluirla en el partido
+func (p *MatchPrediction) Odds() *MatchOdds {
+	return &MatchOdds{
+		Team1Win:   p.Team1Win,
+		Draw:       p.Draw,
+		Team2Win:   p.Team2Win,
+		Team1Form:  p.Team1.Form,
+		Team2Form:  p.Team2.Form,
+		Model:      OddsModel,
+		Label:      OddsLabel,
+		ComputedAt: p.ComputedAt,
+	}
+}
+
+// HasOdds indica si al partido le corresponden probabilidades: solo los
+// partidos principales que todavía no empezaron
+func (m *Match) HasOdds(now time.Time) bool {
+	return m.ParentMatchID == nil && m.Date.After(now)
+}
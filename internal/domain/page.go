@@ -0,0 +1,53 @@
+package domain
+
+import "fmt"
+
+// Límites de los listados paginados
+const (
+	DefaultPageLimit = 100
+	MaxPageLimit     = 500
+)
+
+// Page es la porción pedida de un listado (LIMIT/OFFSET)
+type Page struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+	// Sort es el orden del listado; vacío usa el orden por defecto de cada
+	// listado (ver SortFields.Of)
+	Sort Sort `json:"-"`
+}
+
+// NewPage valida la porción pedida; con limit 0 usa DefaultPageLimit
+func NewPage(limit, offset int) (Page, error) {
+	if limit == 0 {
+		limit = DefaultPageLimit
+	}
+	if limit < 0 || limit > MaxPageLimit {
+		return Page{}, fmt.Errorf("limit must be between 1 and %d", MaxPageLimit)
+	}
+	if offset < 0 {
+		return Page{}, fmt.Errorf("offset cannot be negative")
+	}
+	return Page{Limit: limit, Offset: offset}, nil
+}
+
+// Paged es una página de un listado junto con el total de elementos
+// (como un PagedResult<T> en C#)
+type Paged[T any] struct {
+	Items []T `json:"items"`
+	Total int `json:"total"`
+	Page
+}
+
+// HasNext indica si quedan elementos después de esta página
+func (p Paged[T]) HasNext() bool {
+	return p.Offset+len(p.Items) < p.Total
+}
+
+// SlicePage pagina un listado que ya está completo en memoria (por ejemplo,
+// después de filtrar por etiqueta)
+func SlicePage[T any](items []T, page Page) Paged[T] {
+	from := min(page.Offset, len(items))
+	to := min(from+page.Limit, len(items))
+	return Paged[T]{Items: items[from:to], Total: len(items), Page: page}
+}
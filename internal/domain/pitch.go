@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Pitch es una cancha con nombre dentro de una sede (p. ej. "Cancha 1").
+// Dos partidos no pueden jugarse a la vez en la misma cancha.
+type Pitch struct {
+	ID        uuid.UUID `json:"id"`
+	VenueID   uuid.UUID `json:"venue_id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewPitch crea una nueva cancha de la sede
+func NewPitch(venueID uuid.UUID, name string) *Pitch {
+	return &Pitch{
+		ID:        uuid.New(),
+		VenueID:   venueID,
+		Name:      name,
+		CreatedAt: time.Now().UTC(),
+	}
+}
@@ -6,13 +6,46 @@ import (
 	"github.com/google/uuid"
 )
 
+// Posiciones de juego
+const (
+	PositionGoalkeeper = "goalkeeper"
+	PositionDefender   = "defender"
+	PositionMidfielder = "midfielder"
+	PositionForward    = "forward"
+)
+
+// Roles de un jugador dentro de un equipo
+const (
+	RoleCaptain     = "captain"
+	RoleViceCaptain = "vice_captain"
+	RoleGoalkeeper  = "goalkeeper"
+)
+
+// Pie hábil
+const (
+	FootLeft  = "left"
+	FootRight = "right"
+	FootBoth  = "both"
+)
+
 // Player representa un jugador de fútbol
 // Equivalente a una entidad en C# con propiedades
 type Player struct {
-	ID        uuid.UUID `json:"id"`
-	Name      string    `json:"name"`
-	DateBirth time.Time `json:"date_birth"`
-	CreatedAt time.Time `json:"created_at"`
+	ID            uuid.UUID `json:"id"`
+	Name          string    `json:"name"`
+	DateBirth     time.Time `json:"date_birth"`
+	Position      string    `json:"position"`
+	PreferredFoot string    `json:"preferred_foot"`
+	// Nationality es el código ISO 3166-1 alpha-2 del país (vacío = sin definir)
+	Nationality string    `json:"nationality,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	// IsTest marca datos de prueba que se pueden purgar
+	IsTest bool `json:"is_test,omitempty"`
+	// JerseyNumber es el dorsal en un equipo; solo se carga al listar la plantilla
+	JerseyNumber *int `json:"jersey_number,omitempty"`
+	// Roles son los roles en el equipo (ver Role*); como el dorsal, solo se
+	// cargan al listar la plantilla
+	Roles []string `json:"roles,omitempty"`
 }
 
 // NewPlayer crea un nuevo jugador con ID generado
@@ -25,3 +58,54 @@ func NewPlayer(name string, dateBirth time.Time) *Player {
 		CreatedAt: time.Now().UTC(),
 	}
 }
+
+// IsValidCountryCode indica si el código tiene la forma ISO 3166-1 alpha-2
+// (dos letras mayúsculas); vacío significa sin definir
+func IsValidCountryCode(code string) bool {
+	if code == "" {
+		return true
+	}
+	return len(code) == 2 && code[0] >= 'A' && code[0] <= 'Z' && code[1] >= 'A' && code[1] <= 'Z'
+}
+
+// IsValidPosition indica si la posición es conocida; vacía significa sin definir
+func IsValidPosition(position string) bool {
+	switch position {
+	case "", PositionGoalkeeper, PositionDefender, PositionMidfielder, PositionForward:
+		return true
+	}
+	return false
+}
+
+// IsValidRole indica si el rol en el equipo es conocido
+func IsValidRole(role string) bool {
+	switch role {
+	case RoleCaptain, RoleViceCaptain, RoleGoalkeeper:
+		return true
+	}
+	return false
+}
+
+// IsUniqueRole indica si el rol lo puede tener un solo jugador por equipo
+func IsUniqueRole(role string) bool {
+	return role == RoleCaptain || role == RoleViceCaptain
+}
+
+// HasRole indica si el jugador tiene el rol en el equipo
+func (p *Player) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidFoot indica si el pie hábil es conocido; vacío significa sin definir
+func IsValidFoot(foot string) bool {
+	switch foot {
+	case "", FootLeft, FootRight, FootBoth:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,32 @@
+package domain
+
+// MaxBulkPlayers limita los jugadores de un alta masiva; alcanza para un
+// plantel completo con margen
+const MaxBulkPlayers = 100
+
+// Resultado de cada jugador de un alta masiva
+const (
+	// BulkPlayerCreated: el jugador se guardó
+	BulkPlayerCreated = "created"
+	// BulkPlayerRejected: el jugador no es válido
+	BulkPlayerRejected = "rejected"
+	// BulkPlayerSkipped: el jugador es válido pero otro del lote fue
+	// rechazado y no se guardó ninguno
+	BulkPlayerSkipped = "skipped"
+)
+
+// BulkPlayerResult es el resultado de un jugador del lote; Index es su
+// posición en el arreglo enviado
+type BulkPlayerResult struct {
+	Index  int     `json:"index"`
+	Status string  `json:"status"`
+	Player *Player `json:"player,omitempty"`
+	Error  string  `json:"error,omitempty"`
+}
+
+// BulkPlayerReport es el resultado de un alta masiva: o se crean todos o
+// ninguno, y Results explica cada posición
+type BulkPlayerReport struct {
+	Created int                `json:"created"`
+	Results []BulkPlayerResult `json:"results"`
+}
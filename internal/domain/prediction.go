@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Parámetros de la predicción de partidos
+const (
+	// RecentFormMatches es cuántos partidos cuentan para la forma reciente
+	RecentFormMatches = 5
+	// FormRatingWeight son los puntos de rating que suma una racha perfecta
+	// (o resta una sin puntos) respecto de una forma media
+	FormRatingWeight = 50.0
+	// BaseDrawProbability es la probabilidad de empate entre rivales parejos
+	BaseDrawProbability = 0.28
+)
+
+// TeamOutlook es lo que se sabe de un equipo antes del partido
+type TeamOutlook struct {
+	TeamID uuid.UUID `json:"team_id"`
+	Rating float64   `json:"rating"`
+	// Form son los últimos resultados del más reciente al más antiguo
+	// (W = ganado, D = empatado, L = perdido)
+	Form string `json:"form"`
+	// FormPoints son los puntos obtenidos en esos partidos (3/1/0)
+	FormPoints int `json:"form_points"`
+	// AdjustedRating es el rating corregido por la forma reciente
+	AdjustedRating float64 `json:"adjusted_rating"`
+}
+
+// MatchPrediction son las probabilidades de cada resultado de un partido
+// que todavía no se jugó. Team1Win + Draw + Team2Win = 1.
+type MatchPrediction struct {
+	MatchID    uuid.UUID   `json:"match_id"`
+	Team1      TeamOutlook `json:"team1"`
+	Team2      TeamOutlook `json:"team2"`
+	Team1Win   float64     `json:"team1_win"`
+	Draw       float64     `json:"draw"`
+	Team2Win   float64     `json:"team2_win"`
+	ComputedAt time.Time   `json:"computed_at"`
+}
+
+// NewTeamOutlook calcula la forma del equipo a partir de sus últimos
+// partidos ya jugados, ordenados del más reciente al más antiguo. Una
+// definición por penales cuenta como empate, igual que en el rating.
+func NewTeamOutlook(teamID uuid.UUID, rating float64, recent []Match) TeamOutlook {
+	outlook := TeamOutlook{TeamID: teamID, Rating: rating}
+
+	var form strings.Builder
+	played := 0
+	for _, match := range recent {
+		if played == RecentFormMatches {
+			break
+		}
+		goals1, goals2 := match.TotalGoals()
+		if match.Team2ID == teamID {
+			goals1, goals2 = goals2, goals1
+		}
+		switch {
+		case goals1 > goals2:
+			form.WriteByte('W')
+			outlook.FormPoints += 3
+		case goals1 == goals2:
+			form.WriteByte('D')
+			outlook.FormPoints++
+		default:
+			form.WriteByte('L')
+		}
+		played++
+	}
+	outlook.Form = form.String()
+
+	// Sin partidos la forma es neutra; si no, 1.5 puntos por partido es la media
+	adjustment := 0.0
+	if played > 0 {
+		adjustment = (float64(outlook.FormPoints)/float64(played)/1.5 - 1) * FormRatingWeight
+	}
+	outlook.AdjustedRating = math.Round((rating+adjustment)*100) / 100
+	return outlook
+}
+
+// PredictMatch reparte la expectativa Elo entre victoria, empate y derrota:
+// el empate es más probable cuanto más parejos son los equipos
+func PredictMatch(matchID uuid.UUID, team1, team2 TeamOutlook, now time.Time) *MatchPrediction {
+	expected := 1 / (1 + math.Pow(10, (team2.AdjustedRating-team1.AdjustedRating)/400))
+	draw := BaseDrawProbability * (1 - math.Abs(2*expected-1))
+	team1Win := math.Max(expected-draw/2, 0)
+
+	prediction := &MatchPrediction{
+		MatchID:    matchID,
+		Team1:      team1,
+		Team2:      team2,
+		Team1Win:   math.Round(team1Win*1000) / 1000,
+		Draw:       math.Round(draw*1000) / 1000,
+		ComputedAt: now,
+	}
+	// El redondeo no debe romper la suma
+	prediction.Team2Win = math.Round((1-prediction.Team1Win-prediction.Draw)*1000) / 1000
+	return prediction
+}
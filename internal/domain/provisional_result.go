@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Origen de un resultado provisorio
+const ResultSourceEmail = "email"
+
+// Estados de un resultado provisorio
+const (
+	ProvisionalPending   = "pending"
+	ProvisionalConfirmed = "confirmed"
+	ProvisionalRejected  = "rejected"
+)
+
+// ProvisionalResult es un marcador informado fuera de la API (por ejemplo por
+// email desde el árbitro). No modifica el partido hasta que se confirma.
+type ProvisionalResult struct {
+	ID              uuid.UUID  `json:"id"`
+	MatchID         uuid.UUID  `json:"match_id"`
+	RefereeID       *uuid.UUID `json:"referee_id,omitempty"`
+	GoalScoredTeam1 int        `json:"goal_scored_team1"`
+	GoalScoredTeam2 int        `json:"goal_scored_team2"`
+	Source          string     `json:"source"`
+	Sender          string     `json:"sender,omitempty"`
+	// RawLine es la línea del mensaje de la que salió el resultado
+	RawLine    string     `json:"raw_line,omitempty"`
+	Status     string     `json:"status"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+}
+
+// NewProvisionalResult crea un resultado pendiente de confirmación
+func NewProvisionalResult(matchID uuid.UUID, goals1, goals2 int, source string) *ProvisionalResult {
+	return &ProvisionalResult{
+		ID:              uuid.New(),
+		MatchID:         matchID,
+		GoalScoredTeam1: goals1,
+		GoalScoredTeam2: goals2,
+		Source:          source,
+		Status:          ProvisionalPending,
+		CreatedAt:       time.Now().UTC(),
+	}
+}
+
+// ReportedResult es un resultado leído de una línea como "Match 14: 2-1"
+type ReportedResult struct {
+	MatchNumber     int    `json:"match_number"`
+	GoalScoredTeam1 int    `json:"goal_scored_team1"`
+	GoalScoredTeam2 int    `json:"goal_scored_team2"`
+	Line            string `json:"line"`
+}
+
+// reportedResultPattern acepta "Match 14: 2-1", "Partido #14 - 2:1" o "match 14: 2 x 1"
+var reportedResultPattern = regexp.MustCompile(`(?i)^(?:match|partido)\s*#?\s*(\d+)\s*[:\-]\s*(\d+)\s*[-:x]\s*(\d+)$`)
+
+// ParseReportedResults busca una línea de resultado por renglón del texto.
+// Los renglones que no tienen ese formato (saludos, firmas, texto citado) se
+// ignoran.
+func ParseReportedResults(text string) []ReportedResult {
+	results := []ReportedResult{}
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		match := reportedResultPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		number, err1 := strconv.Atoi(match[1])
+		goals1, err2 := strconv.Atoi(match[2])
+		goals2, err3 := strconv.Atoi(match[3])
+		if err1 != nil || err2 != nil || err3 != nil {
+			continue
+		}
+		results = append(results, ReportedResult{
+			MatchNumber:     number,
+			GoalScoredTeam1: goals1,
+			GoalScoredTeam2: goals2,
+			Line:            line,
+		})
+	}
+	return results
+}
+
+// RejectedLine es una línea de resultado que no se pudo asociar a un partido
+type RejectedLine struct {
+	Line  string `json:"line"`
+	Error string `json:"error"`
+}
+
+// ResultEmailReport resume lo que se hizo con un email de resultados
+type ResultEmailReport struct {
+	Sender   string              `json:"sender"`
+	Created  []ProvisionalResult `json:"created"`
+	Rejected []RejectedLine      `json:"rejected"`
+}
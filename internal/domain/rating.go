@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Parámetros del sistema Elo
+const (
+	// InitialRating es el rating de un equipo sin partidos
+	InitialRating = 1500.0
+	// EloKFactor es cuánto puede moverse el rating en un partido parejo
+	EloKFactor = 32.0
+)
+
+// TeamRating es el rating Elo actual de un equipo
+type TeamRating struct {
+	Position  int       `json:"position,omitempty"`
+	TeamID    uuid.UUID `json:"team_id"`
+	TeamName  string    `json:"team_name,omitempty"`
+	Rating    float64   `json:"rating"`
+	Matches   int       `json:"matches"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// History se carga bajo demanda: la evolución partido a partido
+	History []RatingChange `json:"history,omitempty"`
+}
+
+// RatingChange es la variación del rating de un equipo en un partido
+type RatingChange struct {
+	TeamID       uuid.UUID `json:"team_id"`
+	MatchID      uuid.UUID `json:"match_id"`
+	OpponentID   uuid.UUID `json:"opponent_id"`
+	RatingBefore float64   `json:"rating_before"`
+	RatingAfter  float64   `json:"rating_after"`
+	Delta        float64   `json:"delta"`
+	PlayedAt     time.Time `json:"played_at"`
+}
+
+// ReplayElo calcula los ratings recorriendo los partidos en orden
+// cronológico. Los mini-juegos no cuentan (cuenta su partido padre) y una
+// definición por penales cuenta como empate, como en el ranking Elo de
+// selecciones. Devuelve los ratings de los equipos que jugaron y el
+// historial de cambios.
+func ReplayElo(matches []Match) ([]TeamRating, []RatingChange) {
+	played := make([]Match, 0, len(matches))
+	for _, match := range matches {
+		if match.ParentMatchID == nil {
+			played = append(played, match)
+		}
+	}
+	sort.SliceStable(played, func(i, j int) bool {
+		return played[i].Date.Before(played[j].Date)
+	})
+
+	ratings := make(map[uuid.UUID]*TeamRating)
+	rating := func(teamID uuid.UUID) *TeamRating {
+		current, ok := ratings[teamID]
+		if !ok {
+			current = &TeamRating{TeamID: teamID, Rating: InitialRating}
+			ratings[teamID] = current
+		}
+		return current
+	}
+
+	changes := make([]RatingChange, 0, len(played)*2)
+	for _, match := range played {
+		team1, team2 := rating(match.Team1ID), rating(match.Team2ID)
+		goals1, goals2 := match.TotalGoals()
+		delta := EloDelta(team1.Rating, team2.Rating, goals1, goals2)
+
+		changes = append(changes,
+			RatingChange{TeamID: team1.TeamID, MatchID: match.ID, OpponentID: team2.TeamID, RatingBefore: team1.Rating, RatingAfter: team1.Rating + delta, Delta: delta, PlayedAt: match.Date},
+			RatingChange{TeamID: team2.TeamID, MatchID: match.ID, OpponentID: team1.TeamID, RatingBefore: team2.Rating, RatingAfter: team2.Rating - delta, Delta: -delta, PlayedAt: match.Date},
+		)
+		team1.Rating += delta
+		team2.Rating -= delta
+		team1.Matches++
+		team2.Matches++
+		team1.UpdatedAt = match.Date
+		team2.UpdatedAt = match.Date
+	}
+
+	result := make([]TeamRating, 0, len(ratings))
+	for _, current := range ratings {
+		result = append(result, *current)
+	}
+	SortRatings(result)
+	return result, changes
+}
+
+// EloDelta devuelve los puntos que gana team1 (y pierde team2). La
+// diferencia de goles amplifica el cambio: x1.5 por dos goles y
+// (11+N)/8 por N >= 3.
+func EloDelta(rating1, rating2 float64, goals1, goals2 int) float64 {
+	expected := 1 / (1 + math.Pow(10, (rating2-rating1)/400))
+
+	score := 0.5
+	switch {
+	case goals1 > goals2:
+		score = 1
+	case goals2 > goals1:
+		score = 0
+	}
+
+	margin := goals1 - goals2
+	if margin < 0 {
+		margin = -margin
+	}
+	multiplier := 1.0
+	switch {
+	case margin == 2:
+		multiplier = 1.5
+	case margin >= 3:
+		multiplier = (11 + float64(margin)) / 8
+	}
+
+	return math.Round(EloKFactor*multiplier*(score-expected)*100) / 100
+}
+
+// SortRatings ordena de mayor a menor rating y asigna la posición
+func SortRatings(ratings []TeamRating) {
+	sort.SliceStable(ratings, func(i, j int) bool {
+		if ratings[i].Rating != ratings[j].Rating {
+			return ratings[i].Rating > ratings[j].Rating
+		}
+		return ratings[i].TeamName < ratings[j].TeamName
+	})
+	for i := range ratings {
+		ratings[i].Position = i + 1
+	}
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Roles de un árbitro en un partido
+const (
+	RefereeRoleMain      = "main"
+	RefereeRoleAssistant = "assistant"
+)
+
+// MaxAssistantReferees es la cantidad de asistentes (jueces de línea) por partido
+const MaxAssistantReferees = 2
+
+// Referee representa un árbitro que puede ser designado en partidos
+type Referee struct {
+	ID            uuid.UUID `json:"id"`
+	Name          string    `json:"name"`
+	LicenseNumber string    `json:"license_number"`
+	// Email identifica al árbitro cuando envía resultados por correo
+	Email     string    `json:"email,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewReferee crea un nuevo árbitro
+func NewReferee(name, licenseNumber string) *Referee {
+	return &Referee{
+		ID:            uuid.New(),
+		Name:          name,
+		LicenseNumber: licenseNumber,
+		CreatedAt:     time.Now().UTC(),
+	}
+}
+
+// MatchReferee es la designación de un árbitro en un partido
+type MatchReferee struct {
+	RefereeID uuid.UUID `json:"referee_id"`
+	Name      string    `json:"name"`
+	Role      string    `json:"role"`
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Registration es el fichaje de un jugador con un equipo para un torneo.
+// Un jugador solo puede estar fichado con un equipo por torneo.
+type Registration struct {
+	TournamentID uuid.UUID `json:"tournament_id"`
+	TeamID       uuid.UUID `json:"team_id"`
+	PlayerID     uuid.UUID `json:"player_id"`
+	PlayerName   string    `json:"player_name,omitempty"`
+	RegisteredAt time.Time `json:"registered_at"`
+}
+
+// NewRegistration crea un fichaje con la fecha actual
+func NewRegistration(tournamentID, teamID, playerID uuid.UUID) *Registration {
+	return &Registration{
+		TournamentID: tournamentID,
+		TeamID:       teamID,
+		PlayerID:     playerID,
+		RegisteredAt: time.Now().UTC(),
+	}
+}
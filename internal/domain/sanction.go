@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tipos de sanción disciplinaria
+const (
+	// SanctionFine es una multa económica (Fine)
+	SanctionFine = "fine"
+	// SanctionPointDeduction le quita Points puntos a un equipo en la tabla
+	SanctionPointDeduction = "point_deduction"
+	// SanctionBan suspende a un jugador o equipo por Matches partidos
+	SanctionBan = "ban"
+)
+
+// Sanction es una decisión del tribunal de disciplina de un torneo contra un
+// equipo o un jugador. Las sanciones no se borran: una anulada (por ejemplo
+// tras una apelación) queda en el historial con RevokedAt y deja de contar.
+type Sanction struct {
+	ID           uuid.UUID  `json:"id"`
+	TournamentID uuid.UUID  `json:"tournament_id"`
+	Type         string     `json:"type"`
+	TeamID       *uuid.UUID `json:"team_id,omitempty"`
+	PlayerID     *uuid.UUID `json:"player_id,omitempty"`
+	// Fine es el importe de la multa (ver Money)
+	Fine Money `json:"fine,omitzero"`
+	// Points son los puntos descontados en la tabla
+	Points int `json:"points,omitempty"`
+	// Matches son los partidos de suspensión
+	Matches int `json:"matches,omitempty"`
+	// Round es la jornada desde la que cuenta la quita de puntos en las
+	// tablas por jornada; con 0 cuenta en todas
+	Round     int        `json:"round,omitempty"`
+	Reason    string     `json:"reason"`
+	DecidedAt time.Time  `json:"decided_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// NewSanction crea una sanción del torneo; los importes se completan antes
+// de validarla
+func NewSanction(tournamentID uuid.UUID, sanctionType string, teamID, playerID *uuid.UUID, reason string) *Sanction {
+	return &Sanction{
+		ID:           uuid.New(),
+		TournamentID: tournamentID,
+		Type:         sanctionType,
+		TeamID:       teamID,
+		PlayerID:     playerID,
+		Reason:       reason,
+		DecidedAt:    time.Now().UTC(),
+	}
+}
+
+// IsActive indica si la sanción sigue vigente (no fue anulada)
+func (s *Sanction) IsActive() bool {
+	return s.RevokedAt == nil
+}
+
+// Validate comprueba que la sanción tenga un único sancionado y el importe
+// que corresponde a su tipo
+func (s *Sanction) Validate() error {
+	if (s.TeamID == nil) == (s.PlayerID == nil) {
+		return fmt.Errorf("sanction must target either a team or a player")
+	}
+	if s.Reason == "" {
+		return fmt.Errorf("reason is required")
+	}
+	if s.Round < 0 {
+		return fmt.Errorf("round cannot be negative")
+	}
+
+	switch s.Type {
+	case SanctionFine:
+		if s.Fine == (Money{}) {
+			return fmt.Errorf("fine requires an amount and a currency")
+		}
+		if err := s.Fine.Validate(); err != nil {
+			return fmt.Errorf("invalid fine: %w", err)
+		}
+	case SanctionPointDeduction:
+		if s.TeamID == nil {
+			return fmt.Errorf("point deductions apply to teams only")
+		}
+		if s.Points <= 0 {
+			return fmt.Errorf("deducted points must be positive")
+		}
+	case SanctionBan:
+		if s.Matches <= 0 {
+			return fmt.Errorf("ban must last at least one match")
+		}
+	default:
+		return fmt.Errorf("invalid sanction type: %s", s.Type)
+	}
+	return nil
+}
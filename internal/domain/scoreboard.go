@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Parámetros del marcador de sede
+const (
+	// ScoreboardLiveWindow es cuánto se muestra un partido como en juego
+	// desde su inicio: los partidos no registran cuándo terminan
+	ScoreboardLiveWindow = 2 * time.Hour
+	// ScoreboardUpcomingWindow es hasta dónde se buscan los próximos partidos
+	ScoreboardUpcomingWindow = 24 * time.Hour
+	// ScoreboardNextMatches es cuántos próximos partidos se muestran
+	ScoreboardNextMatches = 4
+)
+
+// ScoreboardMatch es un partido listo para mostrar en una pantalla: nombres
+// en lugar de IDs y el marcador como texto
+type ScoreboardMatch struct {
+	MatchID uuid.UUID `json:"match_id"`
+	Kickoff time.Time `json:"kickoff"`
+	Round   int       `json:"round,omitempty"`
+	Team1   string    `json:"team1"`
+	Team2   string    `json:"team2"`
+	// Score es el marcador ("2 - 1", con prórroga y penales si los hubo);
+	// vacío en los próximos partidos y si el resultado está embargado
+	Score string `json:"score,omitempty"`
+	// ElapsedMinutes son los minutos desde el inicio (solo en juego)
+	ElapsedMinutes int `json:"elapsed_minutes,omitempty"`
+	// Attendance es el público permitido, si hay restricciones
+	Attendance *MatchAttendance `json:"attendance,omitempty"`
+}
+
+// Scoreboard es lo que muestran las pantallas de una sede: los partidos en
+// juego y los siguientes
+type Scoreboard struct {
+	VenueID     uuid.UUID         `json:"venue_id"`
+	VenueName   string            `json:"venue_name"`
+	Live        []ScoreboardMatch `json:"live"`
+	Next        []ScoreboardMatch `json:"next"`
+	GeneratedAt time.Time         `json:"generated_at"`
+}
+
+// NewScoreboard reparte los partidos de la sede (ordenados por fecha) entre
+// en juego y próximos. teamNames resuelve el nombre de cada equipo.
+func NewScoreboard(venue *Venue, matches []Match, teamNames map[uuid.UUID]string, now time.Time) *Scoreboard {
+	scoreboard := &Scoreboard{
+		VenueID:     venue.ID,
+		VenueName:   venue.Name,
+		Live:        []ScoreboardMatch{},
+		Next:        []ScoreboardMatch{},
+		GeneratedAt: now,
+	}
+
+	for i := range matches {
+		match := &matches[i]
+		entry := ScoreboardMatch{
+			MatchID:    match.ID,
+			Kickoff:    match.Date,
+			Round:      match.Round,
+			Team1:      teamNames[match.Team1ID],
+			Team2:      teamNames[match.Team2ID],
+			Attendance: match.Attendance,
+		}
+
+		switch {
+		case match.Date.After(now):
+			if len(scoreboard.Next) < ScoreboardNextMatches {
+				scoreboard.Next = append(scoreboard.Next, entry)
+			}
+		case now.Sub(match.Date) < ScoreboardLiveWindow:
+			entry.ElapsedMinutes = int(now.Sub(match.Date).Minutes())
+			if match.ResultEmbargoedUntil == nil {
+				entry.Score = match.ScoreText()
+			}
+			scoreboard.Live = append(scoreboard.Live, entry)
+		}
+	}
+	return scoreboard
+}
+
+// ScoreText es el marcador como texto: "2 - 1", "1 - 1 (2 - 1 pr.)" con
+// prórroga y "1 - 1 (4 - 3 pen.)" con penales
+func (m *Match) ScoreText() string {
+	goals1, goals2 := m.TotalGoals()
+	text := fmt.Sprintf("%d - %d", m.GoalScoredTeam1, m.GoalScoredTeam2)
+	if m.HasExtraTime() {
+		text += fmt.Sprintf(" (%d - %d pr.)", goals1, goals2)
+	}
+	if m.PenaltiesTeam1 != nil && m.PenaltiesTeam2 != nil {
+		text += fmt.Sprintf(" (%d - %d pen.)", *m.PenaltiesTeam1, *m.PenaltiesTeam2)
+	}
+	return text
+}
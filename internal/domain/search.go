@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Tipos de resultado de la búsqueda global
+const (
+	SearchPlayer     = "player"
+	SearchTeam       = "team"
+	SearchTournament = "tournament"
+)
+
+// SearchTypes son los tipos que se buscan si no se pide ninguno
+var SearchTypes = []string{SearchTeam, SearchTournament, SearchPlayer}
+
+// Límites de la búsqueda global
+const (
+	MinSearchLength    = 2
+	DefaultSearchLimit = 10
+	MaxSearchLimit     = 50
+)
+
+// SearchQuery es una búsqueda por nombre: Limit es el máximo de resultados
+// de cada tipo
+type SearchQuery struct {
+	Text  string
+	Types []string
+	Limit int
+}
+
+// NewSearchQuery valida la búsqueda; sin tipos busca en todos y con limit 0
+// usa DefaultSearchLimit
+func NewSearchQuery(text string, types []string, limit int) (SearchQuery, error) {
+	text = strings.TrimSpace(text)
+	if len([]rune(text)) < MinSearchLength {
+		return SearchQuery{}, fmt.Errorf("q must have at least %d characters", MinSearchLength)
+	}
+	if len(types) == 0 {
+		types = SearchTypes
+	}
+	for _, t := range types {
+		if !slices.Contains(SearchTypes, t) {
+			return SearchQuery{}, fmt.Errorf("type must be one of %s", strings.Join(SearchTypes, ", "))
+		}
+	}
+	if limit == 0 {
+		limit = DefaultSearchLimit
+	}
+	if limit < 0 || limit > MaxSearchLimit {
+		return SearchQuery{}, fmt.Errorf("limit must be between 1 and %d", MaxSearchLimit)
+	}
+	return SearchQuery{Text: text, Types: types, Limit: limit}, nil
+}
+
+// Includes indica si la búsqueda pide el tipo
+func (q SearchQuery) Includes(searchType string) bool {
+	return slices.Contains(q.Types, searchType)
+}
+
+// Rank puntúa el nombre sin distinguir mayúsculas: 0 si es igual al texto,
+// 1 si empieza con él, 2 si lo contiene y -1 si no coincide
+func (q SearchQuery) Rank(name string) int {
+	name, text := strings.ToLower(name), strings.ToLower(q.Text)
+	switch {
+	case name == text:
+		return 0
+	case strings.HasPrefix(name, text):
+		return 1
+	case strings.Contains(name, text):
+		return 2
+	}
+	return -1
+}
+
+// SearchResult es un resultado de la búsqueda global; Detail resume la
+// entidad (la posición del jugador, el estado del torneo)
+type SearchResult struct {
+	Type   string    `json:"type"`
+	ID     uuid.UUID `json:"id"`
+	Name   string    `json:"name"`
+	Detail string    `json:"detail,omitempty"`
+}
+
+// SortSearchResults ordena los resultados de todos los tipos juntos: primero
+// los nombres iguales al texto, después los que empiezan con él y al final
+// los que lo contienen
+func SortSearchResults(results []SearchResult, query SearchQuery) {
+	slices.SortStableFunc(results, func(a, b SearchResult) int {
+		if c := query.Rank(a.Name) - query.Rank(b.Name); c != 0 {
+			return c
+		}
+		return compareFold(a.Name, b.Name)
+	})
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Season es una temporada (p. ej. "2024/25") que agrupa torneos. Los
+// partidos y estadísticas pertenecen a la temporada de su torneo.
+type Season struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	StartsOn  time.Time `json:"starts_on"`
+	EndsOn    time.Time `json:"ends_on"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewSeason crea una nueva temporada
+func NewSeason(name string, startsOn, endsOn time.Time) *Season {
+	return &Season{
+		ID:        uuid.New(),
+		Name:      name,
+		StartsOn:  startsOn,
+		EndsOn:    endsOn,
+		CreatedAt: time.Now().UTC(),
+	}
+}
@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Sort es el orden pedido para un listado: ?sort=name o ?sort=-created_at
+// (el guion invierte el orden). Los empates se resuelven siempre por ID para
+// que las páginas no se solapen.
+type Sort struct {
+	Field string
+	Desc  bool
+}
+
+// String devuelve el orden en el formato del parámetro ?sort=
+func (s Sort) String() string {
+	if s.Desc {
+		return "-" + s.Field
+	}
+	return s.Field
+}
+
+// SortFields es la lista blanca de campos por los que se puede ordenar un
+// listado, junto con su orden por defecto. Los repositorios traducen cada
+// campo a su columna, así el parámetro nunca llega crudo a la consulta.
+type SortFields struct {
+	Fields  []string
+	Default Sort
+}
+
+// Campos ordenables de cada listado. date_birth no está porque puede estar
+// cifrada en la base (ver PII_ENCRYPTION_KEYS).
+var (
+	PlayerSortFields = SortFields{
+		Fields:  []string{"name", "position", "nationality", "created_at"},
+		Default: Sort{Field: "created_at", Desc: true},
+	}
+	TeamSortFields = SortFields{
+		Fields:  []string{"name", "created_at"},
+		Default: Sort{Field: "created_at", Desc: true},
+	}
+	TournamentSortFields = SortFields{
+		Fields:  []string{"name", "status", "created_at"},
+		Default: Sort{Field: "created_at", Desc: true},
+	}
+	MatchSortFields = SortFields{
+		Fields:  []string{"date", "round", "match_number", "created_at"},
+		Default: Sort{Field: "date", Desc: true},
+	}
+)
+
+// Parse valida el parámetro ?sort=; vacío devuelve el orden por defecto
+func (f SortFields) Parse(raw string) (Sort, error) {
+	if raw == "" {
+		return f.Default, nil
+	}
+	sort := Sort{Field: strings.TrimPrefix(raw, "-"), Desc: strings.HasPrefix(raw, "-")}
+	if !slices.Contains(f.Fields, sort.Field) {
+		return Sort{}, fmt.Errorf("sort must be one of %s (prefix - for descending)", strings.Join(f.Fields, ", "))
+	}
+	return sort, nil
+}
+
+// Of devuelve el orden de la página o el por defecto si no pidió ninguno
+func (f SortFields) Of(page Page) Sort {
+	if page.Sort.Field == "" {
+		return f.Default
+	}
+	return page.Sort
+}
+
+// SortPlayers ordena los jugadores en memoria igual que el repositorio SQL
+func SortPlayers(players []Player, sort Sort) {
+	sortByField(players, sort, func(p Player) uuid.UUID { return p.ID }, func(a, b Player) int {
+		switch sort.Field {
+		case "name":
+			return compareFold(a.Name, b.Name)
+		case "position":
+			return strings.Compare(a.Position, b.Position)
+		case "nationality":
+			return strings.Compare(a.Nationality, b.Nationality)
+		}
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+}
+
+// SortTeams ordena los equipos en memoria igual que el repositorio SQL
+func SortTeams(teams []Team, sort Sort) {
+	sortByField(teams, sort, func(t Team) uuid.UUID { return t.ID }, func(a, b Team) int {
+		if sort.Field == "name" {
+			return compareFold(a.Name, b.Name)
+		}
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+}
+
+// SortTournaments ordena los torneos en memoria igual que el repositorio SQL
+func SortTournaments(tournaments []Tournament, sort Sort) {
+	sortByField(tournaments, sort, func(t Tournament) uuid.UUID { return t.ID }, func(a, b Tournament) int {
+		switch sort.Field {
+		case "name":
+			return compareFold(a.Name, b.Name)
+		case "status":
+			return strings.Compare(a.Status, b.Status)
+		}
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+}
+
+// SortMatches ordena los partidos en memoria igual que el repositorio SQL
+func SortMatches(matches []Match, sort Sort) {
+	sortByField(matches, sort, func(m Match) uuid.UUID { return m.ID }, func(a, b Match) int {
+		switch sort.Field {
+		case "round":
+			return a.Round - b.Round
+		case "match_number":
+			return a.MatchNumber - b.MatchNumber
+		case "created_at":
+			return a.CreatedAt.Compare(b.CreatedAt)
+		}
+		return a.Date.Compare(b.Date)
+	})
+}
+
+// sortByField ordena por el campo (invertido si sort.Desc) y desempata por
+// ID ascendente, como ORDER BY campo DESC, id
+func sortByField[T any](items []T, sort Sort, id func(T) uuid.UUID, compare func(a, b T) int) {
+	slices.SortStableFunc(items, func(a, b T) int {
+		c := compare(a, b)
+		if sort.Desc {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+		aID, bID := id(a), id(b)
+		return bytes.Compare(aID[:], bID[:])
+	})
+}
+
+// compareFold compara sin distinguir mayúsculas, como ORDER BY LOWER(name)
+func compareFold(a, b string) int {
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
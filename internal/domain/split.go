@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Modos de arrastre de puntos al dividir la liga
+const (
+	CarryOverFull = "full"
+	CarryOverHalf = "half"
+	CarryOverNone = "none"
+)
+
+// SplitGroup define un grupo del split, p. ej. "Championship" con 6 equipos
+type SplitGroup struct {
+	Name string `json:"name"`
+	Size int    `json:"size"`
+}
+
+// SplitOptions configura la división de la liga tras la fase regular
+type SplitOptions struct {
+	AfterRound        int          `json:"after_round"`
+	CarryOver         string       `json:"carry_over"`
+	Groups            []SplitGroup `json:"groups"`
+	StartDate         time.Time    `json:"start_date"`
+	DaysBetweenRounds int          `json:"days_between_rounds"`
+	DoubleRoundRobin  bool         `json:"double_round_robin"`
+}
+
+// SplitGroupResult es el torneo creado para un grupo con sus puntos iniciales
+type SplitGroupResult struct {
+	Tournament Tournament `json:"tournament"`
+	Standings  []Standing `json:"standings"`
+	Matches    int        `json:"matches"`
+}
+
+// SplitResult es el resultado de dividir una liga
+type SplitResult struct {
+	TournamentID uuid.UUID          `json:"tournament_id"`
+	CarryOver    string             `json:"carry_over"`
+	Groups       []SplitGroupResult `json:"groups"`
+}
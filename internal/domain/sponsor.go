@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Sponsor es un patrocinador de un torneo que se muestra como banner
+// en una ubicación (placement) del frontend durante un rango de fechas
+type Sponsor struct {
+	ID           uuid.UUID `json:"id"`
+	TournamentID uuid.UUID `json:"tournament_id"`
+	Name         string    `json:"name"`
+	LogoURL      string    `json:"logo_url"`
+	LinkURL      string    `json:"link_url"`
+	Placement    string    `json:"placement"`
+	StartsAt     time.Time `json:"starts_at"`
+	EndsAt       time.Time `json:"ends_at"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// NewSponsor crea un nuevo patrocinador
+func NewSponsor(tournamentID uuid.UUID, name, logoURL, linkURL, placement string, startsAt, endsAt time.Time) *Sponsor {
+	return &Sponsor{
+		ID:           uuid.New(),
+		TournamentID: tournamentID,
+		Name:         name,
+		LogoURL:      logoURL,
+		LinkURL:      linkURL,
+		Placement:    placement,
+		StartsAt:     startsAt,
+		EndsAt:       endsAt,
+		CreatedAt:    time.Now().UTC(),
+	}
+}
+
+// IsActive indica si el patrocinador debe mostrarse en el instante dado
+func (s *Sponsor) IsActive(at time.Time) bool {
+	return !at.Before(s.StartsAt) && !at.After(s.EndsAt)
+}
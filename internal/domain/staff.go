@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Roles del cuerpo técnico
+const (
+	StaffCoach     = "coach"
+	StaffAssistant = "assistant"
+	StaffPhysio    = "physio"
+)
+
+// Staff es un integrante del cuerpo técnico de un equipo. No es un jugador:
+// no se alinea ni tiene dorsal.
+type Staff struct {
+	ID        uuid.UUID `json:"id"`
+	TeamID    uuid.UUID `json:"team_id"`
+	Name      string    `json:"name"`
+	Role      string    `json:"role"`
+	Email     string    `json:"email,omitempty"`
+	Phone     string    `json:"phone,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewStaff crea un integrante del cuerpo técnico del equipo
+func NewStaff(teamID uuid.UUID, name, role string) *Staff {
+	return &Staff{
+		ID:        uuid.New(),
+		TeamID:    teamID,
+		Name:      name,
+		Role:      role,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// IsValidStaffRole indica si el rol del cuerpo técnico es conocido
+func IsValidStaffRole(role string) bool {
+	switch role {
+	case StaffCoach, StaffAssistant, StaffPhysio:
+		return true
+	}
+	return false
+}
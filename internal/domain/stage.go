@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Estados de una fase
+const (
+	StagePending   = "pending"
+	StageActive    = "active"
+	StageCompleted = "completed"
+)
+
+// Stage es una fase de un torneo (fase de grupos, cuartos de final, final).
+// Las fases se juegan en el orden de Position y solo una está activa.
+type Stage struct {
+	ID           uuid.UUID `json:"id"`
+	TournamentID uuid.UUID `json:"tournament_id"`
+	Name         string    `json:"name"`
+	Position     int       `json:"position"`
+	Status       string    `json:"status"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// NewStage crea una fase pendiente
+func NewStage(tournamentID uuid.UUID, name string, position int) *Stage {
+	return &Stage{
+		ID:           uuid.New(),
+		TournamentID: tournamentID,
+		Name:         name,
+		Position:     position,
+		Status:       StagePending,
+		CreatedAt:    time.Now().UTC(),
+	}
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Puntos por resultado en la tabla de posiciones
+const (
+	PointsWin  = 3
+	PointsDraw = 1
+)
+
+// Standing es una fila de la tabla de posiciones de un torneo. Points ya
+// suma los puntos arrastrados (CarriedPoints) y resta las quitas por
+// sanciones (DeductedPoints).
+type Standing struct {
+	Position       int       `json:"position"`
+	TeamID         uuid.UUID `json:"team_id"`
+	TeamName       string    `json:"team_name"`
+	Played         int       `json:"played"`
+	Won            int       `json:"won"`
+	Drawn          int       `json:"drawn"`
+	Lost           int       `json:"lost"`
+	GoalsFor       int       `json:"goals_for"`
+	GoalsAgainst   int       `json:"goals_against"`
+	GoalDifference int       `json:"goal_difference"`
+	CarriedPoints  int       `json:"carried_points,omitempty"`
+	DeductedPoints int       `json:"deducted_points,omitempty"`
+	Points         int       `json:"points"`
+	// PreviousPosition es la posición en la jornada anterior y PositionChange
+	// cuántos puestos subió (negativo si bajó); se omiten sin jornada previa
+	PreviousPosition int `json:"previous_position,omitempty"`
+	PositionChange   int `json:"position_change,omitempty"`
+}
+
+// StandingsSnapshot es la tabla de posiciones guardada al completarse una
+// jornada: muestra cómo quedó aunque después cambien nombres o puntos
+type StandingsSnapshot struct {
+	TournamentID uuid.UUID  `json:"tournament_id"`
+	Round        int        `json:"round"`
+	Standings    []Standing `json:"standings"`
+	TakenAt      time.Time  `json:"taken_at"`
+}
+
+// NewStandingsSnapshot crea la foto de la tabla tras la jornada round
+func NewStandingsSnapshot(tournamentID uuid.UUID, round int, standings []Standing) *StandingsSnapshot {
+	return &StandingsSnapshot{
+		TournamentID: tournamentID,
+		Round:        round,
+		Standings:    standings,
+		TakenAt:      time.Now().UTC(),
+	}
+}
+
+// SortStandings ordena la tabla por puntos, diferencia de gol, goles a favor y
+// nombre, y asigna la posición de cada equipo
+func SortStandings(standings []Standing) {
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if a.GoalDifference != b.GoalDifference {
+			return a.GoalDifference > b.GoalDifference
+		}
+		if a.GoalsFor != b.GoalsFor {
+			return a.GoalsFor > b.GoalsFor
+		}
+		return a.TeamName < b.TeamName
+	})
+
+	for i := range standings {
+		standings[i].Position = i + 1
+	}
+}
+
+// ApplyPositionChanges compara la tabla con la de la jornada anterior y
+// completa la posición previa de cada equipo. Los equipos que no estaban en
+// la tabla anterior quedan sin variación.
+func ApplyPositionChanges(standings, previous []Standing) {
+	positions := make(map[uuid.UUID]int, len(previous))
+	for _, row := range previous {
+		positions[row.TeamID] = row.Position
+	}
+
+	for i := range standings {
+		before, ok := positions[standings[i].TeamID]
+		if !ok {
+			continue
+		}
+		standings[i].PreviousPosition = before
+		standings[i].PositionChange = before - standings[i].Position
+	}
+}
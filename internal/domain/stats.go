@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// TopScorer es una fila de la tabla de goleadores de un torneo. Los autogoles
+// no cuentan para el jugador. Los invitados sin ficha figuran por nombre, sin
+// PlayerID y con Guest en true.
+type TopScorer struct {
+	Position   int        `json:"position"`
+	PlayerID   *uuid.UUID `json:"player_id,omitempty"`
+	PlayerName string     `json:"player_name"`
+	Guest      bool       `json:"guest,omitempty"`
+	TeamID     uuid.UUID  `json:"team_id"`
+	TeamName   string     `json:"team_name"`
+	Goals      int        `json:"goals"`
+	Penalties  int        `json:"penalties"`
+}
+
+// SortTopScorers ordena por goles; a igualdad, gana quien convirtió menos de
+// penal, y luego por nombre (y por ID entre homónimos, para que el orden no
+// dependa de la consulta). Asigna la posición de cada jugador.
+func SortTopScorers(scorers []TopScorer) {
+	sort.SliceStable(scorers, func(i, j int) bool {
+		a, b := scorers[i], scorers[j]
+		if a.Goals != b.Goals {
+			return a.Goals > b.Goals
+		}
+		if a.Penalties != b.Penalties {
+			return a.Penalties < b.Penalties
+		}
+		if a.PlayerName != b.PlayerName {
+			return a.PlayerName < b.PlayerName
+		}
+		return a.PlayerID.String() < b.PlayerID.String()
+	})
+
+	for i := range scorers {
+		scorers[i].Position = i + 1
+	}
+}
+
+// TopAssister es una fila de la tabla de asistidores de un torneo
+type TopAssister struct {
+	Position   int       `json:"position"`
+	PlayerID   uuid.UUID `json:"player_id"`
+	PlayerName string    `json:"player_name"`
+	TeamID     uuid.UUID `json:"team_id"`
+	TeamName   string    `json:"team_name"`
+	Assists    int       `json:"assists"`
+}
+
+// SortTopAssisters ordena por asistencias, nombre e ID y asigna la posición
+func SortTopAssisters(assisters []TopAssister) {
+	sort.SliceStable(assisters, func(i, j int) bool {
+		a, b := assisters[i], assisters[j]
+		if a.Assists != b.Assists {
+			return a.Assists > b.Assists
+		}
+		if a.PlayerName != b.PlayerName {
+			return a.PlayerName < b.PlayerName
+		}
+		return a.PlayerID.String() < b.PlayerID.String()
+	})
+
+	for i := range assisters {
+		assisters[i].Position = i + 1
+	}
+}
+
+// TeamCleanSheet resume los goles recibidos por un equipo en un torneo
+type TeamCleanSheet struct {
+	Position      int       `json:"position"`
+	TeamID        uuid.UUID `json:"team_id"`
+	TeamName      string    `json:"team_name"`
+	Played        int       `json:"played"`
+	GoalsConceded int       `json:"goals_conceded"`
+	CleanSheets   int       `json:"clean_sheets"`
+}
+
+// GoalkeeperCleanSheet resume los goles recibidos con un arquero titular.
+// Se toma el arquero de la alineación inicial durante todo el partido.
+type GoalkeeperCleanSheet struct {
+	Position      int       `json:"position"`
+	PlayerID      uuid.UUID `json:"player_id"`
+	PlayerName    string    `json:"player_name"`
+	TeamID        uuid.UUID `json:"team_id"`
+	TeamName      string    `json:"team_name"`
+	Played        int       `json:"played"`
+	GoalsConceded int       `json:"goals_conceded"`
+	CleanSheets   int       `json:"clean_sheets"`
+}
+
+// CleanSheets agrupa las vallas invictas por equipo y por arquero
+type CleanSheets struct {
+	Teams       []TeamCleanSheet       `json:"teams"`
+	Goalkeepers []GoalkeeperCleanSheet `json:"goalkeepers"`
+}
+
+// cleanSheetLess ordena por vallas invictas, luego menos goles recibidos y nombre
+func cleanSheetLess(cleanA, concededA int, nameA string, cleanB, concededB int, nameB string) bool {
+	if cleanA != cleanB {
+		return cleanA > cleanB
+	}
+	if concededA != concededB {
+		return concededA < concededB
+	}
+	return nameA < nameB
+}
+
+// Sort ordena ambas tablas y asigna las posiciones
+func (c *CleanSheets) Sort() {
+	sort.SliceStable(c.Teams, func(i, j int) bool {
+		a, b := c.Teams[i], c.Teams[j]
+		return cleanSheetLess(a.CleanSheets, a.GoalsConceded, a.TeamName, b.CleanSheets, b.GoalsConceded, b.TeamName)
+	})
+	for i := range c.Teams {
+		c.Teams[i].Position = i + 1
+	}
+
+	sort.SliceStable(c.Goalkeepers, func(i, j int) bool {
+		a, b := c.Goalkeepers[i], c.Goalkeepers[j]
+		return cleanSheetLess(a.CleanSheets, a.GoalsConceded, a.PlayerName, b.CleanSheets, b.GoalsConceded, b.PlayerName)
+	})
+	for i := range c.Goalkeepers {
+		c.Goalkeepers[i].Position = i + 1
+	}
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Severidades de un incidente
+const (
+	IncidentMinor       = "minor"
+	IncidentMajor       = "major"
+	IncidentMaintenance = "maintenance"
+)
+
+// Estados del servicio y de sus dependencias
+const (
+	StatusOperational = "operational"
+	StatusDegraded    = "degraded"
+	StatusMaintenance = "maintenance"
+	StatusDown        = "down"
+)
+
+// Incident es una nota de la página de estado que carga un administrador
+type Incident struct {
+	ID         uuid.UUID  `json:"id"`
+	Title      string     `json:"title"`
+	Message    string     `json:"message,omitempty"`
+	Severity   string     `json:"severity"`
+	StartedAt  time.Time  `json:"started_at"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+}
+
+// NewIncident crea un incidente abierto desde ahora
+func NewIncident(title, message, severity string) *Incident {
+	return &Incident{
+		ID:        uuid.New(),
+		Title:     title,
+		Message:   message,
+		Severity:  severity,
+		StartedAt: time.Now().UTC(),
+	}
+}
+
+// IsValidIncidentSeverity indica si la severidad es conocida
+func IsValidIncidentSeverity(severity string) bool {
+	switch severity {
+	case IncidentMinor, IncidentMajor, IncidentMaintenance:
+		return true
+	}
+	return false
+}
+
+// DependencyHealth es el resultado del chequeo de una dependencia
+type DependencyHealth struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// ServiceStatus es el resumen que consulta la página de estado
+type ServiceStatus struct {
+	Status        string             `json:"status"`
+	StartedAt     time.Time          `json:"started_at"`
+	UptimeSeconds int64              `json:"uptime_seconds"`
+	Dependencies  []DependencyHealth `json:"dependencies"`
+	Incidents     []Incident         `json:"incidents"`
+	CheckedAt     time.Time          `json:"checked_at"`
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Substitution es un cambio de jugadores de un equipo durante un partido
+type Substitution struct {
+	ID          uuid.UUID `json:"id"`
+	MatchID     uuid.UUID `json:"match_id"`
+	TeamID      uuid.UUID `json:"team_id"`
+	PlayerOutID uuid.UUID `json:"player_out_id"`
+	PlayerInID  uuid.UUID `json:"player_in_id"`
+	Minute      int       `json:"minute"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// NewSubstitution crea un nuevo cambio
+func NewSubstitution(matchID, teamID, playerOutID, playerInID uuid.UUID, minute int) *Substitution {
+	return &Substitution{
+		ID:          uuid.New(),
+		MatchID:     matchID,
+		TeamID:      teamID,
+		PlayerOutID: playerOutID,
+		PlayerInID:  playerInID,
+		Minute:      minute,
+		CreatedAt:   time.Now().UTC(),
+	}
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tipos de operación que acepta la sincronización por lotes
+const (
+	SyncOpCreateEvent = "create_event"
+	SyncOpUpdateScore = "update_score"
+	SyncOpCheckIn     = "check_in"
+)
+
+// Resultado de cada operación del lote
+const (
+	// SyncOpApplied: la operación se aplicó (o ya estaba aplicada)
+	SyncOpApplied = "applied"
+	// SyncOpRejected: la operación no es válida
+	SyncOpRejected = "rejected"
+	// SyncOpAborted: otra operación del mismo partido fue rechazada y el
+	// partido completo no se aplicó
+	SyncOpAborted = "aborted"
+)
+
+// MaxSyncBatchSize limita la cantidad de operaciones por lote
+const MaxSyncBatchSize = 500
+
+// SyncOperation es una operación cargada por una tablet de planillero.
+// Según Type se usa Event, Score o CheckIn.
+type SyncOperation struct {
+	// OpID lo asigna el cliente para relacionar cada resultado con su operación
+	OpID    string      `json:"op_id"`
+	Type    string      `json:"type"`
+	MatchID uuid.UUID   `json:"match_id"`
+	Event   *MatchEvent `json:"event,omitempty"`
+	Score   *SyncScore  `json:"score,omitempty"`
+	CheckIn *CheckIn    `json:"check_in,omitempty"`
+}
+
+// SyncScore es el marcador informado por el planillero
+type SyncScore struct {
+	GoalScoredTeam1 int `json:"goal_scored_team1"`
+	GoalScoredTeam2 int `json:"goal_scored_team2"`
+}
+
+// CheckIn registra que un jugador se presentó a jugar el partido
+type CheckIn struct {
+	MatchID     uuid.UUID `json:"match_id"`
+	TeamID      uuid.UUID `json:"team_id"`
+	PlayerID    uuid.UUID `json:"player_id"`
+	CheckedInAt time.Time `json:"checked_in_at"`
+}
+
+// SyncOpResult es el resultado de una operación del lote
+type SyncOpResult struct {
+	OpID    string    `json:"op_id"`
+	Type    string    `json:"type"`
+	MatchID uuid.UUID `json:"match_id"`
+	Status  string    `json:"status"`
+	Error   string    `json:"error,omitempty"`
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tipos de conflicto que se detectan al sincronizar datos cargados sin conexión
+const (
+	// ConflictDuplicateMatchNumber: el cliente creó un partido con un número
+	// que ya usa otro partido del mismo torneo
+	ConflictDuplicateMatchNumber = "duplicate_match_number"
+	// ConflictConcurrentEdit: el partido cambió en el servidor después de la
+	// versión sobre la que el cliente hizo su edición
+	ConflictConcurrentEdit = "concurrent_edit"
+)
+
+// Estados de un conflicto
+const (
+	ConflictOpen     = "open"
+	ConflictResolved = "resolved"
+)
+
+// Formas de resolver un conflicto
+const (
+	// ResolutionKeepServer descarta la versión del cliente
+	ResolutionKeepServer = "server"
+	// ResolutionKeepClient sobrescribe el partido del servidor con la del cliente
+	ResolutionKeepClient = "client"
+	// ResolutionRenumber crea el partido del cliente con el siguiente número libre
+	ResolutionRenumber = "renumber"
+)
+
+// SyncConflict registra una operación de un cliente que no se pudo aplicar
+// porque choca con el estado del servidor. Queda abierto hasta que un
+// organizador elige cómo resolverlo.
+type SyncConflict struct {
+	ID   uuid.UUID `json:"id"`
+	Kind string    `json:"kind"`
+	// MatchID es el partido del servidor con el que choca la operación
+	MatchID     uuid.UUID  `json:"match_id"`
+	ClientMatch Match      `json:"client_match"`
+	Status      string     `json:"status"`
+	Resolution  string     `json:"resolution,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ResolvedAt  *time.Time `json:"resolved_at,omitempty"`
+}
+
+// NewSyncConflict crea un conflicto abierto
+func NewSyncConflict(kind string, matchID uuid.UUID, clientMatch Match) *SyncConflict {
+	return &SyncConflict{
+		ID:          uuid.New(),
+		Kind:        kind,
+		MatchID:     matchID,
+		ClientMatch: clientMatch,
+		Status:      ConflictOpen,
+		CreatedAt:   time.Now().UTC(),
+	}
+}
+
+// IsValidResolution indica si la resolución aplica a este tipo de conflicto
+func (c *SyncConflict) IsValidResolution(resolution string) bool {
+	switch c.Kind {
+	case ConflictDuplicateMatchNumber:
+		return resolution == ResolutionKeepServer || resolution == ResolutionRenumber
+	case ConflictConcurrentEdit:
+		return resolution == ResolutionKeepServer || resolution == ResolutionKeepClient
+	}
+	return false
+}
+
+// SyncConflictError se devuelve cuando una operación quedó registrada como
+// conflicto en lugar de aplicarse
+type SyncConflictError struct {
+	Conflict *SyncConflict
+}
+
+func (e *SyncConflictError) Error() string {
+	return fmt.Sprintf("sync conflict (%s) with match %s", e.Conflict.Kind, e.Conflict.MatchID)
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Entidades que se pueden etiquetar
+const (
+	TagEntityTeam   = "team"
+	TagEntityPlayer = "player"
+	TagEntityMatch  = "match"
+)
+
+// Tag es una etiqueta libre de los organizadores (p. ej. "needs-review")
+// para marcar equipos, jugadores y partidos sin agregar campos específicos
+type Tag struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	// Color es un color CSS #rrggbb para mostrar la etiqueta; opcional
+	Color     string    `json:"color,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewTag crea una nueva etiqueta
+func NewTag(name, color string) *Tag {
+	return &Tag{
+		ID:        uuid.New(),
+		Name:      name,
+		Color:     color,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+var tagColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// IsValidTagColor indica si el color está vacío o tiene la forma #rrggbb
+func IsValidTagColor(color string) bool {
+	return color == "" || tagColorPattern.MatchString(color)
+}
+
+// IsValidTagEntity indica si la entidad se puede etiquetar
+func IsValidTagEntity(entity string) bool {
+	switch entity {
+	case TagEntityTeam, TagEntityPlayer, TagEntityMatch:
+		return true
+	}
+	return false
+}
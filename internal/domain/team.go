@@ -11,6 +11,8 @@ type Team struct {
 	ID        uuid.UUID `json:"id"`
 	Name      string    `json:"name"`
 	CreatedAt time.Time `json:"created_at"`
+	// IsTest marca datos de prueba que se pueden purgar
+	IsTest bool `json:"is_test,omitempty"`
 	// Players se carga bajo demanda, no siempre está presente
 	Players []Player `json:"players,omitempty"`
 }
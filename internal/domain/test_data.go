@@ -0,0 +1,12 @@
+package domain
+
+// PurgeReport cuenta lo que borra (o borraría, con DryRun) la purga de los
+// datos de prueba. Matches incluye los partidos de los torneos de prueba y
+// los que juega algún equipo de prueba.
+type PurgeReport struct {
+	DryRun      bool `json:"dry_run"`
+	Tournaments int  `json:"tournaments"`
+	Teams       int  `json:"teams"`
+	Players     int  `json:"players"`
+	Matches     int  `json:"matches"`
+}
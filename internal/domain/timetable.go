@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TeamConstraint limita el horario en el que un equipo puede jugar
+type TeamConstraint struct {
+	TeamID    uuid.UUID  `json:"team_id"`
+	NotBefore *time.Time `json:"not_before,omitempty"`
+	NotAfter  *time.Time `json:"not_after,omitempty"`
+}
+
+// TimetableOptions describe las canchas y horarios disponibles para una jornada.
+// Con VenueID las canchas son las registradas en la sede (todas, o solo las
+// nombradas en Pitches) y se respetan los partidos que ya las ocupan.
+type TimetableOptions struct {
+	VenueID         *uuid.UUID       `json:"venue_id,omitempty"`
+	Pitches         []string         `json:"pitches"`
+	Slots           []time.Time      `json:"slots"`
+	MatchDuration   int              `json:"match_duration_minutes"`
+	MinRest         int              `json:"min_rest_minutes"`
+	TeamConstraints []TeamConstraint `json:"team_constraints"`
+}
+
+// TimetableEntry es un partido asignado a una cancha y un horario concretos
+type TimetableEntry struct {
+	MatchID uuid.UUID `json:"match_id"`
+	Team1ID uuid.UUID `json:"team1_id"`
+	Team2ID uuid.UUID `json:"team2_id"`
+	Pitch   string    `json:"pitch"`
+	// PitchID es la cancha registrada en la sede; solo con VenueID
+	PitchID *uuid.UUID `json:"pitch_id,omitempty"`
+	Kickoff time.Time  `json:"kickoff"`
+}
+
+// UnassignedMatch es un partido para el que no se encontró horario
+type UnassignedMatch struct {
+	MatchID uuid.UUID `json:"match_id"`
+	Reason  string    `json:"reason"`
+}
+
+// Timetable es la propuesta de horarios de una jornada (no se guarda)
+type Timetable struct {
+	TournamentID uuid.UUID         `json:"tournament_id"`
+	Round        int               `json:"round"`
+	Entries      []TimetableEntry  `json:"entries"`
+	Unassigned   []UnassignedMatch `json:"unassigned"`
+	// GapMinutes suma los huecos entre el primer y el último horario usado
+	GapMinutes int `json:"gap_minutes"`
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,9 +9,22 @@ import (
 
 // Tournament representa un torneo de fútbol
 type Tournament struct {
-	ID        uuid.UUID `json:"id"`
-	Name      string    `json:"name"`
-	CreatedAt time.Time `json:"created_at"`
+	ID                 uuid.UUID  `json:"id"`
+	Name               string     `json:"name"`
+	ParentTournamentID *uuid.UUID `json:"parent_tournament_id,omitempty"`
+	SeasonID           *uuid.UUID `json:"season_id,omitempty"`
+	// DivisionID es la categoría que juega el torneo en la pirámide de ligas
+	DivisionID *uuid.UUID `json:"division_id,omitempty"`
+	// Status es la etapa del ciclo de vida; solo cambia con ChangeStatus
+	Status string `json:"status"`
+	// ResultsDelayMinutes embarga los marcadores para el público (0 = sin embargo)
+	ResultsDelayMinutes int `json:"results_delay_minutes"`
+	// ArchivedAt indica que el torneo está en el archivo: no aparece en los
+	// listados salvo con ?archived=true, pero se sigue leyendo por ID
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	// IsTest marca datos de prueba que se pueden purgar
+	IsTest bool `json:"is_test,omitempty"`
 	// Teams se carga bajo demanda
 	Teams []Team `json:"teams,omitempty"`
 }
@@ -20,7 +34,95 @@ func NewTournament(name string) *Tournament {
 	return &Tournament{
 		ID:        uuid.New(),
 		Name:      name,
+		Status:    TournamentDraft,
 		CreatedAt: time.Now().UTC(),
 		Teams:     []Team{},
 	}
 }
+
+// Estados del ciclo de vida de un torneo
+const (
+	TournamentDraft            = "draft"
+	TournamentRegistrationOpen = "registration_open"
+	TournamentInProgress       = "in_progress"
+	TournamentCompleted        = "completed"
+	TournamentCancelled        = "cancelled"
+)
+
+// tournamentTransitions son los cambios de estado permitidos. Completed y
+// cancelled son finales.
+var tournamentTransitions = map[string][]string{
+	TournamentDraft:            {TournamentRegistrationOpen, TournamentInProgress, TournamentCancelled},
+	TournamentRegistrationOpen: {TournamentInProgress, TournamentCancelled},
+	TournamentInProgress:       {TournamentCompleted, TournamentCancelled},
+}
+
+// IsValidTournamentStatus indica si el estado existe
+func IsValidTournamentStatus(status string) bool {
+	switch status {
+	case TournamentDraft, TournamentRegistrationOpen, TournamentInProgress, TournamentCompleted, TournamentCancelled:
+		return true
+	}
+	return false
+}
+
+// ChangeStatus mueve el torneo al estado indicado si la transición es válida
+func (t *Tournament) ChangeStatus(status string) error {
+	if !IsValidTournamentStatus(status) {
+		return fmt.Errorf("invalid tournament status %q", status)
+	}
+	for _, allowed := range tournamentTransitions[t.Status] {
+		if allowed == status {
+			t.Status = status
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot change tournament status from %s to %s", t.Status, status)
+}
+
+// CheckSchedulable indica si se le pueden generar partidos (fixture, sorteos)
+func (t *Tournament) CheckSchedulable() error {
+	if t.Status == TournamentCompleted || t.Status == TournamentCancelled {
+		return fmt.Errorf("tournament is %s: matches cannot be scheduled", t.Status)
+	}
+	return nil
+}
+
+// CheckRegistration indica si se le pueden agregar o quitar equipos
+func (t *Tournament) CheckRegistration() error {
+	if t.Status != TournamentDraft && t.Status != TournamentRegistrationOpen {
+		return fmt.Errorf("tournament is %s: teams can only change while draft or registration_open", t.Status)
+	}
+	return nil
+}
+
+// Archive pasa el torneo al archivo. Un torneo con inscripción abierta o en
+// juego no se puede archivar.
+func (t *Tournament) Archive(at time.Time) error {
+	if t.ArchivedAt != nil {
+		return fmt.Errorf("tournament is already archived")
+	}
+	if t.Status == TournamentRegistrationOpen || t.Status == TournamentInProgress {
+		return fmt.Errorf("tournament is %s: only tournaments that are not being played can be archived", t.Status)
+	}
+	t.ArchivedAt = &at
+	return nil
+}
+
+// Restore devuelve el torneo archivado a las tablas activas
+func (t *Tournament) Restore() error {
+	if t.ArchivedAt == nil {
+		return fmt.Errorf("tournament is not archived")
+	}
+	t.ArchivedAt = nil
+	return nil
+}
+
+// CheckEditable indica si se pueden modificar sus partidos: uno archivado
+// primero se tiene que restaurar
+func (t *Tournament) CheckEditable() error {
+	if t.ArchivedAt != nil {
+		return fmt.Errorf("tournament is archived: restore it before changing its matches")
+	}
+	return nil
+}
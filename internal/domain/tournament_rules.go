@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultMatchDuration es la duración de un partido en minutos cuando el
+// torneo no define otra
+const DefaultMatchDuration = 90
+
+// Desempates de un partido de eliminación empatado en el tiempo reglamentario
+const (
+	// TiebreakExtraTime juega la prórroga completa y, si sigue el empate, penales
+	TiebreakExtraTime = "extra_time"
+	// TiebreakPenalties va directo a los penales, sin prórroga
+	TiebreakPenalties = "penalties"
+	// TiebreakGoldenGoal juega la prórroga hasta el primer gol y, si no hay
+	// goles, penales (habitual en ligas de veteranos)
+	TiebreakGoldenGoal = "golden_goal"
+)
+
+// TournamentRules son las reglas de un torneo. Los valores en cero (o nil)
+// no imponen límites.
+type TournamentRules struct {
+	TournamentID uuid.UUID `json:"tournament_id"`
+	MinSquadSize int       `json:"min_squad_size"`
+	MaxSquadSize int       `json:"max_squad_size"`
+	// MaxForeignPlayers es el cupo de extranjeros por plantel; requiere Country
+	MaxForeignPlayers *int `json:"max_foreign_players,omitempty"`
+	// Country es el país del torneo (ISO 3166-1 alpha-2)
+	Country       string `json:"country,omitempty"`
+	MatchDuration int    `json:"match_duration_minutes"`
+	// KnockoutTiebreak es cómo se desempata un partido de eliminación
+	KnockoutTiebreak string `json:"knockout_tiebreak"`
+	// RegistrationFee es la cuota de inscripción de cada equipo; cero es
+	// inscripción gratuita
+	RegistrationFee Money `json:"registration_fee,omitzero"`
+	// Attendance es la restricción de público de todos los partidos del
+	// torneo; se suma a la de cada sede
+	Attendance AttendanceRestriction `json:"attendance,omitzero"`
+	UpdatedAt  time.Time             `json:"updated_at"`
+}
+
+// NewTournamentRules devuelve las reglas por defecto del torneo
+func NewTournamentRules(tournamentID uuid.UUID) *TournamentRules {
+	return &TournamentRules{
+		TournamentID:     tournamentID,
+		MatchDuration:    DefaultMatchDuration,
+		KnockoutTiebreak: TiebreakExtraTime,
+		UpdatedAt:        time.Now().UTC(),
+	}
+}
+
+// IsValidTiebreak indica si el desempate es conocido
+func IsValidTiebreak(tiebreak string) bool {
+	switch tiebreak {
+	case TiebreakExtraTime, TiebreakPenalties, TiebreakGoldenGoal:
+		return true
+	}
+	return false
+}
+
+// AllowsExtraTime indica si los partidos de eliminación juegan prórroga
+func (r *TournamentRules) AllowsExtraTime() bool {
+	return r.KnockoutTiebreak != TiebreakPenalties
+}
+
+// CheckTiebreak valida la prórroga de un resultado contra el desempate del
+// torneo. Los penales y el empate los valida el resultado en sí.
+func (r *TournamentRules) CheckTiebreak(match *Match) error {
+	if !match.HasExtraTime() {
+		return nil
+	}
+	if !r.AllowsExtraTime() {
+		return fmt.Errorf("extra time is not played in this tournament: ties go straight to penalties")
+	}
+	if r.KnockoutTiebreak == TiebreakGoldenGoal && match.ExtraTimeTeam1 != nil && match.ExtraTimeTeam2 != nil &&
+		*match.ExtraTimeTeam1+*match.ExtraTimeTeam2 > 1 {
+		return fmt.Errorf("extra time ends with the first goal (golden goal)")
+	}
+	return nil
+}
+
+// RosterFullError se devuelve al agregar un jugador a un plantel que ya
+// alcanzó su tamaño máximo. TournamentID es nil si el límite es el global.
+type RosterFullError struct {
+	MaxSquadSize int
+	TournamentID *uuid.UUID
+}
+
+func (e *RosterFullError) Error() string {
+	if e.TournamentID != nil {
+		return fmt.Sprintf("roster is full: tournament %s allows at most %d players", e.TournamentID, e.MaxSquadSize)
+	}
+	return fmt.Sprintf("roster is full: teams allow at most %d players", e.MaxSquadSize)
+}
+
+// IsForeign indica si el jugador cuenta para el cupo de extranjeros. Un
+// jugador sin nacionalidad cargada no cuenta.
+func (r *TournamentRules) IsForeign(player *Player) bool {
+	return r.Country != "" && player.Nationality != "" && player.Nationality != r.Country
+}
+
+// CheckSquadLimits valida el tamaño máximo y el cupo de extranjeros del plantel
+func (r *TournamentRules) CheckSquadLimits(players []Player) error {
+	if r.MaxSquadSize > 0 && len(players) > r.MaxSquadSize {
+		return fmt.Errorf("squad exceeds the maximum of %d players", r.MaxSquadSize)
+	}
+	if r.MaxForeignPlayers != nil {
+		foreign := 0
+		for i := range players {
+			if r.IsForeign(&players[i]) {
+				foreign++
+			}
+		}
+		if foreign > *r.MaxForeignPlayers {
+			return fmt.Errorf("squad exceeds the maximum of %d foreign players", *r.MaxForeignPlayers)
+		}
+	}
+	return nil
+}
+
+// CheckSquad valida el plantel completo, incluido el mínimo de jugadores
+func (r *TournamentRules) CheckSquad(players []Player) error {
+	if len(players) < r.MinSquadSize {
+		return fmt.Errorf("squad has %d players (minimum %d)", len(players), r.MinSquadSize)
+	}
+	return r.CheckSquadLimits(players)
+}
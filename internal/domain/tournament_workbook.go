@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// TournamentWorkbook reúne lo que lleva la planilla de un torneo para
+// imprimir o compartir: la tabla, el fixture y los goleadores
+type TournamentWorkbook struct {
+	Tournament  Tournament
+	Standings   []Standing
+	Fixtures    []WorkbookFixture
+	TopScorers  []TopScorer
+	GeneratedAt time.Time
+}
+
+// WorkbookFixture es un partido del fixture con los nombres ya resueltos.
+// Score queda vacío si el partido no empezó o su resultado está embargado.
+type WorkbookFixture struct {
+	Match  Match
+	Home   string
+	Away   string
+	Venue  string
+	Status string
+	Score  string
+}
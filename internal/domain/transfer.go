@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Transfer es el pase de un jugador a un equipo. FromTeamID es el último
+// equipo al que se incorporó antes; nil si es su primer equipo.
+type Transfer struct {
+	ID            uuid.UUID  `json:"id"`
+	PlayerID      uuid.UUID  `json:"player_id"`
+	FromTeamID    *uuid.UUID `json:"from_team_id,omitempty"`
+	FromTeamName  string     `json:"from_team_name,omitempty"`
+	ToTeamID      uuid.UUID  `json:"to_team_id"`
+	ToTeamName    string     `json:"to_team_name,omitempty"`
+	TransferredAt time.Time  `json:"transferred_at"`
+}
+
+// NewTransfer crea el pase al equipo toTeamID con la fecha actual
+func NewTransfer(playerID uuid.UUID, fromTeamID *uuid.UUID, toTeamID uuid.UUID) *Transfer {
+	return &Transfer{
+		ID:            uuid.New(),
+		PlayerID:      playerID,
+		FromTeamID:    fromTeamID,
+		ToTeamID:      toTeamID,
+		TransferredAt: time.Now().UTC(),
+	}
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Venue es una sede (estadio o cancha) donde se juegan partidos
+type Venue struct {
+	ID      uuid.UUID `json:"id"`
+	Name    string    `json:"name"`
+	Address string    `json:"address"`
+	// Capacity es la cantidad de espectadores; 0 si no se conoce
+	Capacity int `json:"capacity"`
+	// Attendance es la restricción de público de la sede
+	Attendance AttendanceRestriction `json:"attendance,omitzero"`
+	CreatedAt  time.Time             `json:"created_at"`
+}
+
+// NewVenue crea una nueva sede
+func NewVenue(name, address string, capacity int) *Venue {
+	return &Venue{
+		ID:        uuid.New(),
+		Name:      name,
+		Address:   address,
+		Capacity:  capacity,
+		CreatedAt: time.Now().UTC(),
+	}
+}
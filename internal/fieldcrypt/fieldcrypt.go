@@ -0,0 +1,121 @@
+// Package fieldcrypt cifra campos sensibles antes de guardarlos, con
+// AES-256-GCM. Cada valor cifrado lleva el ID de la clave que lo cifró, así
+// se pueden rotar las claves: la vigente cifra y las anteriores solo
+// descifran hasta que se vuelve a cifrar todo (ver `api rotate-pii`).
+package fieldcrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// prefix marca los valores cifrados y la versión del formato
+// "enc:v1:<key-id>:<base64(nonce + ciphertext)>"
+const prefix = "enc:v1:"
+
+// Keyring son las claves configuradas; la primera es la vigente
+type Keyring struct {
+	current string
+	keys    map[string]cipher.AEAD
+}
+
+// ParseKeyring lee una lista "id:clave,id:clave" con claves de 32 bytes en
+// base64. La primera cifra los valores nuevos; las demás quedan para
+// descifrar los cifrados antes de rotar.
+func ParseKeyring(spec string) (*Keyring, error) {
+	keyring := &Keyring{keys: make(map[string]cipher.AEAD)}
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, encoded, ok := strings.Cut(entry, ":")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("invalid key entry %q: expected id:base64-key", entry)
+		}
+		if _, dup := keyring.keys[id]; dup {
+			return nil, fmt.Errorf("duplicate key id %q", id)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key %q is not valid base64: %w", id, err)
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("key %q must be 32 bytes, got %d", id, len(key))
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+
+		keyring.keys[id] = aead
+		if keyring.current == "" {
+			keyring.current = id
+		}
+	}
+
+	if keyring.current == "" {
+		return nil, fmt.Errorf("no encryption keys configured")
+	}
+	return keyring, nil
+}
+
+// CurrentKeyID es el ID de la clave que cifra los valores nuevos
+func (k *Keyring) CurrentKeyID() string {
+	return k.current
+}
+
+// Encrypt cifra el valor con la clave vigente
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	aead := k.keys[k.current]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return prefix + k.current + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt descifra un valor cifrado con cualquiera de las claves del llavero
+func (k *Keyring) Decrypt(value string) (string, error) {
+	id, ok := KeyID(value)
+	if !ok {
+		return "", fmt.Errorf("value is not encrypted")
+	}
+	aead, ok := k.keys[id]
+	if !ok {
+		return "", fmt.Errorf("encryption key %q is not configured", id)
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, prefix+id+":"))
+	if err != nil {
+		return "", fmt.Errorf("corrupt encrypted value: %w", err)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("corrupt encrypted value")
+	}
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("cannot decrypt value with key %q: %w", id, err)
+	}
+	return string(plaintext), nil
+}
+
+// KeyID devuelve el ID de la clave con que se cifró el valor; ok es false
+// si el valor no tiene el formato cifrado
+func KeyID(value string) (string, bool) {
+	if !strings.HasPrefix(value, prefix) {
+		return "", false
+	}
+	id, _, ok := strings.Cut(strings.TrimPrefix(value, prefix), ":")
+	return id, ok && id != ""
+}
@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+)
+
+// AdminHandler atiende /api/admin, las alertas, los incidentes de la página
+// de estado, los clientes de la API pública y las operaciones de
+// mantenimiento de los organizadores
+type AdminHandler struct {
+	testData      usecase.TestDataCommands
+	alerts        usecase.AlertCommands
+	alertQueries  usecase.AlertQueries
+	incidents     usecase.IncidentCommands
+	clients       usecase.APIClientCommands
+	clientQueries usecase.APIClientQueries
+}
+
+func NewAdminHandler(testData usecase.TestDataCommands, alerts usecase.AlertCommands, alertQueries usecase.AlertQueries, incidents usecase.IncidentCommands, clients usecase.APIClientCommands, clientQueries usecase.APIClientQueries) *AdminHandler {
+	return &AdminHandler{testData: testData, alerts: alerts, alertQueries: alertQueries, incidents: incidents, clients: clients, clientQueries: clientQueries}
+}
+
+// incidentInput es el DTO para abrir un incidente
+type incidentInput struct {
+	Title    string `json:"title"`
+	Message  string `json:"message"`
+	Severity string `json:"severity"`
+}
+
+// apiClientInput es el DTO para registrar una aplicación de terceros
+type apiClientInput struct {
+	Name               string   `json:"name"`
+	Scopes             []string `json:"scopes"`
+	RateLimitPerMinute int      `json:"rate_limit_per_minute"`
+}
+
+// Routes son las rutas de /api/admin; todas exigen el token de organizador
+func (h *AdminHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/admin/alerts", Handler: h.GetAlerts, Role: RoleOrganizer, Summary: "Lista las alertas para organizadores", Response: []domain.Alert{}},
+		{Method: http.MethodPost, Pattern: "/api/admin/alerts/{id}/dismiss", Handler: pathParam("id", h.DismissAlert), Role: RoleOrganizer, Summary: "Descarta una alerta", Response: map[string]string{}},
+		{Method: http.MethodPost, Pattern: "/api/admin/incidents", Handler: h.CreateIncident, Role: RoleOrganizer, Summary: "Abre un incidente en la página de estado", Idempotent: true, Request: incidentInput{}, Response: domain.Incident{}},
+		{Method: http.MethodPost, Pattern: "/api/admin/incidents/{id}/resolve", Handler: pathParam("id", h.ResolveIncident), Role: RoleOrganizer, Summary: "Resuelve un incidente", Response: domain.Incident{}},
+		{Method: http.MethodGet, Pattern: "/api/admin/clients", Handler: h.GetAPIClients, Role: RoleOrganizer, Summary: "Lista las aplicaciones de la API pública", Response: []domain.APIClient{}},
+		{Method: http.MethodPost, Pattern: "/api/admin/clients", Handler: h.RegisterAPIClient, Role: RoleOrganizer, Summary: "Registra una aplicación de la API pública", Request: apiClientInput{}, Response: domain.APIClientCredentials{}},
+		{Method: http.MethodPost, Pattern: "/api/admin/clients/{id}/revoke", Handler: pathParam("id", h.RevokeAPIClient), Role: RoleOrganizer, Summary: "Revoca una aplicación y sus tokens", Response: map[string]string{}},
+		{Method: http.MethodPost, Pattern: "/api/admin/test-data/purge", Handler: h.PurgeTestData, Role: RoleOrganizer, Summary: "Borra los datos de prueba (?dry_run=true)", Response: domain.PurgeReport{}},
+	}
+}
+
+// GetAlerts lista las tareas pendientes calculadas por el último chequeo;
+// ?include_dismissed=true incluye las descartadas
+func (h *AdminHandler) GetAlerts(w http.ResponseWriter, r *http.Request) {
+	includeDismissed := r.URL.Query().Get("include_dismissed") == "true"
+
+	alerts, err := h.alertQueries.GetAlerts(includeDismissed)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, alerts)
+}
+
+// DismissAlert oculta una alerta mientras la situación siga vigente
+func (h *AdminHandler) DismissAlert(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid alert UUID")
+		return
+	}
+
+	if err := h.alerts.DismissAlert(id); err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Alert dismissed"})
+}
+
+// CreateIncident abre un incidente que se muestra en /api/status hasta que
+// se resuelve
+func (h *AdminHandler) CreateIncident(w http.ResponseWriter, r *http.Request) {
+	var input incidentInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := sanitizeFields(
+		textField{"title", &input.Title, maxNameLength},
+		textField{"message", &input.Message, maxMessageLength},
+	); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	incident := domain.NewIncident(input.Title, input.Message, input.Severity)
+	if err := h.incidents.CreateIncident(incident); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, incident)
+}
+
+// ResolveIncident cierra un incidente; deja de figurar en /api/status
+func (h *AdminHandler) ResolveIncident(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid incident UUID")
+		return
+	}
+
+	incident, err := h.incidents.ResolveIncident(id)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, incident)
+}
+
+func (h *AdminHandler) GetAPIClients(w http.ResponseWriter, r *http.Request) {
+	clients, err := h.clientQueries.GetAllAPIClients()
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, clients)
+}
+
+// RegisterAPIClient da de alta una aplicación de terceros. La respuesta trae
+// el client_secret, que no se vuelve a mostrar.
+func (h *AdminHandler) RegisterAPIClient(w http.ResponseWriter, r *http.Request) {
+	var input apiClientInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := sanitizeFields(
+		textField{"name", &input.Name, maxNameLength},
+	); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	credentials, err := h.clients.RegisterAPIClient(input.Name, input.Scopes, input.RateLimitPerMinute)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, credentials)
+}
+
+// RevokeAPIClient da de baja un cliente y sus tokens
+func (h *AdminHandler) RevokeAPIClient(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid client UUID")
+		return
+	}
+
+	if err := h.clients.RevokeAPIClient(id); err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "API client revoked"})
+}
+
+// PurgeTestData borra los datos marcados como prueba; ?dry_run=true solo
+// devuelve lo que se borraría
+func (h *AdminHandler) PurgeTestData(w http.ResponseWriter, r *http.Request) {
+	dryRun := r.URL.Query().Get("dry_run") == "true"
+
+	report, err := h.testData.PurgeTestData(dryRun)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, report)
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// IPAllowlist restringe las rutas de administración y las operaciones
+// destructivas a un conjunto de redes. Se compara la IP de la conexión
+// (RemoteAddr), no X-Forwarded-For: está pensada para despliegues expuestos
+// directamente a internet, donde esa cabecera la controla el cliente.
+type IPAllowlist struct {
+	networks []*net.IPNet
+}
+
+// ParseIPAllowlist lee una lista separada por comas de redes CIDR
+// ("203.0.113.0/24") o IPs sueltas ("198.51.100.7")
+func ParseIPAllowlist(spec string) (*IPAllowlist, error) {
+	allowlist := &IPAllowlist{}
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP address %q", entry)
+			}
+			bits := 8 * net.IPv4len
+			if ip.To4() == nil {
+				bits = 8 * net.IPv6len
+			}
+			entry = fmt.Sprintf("%s/%d", entry, bits)
+		}
+
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q", entry)
+		}
+		allowlist.networks = append(allowlist.networks, network)
+	}
+
+	if len(allowlist.networks) == 0 {
+		return nil, fmt.Errorf("allowlist has no networks")
+	}
+	return allowlist, nil
+}
+
+// Allows indica si la IP de la conexión está dentro de alguna red
+func (a *IPAllowlist) Allows(r *http.Request) bool {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+
+	for _, network := range a.networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Protect aplica la lista a /api/admin/* y a todas las peticiones DELETE;
+// el resto pasa sin comprobar. Sin lista (nil) no restringe nada.
+func (a *IPAllowlist) Protect(next http.Handler) http.Handler {
+	if a == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isProtectedRoute(r) && !a.Allows(r) {
+			respondWithError(w, http.StatusForbidden, "Forbidden")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isProtectedRoute indica si la petición es de administración o destructiva
+func isProtectedRoute(r *http.Request) bool {
+	return strings.HasPrefix(r.URL.Path, "/api/admin/") || r.Method == http.MethodDelete
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// AnalyticsHandler atiende /api/tournaments/{id}/analytics
+type AnalyticsHandler struct {
+	commands usecase.AnalyticsCommands
+	queries  usecase.AnalyticsQueries
+}
+
+func NewAnalyticsHandler(commands usecase.AnalyticsCommands, queries usecase.AnalyticsQueries) *AnalyticsHandler {
+	return &AnalyticsHandler{commands: commands, queries: queries}
+}
+
+// Routes son las rutas de /api/tournaments/{id}/analytics
+func (h *AnalyticsHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/tournaments/{id}/analytics", Handler: uuidParam("id", "tournament", h.Get), Summary: "Analíticas del torneo", Response: domain.TournamentAnalytics{}},
+		{Method: http.MethodPost, Pattern: "/api/tournaments/{id}/analytics/refresh", Handler: uuidParam("id", "tournament", h.Refresh), Summary: "Recalcula las analíticas", Response: domain.TournamentAnalytics{}},
+	}
+}
+
+// Get devuelve las estadísticas calculadas por el último paso del job
+func (h *AnalyticsHandler) Get(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	analytics, err := h.queries.GetAnalytics(tournamentID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, analytics)
+}
+
+// Refresh recalcula las estadísticas sin esperar al job
+func (h *AnalyticsHandler) Refresh(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	analytics, err := h.commands.RefreshAnalytics(tournamentID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, analytics)
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+)
+
+// decodeAttendance lee el cuerpo de PUT .../attendance; responde 400 y
+// devuelve false si no es válido
+func decodeAttendance(w http.ResponseWriter, r *http.Request) (domain.AttendanceRestriction, bool) {
+	var restriction domain.AttendanceRestriction
+	if err := json.NewDecoder(r.Body).Decode(&restriction); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return restriction, false
+	}
+	if err := sanitizeFields(textField{"reason", &restriction.Reason, maxCaptionLength}); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return restriction, false
+	}
+	return restriction, true
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+// OrganizerAuth identifica a los organizadores mediante un token compartido
+// enviado como "Authorization: Bearer <token>". Sin token configurado nadie
+// se considera organizador.
+type OrganizerAuth struct {
+	token string
+}
+
+func NewOrganizerAuth(token string) *OrganizerAuth {
+	return &OrganizerAuth{token: token}
+}
+
+// IsOrganizer indica si la petición viene autenticada como organizador
+func (a *OrganizerAuth) IsOrganizer(r *http.Request) bool {
+	if a == nil || a.token == "" {
+		return false
+	}
+
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return false
+	}
+	provided := strings.TrimPrefix(header, "Bearer ")
+
+	// Comparación en tiempo constante para no filtrar el token por timing
+	return subtle.ConstantTimeCompare([]byte(provided), []byte(a.token)) == 1
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// CompetitionHandler atiende /api/tournaments/{id}/divisions cuando el torneo
+// es una competición con varias categorías
+type CompetitionHandler struct {
+	queries usecase.CompetitionQueries
+	auth    *OrganizerAuth
+}
+
+func NewCompetitionHandler(queries usecase.CompetitionQueries, auth *OrganizerAuth) *CompetitionHandler {
+	return &CompetitionHandler{queries: queries, auth: auth}
+}
+
+// Routes son las rutas de /api/tournaments/{id}/divisions
+func (h *CompetitionHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/tournaments/{id}/divisions", Handler: uuidParam("id", "tournament", h.GetDivisions), Summary: "Categorías de la competición", Response: []domain.CompetitionDivision{}},
+		{Method: http.MethodGet, Pattern: "/api/tournaments/{id}/divisions/stats", Handler: uuidParam("id", "tournament", h.GetStats), Summary: "Estadísticas por categoría", Response: domain.CompetitionStats{}},
+	}
+}
+
+// GetDivisions lista las categorías de la competición con su líder
+func (h *CompetitionHandler) GetDivisions(w http.ResponseWriter, r *http.Request, competitionID uuid.UUID) {
+	divisions, err := h.queries.GetCompetitionDivisions(competitionID, h.auth.IsOrganizer(r))
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, divisions)
+}
+
+// GetStats devuelve las estadísticas sumadas de todas las categorías
+func (h *CompetitionHandler) GetStats(w http.ResponseWriter, r *http.Request, competitionID uuid.UUID) {
+	stats, err := h.queries.GetCompetitionStats(competitionID, h.auth.IsOrganizer(r))
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, stats)
+}
@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+)
+
+// DivisionHandler atiende /api/divisions y el cierre de temporada en
+// /api/divisions/promotions
+type DivisionHandler struct {
+	commands usecase.DivisionCommands
+	queries  usecase.DivisionQueries
+}
+
+func NewDivisionHandler(commands usecase.DivisionCommands, queries usecase.DivisionQueries) *DivisionHandler {
+	return &DivisionHandler{commands: commands, queries: queries}
+}
+
+type divisionInput struct {
+	Name      string `json:"name"`
+	Level     int    `json:"level"`
+	Promoted  int    `json:"promoted"`
+	Relegated int    `json:"relegated"`
+}
+
+// Routes son las rutas de /api/divisions
+func (h *DivisionHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/divisions", Handler: h.GetAll, Summary: "Lista las divisiones de la más alta a la más baja", Response: []domain.Division{}},
+		{Method: http.MethodPost, Pattern: "/api/divisions", Handler: h.Create, Summary: "Crea una división", Idempotent: true, Request: divisionInput{}, Response: domain.Division{}},
+		{Method: http.MethodPost, Pattern: "/api/divisions/promotions", Handler: h.ApplyPromotions, Summary: "Aplica los ascensos y descensos del cierre de temporada", Request: promotionsInput{}, Response: domain.PromotionReport{}},
+		{Method: http.MethodGet, Pattern: "/api/divisions/{id}", Handler: pathParam("id", h.GetByID), Summary: "Obtiene una división", Response: domain.Division{}},
+		{Method: http.MethodPut, Pattern: "/api/divisions/{id}", Handler: pathParam("id", h.Update), Summary: "Modifica una división", Request: divisionInput{}, Response: domain.Division{}},
+		{Method: http.MethodDelete, Pattern: "/api/divisions/{id}", Handler: pathParam("id", h.Delete), Summary: "Borra una división", Response: map[string]string{}},
+	}
+}
+
+func (h *DivisionHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var input divisionInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := sanitizeFields(
+		textField{"name", &input.Name, maxShortNameLength},
+	); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	division := domain.NewDivision(input.Name, input.Level, input.Promoted, input.Relegated)
+	if err := h.commands.CreateDivision(division); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, division)
+}
+
+func (h *DivisionHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	divisions, err := h.queries.GetAllDivisions()
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, divisions)
+}
+
+func (h *DivisionHandler) GetByID(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
+		return
+	}
+
+	division, err := h.queries.GetDivisionByID(id)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, division)
+}
+
+func (h *DivisionHandler) Update(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
+		return
+	}
+
+	var input divisionInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := sanitizeFields(
+		textField{"name", &input.Name, maxShortNameLength},
+	); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	division := domain.NewDivision(input.Name, input.Level, input.Promoted, input.Relegated)
+	division.ID = id
+	if err := h.commands.UpdateDivision(division); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, division)
+}
+
+func (h *DivisionHandler) Delete(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
+		return
+	}
+
+	if err := h.commands.DeleteDivision(id); err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Division deleted"})
+}
+
+// promotionsInput es el cuerpo de POST /api/divisions/promotions
+type promotionsInput struct {
+	SeasonID     string `json:"season_id"`
+	NextSeasonID string `json:"next_season_id"`
+}
+
+// ApplyPromotions cierra una temporada: {"season_id": ..., "next_season_id": ...}
+func (h *DivisionHandler) ApplyPromotions(w http.ResponseWriter, r *http.Request) {
+	var input promotionsInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	seasonID, err := parseUUID(input.SeasonID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid season_id UUID")
+		return
+	}
+	nextSeasonID, err := parseUUID(input.NextSeasonID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid next_season_id UUID")
+		return
+	}
+
+	report, err := h.commands.ApplyPromotions(seasonID, nextSeasonID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, report)
+}
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// DrawHandler atiende /api/tournaments/{id}/draws y /api/tournaments/{id}/seeding
+type DrawHandler struct {
+	commands usecase.DrawCommands
+	queries  usecase.DrawQueries
+}
+
+func NewDrawHandler(commands usecase.DrawCommands, queries usecase.DrawQueries) *DrawHandler {
+	return &DrawHandler{commands: commands, queries: queries}
+}
+
+// Routes son las rutas de /api/tournaments/{id}/draws y la sugerencia de bombos
+func (h *DrawHandler) Routes() []Route {
+	const base = "/api/tournaments/{id}/draws"
+	return []Route{
+		{Method: http.MethodGet, Pattern: base, Handler: uuidParam("id", "tournament", h.GetAll), Summary: "Sorteos del torneo", Response: []domain.Draw{}},
+		{Method: http.MethodPost, Pattern: base, Handler: uuidParam("id", "tournament", h.Create), Summary: "Realiza un sorteo", Idempotent: true, Request: domain.DrawOptions{}, Response: domain.Draw{}},
+		{Method: http.MethodGet, Pattern: base + "/{drawId}", Handler: uuidParams("id", "tournament", "drawId", "draw", h.GetByID), Summary: "Obtiene un sorteo", Response: domain.Draw{}},
+		{Method: http.MethodGet, Pattern: base + "/{drawId}/stream", Handler: uuidParams("id", "tournament", "drawId", "draw", h.Stream), Summary: "Reproduce el sorteo bola a bola (SSE)"},
+		{Method: http.MethodGet, Pattern: "/api/tournaments/{id}/seeding", Handler: uuidParam("id", "tournament", h.SuggestSeeding), Summary: "Sugiere bombos según torneos anteriores", Response: domain.SeedingSuggestion{}},
+	}
+}
+
+func (h *DrawHandler) Create(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	var options domain.DrawOptions
+	if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	draw, err := h.commands.RunDraw(tournamentID, options)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, draw)
+}
+
+func (h *DrawHandler) GetAll(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	draws, err := h.queries.GetTournamentDraws(tournamentID)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, draws)
+}
+
+func (h *DrawHandler) GetByID(w http.ResponseWriter, r *http.Request, tournamentID, drawID uuid.UUID) {
+	draw, err := h.queries.GetDraw(tournamentID, drawID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, draw)
+}
+
+// Stream reproduce el sorteo extracción por extracción mediante Server-Sent
+// Events, para mostrar la ceremonia en vivo. ?interval_ms= controla la pausa.
+func (h *DrawHandler) Stream(w http.ResponseWriter, r *http.Request, tournamentID, drawID uuid.UUID) {
+	draw, err := h.queries.GetDraw(tournamentID, drawID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		respondWithError(w, http.StatusInternalServerError, "Streaming not supported")
+		return
+	}
+
+	interval := 2 * time.Second
+	if ms, err := strconv.Atoi(r.URL.Query().Get("interval_ms")); err == nil && ms >= 0 {
+		interval = time.Duration(ms) * time.Millisecond
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	for _, pick := range draw.Picks {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(interval):
+		}
+
+		data, _ := json.Marshal(pick)
+		fmt.Fprintf(w, "event: pick\ndata: %s\n\n", data)
+		flusher.Flush()
+	}
+
+	data, _ := json.Marshal(draw)
+	fmt.Fprintf(w, "event: done\ndata: %s\n\n", data)
+	flusher.Flush()
+}
+
+// SuggestSeeding propone bombos a partir de torneos anteriores (?from= repetible)
+func (h *DrawHandler) SuggestSeeding(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	var sources []uuid.UUID
+	for _, raw := range r.URL.Query()["from"] {
+		id, err := parseUUID(raw)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid from UUID")
+			return
+		}
+		sources = append(sources, id)
+	}
+
+	pots := 4
+	if raw := r.URL.Query().Get("pots"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid pots number")
+			return
+		}
+		pots = n
+	}
+
+	suggestion, err := h.queries.SuggestSeeding(tournamentID, sources, pots)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, suggestion)
+}
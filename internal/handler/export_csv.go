@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// Exportación a planillas: los listados de jugadores, equipos y partidos y
+// la tabla de posiciones responden CSV con ?format=csv. El CSV trae el
+// listado completo (con los filtros y el orden pedidos, sin paginar), que es
+// lo que se quiere al llevarlo a una planilla.
+
+// wantsCSV indica si la petición pide la respuesta en CSV
+func wantsCSV(r *http.Request) bool {
+	return r.URL.Query().Get("format") == "csv"
+}
+
+// csvColumn es una columna de una exportación: su encabezado y cómo se
+// obtiene el valor de cada elemento
+type csvColumn[T any] struct {
+	Header string
+	Value  func(item *T) string
+}
+
+// respondWithCSV escribe los elementos como CSV con encabezado. Cada fila
+// se arma y se escribe en la respuesta a medida que se recorre el listado
+// (encoding/csv la envía cada 4 KB), sin armar el archivo entero en memoria.
+func respondWithCSV[T any](w http.ResponseWriter, filename string, columns []csvColumn[T], items []T) {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", "attachment; filename="+filename)
+	w.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(w)
+	record := make([]string, len(columns))
+	for i, column := range columns {
+		record[i] = column.Header
+	}
+	if err := writer.Write(record); err != nil {
+		return
+	}
+	for i := range items {
+		for j, column := range columns {
+			record[j] = column.Value(&items[i])
+		}
+		// Un error solo puede venir de la conexión: el cliente se fue
+		if err := writer.Write(record); err != nil {
+			return
+		}
+	}
+	writer.Flush()
+}
+
+// csvText prepara un texto libre para una planilla: si empieza con un
+// carácter que Excel toma como fórmula (=, +, -, @) se le antepone un
+// apóstrofo, para que un nombre no se ejecute al abrir el archivo
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func csvInt(n int) string {
+	return strconv.Itoa(n)
+}
+
+// csvOptionalInt deja la celda vacía si no hay valor
+func csvOptionalInt(n *int) string {
+	if n == nil {
+		return ""
+	}
+	return strconv.Itoa(*n)
+}
+
+func csvOptionalUUID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func csvTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// csvDate es la fecha sola, como la importa /api/import/players
+func csvDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
+
+// Las columnas de jugadores coinciden con las de la importación, así una
+// exportación se puede volver a importar
+var playerCSVColumns = []csvColumn[domain.Player]{
+	{"id", func(p *domain.Player) string { return p.ID.String() }},
+	{"name", func(p *domain.Player) string { return csvText(p.Name) }},
+	{"date_birth", func(p *domain.Player) string { return csvDate(p.DateBirth) }},
+	{"position", func(p *domain.Player) string { return p.Position }},
+	{"preferred_foot", func(p *domain.Player) string { return p.PreferredFoot }},
+	{"nationality", func(p *domain.Player) string { return p.Nationality }},
+	{"created_at", func(p *domain.Player) string { return csvTime(p.CreatedAt) }},
+}
+
+var teamCSVColumns = []csvColumn[domain.Team]{
+	{"id", func(t *domain.Team) string { return t.ID.String() }},
+	{"name", func(t *domain.Team) string { return csvText(t.Name) }},
+	{"created_at", func(t *domain.Team) string { return csvTime(t.CreatedAt) }},
+}
+
+// matchTeamName es el nombre del equipo si se cargó (ver
+// MatchQueries.AttachTeams) o su ID
+func matchTeamName(team *domain.Team, id uuid.UUID) string {
+	if team == nil {
+		return id.String()
+	}
+	return csvText(team.Name)
+}
+
+// matchGoals deja vacío el marcador embargado, como el null del JSON
+func matchGoals(m *domain.Match, goals int) string {
+	if m.ResultEmbargoedUntil != nil {
+		return ""
+	}
+	return csvInt(goals)
+}
+
+var matchCSVColumns = []csvColumn[domain.Match]{
+	{"id", func(m *domain.Match) string { return m.ID.String() }},
+	{"date", func(m *domain.Match) string { return csvTime(m.Date) }},
+	{"tournament_id", func(m *domain.Match) string { return csvOptionalUUID(m.TournamentID) }},
+	{"round", func(m *domain.Match) string { return csvInt(m.Round) }},
+	{"match_number", func(m *domain.Match) string { return csvInt(m.MatchNumber) }},
+	{"home", func(m *domain.Match) string { return matchTeamName(m.Team1, m.Team1ID) }},
+	{"away", func(m *domain.Match) string { return matchTeamName(m.Team2, m.Team2ID) }},
+	{"home_goals", func(m *domain.Match) string { return matchGoals(m, m.GoalScoredTeam1) }},
+	{"away_goals", func(m *domain.Match) string { return matchGoals(m, m.GoalScoredTeam2) }},
+	{"extra_time_home", func(m *domain.Match) string { return csvOptionalInt(m.ExtraTimeTeam1) }},
+	{"extra_time_away", func(m *domain.Match) string { return csvOptionalInt(m.ExtraTimeTeam2) }},
+	{"penalties_home", func(m *domain.Match) string { return csvOptionalInt(m.PenaltiesTeam1) }},
+	{"penalties_away", func(m *domain.Match) string { return csvOptionalInt(m.PenaltiesTeam2) }},
+	{"result_type", func(m *domain.Match) string { return m.ResultType }},
+}
+
+var standingCSVColumns = []csvColumn[domain.Standing]{
+	{"position", func(s *domain.Standing) string { return csvInt(s.Position) }},
+	{"team_id", func(s *domain.Standing) string { return s.TeamID.String() }},
+	{"team_name", func(s *domain.Standing) string { return csvText(s.TeamName) }},
+	{"played", func(s *domain.Standing) string { return csvInt(s.Played) }},
+	{"won", func(s *domain.Standing) string { return csvInt(s.Won) }},
+	{"drawn", func(s *domain.Standing) string { return csvInt(s.Drawn) }},
+	{"lost", func(s *domain.Standing) string { return csvInt(s.Lost) }},
+	{"goals_for", func(s *domain.Standing) string { return csvInt(s.GoalsFor) }},
+	{"goals_against", func(s *domain.Standing) string { return csvInt(s.GoalsAgainst) }},
+	{"goal_difference", func(s *domain.Standing) string { return csvInt(s.GoalDifference) }},
+	{"carried_points", func(s *domain.Standing) string { return csvInt(s.CarriedPoints) }},
+	{"deducted_points", func(s *domain.Standing) string { return csvInt(s.DeductedPoints) }},
+	{"points", func(s *domain.Standing) string { return csvInt(s.Points) }},
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// Selección de campos estilo GraphQL sobre REST: ?fields=id,name
+// Permite que pantallas como el marcador pidan solo lo que muestran.
+// En C# sería parecido a usar un $select de OData.
+
+// parseFields lee el parámetro ?fields= y devuelve el conjunto de campos pedidos
+func parseFields(r *http.Request) map[string]bool {
+	raw := r.URL.Query().Get("fields")
+	if raw == "" {
+		return nil
+	}
+
+	fields := make(map[string]bool)
+	for _, field := range strings.Split(raw, ",") {
+		field = strings.TrimSpace(field)
+		if field != "" {
+			fields[field] = true
+		}
+	}
+	return fields
+}
+
+// respondWithFields serializa el payload igual que respondWithJSON, pero si la
+// petición incluye ?fields= recorta cada objeto a los campos solicitados
+func respondWithFields(w http.ResponseWriter, r *http.Request, code int, payload interface{}) {
+	fields := parseFields(r)
+	if len(fields) == 0 {
+		respondWithJSON(w, code, payload)
+		return
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, "Internal Server Error")
+		return
+	}
+
+	selected, err := selectFields(data, fields)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, "Internal Server Error")
+		return
+	}
+
+	respondWithJSON(w, code, selected)
+}
+
+// selectFields aplica la selección a un objeto JSON o a un array de objetos
+func selectFields(data []byte, fields map[string]bool) (interface{}, error) {
+	trimmed := strings.TrimSpace(string(data))
+
+	// Listados: array de objetos
+	if strings.HasPrefix(trimmed, "[") {
+		var items []map[string]json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, err
+		}
+		result := make([]map[string]json.RawMessage, 0, len(items))
+		for _, item := range items {
+			result = append(result, filterObject(item, fields))
+		}
+		return result, nil
+	}
+
+	// Objeto individual
+	if strings.HasPrefix(trimmed, "{") {
+		var item map[string]json.RawMessage
+		if err := json.Unmarshal(data, &item); err != nil {
+			return nil, err
+		}
+		return filterObject(item, fields), nil
+	}
+
+	// null u otros valores se devuelven tal cual
+	return json.RawMessage(data), nil
+}
+
+func filterObject(item map[string]json.RawMessage, fields map[string]bool) map[string]json.RawMessage {
+	filtered := make(map[string]json.RawMessage, len(fields))
+	for key, value := range item {
+		if fields[key] {
+			filtered[key] = value
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+)
+
+// Formato CSV de intercambio de fixtures: round,date,home,away,venue,pitch.
+// Las columnas venue y pitch son opcionales al importar.
+var fixtureCSVHeader = []string{"round", "date", "home", "away", "venue", "pitch"}
+
+func writeFixturesCSV(w io.Writer, fixtures []domain.Fixture) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(fixtureCSVHeader); err != nil {
+		return err
+	}
+
+	for _, fixture := range fixtures {
+		record := []string{
+			strconv.Itoa(fixture.Round),
+			fixture.Date.UTC().Format(time.RFC3339),
+			fixture.Home,
+			fixture.Away,
+			fixture.Venue,
+			fixture.Pitch,
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func readFixturesCSV(r io.Reader) ([]domain.Fixture, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("missing CSV header")
+	}
+
+	// Localizar columnas por nombre para tolerar distinto orden
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"round", "date", "home", "away"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("missing CSV column %q", required)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var fixtures []domain.Fixture
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		round, err := strconv.Atoi(field(record, "round"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid round", line)
+		}
+
+		date, err := parseDateTime(field(record, "date"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid date format", line)
+		}
+
+		fixtures = append(fixtures, domain.Fixture{
+			Round: round,
+			Date:  date,
+			Home:  field(record, "home"),
+			Away:  field(record, "away"),
+			Venue: field(record, "venue"),
+			Pitch: field(record, "pitch"),
+		})
+	}
+
+	return fixtures, nil
+}
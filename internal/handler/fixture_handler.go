@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// FixtureHandler agrupa las rutas de calendario de un torneo: intercambio de
+// fixtures, jornadas, horarios de jornada y split. odds y attendance agregan
+// las probabilidades y las restricciones de público a los partidos de una
+// jornada.
+type FixtureHandler struct {
+	commands   usecase.FixtureCommands
+	queries    usecase.FixtureQueries
+	odds       usecase.PredictionQueries
+	attendance usecase.AttendanceQueries
+}
+
+func NewFixtureHandler(commands usecase.FixtureCommands, queries usecase.FixtureQueries, odds usecase.PredictionQueries, attendance usecase.AttendanceQueries) *FixtureHandler {
+	return &FixtureHandler{commands: commands, queries: queries, odds: odds, attendance: attendance}
+}
+
+// Routes son las rutas de calendario de /api/tournaments/{id}
+func (h *FixtureHandler) Routes() []Route {
+	const base = "/api/tournaments/{id}"
+	return []Route{
+		{Method: http.MethodGet, Pattern: base + "/fixtures/export", Handler: uuidParam("id", "tournament", h.ExportFixtures), Summary: "Exporta el fixture (?format=csv|json)", Response: []domain.Fixture{}},
+		{Method: http.MethodPost, Pattern: base + "/fixtures/import", Handler: uuidParam("id", "tournament", h.ImportFixtures), Summary: "Importa un fixture", Request: []domain.Fixture{}, Response: domain.FixtureImportReport{}},
+		{Method: http.MethodGet, Pattern: base + "/rounds", Handler: uuidParam("id", "tournament", h.GetRounds), Summary: "Jornadas del torneo", Response: []domain.RoundSummary{}},
+		{Method: http.MethodGet, Pattern: base + "/rounds/{round}/matches", Handler: uuidParam("id", "tournament", roundParam(true, h.GetRoundMatches)), Summary: "Partidos de una jornada", Response: []domain.Match{}},
+		{Method: http.MethodPost, Pattern: base + "/rounds/{round}/timetable", Handler: uuidParam("id", "tournament", roundParam(false, h.PlanTimetable)), Summary: "Asigna horarios y canchas a la jornada", Request: domain.TimetableOptions{}, Response: domain.Timetable{}},
+		{Method: http.MethodPost, Pattern: base + "/split", Handler: uuidParam("id", "tournament", h.SplitLeague), Summary: "Divide la liga en grupos de campeonato y descenso", Request: domain.SplitOptions{}, Response: domain.SplitResult{}},
+	}
+}
+
+// roundParam lee el comodín {round}; con positive exige que sea al menos 1
+func roundParam(positive bool, next func(http.ResponseWriter, *http.Request, uuid.UUID, int)) func(http.ResponseWriter, *http.Request, uuid.UUID) {
+	return func(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+		round, err := strconv.Atoi(r.PathValue("round"))
+		if err != nil || (positive && round < 1) {
+			respondWithError(w, http.StatusBadRequest, "Invalid round number")
+			return
+		}
+		next(w, r, tournamentID, round)
+	}
+}
+
+// ExportFixtures devuelve el fixture en formato de intercambio (?format=csv|json)
+func (h *FixtureHandler) ExportFixtures(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	fixtures, err := h.queries.ExportFixtures(tournamentID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	if r.URL.Query().Get("format") == "csv" {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", "attachment; filename=fixtures.csv")
+		w.WriteHeader(http.StatusOK)
+		writeFixturesCSV(w, fixtures)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, fixtures)
+}
+
+// GetRounds lista las jornadas del torneo con su cantidad de partidos y fechas
+func (h *FixtureHandler) GetRounds(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	rounds, err := h.queries.GetRounds(tournamentID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, rounds)
+}
+
+// GetRoundMatches lista los partidos de una jornada
+func (h *FixtureHandler) GetRoundMatches(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID, round int) {
+	matches, err := h.queries.GetRoundMatches(tournamentID, round)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if err := h.odds.AttachOdds(matches); err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := h.attendance.AttachAttendance(matches); err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, matches)
+}
+
+// ImportFixtures crea partidos a partir de un fixture en CSV o JSON.
+// Con ?dry_run=true solo devuelve el reporte de conflictos.
+func (h *FixtureHandler) ImportFixtures(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	var fixtures []domain.Fixture
+
+	if r.URL.Query().Get("format") == "csv" || strings.HasPrefix(r.Header.Get("Content-Type"), "text/csv") {
+		parsed, err := readFixturesCSV(r.Body)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		fixtures = parsed
+	} else if err := json.NewDecoder(r.Body).Decode(&fixtures); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	dryRun := r.URL.Query().Get("dry_run") == "true"
+	report, err := h.commands.ImportFixtures(tournamentID, fixtures, dryRun)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, report)
+}
+
+// PlanTimetable propone horarios para los partidos de una jornada sin guardarlos
+func (h *FixtureHandler) PlanTimetable(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID, round int) {
+	var options domain.TimetableOptions
+	if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	timetable, err := h.queries.PlanTimetable(tournamentID, round, options)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, timetable)
+}
+
+// SplitLeague divide la liga en grupos (split) tras la fase regular
+func (h *FixtureHandler) SplitLeague(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	var options domain.SplitOptions
+	if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	result, err := h.commands.SplitLeague(tournamentID, options)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, result)
+}
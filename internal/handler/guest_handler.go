@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// GuestHandler atiende /api/teams/{id}/guests
+type GuestHandler struct {
+	commands usecase.GuestCommands
+	queries  usecase.GuestQueries
+}
+
+func NewGuestHandler(commands usecase.GuestCommands, queries usecase.GuestQueries) *GuestHandler {
+	return &GuestHandler{commands: commands, queries: queries}
+}
+
+// Routes son las rutas de /api/teams/{id}/guests
+func (h *GuestHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/teams/{id}/guests", Handler: uuidParam("id", "team", h.GetAll), Summary: "Jugadores invitados del equipo", Response: []domain.Guest{}},
+		{Method: http.MethodPost, Pattern: "/api/teams/{id}/guests/promote", Handler: uuidParam("id", "team", h.Promote), Summary: "Pasa un invitado a la plantilla", Request: guestPromotionInput{}, Response: domain.GuestPromotion{}},
+	}
+}
+
+func (h *GuestHandler) GetAll(w http.ResponseWriter, r *http.Request, teamID uuid.UUID) {
+	guests, err := h.queries.GetTeamGuests(teamID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, guests)
+}
+
+// guestPromotionInput es el DTO para pasar un invitado a la plantilla
+type guestPromotionInput struct {
+	Name     string `json:"name"`
+	PlayerID string `json:"player_id"`
+}
+
+// Promote asocia los eventos y alineaciones de un invitado a un jugador inscripto
+func (h *GuestHandler) Promote(w http.ResponseWriter, r *http.Request, teamID uuid.UUID) {
+	var input guestPromotionInput
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := sanitizeFields(textField{"name", &input.Name, maxNameLength}); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	playerID, err := parseUUID(input.PlayerID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid player_id")
+		return
+	}
+
+	promotion, err := h.commands.PromoteGuest(teamID, input.Name, playerID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, promotion)
+}
@@ -2,8 +2,14 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
 )
 
 // Funciones helper para respuestas HTTP (equivalente a ActionResult en C#)
@@ -30,3 +36,86 @@ func parseDateTime(dateStr string) (time.Time, error) {
 	// Ejemplo: "2023-06-24T00:00:00Z"
 	return time.Parse(time.RFC3339, dateStr)
 }
+
+// parseOptionalDateTime parsea una fecha opcional: una cadena vacía devuelve nil
+func parseOptionalDateTime(dateStr string) (*time.Time, error) {
+	if strings.TrimSpace(dateStr) == "" {
+		return nil, nil
+	}
+	parsed, err := parseDateTime(dateStr)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+// parseUUID parsea un UUID recibido en la ruta, la query o el cuerpo. Acepta
+// mayúsculas o minúsculas, con o sin guiones, entre llaves ({...}) o con el
+// prefijo urn:uuid:. Las respuestas siempre lo emiten en forma canónica en
+// minúsculas (uuid.UUID.String), así que los clientes pueden comparar IDs como
+// texto.
+func parseUUID(idStr string) (uuid.UUID, error) {
+	return uuid.Parse(strings.TrimSpace(idStr))
+}
+
+// parseOptionalUUID parsea un UUID opcional: una cadena vacía devuelve nil
+func parseOptionalUUID(idStr string) (*uuid.UUID, error) {
+	if strings.TrimSpace(idStr) == "" {
+		return nil, nil
+	}
+	id, err := parseUUID(idStr)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// applyClientIdentity usa el ID y la fecha de creación generados por un
+// cliente que trabajó sin conexión. Ambos son opcionales.
+func applyClientIdentity(idStr, createdAtStr string, id *uuid.UUID, createdAt *time.Time) error {
+	if idStr != "" {
+		parsed, err := parseUUID(idStr)
+		if err != nil {
+			return fmt.Errorf("Invalid id UUID")
+		}
+		*id = parsed
+	}
+	if createdAtStr != "" {
+		parsed, err := parseDateTime(createdAtStr)
+		if err != nil {
+			return fmt.Errorf("Invalid created_at format")
+		}
+		*createdAt = parsed.UTC()
+	}
+	return nil
+}
+
+// respondWithSyncError responde 409 con el conflicto cuando la operación
+// quedó registrada como conflicto de sincronización y 400 en otro caso
+func respondWithSyncError(w http.ResponseWriter, err error) {
+	var conflictErr *domain.SyncConflictError
+	if errors.As(err, &conflictErr) {
+		respondWithJSON(w, http.StatusConflict, map[string]interface{}{
+			"error":    err.Error(),
+			"conflict": conflictErr.Conflict,
+		})
+		return
+	}
+	respondWithError(w, http.StatusBadRequest, err.Error())
+}
+
+// nameToCheck lee ?name= normalizado igual que en la creación, para que la
+// verificación de disponibilidad coincida con lo que se guardaría
+func nameToCheck(r *http.Request) (string, error) {
+	name := r.URL.Query().Get("name")
+	if err := sanitizeFields(textField{"name", &name, maxNameLength}); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// includeArchived indica si el listado pidió también los datos archivados
+// (?archived=true)
+func includeArchived(r *http.Request) bool {
+	return r.URL.Query().Get("archived") == "true"
+}
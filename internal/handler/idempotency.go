@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+)
+
+// Cabeceras de las rutas con Route.Idempotent
+const (
+	idempotencyKeyHeader = "Idempotency-Key"
+	// idempotentReplayedHeader marca una respuesta repetida de la original
+	idempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// idempotent atiende la cabecera Idempotency-Key: la primera petición con
+// una clave se ejecuta y su respuesta 2xx se guarda; un reintento con la
+// misma clave y la misma petición recibe esa respuesta sin volver a crear
+// nada. Las respuestas de error no se guardan, así el reintento se ejecuta
+// de nuevo. Las claves son de cada cliente (ver idempotencyCaller). Sin la
+// cabecera la ruta funciona igual que siempre.
+func (rt *Router) idempotent(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(idempotencyKeyHeader)
+		if key == "" {
+			next(w, r)
+			return
+		}
+		if err := domain.ValidateIdempotencyKey(key); err != nil {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		caller := idempotencyCaller(r)
+		record, err := rt.idempotency.BeginIdempotent(caller, key, requestFingerprint(r, body))
+		switch {
+		case errors.Is(err, domain.ErrIdempotencyKeyInUse):
+			w.Header().Set("Retry-After", "1")
+			respondWithError(w, http.StatusConflict, err.Error())
+			return
+		case errors.Is(err, domain.ErrIdempotencyKeyReused):
+			respondWithError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		case err != nil:
+			respondWithError(w, http.StatusInternalServerError, err.Error())
+			return
+		case record != nil:
+			w.Header().Set("Content-Type", record.ContentType)
+			w.Header().Set(idempotentReplayedHeader, "true")
+			w.WriteHeader(record.StatusCode)
+			w.Write(record.Body)
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: w}
+		completed := false
+		defer func() {
+			// Si el handler entra en pánico o falla, la clave se libera
+			// para que el reintento se ejecute
+			if completed {
+				return
+			}
+			if err := rt.idempotency.AbortIdempotent(caller, key); err != nil {
+				log.Printf("⚠️  Could not release Idempotency-Key %q: %v", key, err)
+			}
+		}()
+		next(recorder, r)
+
+		if status := recorder.statusCode(); status >= 200 && status < 300 {
+			err := rt.idempotency.FinishIdempotent(caller, key, status, recorder.Header().Get("Content-Type"), recorder.body.Bytes())
+			if err != nil {
+				// La respuesta ya salió: el reintento volverá a ejecutarse
+				log.Printf("⚠️  Could not store response for Idempotency-Key %q: %v", key, err)
+				return
+			}
+			completed = true
+		}
+	}
+}
+
+// idempotencyCaller es el espacio de claves de quien envía la petición: el
+// cliente de la API en las rutas RoleAPIClient (su token cambia al renovarse)
+// o la cabecera Authorization en las demás. Se guarda el SHA-256, nunca el
+// token.
+func idempotencyCaller(r *http.Request) string {
+	identity := "authorization:" + r.Header.Get("Authorization")
+	if token := apiToken(r); token != nil {
+		identity = "client:" + token.ClientID.String()
+	}
+	sum := sha256.Sum256([]byte(identity))
+	return hex.EncodeToString(sum[:])
+}
+
+// requestFingerprint resume lo que tiene que coincidir en un reintento:
+// método, ruta con su query, credenciales y cuerpo
+func requestFingerprint(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	for _, part := range []string{r.Method, r.URL.RequestURI(), r.Header.Get("Authorization")} {
+		io.WriteString(hash, strconv.Itoa(len(part)))
+		io.WriteString(hash, ":")
+		io.WriteString(hash, part)
+	}
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// responseRecorder escribe la respuesta al cliente y se queda con una copia
+// para guardarla
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (rec *responseRecorder) WriteHeader(status int) {
+	if rec.status == 0 {
+		rec.status = status
+	}
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *responseRecorder) Write(data []byte) (int, error) {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+	rec.body.Write(data)
+	return rec.ResponseWriter.Write(data)
+}
+
+// statusCode es el código enviado; 200 si el handler no escribió nada
+func (rec *responseRecorder) statusCode() int {
+	if rec.status == 0 {
+		return http.StatusOK
+	}
+	return rec.status
+}
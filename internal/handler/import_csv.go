@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+)
+
+// importSheet son las columnas que se leen de una planilla; Required son
+// las que tienen que estar en el encabezado
+type importSheet struct {
+	Columns  []string
+	Required []string
+}
+
+var (
+	teamImportSheet = importSheet{
+		Columns:  []string{"name"},
+		Required: []string{"name"},
+	}
+	playerImportSheet = importSheet{
+		Columns:  []string{"name", "date_birth", "position", "preferred_foot", "nationality", "team"},
+		Required: []string{"name", "date_birth"},
+	}
+)
+
+// importColumnLimits son los largos máximos de las columnas de texto libre
+var importColumnLimits = map[string]int{
+	"name": maxNameLength,
+	"team": maxNameLength,
+}
+
+// readImportCSV lee una planilla con encabezado. Las columnas se buscan por
+// nombre (en cualquier orden) y las desconocidas se ignoran. Los valores se limpian como
+// los de la API (ver cleanText) y un valor demasiado largo marca la fila
+// como inválida en lugar de cortar la lectura.
+func readImportCSV(r io.Reader, sheet importSheet) ([]domain.ImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	// Las filas pueden traer menos columnas que el encabezado
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("missing CSV header")
+	}
+
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		// Excel agrega una marca BOM al principio de los CSV en UTF-8
+		name = strings.TrimPrefix(name, "\uFEFF")
+		positions[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, column := range sheet.Required {
+		if _, ok := positions[column]; !ok {
+			return nil, fmt.Errorf("missing CSV column %q", column)
+		}
+	}
+
+	var rows []domain.ImportRow
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if len(rows) == domain.MaxImportRows {
+			return nil, fmt.Errorf("CSV file cannot have more than %d rows", domain.MaxImportRows)
+		}
+
+		row := domain.ImportRow{Line: line, Fields: make(map[string]string, len(sheet.Columns))}
+		for _, column := range sheet.Columns {
+			i, ok := positions[column]
+			if !ok || i >= len(record) {
+				continue
+			}
+			value := cleanText(record[i])
+			if limit, ok := importColumnLimits[column]; ok && utf8.RuneCountInString(value) > limit && row.Error == "" {
+				row.Error = fmt.Sprintf("%s must be at most %d characters", column, limit)
+			}
+			row.Fields[column] = value
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
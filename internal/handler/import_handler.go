@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+)
+
+// maxImportSize limita el tamaño de una planilla importada
+const maxImportSize = 5 << 20
+
+// ImportHandler atiende /api/import: la carga de equipos y jugadores desde
+// las planillas CSV de los clubes
+type ImportHandler struct {
+	commands usecase.ImportCommands
+}
+
+func NewImportHandler(commands usecase.ImportCommands) *ImportHandler {
+	return &ImportHandler{commands: commands}
+}
+
+// Routes son las rutas de /api/import
+func (h *ImportHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPost, Pattern: "/api/import/teams", Handler: h.ImportTeams, Summary: "Importa equipos desde un CSV (?dry_run=true)", Response: domain.ImportReport{}},
+		{Method: http.MethodPost, Pattern: "/api/import/players", Handler: h.ImportPlayers, Summary: "Importa jugadores desde un CSV (?dry_run=true)", Response: domain.ImportReport{}},
+	}
+}
+
+// ImportTeams crea los equipos de la planilla (columna name)
+func (h *ImportHandler) ImportTeams(w http.ResponseWriter, r *http.Request) {
+	h.importCSV(w, r, teamImportSheet, h.commands.ImportTeams)
+}
+
+// ImportPlayers crea los jugadores de la planilla; la columna team los suma
+// a un equipo existente
+func (h *ImportHandler) ImportPlayers(w http.ResponseWriter, r *http.Request) {
+	h.importCSV(w, r, playerImportSheet, h.commands.ImportPlayers)
+}
+
+// importCSV lee la planilla del cuerpo (text/csv) o del campo file de un
+// formulario multipart y responde el reporte de filas importadas y
+// rechazadas
+func (h *ImportHandler) importCSV(w http.ResponseWriter, r *http.Request, sheet importSheet, run func([]domain.ImportRow, bool) (*domain.ImportReport, error)) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportSize)
+
+	var body io.Reader = r.Body
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "Missing CSV file in form field \"file\"")
+			return
+		}
+		defer file.Close()
+		body = file
+	}
+
+	rows, err := readImportCSV(body, sheet)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	report, err := run(rows, r.URL.Query().Get("dry_run") == "true")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, report)
+}
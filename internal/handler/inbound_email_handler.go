@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+)
+
+// maxInboundEmailSize limita el tamaño de un email recibido por webhook
+const maxInboundEmailSize = 10 << 20
+
+// inboundSignatureMaxAge descarta webhooks de Mailgun viejos (reenvíos capturados)
+const inboundSignatureMaxAge = 15 * time.Minute
+
+// InboundEmailHandler atiende POST /api/inbound/email: el webhook del
+// proveedor de correo con los emails de resultados de los árbitros.
+//
+// Acepta dos formatos autenticados con la misma clave:
+//   - el formulario de rutas de Mailgun, firmado con la clave como signing key
+//   - JSON {"sender": "...", "body": "..."} con "Authorization: Bearer <clave>",
+//     para un relay propio (p. ej. una Lambda que recibe de SES)
+type InboundEmailHandler struct {
+	commands usecase.ProvisionalResultCommands
+	key      string
+}
+
+func NewInboundEmailHandler(commands usecase.ProvisionalResultCommands, key string) *InboundEmailHandler {
+	return &InboundEmailHandler{commands: commands, key: key}
+}
+
+// Routes es la ruta del webhook de correo
+func (h *InboundEmailHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPost, Pattern: "/api/inbound/email", Handler: h.Receive, Summary: "Webhook de correos de resultados", Request: inboundEmailInput{}, Response: domain.ResultEmailReport{}},
+	}
+}
+
+// inboundEmailInput es el cuerpo JSON del webhook; Mailgun manda los mismos
+// datos como formulario
+type inboundEmailInput struct {
+	Sender string `json:"sender"`
+	Body   string `json:"body"`
+}
+
+func (h *InboundEmailHandler) Receive(w http.ResponseWriter, r *http.Request) {
+	if h.key == "" {
+		respondWithError(w, http.StatusServiceUnavailable, "Inbound email is not configured")
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxInboundEmailSize)
+
+	var sender, body string
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		if !h.validBearer(r) {
+			respondWithError(w, http.StatusUnauthorized, "Invalid inbound email token")
+			return
+		}
+		var input inboundEmailInput
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+			return
+		}
+		sender, body = input.Sender, input.Body
+	} else {
+		if err := r.ParseMultipartForm(maxInboundEmailSize); err != nil && err != http.ErrNotMultipart {
+			respondWithError(w, http.StatusBadRequest, "Invalid form payload")
+			return
+		}
+		if !h.validMailgunSignature(r.FormValue("timestamp"), r.FormValue("token"), r.FormValue("signature")) {
+			respondWithError(w, http.StatusUnauthorized, "Invalid webhook signature")
+			return
+		}
+		// stripped-text es el cuerpo sin la firma ni el texto citado
+		sender, body = r.FormValue("sender"), r.FormValue("stripped-text")
+		if body == "" {
+			body = r.FormValue("body-plain")
+		}
+	}
+
+	report, err := h.commands.IngestResultEmail(sender, body)
+	if err != nil {
+		// 406 indica a Mailgun que no reintente: el email no es procesable
+		respondWithError(w, http.StatusNotAcceptable, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, report)
+}
+
+func (h *InboundEmailHandler) validBearer(r *http.Request) bool {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return false
+	}
+	provided := strings.TrimPrefix(header, "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(provided), []byte(h.key)) == 1
+}
+
+// validMailgunSignature verifica HMAC-SHA256(timestamp + token) con la clave
+func (h *InboundEmailHandler) validMailgunSignature(timestamp, token, signature string) bool {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || time.Since(time.Unix(seconds, 0)).Abs() > inboundSignatureMaxAge {
+		return false
+	}
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(h.key))
+	mac.Write([]byte(timestamp + token))
+	return hmac.Equal(mac.Sum(nil), expected)
+}
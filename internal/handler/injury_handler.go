@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// InjuryHandler atiende /api/players/{id}/injuries
+type InjuryHandler struct {
+	commands usecase.InjuryCommands
+	queries  usecase.InjuryQueries
+}
+
+func NewInjuryHandler(commands usecase.InjuryCommands, queries usecase.InjuryQueries) *InjuryHandler {
+	return &InjuryHandler{commands: commands, queries: queries}
+}
+
+type injuryInput struct {
+	Type           string `json:"type"`
+	StartDate      string `json:"start_date"`
+	ExpectedReturn string `json:"expected_return"`
+}
+
+// Routes son las rutas de /api/players/{id}/injuries
+func (h *InjuryHandler) Routes() []Route {
+	const base = "/api/players/{id}/injuries"
+	return []Route{
+		{Method: http.MethodGet, Pattern: base, Handler: uuidParam("id", "player", h.GetAll), Summary: "Lesiones del jugador", Response: []domain.Injury{}},
+		{Method: http.MethodPost, Pattern: base, Handler: uuidParam("id", "player", h.Create), Summary: "Registra una lesión", Idempotent: true, Request: injuryInput{}, Response: domain.Injury{}},
+		{Method: http.MethodGet, Pattern: base + "/{injuryId}", Handler: uuidParams("id", "player", "injuryId", "injury", h.GetByID), Summary: "Obtiene una lesión", Response: domain.Injury{}},
+		{Method: http.MethodPut, Pattern: base + "/{injuryId}", Handler: uuidParams("id", "player", "injuryId", "injury", h.Update), Summary: "Modifica una lesión", Request: injuryInput{}, Response: domain.Injury{}},
+		{Method: http.MethodDelete, Pattern: base + "/{injuryId}", Handler: uuidParams("id", "player", "injuryId", "injury", h.Delete), Summary: "Borra una lesión", Response: map[string]string{}},
+	}
+}
+
+func (h *InjuryHandler) decode(w http.ResponseWriter, r *http.Request, playerID uuid.UUID) (*domain.Injury, bool) {
+	var input injuryInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return nil, false
+	}
+
+	startDate, err := parseDateTime(input.StartDate)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid start_date format")
+		return nil, false
+	}
+
+	var expectedReturn *time.Time
+	if input.ExpectedReturn != "" {
+		parsed, err := parseDateTime(input.ExpectedReturn)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid expected_return format")
+			return nil, false
+		}
+		expectedReturn = &parsed
+	}
+
+	return domain.NewInjury(playerID, input.Type, startDate, expectedReturn), true
+}
+
+func (h *InjuryHandler) Create(w http.ResponseWriter, r *http.Request, playerID uuid.UUID) {
+	injury, ok := h.decode(w, r, playerID)
+	if !ok {
+		return
+	}
+
+	if err := h.commands.CreateInjury(injury); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, injury)
+}
+
+func (h *InjuryHandler) GetAll(w http.ResponseWriter, r *http.Request, playerID uuid.UUID) {
+	injuries, err := h.queries.GetPlayerInjuries(playerID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, injuries)
+}
+
+func (h *InjuryHandler) GetByID(w http.ResponseWriter, r *http.Request, playerID, injuryID uuid.UUID) {
+	injury, err := h.queries.GetInjury(playerID, injuryID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, injury)
+}
+
+func (h *InjuryHandler) Update(w http.ResponseWriter, r *http.Request, playerID, injuryID uuid.UUID) {
+	injury, ok := h.decode(w, r, playerID)
+	if !ok {
+		return
+	}
+	injury.ID = injuryID
+
+	if err := h.commands.UpdateInjury(injury); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, injury)
+}
+
+func (h *InjuryHandler) Delete(w http.ResponseWriter, r *http.Request, playerID, injuryID uuid.UUID) {
+	if err := h.commands.DeleteInjury(playerID, injuryID); err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Injury deleted"})
+}
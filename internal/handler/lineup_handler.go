@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// LineupHandler atiende /api/matches/{id}/lineups
+type LineupHandler struct {
+	commands usecase.LineupCommands
+	queries  usecase.LineupQueries
+}
+
+func NewLineupHandler(commands usecase.LineupCommands, queries usecase.LineupQueries) *LineupHandler {
+	return &LineupHandler{commands: commands, queries: queries}
+}
+
+// Routes son las rutas de /api/matches/{id}/lineups
+func (h *LineupHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/matches/{id}/lineups", Handler: uuidParam("id", "match", h.GetAll), Summary: "Alineaciones del partido", Response: []domain.Lineup{}},
+		{Method: http.MethodPost, Pattern: "/api/matches/{id}/lineups", Handler: uuidParam("id", "match", h.Save), Summary: "Guarda la alineación de un equipo", Request: lineupInput{}, Response: domain.Lineup{}},
+	}
+}
+
+// lineupInput es el cuerpo de POST /api/matches/{id}/lineups
+type lineupInput struct {
+	TeamID    uuid.UUID   `json:"team_id"`
+	Formation string      `json:"formation"`
+	Starting  []uuid.UUID `json:"starting"`
+	Bench     []uuid.UUID `json:"bench"`
+	Guests    []string    `json:"guests"`
+}
+
+// Save registra la alineación de un equipo; si ya tenía una se reemplaza
+func (h *LineupHandler) Save(w http.ResponseWriter, r *http.Request, matchID uuid.UUID) {
+	var input lineupInput
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	guests := make([]textField, len(input.Guests))
+	for i := range input.Guests {
+		guests[i] = textField{"guests", &input.Guests[i], maxNameLength}
+	}
+	if err := sanitizeFields(guests...); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	lineup := domain.NewLineup(matchID, input.TeamID, input.Formation, input.Starting, input.Bench)
+	if input.Guests != nil {
+		lineup.Guests = input.Guests
+	}
+	if err := h.commands.SaveLineup(lineup); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, lineup)
+}
+
+func (h *LineupHandler) GetAll(w http.ResponseWriter, r *http.Request, matchID uuid.UUID) {
+	lineups, err := h.queries.GetMatchLineups(matchID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, lineups)
+}
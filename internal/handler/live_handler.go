@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/realtime"
+)
+
+// livePingInterval mantiene viva la conexión a través de proxies y detecta
+// clientes caídos
+const livePingInterval = 30 * time.Second
+
+// LiveHandler atiende /ws: empuja por WebSocket las actualizaciones de
+// marcadores y eventos a medida que ocurren. Con ?match_id= se recibe solo
+// ese partido.
+type LiveHandler struct {
+	hub *realtime.Hub
+}
+
+func NewLiveHandler(hub *realtime.Hub) *LiveHandler {
+	return &LiveHandler{hub: hub}
+}
+
+// Routes es la ruta de /ws
+func (h *LiveHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/ws", Handler: h.Connect, Summary: "Actualizaciones en vivo por WebSocket (?match_id=)"},
+	}
+}
+
+func (h *LiveHandler) Connect(w http.ResponseWriter, r *http.Request) {
+	matchID, err := parseOptionalUUID(r.URL.Query().Get("match_id"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid match_id UUID")
+		return
+	}
+
+	conn, err := realtime.Upgrade(w, r)
+	if errors.Is(err, realtime.ErrNotWebSocket) {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	sub := h.hub.Subscribe(matchID)
+	defer sub.Close()
+
+	// El cliente no envía datos; la lectura solo detecta el cierre
+	closed := make(chan struct{})
+	go func() {
+		conn.ReadLoop()
+		close(closed)
+	}()
+
+	ticker := time.NewTicker(livePingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case update, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			if err := conn.WriteJSON(update); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := conn.Ping(); err != nil {
+				return
+			}
+		case <-closed:
+			return
+		}
+	}
+}
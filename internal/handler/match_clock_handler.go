@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// MatchClockHandler atiende /api/matches/{id}/clock
+type MatchClockHandler struct {
+	commands usecase.MatchClockCommands
+	queries  usecase.MatchClockQueries
+}
+
+func NewMatchClockHandler(commands usecase.MatchClockCommands, queries usecase.MatchClockQueries) *MatchClockHandler {
+	return &MatchClockHandler{commands: commands, queries: queries}
+}
+
+// Routes son las rutas de /api/matches/{id}/clock, con una ruta por acción
+// para que aparezcan en OpenAPI
+func (h *MatchClockHandler) Routes() []Route {
+	routes := []Route{
+		{Method: http.MethodGet, Pattern: "/api/matches/{id}/clock", Handler: uuidParam("id", "match", h.Get), Summary: "Reloj del partido", Response: domain.MatchClock{}},
+	}
+	for _, action := range []string{domain.ClockActionStart, domain.ClockActionPause, domain.ClockActionResume, domain.ClockActionStoppage, domain.ClockActionEndPeriod, domain.ClockActionFinish} {
+		route := Route{
+			Method:  http.MethodPost,
+			Pattern: "/api/matches/{id}/clock/" + action,
+			Handler: uuidParam("id", "match", func(w http.ResponseWriter, r *http.Request, matchID uuid.UUID) {
+				h.Apply(w, r, matchID, action)
+			}),
+			Summary:  "Acción " + action + " del reloj",
+			Response: domain.MatchClock{},
+		}
+		// solo el descuento lleva cuerpo
+		if action == domain.ClockActionStoppage {
+			route.Request = clockInput{}
+		}
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+func (h *MatchClockHandler) Get(w http.ResponseWriter, r *http.Request, matchID uuid.UUID) {
+	clock, err := h.queries.GetMatchClock(matchID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, clock)
+}
+
+// clockInput es el cuerpo de las acciones del reloj; solo stoppage lo usa
+type clockInput struct {
+	Minutes int `json:"minutes"`
+}
+
+// Apply ejecuta la acción sobre el reloj; el descuento se envía como
+// {"minutes": 3} en la acción stoppage
+func (h *MatchClockHandler) Apply(w http.ResponseWriter, r *http.Request, matchID uuid.UUID, action string) {
+	var input clockInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil && err != io.EOF {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	clock, err := h.commands.ApplyClockAction(matchID, action, input.Minutes)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, clock)
+}
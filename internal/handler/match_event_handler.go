@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// MatchEventHandler atiende /api/matches/{id}/events
+type MatchEventHandler struct {
+	commands usecase.MatchEventCommands
+	queries  usecase.MatchEventQueries
+	auth     *OrganizerAuth
+}
+
+func NewMatchEventHandler(commands usecase.MatchEventCommands, queries usecase.MatchEventQueries, auth *OrganizerAuth) *MatchEventHandler {
+	return &MatchEventHandler{commands: commands, queries: queries, auth: auth}
+}
+
+// Routes son las rutas de /api/matches/{id}/events
+func (h *MatchEventHandler) Routes() []Route {
+	const base = "/api/matches/{id}/events"
+	return []Route{
+		{Method: http.MethodGet, Pattern: base, Handler: uuidParam("id", "match", h.GetAll), Summary: "Eventos del partido", Response: []domain.MatchEvent{}},
+		{Method: http.MethodPost, Pattern: base, Handler: uuidParam("id", "match", h.Create), Summary: "Registra un gol, tarjeta u otro evento", Idempotent: true, Request: matchEventInput{}, Response: domain.MatchEvent{}},
+		{Method: http.MethodGet, Pattern: base + "/{eventId}", Handler: uuidParams("id", "match", "eventId", "event", h.GetByID), Summary: "Obtiene un evento", Response: domain.MatchEvent{}},
+		{Method: http.MethodDelete, Pattern: base + "/{eventId}", Handler: uuidParams("id", "match", "eventId", "event", h.Delete), Summary: "Anula un evento", Response: map[string]string{}},
+	}
+}
+
+// matchEventInput es el cuerpo de POST /api/matches/{id}/events
+type matchEventInput struct {
+	ID             string `json:"id"`
+	CreatedAt      string `json:"created_at"`
+	Type           string `json:"type"`
+	Minute         int    `json:"minute"`
+	TeamID         string `json:"team_id"`
+	PlayerID       string `json:"player_id"`
+	GuestName      string `json:"guest_name"`
+	AssistPlayerID string `json:"assist_player_id"`
+}
+
+func (h *MatchEventHandler) Create(w http.ResponseWriter, r *http.Request, matchID uuid.UUID) {
+	var input matchEventInput
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := sanitizeFields(textField{"guest_name", &input.GuestName, maxNameLength}); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	teamID, err := parseUUID(input.TeamID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid team_id")
+		return
+	}
+
+	playerID, err := parseOptionalUUID(input.PlayerID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid player_id")
+		return
+	}
+
+	assistPlayerID, err := parseOptionalUUID(input.AssistPlayerID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid assist_player_id")
+		return
+	}
+
+	event := domain.NewMatchEvent(matchID, input.Type, input.Minute, teamID, playerID)
+	event.GuestName = input.GuestName
+	event.AssistPlayerID = assistPlayerID
+	if err := applyClientIdentity(input.ID, input.CreatedAt, &event.ID, &event.CreatedAt); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := h.commands.CreateMatchEvent(event); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, event)
+}
+
+func (h *MatchEventHandler) GetAll(w http.ResponseWriter, r *http.Request, matchID uuid.UUID) {
+	events, err := h.queries.GetMatchEvents(matchID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	if !h.auth.IsOrganizer(r) {
+		events, err = h.queries.HideEmbargoedEvents(matchID, events)
+		if err != nil {
+			respondWithError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+
+	respondWithFields(w, r, http.StatusOK, events)
+}
+
+func (h *MatchEventHandler) GetByID(w http.ResponseWriter, r *http.Request, matchID, eventID uuid.UUID) {
+	event, err := h.queries.GetMatchEvent(matchID, eventID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	if !h.auth.IsOrganizer(r) {
+		visible, err := h.queries.HideEmbargoedEvents(matchID, []domain.MatchEvent{*event})
+		if err != nil {
+			respondWithError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if len(visible) == 0 {
+			respondWithError(w, http.StatusNotFound, "match event not found")
+			return
+		}
+	}
+
+	respondWithJSON(w, http.StatusOK, event)
+}
+
+func (h *MatchEventHandler) Delete(w http.ResponseWriter, r *http.Request, matchID, eventID uuid.UUID) {
+	if err := h.commands.DeleteMatchEvent(matchID, eventID); err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Match event deleted"})
+}
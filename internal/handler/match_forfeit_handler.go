@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// MatchForfeitHandler atiende /api/matches/{id}/forfeit
+type MatchForfeitHandler struct {
+	commands usecase.MatchForfeitCommands
+	queries  usecase.MatchForfeitQueries
+}
+
+func NewMatchForfeitHandler(commands usecase.MatchForfeitCommands, queries usecase.MatchForfeitQueries) *MatchForfeitHandler {
+	return &MatchForfeitHandler{commands: commands, queries: queries}
+}
+
+// Routes son las rutas de /api/matches/{id}/forfeit
+func (h *MatchForfeitHandler) Routes() []Route {
+	const base = "/api/matches/{id}/forfeit"
+	return []Route{
+		{Method: http.MethodGet, Pattern: base, Handler: uuidParam("id", "match", h.GetAll), Summary: "Walkover del partido", Response: []domain.MatchForfeit{}},
+		{Method: http.MethodPost, Pattern: base, Handler: uuidParam("id", "match", h.Award), Summary: "Da el partido por walkover", Request: forfeitInput{}, Response: domain.MatchForfeit{}},
+		{Method: http.MethodDelete, Pattern: base, Handler: uuidParam("id", "match", h.Revoke), Summary: "Anula el walkover", Response: domain.MatchForfeit{}},
+	}
+}
+
+// GetAll devuelve el historial de adjudicaciones del partido, incluidas las revocadas
+func (h *MatchForfeitHandler) GetAll(w http.ResponseWriter, r *http.Request, matchID uuid.UUID) {
+	forfeits, err := h.queries.GetForfeits(matchID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, forfeits)
+}
+
+// forfeitInput es el cuerpo de POST /api/matches/{id}/forfeit
+type forfeitInput struct {
+	ForfeitingTeamID string `json:"forfeiting_team_id"`
+	AwardedGoals     int    `json:"awarded_goals"`
+	Reason           string `json:"reason"`
+}
+
+// Award adjudica el partido por walkover:
+// {"forfeiting_team_id": "...", "awarded_goals": 3, "reason": "..."}
+func (h *MatchForfeitHandler) Award(w http.ResponseWriter, r *http.Request, matchID uuid.UUID) {
+	var input forfeitInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	teamID, err := parseUUID(input.ForfeitingTeamID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid forfeiting_team_id UUID")
+		return
+	}
+	if err := sanitizeFields(textField{"reason", &input.Reason, maxMessageLength}); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	forfeit, err := h.commands.AwardForfeit(matchID, teamID, input.AwardedGoals, input.Reason)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, forfeit)
+}
+
+// Revoke anula la adjudicación vigente y devuelve el resultado anterior
+func (h *MatchForfeitHandler) Revoke(w http.ResponseWriter, r *http.Request, matchID uuid.UUID) {
+	forfeit, err := h.commands.RevokeForfeit(matchID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, forfeit)
+}
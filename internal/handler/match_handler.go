@@ -2,53 +2,85 @@ package handler
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
 	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
 	"github.com/google/uuid"
 )
 
+// MatchHandler atiende /api/matches; tags resuelve el filtro ?tag= del
+// listado y odds agrega las probabilidades a los partidos por jugar. Las
+// restricciones de público las agrega queries.
 type MatchHandler struct {
-	useCase *usecase.MatchUseCase
+	commands usecase.MatchCommands
+	queries  usecase.MatchQueries
+	auth     *OrganizerAuth
+	tags     *TagHandler
+	odds     usecase.PredictionQueries
 }
 
-func NewMatchHandler(useCase *usecase.MatchUseCase) *MatchHandler {
-	return &MatchHandler{useCase: useCase}
+func NewMatchHandler(commands usecase.MatchCommands, queries usecase.MatchQueries, auth *OrganizerAuth, tags *TagHandler, odds usecase.PredictionQueries) *MatchHandler {
+	return &MatchHandler{commands: commands, queries: queries, auth: auth, tags: tags, odds: odds}
 }
 
-func (h *MatchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	path := strings.TrimPrefix(r.URL.Path, "/api/matches")
-	path = strings.Trim(path, "/")
+// hideEmbargoed aplica el embargo de resultados salvo para organizadores
+func (h *MatchHandler) hideEmbargoed(r *http.Request, matches []domain.Match) error {
+	if h.auth.IsOrganizer(r) {
+		return nil
+	}
+	return h.queries.HideEmbargoedResults(matches)
+}
 
-	switch r.Method {
-	case http.MethodGet:
-		if path == "" {
-			h.GetAll(w, r)
-		} else {
-			h.GetByID(w, r, path)
-		}
-	case http.MethodPost:
-		h.Create(w, r)
-	case http.MethodPut:
-		h.Update(w, r, path)
-	case http.MethodDelete:
-		h.Delete(w, r, path)
-	default:
-		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+// Routes son las rutas de /api/matches; los eventos, cambios, reloj, etc.
+// los declaran sus propios handlers
+func (h *MatchHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/matches", Handler: h.GetAll, Summary: "Lista los partidos (paginado, ?tag=, ?season=)", Response: []domain.Match{}},
+		{Method: http.MethodPost, Pattern: "/api/matches", Handler: h.Create, Summary: "Crea un partido", Request: matchInput{}, Response: domain.Match{}, Idempotent: true},
+		{Method: http.MethodGet, Pattern: "/api/matches/{id}", Handler: pathParam("id", h.GetByID), Summary: "Obtiene un partido", Response: domain.Match{}},
+		{Method: http.MethodPut, Pattern: "/api/matches/{id}", Handler: pathParam("id", h.Update), Summary: "Modifica un partido (marcador, estado)", Request: matchUpdateInput{}, Response: domain.Match{}},
+		{Method: http.MethodDelete, Pattern: "/api/matches/{id}", Handler: pathParam("id", h.Delete), Summary: "Borra un partido", Response: map[string]string{}},
+		{Method: http.MethodGet, Pattern: "/api/matches/{id}/submatches", Handler: uuidParam("id", "match", h.GetSubMatches), Summary: "Partidos de la serie", Response: []domain.Match{}},
+		{Method: http.MethodPost, Pattern: "/api/matches/{id}/submatches", Handler: uuidParam("id", "match", h.CreateSubMatch), Summary: "Agrega un partido a la serie", Request: subMatchInput{}, Response: domain.Match{}, Idempotent: true},
 	}
 }
 
+// matchInput es el cuerpo de POST /api/matches; las fechas van en RFC3339
+type matchInput struct {
+	ID              string `json:"id"`
+	CreatedAt       string `json:"created_at"`
+	TournamentID    string `json:"tournament_id"`
+	VenueID         string `json:"venue_id"`
+	PitchID         string `json:"pitch_id"`
+	StageID         string `json:"stage_id"`
+	Round           int    `json:"round"`
+	MatchNumber     int    `json:"match_number"`
+	Date            string `json:"date"`
+	Team1ID         string `json:"team1_id"`
+	Team2ID         string `json:"team2_id"`
+	GoalScoredTeam1 int    `json:"goal_scored_team1"`
+	GoalScoredTeam2 int    `json:"goal_scored_team2"`
+	ExtraTimeTeam1  *int   `json:"extra_time_team1"`
+	ExtraTimeTeam2  *int   `json:"extra_time_team2"`
+	PenaltiesTeam1  *int   `json:"penalties_team1"`
+	PenaltiesTeam2  *int   `json:"penalties_team2"`
+	// Transmisión (opcional)
+	StreamURL          string `json:"stream_url"`
+	Broadcaster        string `json:"broadcaster"`
+	StreamEmbargoUntil string `json:"stream_embargo_until"`
+	// IsFriendly excluye el partido de posiciones y tablas de jugadores
+	IsFriendly bool `json:"is_friendly"`
+	// ResultConfirmed carga el partido con el resultado definitivo (un
+	// partido ya jugado); sin él no cuenta para la tabla
+	ResultConfirmed bool `json:"result_confirmed"`
+}
+
 func (h *MatchHandler) Create(w http.ResponseWriter, r *http.Request) {
-	var input struct {
-		MatchNumber     int    `json:"match_number"`
-		Date            string `json:"date"`
-		Team1ID         string `json:"team1_id"`
-		Team2ID         string `json:"team2_id"`
-		GoalScoredTeam1 int    `json:"goal_scored_team1"`
-		GoalScoredTeam2 int    `json:"goal_scored_team2"`
-	}
+	var input matchInput
 
 	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
@@ -61,18 +93,53 @@ func (h *MatchHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	team1ID, err := uuid.Parse(input.Team1ID)
+	team1ID, err := parseUUID(input.Team1ID)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid team1_id UUID")
 		return
 	}
 
-	team2ID, err := uuid.Parse(input.Team2ID)
+	team2ID, err := parseUUID(input.Team2ID)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid team2_id UUID")
 		return
 	}
 
+	tournamentID, err := parseOptionalUUID(input.TournamentID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid tournament_id UUID")
+		return
+	}
+
+	venueID, err := parseOptionalUUID(input.VenueID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid venue_id UUID")
+		return
+	}
+
+	pitchID, err := parseOptionalUUID(input.PitchID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid pitch_id UUID")
+		return
+	}
+
+	stageID, err := parseOptionalUUID(input.StageID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid stage_id UUID")
+		return
+	}
+
+	if err := sanitizeFields(textField{"broadcaster", &input.Broadcaster, maxNameLength}); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	streamEmbargoUntil, err := parseOptionalDateTime(input.StreamEmbargoUntil)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid stream_embargo_until format")
+		return
+	}
+
 	match := domain.NewMatch(
 		input.MatchNumber,
 		date,
@@ -81,56 +148,246 @@ func (h *MatchHandler) Create(w http.ResponseWriter, r *http.Request) {
 		input.GoalScoredTeam1,
 		input.GoalScoredTeam2,
 	)
-
-	if err := h.useCase.CreateMatch(match); err != nil {
+	match.TournamentID = tournamentID
+	match.VenueID = venueID
+	match.PitchID = pitchID
+	match.StageID = stageID
+	match.Round = input.Round
+	match.StreamURL = strings.TrimSpace(input.StreamURL)
+	match.Broadcaster = input.Broadcaster
+	match.StreamEmbargoUntil = streamEmbargoUntil
+	match.IsFriendly = input.IsFriendly
+	match.ExtraTimeTeam1, match.ExtraTimeTeam2 = input.ExtraTimeTeam1, input.ExtraTimeTeam2
+	match.PenaltiesTeam1, match.PenaltiesTeam2 = input.PenaltiesTeam1, input.PenaltiesTeam2
+	if input.ResultConfirmed {
+		match.ConfirmResult(time.Now().UTC())
+	}
+	if err := applyClientIdentity(input.ID, input.CreatedAt, &match.ID, &match.CreatedAt); err != nil {
 		respondWithError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
+	if err := h.commands.CreateMatch(match); err != nil {
+		respondWithSyncError(w, err)
+		return
+	}
+
 	respondWithJSON(w, http.StatusCreated, match)
 }
 
 func (h *MatchHandler) GetAll(w http.ResponseWriter, r *http.Request) {
-	matches, err := h.useCase.GetAllMatches()
+	page, err := parsePage(r, domain.MatchSortFields)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	filter, err := parseMatchFilter(r)
 	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if season := r.URL.Query().Get("season"); season != "" {
+		seasonID, err := h.queries.SeasonID(season)
+		if err != nil {
+			respondWithError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		filter.SeasonID = &seasonID
+		// Quien pide una temporada la quiere completa aunque esté archivada
+		filter.IncludeArchived = true
+	}
+	tagged, ok := h.tags.taggedIDs(w, r, domain.TagEntityMatch)
+	if !ok {
+		return
+	}
+
+	if wantsCSV(r) {
+		h.exportCSV(w, r, filter, page, tagged)
+		return
+	}
+
+	var paged domain.Paged[domain.Match]
+	if tagged == nil {
+		paged, err = h.queries.FindMatchesPage(filter, page)
+		if err != nil {
+			respondWithError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	} else {
+		// Las etiquetas no están en la consulta: se filtra el resultado y se
+		// pagina en memoria
+		matches, err := h.queries.FindMatches(filter)
+		if err != nil {
+			respondWithError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		filtered := []domain.Match{}
+		for _, match := range matches {
+			if tagged[match.ID] {
+				filtered = append(filtered, match)
+			}
+		}
+		domain.SortMatches(filtered, page.Sort)
+		paged = domain.SlicePage(filtered, page)
+	}
+
+	if err := h.hideEmbargoed(r, paged.Items); err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := h.odds.AttachOdds(paged.Items); err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := h.queries.AttachAttendance(paged.Items); err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	respondWithJSON(w, http.StatusOK, matches)
+	respondWithPage(w, r, paged)
+}
+
+// exportCSV responde todos los partidos del filtro en CSV, con los nombres
+// de los equipos y sin las probabilidades ni el público
+func (h *MatchHandler) exportCSV(w http.ResponseWriter, r *http.Request, filter domain.MatchFilter, page domain.Page, tagged map[uuid.UUID]bool) {
+	matches, err := h.queries.FindMatches(filter)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if tagged != nil {
+		filtered := []domain.Match{}
+		for _, match := range matches {
+			if tagged[match.ID] {
+				filtered = append(filtered, match)
+			}
+		}
+		matches = filtered
+	}
+	domain.SortMatches(matches, page.Sort)
+
+	if err := h.hideEmbargoed(r, matches); err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := h.queries.AttachTeams(matches); err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithCSV(w, "matches.csv", matchCSVColumns, matches)
+}
+
+// parseMatchFilter lee ?team_id=&tournament_id=&from=&to=&status= y
+// ?archived=true. from y to aceptan RFC3339 o una fecha (2025-03-01); una
+// fecha en to incluye todo ese día.
+func parseMatchFilter(r *http.Request) (domain.MatchFilter, error) {
+	query := r.URL.Query()
+	filter := domain.MatchFilter{Status: query.Get("status"), IncludeArchived: includeArchived(r)}
+
+	var err error
+	if filter.TeamID, err = parseOptionalUUID(query.Get("team_id")); err != nil {
+		return filter, fmt.Errorf("Invalid team_id UUID")
+	}
+	if filter.TournamentID, err = parseOptionalUUID(query.Get("tournament_id")); err != nil {
+		return filter, fmt.Errorf("Invalid tournament_id UUID")
+	}
+	if filter.From, err = parseFilterDate(query.Get("from"), false); err != nil {
+		return filter, fmt.Errorf("Invalid from date")
+	}
+	if filter.To, err = parseFilterDate(query.Get("to"), true); err != nil {
+		return filter, fmt.Errorf("Invalid to date")
+	}
+	return filter, filter.Validate()
+}
+
+// parseFilterDate parsea un límite de fecha opcional; con endOfDay una
+// fecha sin hora llega hasta el último instante de ese día
+func parseFilterDate(value string, endOfDay bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if date, err := time.Parse(time.DateOnly, value); err == nil {
+		if endOfDay {
+			date = date.Add(24*time.Hour - time.Nanosecond)
+		}
+		return &date, nil
+	}
+	date, err := parseDateTime(value)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
 }
 
 func (h *MatchHandler) GetByID(w http.ResponseWriter, r *http.Request, idStr string) {
-	id, err := uuid.Parse(idStr)
+	id, err := parseUUID(idStr)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
 		return
 	}
 
-	match, err := h.useCase.GetMatchByID(id)
+	match, err := h.queries.GetMatchByID(id)
 	if err != nil {
 		respondWithError(w, http.StatusNotFound, err.Error())
 		return
 	}
 
-	respondWithJSON(w, http.StatusOK, match)
+	visible := []domain.Match{*match}
+	if err := h.hideEmbargoed(r, visible); err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := h.odds.AttachOdds(visible); err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := h.queries.AttachAttendance(visible); err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, visible[0])
+}
+
+// matchUpdateInput es el cuerpo de PUT /api/matches/{id}; las fechas van en RFC3339
+type matchUpdateInput struct {
+	TournamentID    string `json:"tournament_id"`
+	VenueID         string `json:"venue_id"`
+	PitchID         string `json:"pitch_id"`
+	StageID         string `json:"stage_id"`
+	Round           int    `json:"round"`
+	MatchNumber     int    `json:"match_number"`
+	Date            string `json:"date"`
+	Team1ID         string `json:"team1_id"`
+	Team2ID         string `json:"team2_id"`
+	GoalScoredTeam1 int    `json:"goal_scored_team1"`
+	GoalScoredTeam2 int    `json:"goal_scored_team2"`
+	ExtraTimeTeam1  *int   `json:"extra_time_team1"`
+	ExtraTimeTeam2  *int   `json:"extra_time_team2"`
+	PenaltiesTeam1  *int   `json:"penalties_team1"`
+	PenaltiesTeam2  *int   `json:"penalties_team2"`
+	// Transmisión (opcional)
+	StreamURL          string `json:"stream_url"`
+	Broadcaster        string `json:"broadcaster"`
+	StreamEmbargoUntil string `json:"stream_embargo_until"`
+	// IsFriendly excluye el partido de posiciones y tablas de jugadores
+	IsFriendly bool `json:"is_friendly"`
+	// ResultConfirmed marca el resultado como definitivo; una vez
+	// confirmado, omitirlo no lo vuelve a dejar pendiente
+	ResultConfirmed bool `json:"result_confirmed"`
+	// UpdatedAt es la versión que el cliente leyó; opcional
+	UpdatedAt string `json:"updated_at"`
 }
 
 func (h *MatchHandler) Update(w http.ResponseWriter, r *http.Request, idStr string) {
-	id, err := uuid.Parse(idStr)
+	id, err := parseUUID(idStr)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
 		return
 	}
 
-	var input struct {
-		MatchNumber     int    `json:"match_number"`
-		Date            string `json:"date"`
-		Team1ID         string `json:"team1_id"`
-		Team2ID         string `json:"team2_id"`
-		GoalScoredTeam1 int    `json:"goal_scored_team1"`
-		GoalScoredTeam2 int    `json:"goal_scored_team2"`
-	}
+	var input matchUpdateInput
 
 	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
@@ -143,47 +400,153 @@ func (h *MatchHandler) Update(w http.ResponseWriter, r *http.Request, idStr stri
 		return
 	}
 
-	team1ID, err := uuid.Parse(input.Team1ID)
+	team1ID, err := parseUUID(input.Team1ID)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid team1_id UUID")
 		return
 	}
 
-	team2ID, err := uuid.Parse(input.Team2ID)
+	team2ID, err := parseUUID(input.Team2ID)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid team2_id UUID")
 		return
 	}
 
-	match := &domain.Match{
-		ID:              id,
-		MatchNumber:     input.MatchNumber,
-		Date:            date,
-		Team1ID:         team1ID,
-		Team2ID:         team2ID,
-		GoalScoredTeam1: input.GoalScoredTeam1,
-		GoalScoredTeam2: input.GoalScoredTeam2,
+	tournamentID, err := parseOptionalUUID(input.TournamentID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid tournament_id UUID")
+		return
+	}
+
+	venueID, err := parseOptionalUUID(input.VenueID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid venue_id UUID")
+		return
+	}
+
+	pitchID, err := parseOptionalUUID(input.PitchID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid pitch_id UUID")
+		return
+	}
+
+	stageID, err := parseOptionalUUID(input.StageID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid stage_id UUID")
+		return
 	}
 
-	if err := h.useCase.UpdateMatch(match); err != nil {
+	if err := sanitizeFields(textField{"broadcaster", &input.Broadcaster, maxNameLength}); err != nil {
 		respondWithError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
+	streamEmbargoUntil, err := parseOptionalDateTime(input.StreamEmbargoUntil)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid stream_embargo_until format")
+		return
+	}
+
+	var updatedAt time.Time
+	if input.UpdatedAt != "" {
+		if updatedAt, err = parseDateTime(input.UpdatedAt); err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid updated_at format")
+			return
+		}
+	}
+
+	match := &domain.Match{
+		ID:                 id,
+		TournamentID:       tournamentID,
+		VenueID:            venueID,
+		PitchID:            pitchID,
+		StageID:            stageID,
+		Round:              input.Round,
+		MatchNumber:        input.MatchNumber,
+		Date:               date,
+		Team1ID:            team1ID,
+		Team2ID:            team2ID,
+		GoalScoredTeam1:    input.GoalScoredTeam1,
+		GoalScoredTeam2:    input.GoalScoredTeam2,
+		ExtraTimeTeam1:     input.ExtraTimeTeam1,
+		ExtraTimeTeam2:     input.ExtraTimeTeam2,
+		PenaltiesTeam1:     input.PenaltiesTeam1,
+		PenaltiesTeam2:     input.PenaltiesTeam2,
+		StreamURL:          strings.TrimSpace(input.StreamURL),
+		Broadcaster:        input.Broadcaster,
+		StreamEmbargoUntil: streamEmbargoUntil,
+		IsFriendly:         input.IsFriendly,
+		UpdatedAt:          updatedAt,
+	}
+	if input.ResultConfirmed {
+		match.ConfirmResult(time.Now().UTC())
+	}
+
+	if err := h.commands.UpdateMatch(match); err != nil {
+		respondWithSyncError(w, err)
+		return
+	}
+
 	respondWithJSON(w, http.StatusOK, match)
 }
 
 func (h *MatchHandler) Delete(w http.ResponseWriter, r *http.Request, idStr string) {
-	id, err := uuid.Parse(idStr)
+	id, err := parseUUID(idStr)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
 		return
 	}
 
-	if err := h.useCase.DeleteMatch(id); err != nil {
+	if err := h.commands.DeleteMatch(id); err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Match deleted"})
 }
+
+// subMatchInput es el cuerpo de POST /api/matches/{id}/submatches
+type subMatchInput struct {
+	Date            string `json:"date"`
+	GoalScoredTeam1 int    `json:"goal_scored_team1"`
+	GoalScoredTeam2 int    `json:"goal_scored_team2"`
+}
+
+func (h *MatchHandler) CreateSubMatch(w http.ResponseWriter, r *http.Request, parentID uuid.UUID) {
+	var input subMatchInput
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	date, err := parseDateTime(input.Date)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid date format")
+		return
+	}
+
+	// Equipos, torneo y número de mini-juego los completa el caso de uso
+	subMatch := domain.NewMatch(0, date, uuid.Nil, uuid.Nil, input.GoalScoredTeam1, input.GoalScoredTeam2)
+	if err := h.commands.CreateSubMatch(parentID, subMatch); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, subMatch)
+}
+
+func (h *MatchHandler) GetSubMatches(w http.ResponseWriter, r *http.Request, parentID uuid.UUID) {
+	subMatches, err := h.queries.GetSubMatches(parentID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	if err := h.hideEmbargoed(r, subMatches); err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, subMatches)
+}
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/realtime"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// streamHeartbeatInterval envía un comentario periódico para que los
+// proxies no corten la conexión por inactividad
+const streamHeartbeatInterval = 15 * time.Second
+
+// MatchStreamHandler atiende /api/matches/{id}/stream: la alternativa con
+// Server-Sent Events al WebSocket de /ws para frontends que no pueden usarlo
+// (en C# sería un endpoint que escribe en Response.Body con
+// "text/event-stream"). Empieza con el estado actual del partido y después
+// envía las mismas actualizaciones que el hub: marcador y estado del partido
+// (match_updated), goles y tarjetas (event_created, event_deleted) y el
+// reloj (clock_updated).
+type MatchStreamHandler struct {
+	queries usecase.MatchQueries
+	clock   usecase.MatchClockQueries
+	auth    *OrganizerAuth
+	hub     *realtime.Hub
+}
+
+func NewMatchStreamHandler(queries usecase.MatchQueries, clock usecase.MatchClockQueries, auth *OrganizerAuth, hub *realtime.Hub) *MatchStreamHandler {
+	return &MatchStreamHandler{queries: queries, clock: clock, auth: auth, hub: hub}
+}
+
+// Routes son las rutas de /api/matches/{id}/stream
+func (h *MatchStreamHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/matches/{id}/stream", Handler: uuidParam("id", "match", h.Stream), Summary: "Actualizaciones en vivo del partido (SSE)"},
+	}
+}
+
+func (h *MatchStreamHandler) Stream(w http.ResponseWriter, r *http.Request, matchID uuid.UUID) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		respondWithError(w, http.StatusInternalServerError, "Streaming not supported")
+		return
+	}
+
+	match, err := h.queries.GetMatchByID(matchID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	// Suscribirse antes de enviar el estado inicial para no perder
+	// actualizaciones que lleguen entre medio
+	sub := h.hub.Subscribe(&matchID)
+	defer sub.Close()
+
+	visible := []domain.Match{*match}
+	if !h.auth.IsOrganizer(r) {
+		if err := h.queries.HideEmbargoedResults(visible); err != nil {
+			respondWithError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Evita que nginx acumule la respuesta en su buffer
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	initial := domain.NewMatchUpdate(domain.MatchUpdateScore, matchID)
+	initial.Match = &visible[0]
+	if err := writeSSE(w, initial); err != nil {
+		return
+	}
+	// Un partido en juego también arranca con el minuto actual
+	if clock, err := h.clock.GetMatchClock(matchID); err == nil && clock.Status != domain.ClockNotStarted {
+		clockUpdate := domain.NewMatchUpdate(domain.MatchUpdateClock, matchID)
+		clockUpdate.Clock = clock
+		if err := writeSSE(w, clockUpdate); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	ticker := time.NewTicker(streamHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case update, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			if err := writeSSE(w, update); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		case <-r.Context().Done():
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// writeSSE escribe la actualización como un evento SSE con su tipo como
+// nombre de evento, para que el cliente pueda usar addEventListener
+func writeSSE(w http.ResponseWriter, update domain.MatchUpdate) error {
+	data, err := json.Marshal(update)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", update.Type, data)
+	return err
+}
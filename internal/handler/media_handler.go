@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// MediaHandler atiende /api/matches/{id}/media
+type MediaHandler struct {
+	commands usecase.MediaCommands
+	queries  usecase.MediaQueries
+}
+
+func NewMediaHandler(commands usecase.MediaCommands, queries usecase.MediaQueries) *MediaHandler {
+	return &MediaHandler{commands: commands, queries: queries}
+}
+
+type mediaInput struct {
+	Type    string `json:"type"`
+	URL     string `json:"url"`
+	Caption string `json:"caption"`
+}
+
+// Routes son las rutas de /api/matches/{id}/media
+func (h *MediaHandler) Routes() []Route {
+	const base = "/api/matches/{id}/media"
+	return []Route{
+		{Method: http.MethodGet, Pattern: base, Handler: uuidParam("id", "match", h.GetAll), Summary: "Galería del partido", Response: []domain.MatchMedia{}},
+		{Method: http.MethodPost, Pattern: base, Handler: uuidParam("id", "match", h.Create), Summary: "Agrega una foto o video", Idempotent: true, Request: mediaInput{}, Response: domain.MatchMedia{}},
+		{Method: http.MethodPut, Pattern: base + "/order", Handler: uuidParam("id", "match", h.Reorder), Summary: "Reordena la galería", Request: mediaOrderInput{}, Response: []domain.MatchMedia{}},
+		{Method: http.MethodGet, Pattern: base + "/{mediaId}", Handler: uuidParams("id", "match", "mediaId", "media", h.GetByID), Summary: "Obtiene un elemento de la galería", Response: domain.MatchMedia{}},
+		{Method: http.MethodPut, Pattern: base + "/{mediaId}", Handler: uuidParams("id", "match", "mediaId", "media", h.Update), Summary: "Modifica un elemento de la galería", Request: mediaInput{}, Response: domain.MatchMedia{}},
+		{Method: http.MethodDelete, Pattern: base + "/{mediaId}", Handler: uuidParams("id", "match", "mediaId", "media", h.Delete), Summary: "Quita un elemento de la galería", Response: map[string]string{}},
+	}
+}
+
+func (h *MediaHandler) decode(w http.ResponseWriter, r *http.Request, matchID uuid.UUID) (*domain.MatchMedia, bool) {
+	var input mediaInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return nil, false
+	}
+
+	if err := sanitizeFields(
+		textField{"caption", &input.Caption, maxCaptionLength},
+	); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+
+	return domain.NewMatchMedia(matchID, input.Type, input.URL, input.Caption), true
+}
+
+func (h *MediaHandler) Create(w http.ResponseWriter, r *http.Request, matchID uuid.UUID) {
+	media, ok := h.decode(w, r, matchID)
+	if !ok {
+		return
+	}
+
+	if err := h.commands.CreateMedia(media); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, media)
+}
+
+func (h *MediaHandler) GetAll(w http.ResponseWriter, r *http.Request, matchID uuid.UUID) {
+	gallery, err := h.queries.GetMatchMedia(matchID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, gallery)
+}
+
+func (h *MediaHandler) GetByID(w http.ResponseWriter, r *http.Request, matchID, mediaID uuid.UUID) {
+	media, err := h.queries.GetMedia(matchID, mediaID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, media)
+}
+
+func (h *MediaHandler) Update(w http.ResponseWriter, r *http.Request, matchID, mediaID uuid.UUID) {
+	media, ok := h.decode(w, r, matchID)
+	if !ok {
+		return
+	}
+	media.ID = mediaID
+
+	if err := h.commands.UpdateMedia(media); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, media)
+}
+
+func (h *MediaHandler) Delete(w http.ResponseWriter, r *http.Request, matchID, mediaID uuid.UUID) {
+	if err := h.commands.DeleteMedia(matchID, mediaID); err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Media deleted"})
+}
+
+// mediaOrderInput es el cuerpo de PUT /api/matches/{id}/media/order
+type mediaOrderInput struct {
+	IDs []uuid.UUID `json:"ids"`
+}
+
+// Reorder recibe {"ids": [...]} con toda la galería en el orden deseado
+func (h *MediaHandler) Reorder(w http.ResponseWriter, r *http.Request, matchID uuid.UUID) {
+	var input mediaOrderInput
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	gallery, err := h.commands.ReorderMedia(matchID, input.IDs)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, gallery)
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+)
+
+// requestLocale es el idioma en que se escriben los importes: ?locale= o la
+// primera etiqueta de Accept-Language (es-AR,es;q=0.9 → es-AR)
+func requestLocale(r *http.Request) string {
+	if locale := r.URL.Query().Get("locale"); locale != "" {
+		return domain.ResolveLocale(locale)
+	}
+	first, _, _ := strings.Cut(r.Header.Get("Accept-Language"), ",")
+	tag, _, _ := strings.Cut(first, ";")
+	return domain.ResolveLocale(tag)
+}
+
+// formatMoney completa Formatted en los importes de la respuesta; los
+// importes cero (sin multa, sin cuota) quedan fuera del JSON
+func formatMoney(r *http.Request, amounts ...*domain.Money) {
+	locale := requestLocale(r)
+	for _, money := range amounts {
+		if *money != (domain.Money{}) {
+			money.Formatted = money.Format(locale)
+		}
+	}
+}
+
+// normalizeMoney limpia la moneda de un importe recibido (" eur" → "EUR")
+func normalizeMoney(money *domain.Money) {
+	money.Currency = strings.ToUpper(strings.TrimSpace(money.Currency))
+	money.Formatted = ""
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// OAuthHandler atiende POST /oauth/token: el flujo client credentials de
+// OAuth2 con que las aplicaciones de terceros obtienen tokens para /api/v1
+type OAuthHandler struct {
+	commands usecase.APIClientCommands
+}
+
+func NewOAuthHandler(commands usecase.APIClientCommands) *OAuthHandler {
+	return &OAuthHandler{commands: commands}
+}
+
+// Routes es la ruta de /oauth/token
+func (h *OAuthHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPost, Pattern: "/oauth/token", Handler: h.Token, Summary: "Emite un token de acceso (client credentials)", Response: domain.TokenResponse{}},
+	}
+}
+
+// Token recibe el formulario de RFC 6749 (grant_type, scope) con las
+// credenciales por HTTP Basic o como client_id y client_secret
+func (h *OAuthHandler) Token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		respondWithOAuthError(w, &domain.OAuthError{Code: domain.OAuthInvalidRequest, Description: "invalid form body"})
+		return
+	}
+	if r.PostForm.Get("grant_type") != "client_credentials" {
+		respondWithOAuthError(w, &domain.OAuthError{Code: domain.OAuthUnsupportedGrantType, Description: "only client_credentials is supported"})
+		return
+	}
+
+	clientIDStr, secret, ok := r.BasicAuth()
+	if !ok {
+		clientIDStr, secret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	clientID, err := uuid.Parse(clientIDStr)
+	if err != nil || secret == "" {
+		respondWithOAuthError(w, &domain.OAuthError{Code: domain.OAuthInvalidClient, Description: "client authentication failed"})
+		return
+	}
+
+	token, err := h.commands.IssueToken(clientID, secret, r.PostForm.Get("scope"))
+	if err != nil {
+		respondWithOAuthError(w, err)
+		return
+	}
+
+	// Los tokens no se cachean (RFC 6749, sección 5.1)
+	w.Header().Set("Cache-Control", "no-store")
+	respondWithJSON(w, http.StatusOK, token)
+}
+
+// respondWithOAuthError responde con el formato de error de RFC 6749:
+// invalid_client es 401 y el resto de los rechazos 400
+func respondWithOAuthError(w http.ResponseWriter, err error) {
+	var oauthErr *domain.OAuthError
+	if !errors.As(err, &oauthErr) {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	code := http.StatusBadRequest
+	if oauthErr.Code == domain.OAuthInvalidClient {
+		code = http.StatusUnauthorized
+		w.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+	}
+	respondWithJSON(w, code, map[string]string{
+		"error":             oauthErr.Code,
+		"error_description": oauthErr.Description,
+	})
+}
@@ -0,0 +1,218 @@
+package handler
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+)
+
+// OpenAPI genera el documento OpenAPI 3 a partir de la tabla de rutas, así
+// cada ruta registrada aparece en /openapi.json con su rol y su límite.
+// En C# lo haría Swashbuckle leyendo los controladores.
+
+// OpenAPIDocument es la raíz del documento (solo los campos que se usan)
+type OpenAPIDocument struct {
+	OpenAPI    string                                 `json:"openapi"`
+	Info       OpenAPIInfo                            `json:"info"`
+	Paths      map[string]map[string]OpenAPIOperation `json:"paths"`
+	Components OpenAPIComponents                      `json:"components"`
+}
+
+type OpenAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type OpenAPIOperation struct {
+	// OperationID sale del método y el patrón: GET /api/teams/{id} → getTeamsById
+	OperationID string                     `json:"operationId"`
+	Summary     string                     `json:"summary,omitempty"`
+	Tags        []string                   `json:"tags,omitempty"`
+	Parameters  []OpenAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *OpenAPIRequestBody        `json:"requestBody,omitempty"`
+	Security    []map[string][]string      `json:"security,omitempty"`
+	Responses   map[string]OpenAPIResponse `json:"responses"`
+	// RateLimit es la extensión x-rate-limit-class
+	RateLimit RateLimitClass `json:"x-rate-limit-class,omitempty"`
+}
+
+type OpenAPIParameter struct {
+	Name     string            `json:"name"`
+	In       string            `json:"in"`
+	Required bool              `json:"required"`
+	Schema   map[string]string `json:"schema"`
+}
+
+type OpenAPIRequestBody struct {
+	Required bool                        `json:"required"`
+	Content  map[string]OpenAPIMediaType `json:"content"`
+}
+
+type OpenAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]OpenAPIMediaType `json:"content,omitempty"`
+}
+
+type OpenAPIMediaType struct {
+	Schema *OpenAPISchema `json:"schema"`
+}
+
+type OpenAPIComponents struct {
+	Schemas         map[string]*OpenAPISchema `json:"schemas,omitempty"`
+	SecuritySchemes map[string]any            `json:"securitySchemes"`
+}
+
+// Nombres de los esquemas de seguridad del documento
+const (
+	openAPIOrganizerScheme = "organizerToken"
+	openAPIClientScheme    = "oauth2"
+)
+
+var pathParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// errorResponse describe en el documento el cuerpo de los errores que arma
+// respondWithError; error_description solo lo agrega /oauth/token (RFC 6749)
+type errorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// NewOpenAPIDocument describe las rutas; version es la del binario
+func NewOpenAPIDocument(routes []Route, version string) OpenAPIDocument {
+	doc := OpenAPIDocument{
+		OpenAPI: "3.0.3",
+		Info:    OpenAPIInfo{Title: "Football Tournament API", Version: version},
+		Paths:   make(map[string]map[string]OpenAPIOperation),
+		Components: OpenAPIComponents{SecuritySchemes: map[string]any{
+			openAPIOrganizerScheme: map[string]string{"type": "http", "scheme": "bearer"},
+			openAPIClientScheme: map[string]any{
+				"type": "oauth2",
+				"flows": map[string]any{
+					"clientCredentials": map[string]any{
+						"tokenUrl": "/oauth/token",
+						"scopes": map[string]string{
+							domain.ScopeReadMatches:   "Leer partidos",
+							domain.ScopeReadStandings: "Leer tablas de posiciones",
+						},
+					},
+				},
+			},
+		}},
+	}
+
+	// Las respuestas van primero: un tipo que también aparece dentro de un
+	// cuerpo de entrada queda registrado con sus campos requeridos
+	schemas := newOpenAPISchemas()
+	schemas.required = true
+	responses := make([]*OpenAPISchema, len(routes))
+	for i, route := range routes {
+		responses[i] = schemas.of(route.Response)
+	}
+	errorSchema := schemas.of(errorResponse{})
+	schemas.required = false
+
+	for i, route := range routes {
+		operation := OpenAPIOperation{
+			OperationID: openAPIOperationID(route.Method, route.Pattern),
+			Summary:     route.Summary,
+			Tags:        []string{openAPITag(route.Pattern)},
+			RateLimit:   route.RateLimit,
+			Responses: map[string]OpenAPIResponse{
+				"default": {Description: "Error", Content: jsonContent(errorSchema)},
+			},
+		}
+		for _, match := range pathParamPattern.FindAllStringSubmatch(route.Pattern, -1) {
+			operation.Parameters = append(operation.Parameters, OpenAPIParameter{
+				Name: match[1], In: "path", Required: true, Schema: map[string]string{"type": "string"},
+			})
+		}
+
+		if route.Idempotent {
+			operation.Parameters = append(operation.Parameters, OpenAPIParameter{
+				Name: idempotencyKeyHeader, In: "header", Schema: map[string]string{"type": "string"},
+			})
+			operation.Responses["409"] = OpenAPIResponse{Description: "La petición original con esa clave sigue en curso"}
+			operation.Responses["422"] = OpenAPIResponse{Description: "La clave ya se usó con otra petición"}
+		}
+		if schema := schemas.of(route.Request); schema != nil {
+			operation.RequestBody = &OpenAPIRequestBody{Required: true, Content: jsonContent(schema)}
+		}
+		if schema := responses[i]; schema != nil {
+			operation.Responses["2XX"] = OpenAPIResponse{Description: "Respuesta correcta", Content: jsonContent(schema)}
+			if route.Unavailable {
+				operation.Responses["503"] = OpenAPIResponse{Description: "No está disponible", Content: jsonContent(schema)}
+			}
+		}
+
+		switch route.Role {
+		case RoleOrganizer:
+			operation.Security = []map[string][]string{{openAPIOrganizerScheme: {}}}
+			operation.Responses["401"] = OpenAPIResponse{Description: "Falta el token de organizador"}
+		case RoleAPIClient:
+			scopes := []string{}
+			if route.Scope != "" {
+				scopes = append(scopes, route.Scope)
+			}
+			operation.Security = []map[string][]string{{openAPIClientScheme: scopes}}
+			operation.Responses["401"] = OpenAPIResponse{Description: "Token de acceso inválido o vencido"}
+			if route.Scope != "" {
+				operation.Responses["403"] = OpenAPIResponse{Description: "El token no tiene el alcance " + route.Scope}
+			}
+		}
+		if route.RateLimit == RateLimitPerClient {
+			operation.Responses["429"] = OpenAPIResponse{Description: "Se superó el límite por minuto del cliente"}
+		}
+
+		if doc.Paths[route.Pattern] == nil {
+			doc.Paths[route.Pattern] = make(map[string]OpenAPIOperation)
+		}
+		doc.Paths[route.Pattern][strings.ToLower(route.Method)] = operation
+	}
+	doc.Components.Schemas = schemas.schemas
+	return doc
+}
+
+func jsonContent(schema *OpenAPISchema) map[string]OpenAPIMediaType {
+	return map[string]OpenAPIMediaType{"application/json": {Schema: schema}}
+}
+
+// openAPIOperationID arma el nombre de la operación con el método y los
+// segmentos del patrón sin /api: GET /api/v1/matches/{id} → getV1MatchesById
+func openAPIOperationID(method, pattern string) string {
+	id := strings.ToLower(method)
+	for _, segment := range strings.Split(strings.TrimPrefix(pattern, "/api"), "/") {
+		if param, ok := strings.CutPrefix(segment, "{"); ok {
+			id += "By" + pascalCase(strings.TrimSuffix(param, "}"))
+			continue
+		}
+		id += pascalCase(segment)
+	}
+	return id
+}
+
+// pascalCase une las palabras separadas por guiones, puntos o guiones bajos
+// con la primera letra en mayúscula: check-name → CheckName
+func pascalCase(s string) string {
+	var b strings.Builder
+	for _, word := range strings.FieldsFunc(s, func(r rune) bool { return r == '-' || r == '.' || r == '_' }) {
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
+
+// openAPITag agrupa las rutas por recurso: /api/v1/matches/{id} → matches
+func openAPITag(pattern string) string {
+	path := strings.TrimPrefix(strings.TrimPrefix(pattern, "/api/v1"), "/api")
+	tag, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	return tag
+}
+
+// OpenAPI atiende /openapi.json con las rutas registradas en el momento de
+// la petición
+func (rt *Router) OpenAPI(version string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		respondWithJSON(w, http.StatusOK, NewOpenAPIDocument(rt.Routes(), version))
+	}
+}
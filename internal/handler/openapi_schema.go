@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Esquemas de OpenAPI generados por reflexión a partir de los tipos que
+// declaran las rutas en Request y Response, leyendo los tags json igual que
+// encoding/json. Los structs con nombre van a components/schemas y se
+// referencian con $ref; así un cambio en domain.Team cambia el documento
+// sin tocar nada más. En C# es lo que hace Swashbuckle con los DTOs.
+
+// OpenAPISchema es un esquema de JSON Schema (el subconjunto de OpenAPI 3.0)
+type OpenAPISchema struct {
+	Ref        string                    `json:"$ref,omitempty"`
+	Type       string                    `json:"type,omitempty"`
+	Format     string                    `json:"format,omitempty"`
+	Nullable   bool                      `json:"nullable,omitempty"`
+	Items      *OpenAPISchema            `json:"items,omitempty"`
+	Properties map[string]*OpenAPISchema `json:"properties,omitempty"`
+	// Required son los campos de una respuesta que siempre vienen (sin
+	// omitempty ni omitzero)
+	Required             []string       `json:"required,omitempty"`
+	AdditionalProperties *OpenAPISchema `json:"additionalProperties,omitempty"`
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	rawMessageType    = reflect.TypeOf(json.RawMessage{})
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// openAPISchemas junta los esquemas con nombre de todo el documento
+type openAPISchemas struct {
+	schemas map[string]*OpenAPISchema
+	// names evita que dos tipos de paquetes distintos con el mismo nombre
+	// se pisen en components/schemas
+	names map[reflect.Type]string
+	// required marca los campos sin omitempty como requeridos; vale para las
+	// respuestas, donde siempre vienen, y no para los cuerpos de entrada
+	required bool
+}
+
+func newOpenAPISchemas() *openAPISchemas {
+	return &openAPISchemas{schemas: make(map[string]*OpenAPISchema), names: make(map[reflect.Type]string)}
+}
+
+// of devuelve el esquema del valor de ejemplo de una ruta (nil si no hay)
+func (s *openAPISchemas) of(example any) *OpenAPISchema {
+	if example == nil {
+		return nil
+	}
+	return s.schema(reflect.TypeOf(example))
+}
+
+func (s *openAPISchemas) schema(t reflect.Type) *OpenAPISchema {
+	if t.Kind() == reflect.Pointer {
+		elem := s.schema(t.Elem())
+		if elem.Ref != "" {
+			// $ref no admite otras claves en OpenAPI 3.0
+			return elem
+		}
+		nullable := *elem
+		nullable.Nullable = true
+		return &nullable
+	}
+
+	switch {
+	case t == timeType:
+		return &OpenAPISchema{Type: "string", Format: "date-time"}
+	case t == rawMessageType:
+		return &OpenAPISchema{}
+	case t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType):
+		// uuid.UUID y los demás tipos que se escriben como texto
+		format := ""
+		if t.Name() == "UUID" {
+			format = "uuid"
+		}
+		return &OpenAPISchema{Type: "string", Format: format}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &OpenAPISchema{Type: "string"}
+	case reflect.Bool:
+		return &OpenAPISchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &OpenAPISchema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &OpenAPISchema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &OpenAPISchema{Type: "string", Format: "byte"}
+		}
+		if t.Kind() == reflect.Array {
+			return &OpenAPISchema{Type: "array", Items: s.schema(t.Elem())}
+		}
+		// encoding/json escribe null para los slices y mapas nil
+		return &OpenAPISchema{Type: "array", Nullable: true, Items: s.schema(t.Elem())}
+	case reflect.Map:
+		return &OpenAPISchema{Type: "object", Nullable: true, AdditionalProperties: s.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return s.object(t)
+		}
+		return s.ref(t)
+	}
+	// interfaces y demás: cualquier valor JSON
+	return &OpenAPISchema{}
+}
+
+// ref registra el struct en components/schemas antes de recorrer sus campos,
+// así los tipos recursivos terminan en un $ref a sí mismos
+func (s *openAPISchemas) ref(t reflect.Type) *OpenAPISchema {
+	name, ok := s.names[t]
+	if !ok {
+		name = schemaName(t)
+		if _, taken := s.schemas[name]; taken {
+			name = t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:] + "." + name
+		}
+		s.names[t] = name
+		s.schemas[name] = &OpenAPISchema{}
+		*s.schemas[name] = *s.object(t)
+	}
+	return &OpenAPISchema{Ref: "#/components/schemas/" + name}
+}
+
+// object describe los campos exportados con las reglas de encoding/json:
+// el nombre del tag, "-" se omite y los structs embebidos sin tag se aplanan.
+// Con s.required, los campos sin omitempty ni omitzero quedan como requeridos.
+func (s *openAPISchemas) object(t reflect.Type) *OpenAPISchema {
+	object := &OpenAPISchema{Type: "object", Properties: make(map[string]*OpenAPISchema)}
+	// Un campo propio pisa al del struct embebido, también en si es requerido
+	required := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				inner := s.object(embedded)
+				for key, property := range inner.Properties {
+					if _, ok := object.Properties[key]; !ok {
+						object.Properties[key] = property
+						required[key] = slices.Contains(inner.Required, key)
+					}
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		property := s.schema(field.Type)
+		if strings.Contains(options, "string") {
+			property = &OpenAPISchema{Type: "string"}
+		}
+		object.Properties[name] = property
+		required[name] = !strings.Contains(options, "omitempty") && !strings.Contains(options, "omitzero")
+	}
+	for name, isRequired := range required {
+		if isRequired && s.required {
+			object.Required = append(object.Required, name)
+		}
+	}
+	sort.Strings(object.Required)
+	return object
+}
+
+// schemaName es el nombre del tipo sin los parámetros de un genérico:
+// domain.Paged[domain.Team] queda como Paged_Team
+func schemaName(t reflect.Type) string {
+	name := t.Name()
+	base, args, generic := strings.Cut(name, "[")
+	if !generic {
+		return name
+	}
+	args = strings.TrimSuffix(args, "]")
+	for _, arg := range strings.Split(args, ",") {
+		base += "_" + arg[strings.LastIndex(arg, ".")+1:]
+	}
+	return base
+}
@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+)
+
+// Paginación de los listados: ?limit=&offset= o, si se prefiere contar
+// páginas, ?page=&per_page= (page empieza en 1). El cuerpo sigue siendo el
+// array de siempre, así ?fields= y los clientes existentes no cambian; el
+// total y los enlaces a la página anterior y siguiente van en las cabeceras
+// X-Total-Count y Link, como hace la API de GitHub. ?sort=campo (o
+// ?sort=-campo para el orden inverso) elige el orden entre los campos que
+// admite cada listado.
+
+// parsePage lee la página y el orden pedidos; sin parámetros devuelve la
+// primera con domain.DefaultPageLimit elementos en el orden por defecto
+func parsePage(r *http.Request, sortable domain.SortFields) (domain.Page, error) {
+	query := r.URL.Query()
+	intParam := func(name string) (int, error) {
+		raw := query.Get(name)
+		if raw == "" {
+			return 0, nil
+		}
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return 0, fmt.Errorf("Invalid %s parameter", name)
+		}
+		return n, nil
+	}
+
+	limit, err := intParam("limit")
+	if err != nil {
+		return domain.Page{}, err
+	}
+	offset, err := intParam("offset")
+	if err != nil {
+		return domain.Page{}, err
+	}
+
+	if query.Has("page") || query.Has("per_page") {
+		if query.Has("limit") || query.Has("offset") {
+			return domain.Page{}, fmt.Errorf("use either limit/offset or page/per_page")
+		}
+		page, err := intParam("page")
+		if err != nil {
+			return domain.Page{}, err
+		}
+		perPage, err := intParam("per_page")
+		if err != nil {
+			return domain.Page{}, err
+		}
+		if query.Has("page") && page < 1 {
+			return domain.Page{}, fmt.Errorf("page must be at least 1")
+		}
+		if perPage == 0 {
+			perPage = domain.DefaultPageLimit
+		}
+		limit, offset = perPage, max(page-1, 0)*perPage
+	}
+
+	page, err := domain.NewPage(limit, offset)
+	if err != nil {
+		return page, err
+	}
+	page.Sort, err = sortable.Parse(query.Get("sort"))
+	return page, err
+}
+
+// respondWithPage responde los elementos de la página (con ?fields= si se
+// pidió) y agrega X-Total-Count y Link
+func respondWithPage[T any](w http.ResponseWriter, r *http.Request, paged domain.Paged[T]) {
+	w.Header().Set("X-Total-Count", strconv.Itoa(paged.Total))
+
+	var links []string
+	if paged.HasNext() {
+		links = append(links, pageLink(r, paged.Offset+paged.Limit, paged.Limit, "next"))
+	}
+	if paged.Offset > 0 {
+		links = append(links, pageLink(r, max(paged.Offset-paged.Limit, 0), paged.Limit, "prev"))
+	}
+	if len(links) > 0 {
+		w.Header().Set("Link", strings.Join(links, ", "))
+	}
+
+	items := paged.Items
+	if items == nil {
+		items = []T{}
+	}
+	respondWithFields(w, r, http.StatusOK, items)
+}
+
+// pageLink arma el enlace a otra página conservando los demás parámetros
+// (filtros, ?fields=); siempre usa limit/offset
+func pageLink(r *http.Request, offset, limit int, rel string) string {
+	query := r.URL.Query()
+	query.Del("page")
+	query.Del("per_page")
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set("offset", strconv.Itoa(offset))
+	return fmt.Sprintf(`<%s?%s>; rel="%s"`, r.URL.Path, query.Encode(), rel)
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// PitchHandler atiende /api/venues/{id}/pitches
+type PitchHandler struct {
+	commands usecase.VenueCommands
+	queries  usecase.VenueQueries
+}
+
+func NewPitchHandler(commands usecase.VenueCommands, queries usecase.VenueQueries) *PitchHandler {
+	return &PitchHandler{commands: commands, queries: queries}
+}
+
+type pitchInput struct {
+	Name string `json:"name"`
+}
+
+// Routes son las rutas de /api/venues/{id}/pitches
+func (h *PitchHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/venues/{id}/pitches", Handler: uuidParam("id", "venue", h.GetAll), Summary: "Lista las canchas de la sede", Response: []domain.Pitch{}},
+		{Method: http.MethodPost, Pattern: "/api/venues/{id}/pitches", Handler: uuidParam("id", "venue", h.Create), Summary: "Agrega una cancha a la sede", Request: pitchInput{}, Response: domain.Pitch{}, Idempotent: true},
+		{Method: http.MethodGet, Pattern: "/api/venues/{id}/pitches/{pitchId}", Handler: uuidParams("id", "venue", "pitchId", "pitch", h.GetByID), Summary: "Obtiene una cancha", Response: domain.Pitch{}},
+		{Method: http.MethodPut, Pattern: "/api/venues/{id}/pitches/{pitchId}", Handler: uuidParams("id", "venue", "pitchId", "pitch", h.Update), Summary: "Modifica una cancha", Request: pitchInput{}, Response: domain.Pitch{}},
+		{Method: http.MethodDelete, Pattern: "/api/venues/{id}/pitches/{pitchId}", Handler: uuidParams("id", "venue", "pitchId", "pitch", h.Delete), Summary: "Borra una cancha", Response: map[string]string{}},
+	}
+}
+
+func (h *PitchHandler) decode(w http.ResponseWriter, r *http.Request, venueID uuid.UUID) (*domain.Pitch, bool) {
+	var input pitchInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return nil, false
+	}
+
+	if err := sanitizeFields(textField{"name", &input.Name, maxPitchNameLength}); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+
+	return domain.NewPitch(venueID, input.Name), true
+}
+
+func (h *PitchHandler) Create(w http.ResponseWriter, r *http.Request, venueID uuid.UUID) {
+	pitch, ok := h.decode(w, r, venueID)
+	if !ok {
+		return
+	}
+
+	if err := h.commands.CreatePitch(pitch); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, pitch)
+}
+
+func (h *PitchHandler) GetAll(w http.ResponseWriter, r *http.Request, venueID uuid.UUID) {
+	pitches, err := h.queries.GetVenuePitches(venueID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, pitches)
+}
+
+func (h *PitchHandler) GetByID(w http.ResponseWriter, r *http.Request, venueID, pitchID uuid.UUID) {
+	pitch, err := h.queries.GetPitch(venueID, pitchID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, pitch)
+}
+
+func (h *PitchHandler) Update(w http.ResponseWriter, r *http.Request, venueID, pitchID uuid.UUID) {
+	pitch, ok := h.decode(w, r, venueID)
+	if !ok {
+		return
+	}
+	pitch.ID = pitchID
+
+	if err := h.commands.UpdatePitch(pitch); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, pitch)
+}
+
+func (h *PitchHandler) Delete(w http.ResponseWriter, r *http.Request, venueID, pitchID uuid.UUID) {
+	if err := h.commands.DeletePitch(venueID, pitchID); err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Pitch deleted"})
+}
@@ -2,65 +2,86 @@ package handler
 
 import (
 	"encoding/json"
+	"fmt"
+	"log"
 	"net/http"
 	"strings"
 
 	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
 	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
-	"github.com/google/uuid"
 )
 
+// PlayerHandler atiende /api/players; las lesiones están en InjuryHandler y
+// tags resuelve el filtro ?tag= del listado
 type PlayerHandler struct {
-	useCase *usecase.PlayerUseCase
+	commands usecase.PlayerCommands
+	queries  usecase.PlayerQueries
+	tags     *TagHandler
 }
 
-func NewPlayerHandler(useCase *usecase.PlayerUseCase) *PlayerHandler {
-	return &PlayerHandler{useCase: useCase}
+func NewPlayerHandler(commands usecase.PlayerCommands, queries usecase.PlayerQueries, tags *TagHandler) *PlayerHandler {
+	return &PlayerHandler{commands: commands, queries: queries, tags: tags}
 }
 
-// En Go no hay atributos como [HttpGet], usamos funciones que verifican el método
-func (h *PlayerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	// Extraer ID de la URL si existe: /api/players/{id}
-	path := strings.TrimPrefix(r.URL.Path, "/api/players")
-	path = strings.Trim(path, "/")
-
-	switch r.Method {
-	case http.MethodGet:
-		if path == "" {
-			h.GetAll(w, r)
-		} else {
-			h.GetByID(w, r, path)
-		}
-	case http.MethodPost:
-		h.Create(w, r)
-	case http.MethodPut:
-		h.Update(w, r, path)
-	case http.MethodDelete:
-		h.Delete(w, r, path)
-	default:
-		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+// Routes son las rutas de /api/players. En Go no hay atributos como
+// [HttpGet]: cada ruta se declara en esta tabla.
+func (h *PlayerHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/players", Handler: h.GetAll, Summary: "Lista los jugadores (paginado, ?tag=)", Response: []domain.Player{}},
+		{Method: http.MethodPost, Pattern: "/api/players", Handler: h.Create, Summary: "Crea un jugador", Request: playerInput{}, Response: domain.Player{}, Idempotent: true},
+		{Method: http.MethodPost, Pattern: "/api/players/bulk", Handler: h.CreateBulk, Summary: "Crea un lote de jugadores en una transacción", Request: []playerInput{}, Response: domain.BulkPlayerReport{}, Idempotent: true},
+		{Method: http.MethodGet, Pattern: "/api/players/{id}", Handler: pathParam("id", h.GetByID), Summary: "Obtiene un jugador", Response: domain.Player{}},
+		{Method: http.MethodPut, Pattern: "/api/players/{id}", Handler: pathParam("id", h.Update), Summary: "Modifica un jugador", Request: playerUpdateInput{}, Response: domain.Player{}},
+		{Method: http.MethodDelete, Pattern: "/api/players/{id}", Handler: pathParam("id", h.Delete), Summary: "Borra un jugador", Response: map[string]string{}},
+		{Method: http.MethodGet, Pattern: "/api/players/{id}/transfers", Handler: pathParam("id", h.GetTransfers), Summary: "Historial de pases del jugador", Response: []domain.Transfer{}},
 	}
 }
 
-func (h *PlayerHandler) Create(w http.ResponseWriter, r *http.Request) {
-	var input struct {
-		Name      string `json:"name"`
-		DateBirth string `json:"date_birth"`
+// playerInput es el cuerpo del alta de un jugador, solo o en lote
+type playerInput struct {
+	Name          string `json:"name"`
+	DateBirth     string `json:"date_birth"`
+	Position      string `json:"position"`
+	PreferredFoot string `json:"preferred_foot"`
+	Nationality   string `json:"nationality"`
+	IsTest        bool   `json:"is_test"`
+}
+
+// newPlayer limpia el input y arma el jugador; las reglas de negocio las
+// revisa el caso de uso
+func (input playerInput) newPlayer() (*domain.Player, error) {
+	if err := sanitizeFields(
+		textField{"name", &input.Name, maxNameLength},
+	); err != nil {
+		return nil, err
+	}
+
+	dateBirth, err := parseDateTime(input.DateBirth)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid date format, use ISO 8601")
 	}
 
+	player := domain.NewPlayer(input.Name, dateBirth)
+	player.Position = input.Position
+	player.PreferredFoot = input.PreferredFoot
+	player.Nationality = strings.ToUpper(strings.TrimSpace(input.Nationality))
+	player.IsTest = input.IsTest
+	return player, nil
+}
+
+func (h *PlayerHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var input playerInput
 	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
 		return
 	}
 
-	dateBirth, err := parseDateTime(input.DateBirth)
+	player, err := input.newPlayer()
 	if err != nil {
-		respondWithError(w, http.StatusBadRequest, "Invalid date format, use ISO 8601")
+		respondWithError(w, http.StatusBadRequest, err.Error())
 		return
 	}
-
-	player := domain.NewPlayer(input.Name, dateBirth)
-	if err := h.useCase.CreatePlayer(player); err != nil {
+	if err := h.commands.CreatePlayer(player); err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -68,24 +89,101 @@ func (h *PlayerHandler) Create(w http.ResponseWriter, r *http.Request) {
 	respondWithJSON(w, http.StatusCreated, player)
 }
 
+// CreateBulk da de alta un arreglo de jugadores (un plantel completo) en una
+// sola transacción. Responde 201 si se crearon todos y 400 si alguno no es
+// válido, con el resultado de cada posición en ambos casos.
+func (h *PlayerHandler) CreateBulk(w http.ResponseWriter, r *http.Request) {
+	var inputs []playerInput
+	if err := json.NewDecoder(r.Body).Decode(&inputs); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	if len(inputs) == 0 || len(inputs) > domain.MaxBulkPlayers {
+		respondWithError(w, http.StatusBadRequest, fmt.Sprintf("Send between 1 and %d players", domain.MaxBulkPlayers))
+		return
+	}
+
+	players := make([]*domain.Player, len(inputs))
+	rejected := make(map[int]error)
+	for i, input := range inputs {
+		player, err := input.newPlayer()
+		if err != nil {
+			rejected[i] = err
+			continue
+		}
+		players[i] = player
+	}
+
+	// Los errores de cada fila ya vienen en el reporte: un error acá es de
+	// la base o de la transacción
+	report, err := h.commands.CreatePlayers(players, rejected)
+	if err != nil {
+		log.Printf("⚠️  Could not create players in bulk: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Internal Server Error")
+		return
+	}
+
+	status := http.StatusCreated
+	if report.Created < len(report.Results) {
+		status = http.StatusBadRequest
+	}
+	respondWithJSON(w, status, report)
+}
+
+// GetAll lista los jugadores paginados (ver parsePage); ?tag= (ID o nombre)
+// filtra por etiqueta y ?format=csv exporta el listado completo
 func (h *PlayerHandler) GetAll(w http.ResponseWriter, r *http.Request) {
-	players, err := h.useCase.GetAllPlayers()
+	page, err := parsePage(r, domain.PlayerSortFields)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	tagged, ok := h.tags.taggedIDs(w, r, domain.TagEntityPlayer)
+	if !ok {
+		return
+	}
+
+	if tagged == nil && !wantsCSV(r) {
+		paged, err := h.queries.GetPlayersPage(page)
+		if err != nil {
+			respondWithError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		respondWithPage(w, r, paged)
+		return
+	}
+
+	// Con etiqueta se filtra el listado completo y se pagina el resultado;
+	// el CSV lo exporta entero
+	players, err := h.queries.GetAllPlayers()
 	if err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	respondWithJSON(w, http.StatusOK, players)
+	filtered := []domain.Player{}
+	for _, player := range players {
+		if tagged == nil || tagged[player.ID] {
+			filtered = append(filtered, player)
+		}
+	}
+	domain.SortPlayers(filtered, page.Sort)
+
+	if wantsCSV(r) {
+		respondWithCSV(w, "players.csv", playerCSVColumns, filtered)
+		return
+	}
+	respondWithPage(w, r, domain.SlicePage(filtered, page))
 }
 
 func (h *PlayerHandler) GetByID(w http.ResponseWriter, r *http.Request, idStr string) {
-	id, err := uuid.Parse(idStr)
+	id, err := parseUUID(idStr)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
 		return
 	}
 
-	player, err := h.useCase.GetPlayerByID(id)
+	player, err := h.queries.GetPlayerByID(id)
 	if err != nil {
 		respondWithError(w, http.StatusNotFound, err.Error())
 		return
@@ -94,23 +192,53 @@ func (h *PlayerHandler) GetByID(w http.ResponseWriter, r *http.Request, idStr st
 	respondWithJSON(w, http.StatusOK, player)
 }
 
-func (h *PlayerHandler) Update(w http.ResponseWriter, r *http.Request, idStr string) {
-	id, err := uuid.Parse(idStr)
+// GetTransfers devuelve el historial de pases del jugador
+func (h *PlayerHandler) GetTransfers(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
 		return
 	}
 
-	var input struct {
-		Name      string `json:"name"`
-		DateBirth string `json:"date_birth"`
+	transfers, err := h.queries.GetPlayerTransfers(id)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, transfers)
+}
+
+// playerUpdateInput es el cuerpo de PUT /api/players/{id}
+type playerUpdateInput struct {
+	Name          string `json:"name"`
+	DateBirth     string `json:"date_birth"`
+	Position      string `json:"position"`
+	PreferredFoot string `json:"preferred_foot"`
+	Nationality   string `json:"nationality"`
+}
+
+func (h *PlayerHandler) Update(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
+		return
 	}
 
+	var input playerUpdateInput
+
 	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
 		return
 	}
 
+	if err := sanitizeFields(
+		textField{"name", &input.Name, maxNameLength},
+	); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	dateBirth, err := parseDateTime(input.DateBirth)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid date format")
@@ -118,12 +246,15 @@ func (h *PlayerHandler) Update(w http.ResponseWriter, r *http.Request, idStr str
 	}
 
 	player := &domain.Player{
-		ID:        id,
-		Name:      input.Name,
-		DateBirth: dateBirth,
+		ID:            id,
+		Name:          input.Name,
+		DateBirth:     dateBirth,
+		Position:      input.Position,
+		PreferredFoot: input.PreferredFoot,
+		Nationality:   strings.ToUpper(strings.TrimSpace(input.Nationality)),
 	}
 
-	if err := h.useCase.UpdatePlayer(player); err != nil {
+	if err := h.commands.UpdatePlayer(player); err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -132,13 +263,13 @@ func (h *PlayerHandler) Update(w http.ResponseWriter, r *http.Request, idStr str
 }
 
 func (h *PlayerHandler) Delete(w http.ResponseWriter, r *http.Request, idStr string) {
-	id, err := uuid.Parse(idStr)
+	id, err := parseUUID(idStr)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
 		return
 	}
 
-	if err := h.useCase.DeletePlayer(id); err != nil {
+	if err := h.commands.DeletePlayer(id); err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// PredictionHandler atiende /api/matches/{id}/prediction
+type PredictionHandler struct {
+	queries usecase.PredictionQueries
+}
+
+func NewPredictionHandler(queries usecase.PredictionQueries) *PredictionHandler {
+	return &PredictionHandler{queries: queries}
+}
+
+// Routes son las rutas de /api/matches/{id}/prediction
+func (h *PredictionHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/matches/{id}/prediction", Handler: uuidParam("id", "match", h.Predict), Summary: "Predicción del resultado", Response: domain.MatchPrediction{}},
+	}
+}
+
+// Predict devuelve las probabilidades de victoria, empate y derrota
+func (h *PredictionHandler) Predict(w http.ResponseWriter, r *http.Request, matchID uuid.UUID) {
+	prediction, err := h.queries.PredictMatch(matchID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, prediction)
+}
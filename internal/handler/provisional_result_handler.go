@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// ProvisionalResultHandler atiende /api/provisional-results: los resultados
+// recibidos por email que esperan la confirmación de un organizador
+type ProvisionalResultHandler struct {
+	commands usecase.ProvisionalResultCommands
+	queries  usecase.ProvisionalResultQueries
+}
+
+func NewProvisionalResultHandler(commands usecase.ProvisionalResultCommands, queries usecase.ProvisionalResultQueries) *ProvisionalResultHandler {
+	return &ProvisionalResultHandler{commands: commands, queries: queries}
+}
+
+// Routes son las rutas de /api/provisional-results
+func (h *ProvisionalResultHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/provisional-results", Handler: h.GetAll, Summary: "Lista los resultados recibidos por email (?status=pending)", Response: []domain.ProvisionalResult{}},
+		{Method: http.MethodGet, Pattern: "/api/provisional-results/{id}", Handler: uuidParam("id", "provisional result", h.GetByID), Summary: "Obtiene un resultado provisorio", Response: domain.ProvisionalResult{}},
+		{Method: http.MethodPost, Pattern: "/api/provisional-results/{id}/confirm", Handler: uuidParam("id", "provisional result", h.Confirm), Summary: "Confirma el resultado y lo carga en el partido", Response: domain.ProvisionalResult{}},
+		{Method: http.MethodPost, Pattern: "/api/provisional-results/{id}/reject", Handler: uuidParam("id", "provisional result", h.Reject), Summary: "Rechaza el resultado", Response: domain.ProvisionalResult{}},
+	}
+}
+
+func (h *ProvisionalResultHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	results, err := h.queries.GetProvisionalResults(r.URL.Query().Get("status"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, results)
+}
+
+func (h *ProvisionalResultHandler) GetByID(w http.ResponseWriter, r *http.Request, resultID uuid.UUID) {
+	result, err := h.queries.GetProvisionalResult(resultID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, result)
+}
+
+func (h *ProvisionalResultHandler) Confirm(w http.ResponseWriter, r *http.Request, resultID uuid.UUID) {
+	result, err := h.commands.ConfirmProvisionalResult(resultID)
+	if err != nil {
+		respondWithSyncError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, result)
+}
+
+func (h *ProvisionalResultHandler) Reject(w http.ResponseWriter, r *http.Request, resultID uuid.UUID) {
+	result, err := h.commands.RejectProvisionalResult(resultID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// PublicAPIHandler arma /api/v1, la API de datos para aplicaciones de
+// terceros: las mismas lecturas de /api (que responden la vista pública)
+// declaradas con RoleAPIClient, el alcance de cada recurso y el límite por
+// minuto del cliente, que aplica el Router. Limits expone el consumo del
+// límite en /api/me/limits.
+type PublicAPIHandler struct {
+	tokens  usecase.APIClientQueries
+	limiter *rateLimiter
+}
+
+func NewPublicAPIHandler(tokens usecase.APIClientQueries) *PublicAPIHandler {
+	return &PublicAPIHandler{tokens: tokens, limiter: newRateLimiter()}
+}
+
+// Routes copia a /api/v1 las lecturas de partidos (GET /api/matches/...) y
+// la tabla de posiciones de las rutas internas
+func (h *PublicAPIHandler) Routes(internal []Route) []Route {
+	var routes []Route
+	for _, route := range internal {
+		if route.Method != http.MethodGet {
+			continue
+		}
+		var scope string
+		switch {
+		case route.Pattern == "/api/matches" || strings.HasPrefix(route.Pattern, "/api/matches/"):
+			scope = domain.ScopeReadMatches
+		case route.Pattern == "/api/tournaments/{id}/standings":
+			scope = domain.ScopeReadStandings
+		default:
+			continue
+		}
+
+		route.Pattern = "/api/v1" + strings.TrimPrefix(route.Pattern, "/api")
+		route.Role = RoleAPIClient
+		route.Scope = scope
+		route.RateLimit = RateLimitPerClient
+		routes = append(routes, route)
+	}
+
+	return append(routes, Route{
+		Method: http.MethodGet, Pattern: "/api/me/limits", Handler: h.Limits, Role: RoleAPIClient,
+		Summary: "Consumo del límite de peticiones del token", Response: domain.RateLimitStatus{},
+	})
+}
+
+// Limits atiende GET /api/me/limits: el consumo del cliente del token en la
+// ventana actual. No descuenta del límite ni exige alcance.
+func (h *PublicAPIHandler) Limits(w http.ResponseWriter, r *http.Request) {
+	token := apiToken(r)
+	status := h.limiter.peek(token.ClientID, token.RateLimitPerMinute)
+	status.Scopes = token.Scopes
+	status.ExpiresAt = token.ExpiresAt
+	setRateLimitHeaders(w, status)
+	respondWithJSON(w, http.StatusOK, status)
+}
+
+// allow descuenta una petición del límite del cliente; si lo superó
+// responde 429
+func (h *PublicAPIHandler) allow(w http.ResponseWriter, token *domain.APIToken) bool {
+	status, ok := h.limiter.allow(token.ClientID, token.RateLimitPerMinute)
+	setRateLimitHeaders(w, status)
+	if !ok {
+		w.Header().Set("Retry-After", w.Header().Get("X-RateLimit-Reset"))
+		respondWithError(w, http.StatusTooManyRequests, "Rate limit exceeded")
+	}
+	return ok
+}
+
+// authenticate valida el token de acceso; si no es válido responde 401
+func (h *PublicAPIHandler) authenticate(w http.ResponseWriter, r *http.Request) (*domain.APIToken, bool) {
+	token, err := h.tokens.AuthenticateToken(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		respondWithError(w, http.StatusUnauthorized, "Valid access token required")
+		return nil, false
+	}
+	return token, true
+}
+
+// setRateLimitHeaders informa el límite, lo que queda y los segundos que
+// faltan para la próxima ventana (X-RateLimit-Reset)
+func setRateLimitHeaders(w http.ResponseWriter, status *domain.RateLimitStatus) {
+	reset := int(time.Until(status.ResetAt).Seconds() + 0.999)
+	if reset < 0 {
+		reset = 0
+	}
+	w.Header().Set("X-RateLimit-Limit", strconv.Itoa(status.Limit))
+	w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(status.Remaining))
+	w.Header().Set("X-RateLimit-Reset", strconv.Itoa(reset))
+}
+
+// rateLimiter cuenta las peticiones de cada cliente en ventanas fijas de un
+// minuto. Los contadores viven en memoria: con varias instancias detrás de
+// un balanceador el límite se aplica por instancia.
+type rateLimiter struct {
+	mu      sync.Mutex
+	windows map[uuid.UUID]*rateWindow
+}
+
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{windows: make(map[uuid.UUID]*rateWindow)}
+}
+
+// allow registra una petición y devuelve el estado de la ventana; false si
+// se superó el límite
+func (l *rateLimiter) allow(clientID uuid.UUID, limit int) (*domain.RateLimitStatus, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	window := l.current(clientID)
+	if window.count >= limit {
+		return window.status(clientID, limit), false
+	}
+	window.count++
+	return window.status(clientID, limit), true
+}
+
+// peek devuelve el estado de la ventana sin registrar una petición. No abre
+// ventanas: sin una en curso informa el límite completo y un reinicio dentro
+// de un minuto, así consultar no corre el reinicio de la próxima.
+func (l *rateLimiter) peek(clientID uuid.UUID, limit int) *domain.RateLimitStatus {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	window, ok := l.windows[clientID]
+	if !ok || now.Sub(window.start) >= time.Minute {
+		window = &rateWindow{start: now}
+	}
+	return window.status(clientID, limit)
+}
+
+// current devuelve la ventana en curso del cliente, abriendo una nueva si
+// la anterior ya venció. Requiere l.mu tomado.
+func (l *rateLimiter) current(clientID uuid.UUID) *rateWindow {
+	now := time.Now()
+	window, ok := l.windows[clientID]
+	if !ok || now.Sub(window.start) >= time.Minute {
+		window = &rateWindow{start: now}
+		l.windows[clientID] = window
+	}
+	return window
+}
+
+func (w *rateWindow) status(clientID uuid.UUID, limit int) *domain.RateLimitStatus {
+	remaining := limit - w.count
+	if remaining < 0 {
+		remaining = 0
+	}
+	return &domain.RateLimitStatus{
+		ClientID:  clientID,
+		Limit:     limit,
+		Remaining: remaining,
+		ResetAt:   w.start.Add(time.Minute).UTC(),
+	}
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// RatingHandler atiende /api/ratings (ranking Elo) y el rating de cada
+// equipo en /api/teams/{id}/rating
+type RatingHandler struct {
+	commands usecase.RatingCommands
+	queries  usecase.RatingQueries
+}
+
+func NewRatingHandler(commands usecase.RatingCommands, queries usecase.RatingQueries) *RatingHandler {
+	return &RatingHandler{commands: commands, queries: queries}
+}
+
+// Routes son las rutas de /api/ratings y del rating de cada equipo
+func (h *RatingHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/ratings", Handler: h.GetRanking, Summary: "Ranking Elo de los equipos", Response: []domain.TeamRating{}},
+		{Method: http.MethodPost, Pattern: "/api/ratings/recalculate", Handler: h.Recalculate, Summary: "Recalcula el ranking Elo desde cero", Response: []domain.TeamRating{}},
+		{Method: http.MethodGet, Pattern: "/api/teams/{id}/rating", Handler: uuidParam("id", "team", h.TeamRating), Summary: "Rating Elo e historial del equipo", Response: domain.TeamRating{}},
+	}
+}
+
+// GetRanking devuelve el ranking Elo de todos los equipos que jugaron
+func (h *RatingHandler) GetRanking(w http.ResponseWriter, r *http.Request) {
+	ranking, err := h.queries.GetRanking()
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, ranking)
+}
+
+// Recalculate rehace los ratings sin esperar al recálculo en segundo plano
+func (h *RatingHandler) Recalculate(w http.ResponseWriter, r *http.Request) {
+	if err := h.commands.RecalculateRatings(); err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	h.GetRanking(w, r)
+}
+
+// TeamRating devuelve el rating del equipo y su historial por partido
+func (h *RatingHandler) TeamRating(w http.ResponseWriter, r *http.Request, teamID uuid.UUID) {
+	rating, err := h.queries.GetTeamRating(teamID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, rating)
+}
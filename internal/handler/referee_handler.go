@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// RefereeHandler atiende /api/referees y la designación de árbitros en
+// /api/matches/{id}/referees
+type RefereeHandler struct {
+	commands usecase.RefereeCommands
+	queries  usecase.RefereeQueries
+}
+
+func NewRefereeHandler(commands usecase.RefereeCommands, queries usecase.RefereeQueries) *RefereeHandler {
+	return &RefereeHandler{commands: commands, queries: queries}
+}
+
+type refereeInput struct {
+	Name          string `json:"name"`
+	LicenseNumber string `json:"license_number"`
+	Email         string `json:"email"`
+}
+
+// Routes son las rutas de /api/referees y de la terna de cada partido
+func (h *RefereeHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/referees", Handler: h.GetAll, Summary: "Lista los árbitros", Response: []domain.Referee{}},
+		{Method: http.MethodPost, Pattern: "/api/referees", Handler: h.Create, Summary: "Registra un árbitro", Idempotent: true, Request: refereeInput{}, Response: domain.Referee{}},
+		{Method: http.MethodGet, Pattern: "/api/referees/{id}", Handler: pathParam("id", h.GetByID), Summary: "Obtiene un árbitro", Response: domain.Referee{}},
+		{Method: http.MethodPut, Pattern: "/api/referees/{id}", Handler: pathParam("id", h.Update), Summary: "Modifica un árbitro", Request: refereeInput{}, Response: domain.Referee{}},
+		{Method: http.MethodDelete, Pattern: "/api/referees/{id}", Handler: pathParam("id", h.Delete), Summary: "Borra un árbitro", Response: map[string]string{}},
+		{Method: http.MethodGet, Pattern: "/api/matches/{id}/referees", Handler: uuidParam("id", "match", h.GetMatchReferees), Summary: "Terna arbitral del partido", Response: []domain.MatchReferee{}},
+		{Method: http.MethodPut, Pattern: "/api/matches/{id}/referees", Handler: uuidParam("id", "match", h.AssignMatchReferees), Summary: "Designa la terna arbitral del partido", Request: matchRefereesInput{}, Response: []domain.MatchReferee{}},
+	}
+}
+
+func (h *RefereeHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var input refereeInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := sanitizeFields(
+		textField{"name", &input.Name, maxNameLength},
+		textField{"license_number", &input.LicenseNumber, maxLicenseLength},
+		textField{"email", &input.Email, maxNameLength},
+	); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	referee := domain.NewReferee(input.Name, input.LicenseNumber)
+	referee.Email = input.Email
+	if err := h.commands.CreateReferee(referee); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, referee)
+}
+
+func (h *RefereeHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	referees, err := h.queries.GetAllReferees()
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, referees)
+}
+
+func (h *RefereeHandler) GetByID(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
+		return
+	}
+
+	referee, err := h.queries.GetRefereeByID(id)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, referee)
+}
+
+func (h *RefereeHandler) Update(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
+		return
+	}
+
+	var input refereeInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := sanitizeFields(
+		textField{"name", &input.Name, maxNameLength},
+		textField{"license_number", &input.LicenseNumber, maxLicenseLength},
+		textField{"email", &input.Email, maxNameLength},
+	); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	referee := &domain.Referee{
+		ID:            id,
+		Name:          input.Name,
+		LicenseNumber: input.LicenseNumber,
+		Email:         input.Email,
+	}
+	if err := h.commands.UpdateReferee(referee); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, referee)
+}
+
+func (h *RefereeHandler) Delete(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
+		return
+	}
+
+	if err := h.commands.DeleteReferee(id); err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Referee deleted"})
+}
+
+func (h *RefereeHandler) GetMatchReferees(w http.ResponseWriter, r *http.Request, matchID uuid.UUID) {
+	referees, err := h.queries.GetMatchReferees(matchID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, referees)
+}
+
+// matchRefereesInput es el cuerpo de PUT /api/matches/{id}/referees
+type matchRefereesInput struct {
+	MainRefereeID *uuid.UUID  `json:"main_referee_id"`
+	AssistantIDs  []uuid.UUID `json:"assistant_ids"`
+}
+
+func (h *RefereeHandler) AssignMatchReferees(w http.ResponseWriter, r *http.Request, matchID uuid.UUID) {
+	var input matchRefereesInput
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	referees, err := h.commands.AssignMatchReferees(matchID, input.MainRefereeID, input.AssistantIDs)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, referees)
+}
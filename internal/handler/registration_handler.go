@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// RegistrationHandler atiende /api/tournaments/{id}/registrations
+type RegistrationHandler struct {
+	commands usecase.RegistrationCommands
+	queries  usecase.RegistrationQueries
+}
+
+func NewRegistrationHandler(commands usecase.RegistrationCommands, queries usecase.RegistrationQueries) *RegistrationHandler {
+	return &RegistrationHandler{commands: commands, queries: queries}
+}
+
+type registrationInput struct {
+	TeamID   string `json:"team_id"`
+	PlayerID string `json:"player_id"`
+}
+
+// Routes son las rutas de /api/tournaments/{id}/registrations
+func (h *RegistrationHandler) Routes() []Route {
+	const base = "/api/tournaments/{id}/registrations"
+	return []Route{
+		{Method: http.MethodGet, Pattern: base, Handler: uuidParam("id", "tournament", h.GetAll), Summary: "Fichajes del torneo", Response: []domain.Registration{}},
+		{Method: http.MethodPost, Pattern: base, Handler: uuidParam("id", "tournament", h.Create), Summary: "Ficha un jugador", Idempotent: true, Request: registrationInput{}, Response: domain.Registration{}},
+		{Method: http.MethodDelete, Pattern: base + "/{playerId}", Handler: uuidParams("id", "tournament", "playerId", "player", h.Delete), Summary: "Da de baja un fichaje", Response: map[string]string{}},
+	}
+}
+
+// GetAll lista los fichajes del torneo; ?team_id= filtra por equipo
+func (h *RegistrationHandler) GetAll(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	teamID, err := parseOptionalUUID(r.URL.Query().Get("team_id"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid team UUID")
+		return
+	}
+
+	registrations, err := h.queries.GetRegistrations(tournamentID, teamID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, registrations)
+}
+
+func (h *RegistrationHandler) Create(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	var input registrationInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	teamID, err := parseUUID(input.TeamID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid team UUID")
+		return
+	}
+	playerID, err := parseUUID(input.PlayerID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid player UUID")
+		return
+	}
+
+	registration := domain.NewRegistration(tournamentID, teamID, playerID)
+	if err := h.commands.RegisterPlayer(registration); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, registration)
+}
+
+func (h *RegistrationHandler) Delete(w http.ResponseWriter, r *http.Request, tournamentID, playerID uuid.UUID) {
+	if err := h.commands.UnregisterPlayer(tournamentID, playerID); err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Registration deleted"})
+}
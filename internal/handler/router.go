@@ -0,0 +1,271 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// Tabla de rutas: cada handler declara sus rutas (método, patrón, función,
+// rol requerido y clase de límite) con Routes() y el Router las registra en
+// un http.ServeMux. La misma tabla genera las respuestas OPTIONS/Allow y los
+// paths de /openapi.json, así los tres no pueden quedar desalineados.
+// En C# sería parecido a los atributos [HttpGet("{id}")] y [Authorize] que
+// lee el enrutador y también Swashbuckle.
+
+// Role es quién puede llamar a una ruta
+type Role string
+
+const (
+	// RolePublic no exige credenciales (valor por defecto)
+	RolePublic Role = ""
+	// RoleOrganizer exige el token de organizador (ORGANIZER_TOKEN)
+	RoleOrganizer Role = "organizer"
+	// RoleAPIClient exige un token de /oauth/token con el alcance Route.Scope
+	// (cualquier token si Scope está vacío)
+	RoleAPIClient Role = "api_client"
+)
+
+// RateLimitClass agrupa las rutas que comparten un límite de peticiones
+type RateLimitClass string
+
+const (
+	// RateLimitNone no limita (valor por defecto)
+	RateLimitNone RateLimitClass = ""
+	// RateLimitPerClient cuenta las peticiones de cada aplicación de terceros
+	// contra el límite por minuto de su token; requiere RoleAPIClient. Es el
+	// único límite, así que solo estas rutas envían X-RateLimit-*
+	RateLimitPerClient RateLimitClass = "per_client"
+)
+
+// Route es una entrada de la tabla de rutas
+type Route struct {
+	Method string
+	// Pattern es un patrón de http.ServeMux sin método, p. ej.
+	// /api/tournaments/{id}/sanctions/{sanctionId}
+	Pattern   string
+	Handler   http.HandlerFunc
+	Role      Role
+	Scope     string
+	RateLimit RateLimitClass
+	// Summary es la descripción corta que aparece en OpenAPI
+	Summary string
+	// Idempotent acepta la cabecera Idempotency-Key (solo POST): un
+	// reintento con la misma clave repite la respuesta original
+	Idempotent bool
+	// Request y Response son valores de ejemplo del cuerpo que recibe y del
+	// que responde la ruta (p. ej. domain.Team{} o []domain.Team{}); OpenAPI
+	// describe sus campos. Vacíos, el documento no detalla el cuerpo.
+	Request  any
+	Response any
+	// Unavailable indica que la ruta responde 503 con un cuerpo de la forma
+	// de Response en lugar del formato de error (p. ej. /ready)
+	Unavailable bool
+}
+
+// Router registra la tabla de rutas y aplica rol, límite y CORS a cada una
+type Router struct {
+	mux    *http.ServeMux
+	routes []Route
+	// methods son los métodos de cada patrón, en orden de registro (Allow)
+	methods map[string][]string
+	auth    *OrganizerAuth
+	clients *PublicAPIHandler
+	// idempotency guarda las respuestas de las rutas Idempotent
+	idempotency usecase.IdempotencyCommands
+}
+
+// NewRouter crea el router; clients autentica y limita las rutas
+// RoleAPIClient e idempotency atiende las claves de las rutas Idempotent
+func NewRouter(auth *OrganizerAuth, clients *PublicAPIHandler, idempotency usecase.IdempotencyCommands) *Router {
+	rt := &Router{mux: http.NewServeMux(), methods: make(map[string][]string), auth: auth, clients: clients, idempotency: idempotency}
+	rt.mux.HandleFunc("/", rt.fallback)
+	return rt
+}
+
+// Handle registra rutas. Entra en pánico si una ruta está mal declarada o
+// choca con otra (igual que http.ServeMux), así el error aparece al arrancar.
+func (rt *Router) Handle(routes ...Route) {
+	for _, route := range routes {
+		if err := route.validate(); err != nil {
+			panic(fmt.Sprintf("route %s %s: %v", route.Method, route.Pattern, err))
+		}
+		if slices.Contains(rt.methods[route.Pattern], route.Method) {
+			panic(fmt.Sprintf("route %s %s registered twice", route.Method, route.Pattern))
+		}
+
+		if _, ok := rt.methods[route.Pattern]; !ok {
+			pattern := route.Pattern
+			rt.mux.HandleFunc(http.MethodOptions+" "+pattern, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Allow", rt.allow(pattern))
+				w.WriteHeader(http.StatusOK)
+			})
+		}
+		rt.methods[route.Pattern] = append(rt.methods[route.Pattern], route.Method)
+		rt.routes = append(rt.routes, route)
+		rt.mux.Handle(route.Method+" "+route.Pattern, rt.wrap(route))
+	}
+}
+
+// Routes devuelve la tabla en orden de registro
+func (rt *Router) Routes() []Route {
+	return slices.Clone(rt.routes)
+}
+
+func (route Route) validate() error {
+	if route.Handler == nil {
+		return fmt.Errorf("missing handler")
+	}
+	if !strings.HasPrefix(route.Pattern, "/") || strings.ContainsAny(route.Pattern, " ") {
+		return fmt.Errorf("pattern must be a path without method")
+	}
+	if route.Method == "" || route.Method == http.MethodOptions {
+		return fmt.Errorf("method must be set and cannot be OPTIONS")
+	}
+	if route.Scope != "" && route.Role != RoleAPIClient {
+		return fmt.Errorf("scope requires the api_client role")
+	}
+	if route.RateLimit == RateLimitPerClient && route.Role != RoleAPIClient {
+		return fmt.Errorf("per-client rate limit requires the api_client role")
+	}
+	if route.Idempotent && route.Method != http.MethodPost {
+		return fmt.Errorf("idempotency keys are only supported on POST")
+	}
+	return nil
+}
+
+func (rt *Router) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	setCORSHeaders(w)
+	rt.mux.ServeHTTP(w, r)
+}
+
+// fallback atiende lo que no coincide con ninguna ruta: quita la barra
+// final (/api/players/ es /api/players), responde 405 con Allow si la ruta
+// existe con otro método y 404 si no existe
+func (rt *Router) fallback(w http.ResponseWriter, r *http.Request) {
+	if trimmed := strings.TrimRight(r.URL.Path, "/"); trimmed != r.URL.Path && trimmed != "" {
+		r2 := r.Clone(r.Context())
+		r2.URL.Path = trimmed
+		r2.URL.RawPath = ""
+		rt.mux.ServeHTTP(w, r2)
+		return
+	}
+
+	// Todas las rutas tienen un OPTIONS registrado: si coincide, el path
+	// existe y lo que falla es el método
+	probe := r.Clone(r.Context())
+	probe.Method = http.MethodOptions
+	if _, pattern := rt.mux.Handler(probe); pattern != "" && pattern != "/" {
+		w.Header().Set("Allow", rt.allow(strings.TrimPrefix(pattern, http.MethodOptions+" ")))
+		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	respondWithError(w, http.StatusNotFound, "Not found")
+}
+
+func (rt *Router) allow(pattern string) string {
+	return strings.Join(append(slices.Clone(rt.methods[pattern]), http.MethodOptions), ", ")
+}
+
+// wrap aplica el rol y el límite de la ruta antes de llamar al handler; la
+// clave de idempotencia se atiende después, así una petición rechazada no
+// reserva la clave
+func (rt *Router) wrap(route Route) http.Handler {
+	if route.Idempotent {
+		route.Handler = rt.idempotent(route.Handler)
+	}
+	switch route.Role {
+	case RoleOrganizer:
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !rt.auth.IsOrganizer(r) {
+				respondWithError(w, http.StatusUnauthorized, "Organizer token required")
+				return
+			}
+			route.Handler(w, r)
+		})
+	case RoleAPIClient:
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := rt.clients.authenticate(w, r)
+			if !ok {
+				return
+			}
+			if route.Scope != "" && !token.HasScope(route.Scope) {
+				w.Header().Set("WWW-Authenticate", `Bearer error="insufficient_scope", scope="`+route.Scope+`"`)
+				respondWithError(w, http.StatusForbidden, "Token lacks scope "+route.Scope)
+				return
+			}
+			if route.RateLimit == RateLimitPerClient && !rt.clients.allow(w, token) {
+				return
+			}
+
+			// El token del cliente no llega al handler: lo tomaría por un
+			// intento de autenticarse como organizador
+			inner := r.Clone(context.WithValue(r.Context(), apiTokenKey{}, token))
+			inner.Header.Del("Authorization")
+			route.Handler(w, inner)
+		})
+	default:
+		return route.Handler
+	}
+}
+
+// apiTokenKey guarda en el contexto el token de las rutas RoleAPIClient
+type apiTokenKey struct{}
+
+// apiToken devuelve el token que validó el router
+func apiToken(r *http.Request) *domain.APIToken {
+	token, _ := r.Context().Value(apiTokenKey{}).(*domain.APIToken)
+	return token
+}
+
+// setCORSHeaders habilita CORS en todas las rutas
+// En C# esto sería similar a app.UseCors() en Program.cs
+func setCORSHeaders(w http.ResponseWriter) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Idempotency-Key")
+	// Las aplicaciones web leen el consumo de su límite de peticiones, el
+	// total de los listados paginados y si la respuesta es una repetición
+	w.Header().Set("Access-Control-Expose-Headers", "X-RateLimit-Limit, X-RateLimit-Remaining, X-RateLimit-Reset, Retry-After, X-Total-Count, Link, Idempotent-Replayed")
+}
+
+// Adaptadores de los comodines de la ruta a las firmas de los handlers. Los
+// mensajes de error son los mismos que daba el despacho a mano.
+
+// pathParam pasa el comodín name sin parsear (los handlers CRUD parsean el ID)
+func pathParam(name string, next func(http.ResponseWriter, *http.Request, string)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		next(w, r, r.PathValue(name))
+	}
+}
+
+// uuidParam parsea el comodín name; si no es un UUID responde
+// 400 "Invalid <label> UUID"
+func uuidParam(name, label string, next func(http.ResponseWriter, *http.Request, uuid.UUID)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseUUID(r.PathValue(name))
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid "+label+" UUID")
+			return
+		}
+		next(w, r, id)
+	}
+}
+
+// uuidParams es uuidParam para rutas con dos IDs (recurso padre e hijo)
+func uuidParams(name, label, childName, childLabel string, next func(http.ResponseWriter, *http.Request, uuid.UUID, uuid.UUID)) http.HandlerFunc {
+	return uuidParam(name, label, func(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+		childID, err := parseUUID(r.PathValue(childName))
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid "+childLabel+" UUID")
+			return
+		}
+		next(w, r, id, childID)
+	})
+}
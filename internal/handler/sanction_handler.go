@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// SanctionHandler atiende /api/tournaments/{id}/sanctions
+type SanctionHandler struct {
+	commands usecase.SanctionCommands
+	queries  usecase.SanctionQueries
+}
+
+func NewSanctionHandler(commands usecase.SanctionCommands, queries usecase.SanctionQueries) *SanctionHandler {
+	return &SanctionHandler{commands: commands, queries: queries}
+}
+
+// Routes son las rutas de /api/tournaments/{id}/sanctions
+func (h *SanctionHandler) Routes() []Route {
+	const base = "/api/tournaments/{id}/sanctions"
+	return []Route{
+		{Method: http.MethodGet, Pattern: base, Handler: uuidParam("id", "tournament", h.GetAll), Summary: "Sanciones del torneo", Response: []domain.Sanction{}},
+		{Method: http.MethodPost, Pattern: base, Handler: uuidParam("id", "tournament", h.Create), Summary: "Registra una sanción", Idempotent: true, Request: sanctionInput{}, Response: domain.Sanction{}},
+		{Method: http.MethodGet, Pattern: base + "/{sanctionId}", Handler: uuidParams("id", "tournament", "sanctionId", "sanction", h.GetByID), Summary: "Obtiene una sanción", Response: domain.Sanction{}},
+		{Method: http.MethodDelete, Pattern: base + "/{sanctionId}", Handler: uuidParams("id", "tournament", "sanctionId", "sanction", h.Revoke), Summary: "Revoca una sanción", Response: domain.Sanction{}},
+	}
+}
+
+// sanctionInput es el cuerpo de POST /api/tournaments/{id}/sanctions
+type sanctionInput struct {
+	Type     string       `json:"type"`
+	TeamID   string       `json:"team_id"`
+	PlayerID string       `json:"player_id"`
+	Fine     domain.Money `json:"fine"`
+	Points   int          `json:"points"`
+	Matches  int          `json:"matches"`
+	Round    int          `json:"round"`
+	Reason   string       `json:"reason"`
+}
+
+// Create registra una sanción:
+// {"type": "point_deduction", "team_id": "...", "points": 3, "round": 5, "reason": "..."}
+// Las multas llevan el importe en unidades menores:
+// {"type": "fine", "team_id": "...", "fine": {"amount": 15000, "currency": "EUR"}, "reason": "..."}
+func (h *SanctionHandler) Create(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	var input sanctionInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	teamID, err := parseOptionalUUID(input.TeamID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid team_id UUID")
+		return
+	}
+	playerID, err := parseOptionalUUID(input.PlayerID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid player_id UUID")
+		return
+	}
+	if err := sanitizeFields(textField{"reason", &input.Reason, maxMessageLength}); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	sanction := domain.NewSanction(tournamentID, input.Type, teamID, playerID, input.Reason)
+	normalizeMoney(&input.Fine)
+	sanction.Fine = input.Fine
+	sanction.Points = input.Points
+	sanction.Matches = input.Matches
+	sanction.Round = input.Round
+	if err := h.commands.CreateSanction(sanction); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	formatMoney(r, &sanction.Fine)
+	respondWithJSON(w, http.StatusCreated, sanction)
+}
+
+// GetAll devuelve el historial disciplinario del torneo, incluidas las
+// sanciones anuladas; acepta ?team_id= y ?player_id=
+func (h *SanctionHandler) GetAll(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	teamID, err := parseOptionalUUID(r.URL.Query().Get("team_id"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid team_id UUID")
+		return
+	}
+	playerID, err := parseOptionalUUID(r.URL.Query().Get("player_id"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid player_id UUID")
+		return
+	}
+
+	sanctions, err := h.queries.GetTournamentSanctions(tournamentID, teamID, playerID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	for i := range sanctions {
+		formatMoney(r, &sanctions[i].Fine)
+	}
+	respondWithFields(w, r, http.StatusOK, sanctions)
+}
+
+func (h *SanctionHandler) GetByID(w http.ResponseWriter, r *http.Request, tournamentID, sanctionID uuid.UUID) {
+	sanction, err := h.queries.GetSanction(tournamentID, sanctionID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	formatMoney(r, &sanction.Fine)
+	respondWithJSON(w, http.StatusOK, sanction)
+}
+
+// Revoke anula la sanción; queda en el historial y deja de contar en la tabla
+func (h *SanctionHandler) Revoke(w http.ResponseWriter, r *http.Request, tournamentID, sanctionID uuid.UUID) {
+	sanction, err := h.commands.RevokeSanction(tournamentID, sanctionID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	formatMoney(r, &sanction.Fine)
+	respondWithJSON(w, http.StatusOK, sanction)
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Largos máximos de los textos, en caracteres (no bytes), alineados con las
+// columnas VARCHAR de las migraciones
+const (
+	maxNameLength      = 255
+	maxShortNameLength = 50
+	maxStageNameLength = 100
+	maxPitchNameLength = 100
+	maxTagNameLength   = 50
+	maxAddressLength   = 500
+	maxLicenseLength   = 50
+	maxPhoneLength     = 50
+	maxCaptionLength   = 500
+	maxMessageLength   = 5000
+)
+
+// escapeHTMLInput activa el escape HTML de los textos recibidos
+var escapeHTMLInput atomic.Bool
+
+// SetHTMLEscaping activa o desactiva el escape HTML de nombres y textos
+// libres. Sirve cuando los datos terminan en páginas o PDFs que no escapan.
+func SetHTMLEscaping(enabled bool) {
+	escapeHTMLInput.Store(enabled)
+}
+
+// textField es un campo de texto de un DTO a normalizar
+type textField struct {
+	name   string
+	value  *string
+	maxLen int
+}
+
+// cleanText normaliza un texto: descarta UTF-8 inválido y caracteres de
+// control, colapsa los espacios (incluidos tabs, saltos de línea y espacios
+// no separables) y recorta los extremos
+func cleanText(value string) string {
+	value = strings.ToValidUTF8(value, "")
+	value = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			// Un salto de línea o tab separa palabras: se reemplaza por espacio
+			return ' '
+		}
+		return r
+	}, value)
+	value = strings.Join(strings.Fields(value), " ")
+	if escapeHTMLInput.Load() {
+		value = html.EscapeString(value)
+	}
+	return value
+}
+
+// sanitizeFields normaliza los campos en el lugar y valida su largo.
+// El largo se mide después de normalizar (y escapar) para que nunca supere
+// el de la columna.
+func sanitizeFields(fields ...textField) error {
+	for _, field := range fields {
+		*field.value = cleanText(*field.value)
+		if utf8.RuneCountInString(*field.value) > field.maxLen {
+			return fmt.Errorf("%s must be at most %d characters", field.name, field.maxLen)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/realtime"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// scoreboardRefreshInterval es cada cuánto el stream revisa el marcador
+// aunque no haya cambios: un partido pasa de "próximo" a "en juego" solo
+// por la hora. Sirve también de heartbeat.
+const scoreboardRefreshInterval = 30 * time.Second
+
+// ScoreboardHandler atiende /api/venues/{id}/scoreboard, pensado para las
+// pantallas de los complejos:
+//
+//	GET /api/venues/{id}/scoreboard         marcador con cache HTTP
+//	GET /api/venues/{id}/scoreboard/stream  el mismo marcador por SSE cada vez que cambia
+type ScoreboardHandler struct {
+	queries usecase.ScoreboardQueries
+	hub     *realtime.Hub
+	maxAge  time.Duration
+}
+
+// NewScoreboardHandler crea el handler; maxAge es el Cache-Control que se
+// permite a navegadores y CDN
+func NewScoreboardHandler(queries usecase.ScoreboardQueries, hub *realtime.Hub, maxAge time.Duration) *ScoreboardHandler {
+	return &ScoreboardHandler{queries: queries, hub: hub, maxAge: maxAge}
+}
+
+// Routes son las rutas del marcador de cada sede
+func (h *ScoreboardHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/venues/{id}/scoreboard", Handler: uuidParam("id", "venue", h.Get), Summary: "Marcador de la sede para pantallas", Response: domain.Scoreboard{}},
+		{Method: http.MethodGet, Pattern: "/api/venues/{id}/scoreboard/stream", Handler: uuidParam("id", "venue", h.Stream), Summary: "Marcador de la sede por Server-Sent Events"},
+	}
+}
+
+// Get devuelve el marcador. El ETag es la hora en que se generó, así las
+// pantallas que consultan seguido reciben 304 mientras no cambie.
+func (h *ScoreboardHandler) Get(w http.ResponseWriter, r *http.Request, venueID uuid.UUID) {
+	scoreboard, err := h.queries.GetScoreboard(venueID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	etag := `"` + strconv.FormatInt(scoreboard.GeneratedAt.UnixNano(), 36) + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(h.maxAge.Seconds())))
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, scoreboard)
+}
+
+// Stream envía el marcador como evento "scoreboard" al conectarse y cada vez
+// que cambia: ante cualquier actualización de un partido y periódicamente
+func (h *ScoreboardHandler) Stream(w http.ResponseWriter, r *http.Request, venueID uuid.UUID) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		respondWithError(w, http.StatusInternalServerError, "Streaming not supported")
+		return
+	}
+
+	// Suscribirse antes del primer marcador para no perder cambios
+	sub := h.hub.Subscribe(nil)
+	defer sub.Close()
+
+	scoreboard, err := h.queries.GetScoreboard(venueID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	last, err := json.Marshal(scoreboard)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := fmt.Fprintf(w, "event: scoreboard\ndata: %s\n\n", last); err != nil {
+		return
+	}
+	flusher.Flush()
+
+	ticker := time.NewTicker(scoreboardRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case _, ok := <-sub.C:
+			if !ok {
+				return
+			}
+		case <-ticker.C:
+		case <-r.Context().Done():
+			return
+		}
+
+		scoreboard, err := h.queries.GetScoreboard(venueID)
+		if err != nil {
+			return
+		}
+		data, err := json.Marshal(scoreboard)
+		if err != nil {
+			return
+		}
+
+		// generated_at cambia en cada cálculo: comparar sin él
+		if sameScoreboard(last, data) {
+			_, err = fmt.Fprint(w, ": ping\n\n")
+		} else {
+			_, err = fmt.Fprintf(w, "event: scoreboard\ndata: %s\n\n", data)
+			last = data
+		}
+		if err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// sameScoreboard compara dos marcadores serializados ignorando generated_at
+func sameScoreboard(a, b []byte) bool {
+	var left, right map[string]json.RawMessage
+	if json.Unmarshal(a, &left) != nil || json.Unmarshal(b, &right) != nil {
+		return false
+	}
+	delete(left, "generated_at")
+	delete(right, "generated_at")
+	for key, value := range left {
+		if !bytes.Equal(value, right[key]) {
+			return false
+		}
+	}
+	return len(left) == len(right)
+}
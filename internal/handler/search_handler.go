@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+)
+
+// SearchHandler atiende /api/search, el buscador único de las pantallas de
+// administración
+type SearchHandler struct {
+	queries usecase.SearchQueries
+}
+
+func NewSearchHandler(queries usecase.SearchQueries) *SearchHandler {
+	return &SearchHandler{queries: queries}
+}
+
+// Routes son las rutas de /api/search
+func (h *SearchHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/search", Handler: h.Search, Summary: "Busca jugadores, equipos y torneos por nombre", Response: []domain.SearchResult{}},
+	}
+}
+
+// Search busca ?q= en los nombres; ?type=team,player limita los tipos y
+// ?limit= es el máximo de resultados de cada tipo
+func (h *SearchHandler) Search(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+
+	var types []string
+	if raw := params.Get("type"); raw != "" {
+		for _, t := range strings.Split(raw, ",") {
+			types = append(types, strings.TrimSpace(t))
+		}
+	}
+	limit := 0
+	if raw := params.Get("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid limit parameter")
+			return
+		}
+		limit = n
+	}
+
+	query, err := domain.NewSearchQuery(params.Get("q"), types, limit)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	results, err := h.queries.Search(query)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, results)
+}
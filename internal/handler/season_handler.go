@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+)
+
+// SeasonHandler atiende /api/seasons
+type SeasonHandler struct {
+	commands usecase.SeasonCommands
+	queries  usecase.SeasonQueries
+}
+
+func NewSeasonHandler(commands usecase.SeasonCommands, queries usecase.SeasonQueries) *SeasonHandler {
+	return &SeasonHandler{commands: commands, queries: queries}
+}
+
+type seasonInput struct {
+	Name     string `json:"name"`
+	StartsOn string `json:"starts_on"`
+	EndsOn   string `json:"ends_on"`
+}
+
+// parse convierte las fechas (RFC3339) de la entrada
+func (input seasonInput) parse() (*domain.Season, error) {
+	startsOn, err := parseDateTime(input.StartsOn)
+	if err != nil {
+		return nil, err
+	}
+	endsOn, err := parseDateTime(input.EndsOn)
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewSeason(input.Name, startsOn, endsOn), nil
+}
+
+// Routes son las rutas de /api/seasons
+func (h *SeasonHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/seasons", Handler: h.GetAll, Summary: "Lista las temporadas", Response: []domain.Season{}},
+		{Method: http.MethodPost, Pattern: "/api/seasons", Handler: h.Create, Summary: "Crea una temporada", Request: seasonInput{}, Response: domain.Season{}, Idempotent: true},
+		{Method: http.MethodGet, Pattern: "/api/seasons/{id}", Handler: pathParam("id", h.GetByID), Summary: "Obtiene una temporada", Response: domain.Season{}},
+		{Method: http.MethodPut, Pattern: "/api/seasons/{id}", Handler: pathParam("id", h.Update), Summary: "Modifica una temporada", Request: seasonInput{}, Response: domain.Season{}},
+		{Method: http.MethodDelete, Pattern: "/api/seasons/{id}", Handler: pathParam("id", h.Delete), Summary: "Borra una temporada", Response: map[string]string{}},
+	}
+}
+
+func (h *SeasonHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var input seasonInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := sanitizeFields(
+		textField{"name", &input.Name, maxShortNameLength},
+	); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	season, err := input.parse()
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid date format")
+		return
+	}
+
+	if err := h.commands.CreateSeason(season); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, season)
+}
+
+func (h *SeasonHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	seasons, err := h.queries.GetAllSeasons()
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, seasons)
+}
+
+func (h *SeasonHandler) GetByID(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
+		return
+	}
+
+	season, err := h.queries.GetSeasonByID(id)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, season)
+}
+
+func (h *SeasonHandler) Update(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
+		return
+	}
+
+	var input seasonInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := sanitizeFields(
+		textField{"name", &input.Name, maxShortNameLength},
+	); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	season, err := input.parse()
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid date format")
+		return
+	}
+	season.ID = id
+
+	if err := h.commands.UpdateSeason(season); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, season)
+}
+
+func (h *SeasonHandler) Delete(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
+		return
+	}
+
+	if err := h.commands.DeleteSeason(id); err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Season deleted"})
+}
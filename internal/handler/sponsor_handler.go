@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// SponsorHandler atiende /api/tournaments/{id}/sponsors
+type SponsorHandler struct {
+	commands usecase.SponsorCommands
+	queries  usecase.SponsorQueries
+}
+
+func NewSponsorHandler(commands usecase.SponsorCommands, queries usecase.SponsorQueries) *SponsorHandler {
+	return &SponsorHandler{commands: commands, queries: queries}
+}
+
+type sponsorInput struct {
+	Name      string `json:"name"`
+	LogoURL   string `json:"logo_url"`
+	LinkURL   string `json:"link_url"`
+	Placement string `json:"placement"`
+	StartsAt  string `json:"starts_at"`
+	EndsAt    string `json:"ends_at"`
+}
+
+// Routes son las rutas de /api/tournaments/{id}/sponsors
+func (h *SponsorHandler) Routes() []Route {
+	const base = "/api/tournaments/{id}/sponsors"
+	return []Route{
+		{Method: http.MethodGet, Pattern: base, Handler: uuidParam("id", "tournament", h.GetAll), Summary: "Patrocinadores del torneo", Response: []domain.Sponsor{}},
+		{Method: http.MethodPost, Pattern: base, Handler: uuidParam("id", "tournament", h.Create), Summary: "Agrega un patrocinador", Idempotent: true, Request: sponsorInput{}, Response: domain.Sponsor{}},
+		{Method: http.MethodGet, Pattern: base + "/active", Handler: uuidParam("id", "tournament", h.GetActive), Summary: "Patrocinadores vigentes (?placement=)", Response: []domain.Sponsor{}},
+		{Method: http.MethodGet, Pattern: base + "/{sponsorId}", Handler: uuidParams("id", "tournament", "sponsorId", "sponsor", h.GetByID), Summary: "Obtiene un patrocinador", Response: domain.Sponsor{}},
+		{Method: http.MethodPut, Pattern: base + "/{sponsorId}", Handler: uuidParams("id", "tournament", "sponsorId", "sponsor", h.Update), Summary: "Modifica un patrocinador", Request: sponsorInput{}, Response: domain.Sponsor{}},
+		{Method: http.MethodDelete, Pattern: base + "/{sponsorId}", Handler: uuidParams("id", "tournament", "sponsorId", "sponsor", h.Delete), Summary: "Quita un patrocinador", Response: map[string]string{}},
+	}
+}
+
+func (h *SponsorHandler) decode(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) (*domain.Sponsor, bool) {
+	var input sponsorInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return nil, false
+	}
+
+	if err := sanitizeFields(
+		textField{"name", &input.Name, maxNameLength},
+	); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+
+	startsAt, err := parseDateTime(input.StartsAt)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid starts_at format")
+		return nil, false
+	}
+
+	endsAt, err := parseDateTime(input.EndsAt)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid ends_at format")
+		return nil, false
+	}
+
+	return domain.NewSponsor(tournamentID, input.Name, input.LogoURL, input.LinkURL, input.Placement, startsAt, endsAt), true
+}
+
+func (h *SponsorHandler) Create(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	sponsor, ok := h.decode(w, r, tournamentID)
+	if !ok {
+		return
+	}
+
+	if err := h.commands.CreateSponsor(sponsor); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, sponsor)
+}
+
+func (h *SponsorHandler) GetAll(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	sponsors, err := h.queries.GetTournamentSponsors(tournamentID)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, sponsors)
+}
+
+func (h *SponsorHandler) GetActive(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	sponsors, err := h.queries.GetActiveSponsors(tournamentID, r.URL.Query().Get("placement"))
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Los banners cambian poco: permitir cache corto en el navegador/CDN
+	w.Header().Set("Cache-Control", "public, max-age=60")
+	respondWithFields(w, r, http.StatusOK, sponsors)
+}
+
+func (h *SponsorHandler) GetByID(w http.ResponseWriter, r *http.Request, tournamentID, sponsorID uuid.UUID) {
+	sponsor, err := h.queries.GetSponsor(tournamentID, sponsorID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, sponsor)
+}
+
+func (h *SponsorHandler) Update(w http.ResponseWriter, r *http.Request, tournamentID, sponsorID uuid.UUID) {
+	sponsor, ok := h.decode(w, r, tournamentID)
+	if !ok {
+		return
+	}
+	sponsor.ID = sponsorID
+
+	if err := h.commands.UpdateSponsor(sponsor); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, sponsor)
+}
+
+func (h *SponsorHandler) Delete(w http.ResponseWriter, r *http.Request, tournamentID, sponsorID uuid.UUID) {
+	if err := h.commands.DeleteSponsor(tournamentID, sponsorID); err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Sponsor deleted"})
+}
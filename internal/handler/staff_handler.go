@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// StaffHandler atiende /api/teams/{id}/staff
+type StaffHandler struct {
+	commands usecase.StaffCommands
+	queries  usecase.StaffQueries
+}
+
+func NewStaffHandler(commands usecase.StaffCommands, queries usecase.StaffQueries) *StaffHandler {
+	return &StaffHandler{commands: commands, queries: queries}
+}
+
+type staffInput struct {
+	Name  string `json:"name"`
+	Role  string `json:"role"`
+	Email string `json:"email"`
+	Phone string `json:"phone"`
+}
+
+// Routes son las rutas de /api/teams/{id}/staff
+func (h *StaffHandler) Routes() []Route {
+	const base = "/api/teams/{id}/staff"
+	return []Route{
+		{Method: http.MethodGet, Pattern: base, Handler: uuidParam("id", "team", h.GetAll), Summary: "Cuerpo técnico del equipo", Response: []domain.Staff{}},
+		{Method: http.MethodPost, Pattern: base, Handler: uuidParam("id", "team", h.Create), Summary: "Agrega un miembro del cuerpo técnico", Idempotent: true, Request: staffInput{}, Response: domain.Staff{}},
+		{Method: http.MethodGet, Pattern: base + "/{staffId}", Handler: uuidParams("id", "team", "staffId", "staff", h.GetByID), Summary: "Obtiene un miembro del cuerpo técnico", Response: domain.Staff{}},
+		{Method: http.MethodPut, Pattern: base + "/{staffId}", Handler: uuidParams("id", "team", "staffId", "staff", h.Update), Summary: "Modifica un miembro del cuerpo técnico", Request: staffInput{}, Response: domain.Staff{}},
+		{Method: http.MethodDelete, Pattern: base + "/{staffId}", Handler: uuidParams("id", "team", "staffId", "staff", h.Delete), Summary: "Quita un miembro del cuerpo técnico", Response: map[string]string{}},
+	}
+}
+
+func (h *StaffHandler) decode(w http.ResponseWriter, r *http.Request, teamID uuid.UUID) (*domain.Staff, bool) {
+	var input staffInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return nil, false
+	}
+
+	if err := sanitizeFields(
+		textField{"name", &input.Name, maxNameLength},
+		textField{"email", &input.Email, maxNameLength},
+		textField{"phone", &input.Phone, maxPhoneLength},
+	); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+
+	staff := domain.NewStaff(teamID, input.Name, input.Role)
+	staff.Email = input.Email
+	staff.Phone = input.Phone
+	return staff, true
+}
+
+func (h *StaffHandler) Create(w http.ResponseWriter, r *http.Request, teamID uuid.UUID) {
+	staff, ok := h.decode(w, r, teamID)
+	if !ok {
+		return
+	}
+
+	if err := h.commands.CreateStaff(staff); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, staff)
+}
+
+func (h *StaffHandler) GetAll(w http.ResponseWriter, r *http.Request, teamID uuid.UUID) {
+	members, err := h.queries.GetTeamStaff(teamID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, members)
+}
+
+func (h *StaffHandler) GetByID(w http.ResponseWriter, r *http.Request, teamID, staffID uuid.UUID) {
+	staff, err := h.queries.GetStaff(teamID, staffID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, staff)
+}
+
+func (h *StaffHandler) Update(w http.ResponseWriter, r *http.Request, teamID, staffID uuid.UUID) {
+	staff, ok := h.decode(w, r, teamID)
+	if !ok {
+		return
+	}
+	staff.ID = staffID
+
+	if err := h.commands.UpdateStaff(staff); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, staff)
+}
+
+func (h *StaffHandler) Delete(w http.ResponseWriter, r *http.Request, teamID, staffID uuid.UUID) {
+	if err := h.commands.DeleteStaff(teamID, staffID); err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Staff member deleted"})
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// StageHandler atiende /api/tournaments/{id}/stages
+type StageHandler struct {
+	commands usecase.StageCommands
+	queries  usecase.StageQueries
+}
+
+func NewStageHandler(commands usecase.StageCommands, queries usecase.StageQueries) *StageHandler {
+	return &StageHandler{commands: commands, queries: queries}
+}
+
+// Routes son las rutas de /api/tournaments/{id}/stages
+func (h *StageHandler) Routes() []Route {
+	const base = "/api/tournaments/{id}/stages"
+	return []Route{
+		{Method: http.MethodGet, Pattern: base, Handler: uuidParam("id", "tournament", h.GetAll), Summary: "Fases del torneo", Response: []domain.Stage{}},
+		{Method: http.MethodPost, Pattern: base, Handler: uuidParam("id", "tournament", h.Create), Summary: "Crea una fase", Idempotent: true, Request: stageInput{}, Response: domain.Stage{}},
+		{Method: http.MethodPost, Pattern: base + "/advance", Handler: uuidParam("id", "tournament", h.Advance), Summary: "Pasa los clasificados a la fase siguiente", Response: []domain.Stage{}},
+		{Method: http.MethodGet, Pattern: base + "/{stageId}", Handler: uuidParams("id", "tournament", "stageId", "stage", h.GetByID), Summary: "Obtiene una fase", Response: domain.Stage{}},
+		{Method: http.MethodDelete, Pattern: base + "/{stageId}", Handler: uuidParams("id", "tournament", "stageId", "stage", h.Delete), Summary: "Borra una fase", Response: map[string]string{}},
+		{Method: http.MethodGet, Pattern: base + "/{stageId}/matches", Handler: uuidParams("id", "tournament", "stageId", "stage", h.GetMatches), Summary: "Partidos de la fase", Response: []domain.Match{}},
+	}
+}
+
+// stageInput es el cuerpo de POST /api/tournaments/{id}/stages
+type stageInput struct {
+	Name     string `json:"name"`
+	Position int    `json:"position"`
+}
+
+func (h *StageHandler) Create(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	var input stageInput
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := sanitizeFields(
+		textField{"name", &input.Name, maxStageNameLength},
+	); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	stage := domain.NewStage(tournamentID, input.Name, input.Position)
+	if err := h.commands.CreateStage(stage); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, stage)
+}
+
+func (h *StageHandler) GetAll(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	stages, err := h.queries.GetTournamentStages(tournamentID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, stages)
+}
+
+func (h *StageHandler) GetByID(w http.ResponseWriter, r *http.Request, tournamentID, stageID uuid.UUID) {
+	stage, err := h.queries.GetStage(tournamentID, stageID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, stage)
+}
+
+func (h *StageHandler) GetMatches(w http.ResponseWriter, r *http.Request, tournamentID, stageID uuid.UUID) {
+	matches, err := h.queries.GetStageMatches(tournamentID, stageID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, matches)
+}
+
+func (h *StageHandler) Advance(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	stages, err := h.commands.AdvanceStage(tournamentID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, stages)
+}
+
+func (h *StageHandler) Delete(w http.ResponseWriter, r *http.Request, tournamentID, stageID uuid.UUID) {
+	if err := h.commands.DeleteStage(tournamentID, stageID); err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Stage deleted"})
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// StatsHandler atiende las tablas de jugadores de un torneo
+type StatsHandler struct {
+	queries usecase.StatsQueries
+	auth    *OrganizerAuth
+}
+
+func NewStatsHandler(queries usecase.StatsQueries, auth *OrganizerAuth) *StatsHandler {
+	return &StatsHandler{queries: queries, auth: auth}
+}
+
+// Routes son las rutas de las tablas de jugadores del torneo
+func (h *StatsHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/tournaments/{id}/topscorers", Handler: uuidParam("id", "tournament", h.TopScorers), Summary: "Tabla de goleadores", Response: []domain.TopScorer{}},
+		{Method: http.MethodGet, Pattern: "/api/tournaments/{id}/assists", Handler: uuidParam("id", "tournament", h.TopAssists), Summary: "Tabla de asistencias", Response: []domain.TopAssister{}},
+		{Method: http.MethodGet, Pattern: "/api/tournaments/{id}/cleansheets", Handler: uuidParam("id", "tournament", h.CleanSheets), Summary: "Vallas invictas", Response: domain.CleanSheets{}},
+	}
+}
+
+// TopScorers devuelve la tabla de goleadores del torneo
+func (h *StatsHandler) TopScorers(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	scorers, err := h.queries.GetTopScorers(tournamentID, h.auth.IsOrganizer(r))
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, scorers)
+}
+
+// TopAssists devuelve la tabla de asistidores del torneo
+func (h *StatsHandler) TopAssists(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	assisters, err := h.queries.GetTopAssists(tournamentID, h.auth.IsOrganizer(r))
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, assisters)
+}
+
+// CleanSheets devuelve vallas invictas y goles recibidos por equipo y por arquero
+func (h *StatsHandler) CleanSheets(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	cleanSheets, err := h.queries.GetCleanSheets(tournamentID, h.auth.IsOrganizer(r))
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, cleanSheets)
+}
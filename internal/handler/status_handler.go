@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+)
+
+// StatusHandler atiende /api/status, el resumen público que consulta la
+// página de estado
+type StatusHandler struct {
+	queries usecase.StatusQueries
+}
+
+func NewStatusHandler(queries usecase.StatusQueries) *StatusHandler {
+	return &StatusHandler{queries: queries}
+}
+
+// Routes es la ruta de /api/status
+func (h *StatusHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/status", Handler: h.Get, Summary: "Estado de los servicios", Response: domain.ServiceStatus{}},
+	}
+}
+
+func (h *StatusHandler) Get(w http.ResponseWriter, r *http.Request) {
+	status, err := h.queries.GetStatus(r.Context())
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// La página de estado consulta seguido: un cache corto evita que cada
+	// visitante dispare los chequeos
+	w.Header().Set("Cache-Control", "public, max-age=10")
+	respondWithJSON(w, http.StatusOK, status)
+}
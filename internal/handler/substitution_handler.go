@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// SubstitutionHandler atiende /api/matches/{id}/substitutions
+type SubstitutionHandler struct {
+	commands usecase.SubstitutionCommands
+	queries  usecase.SubstitutionQueries
+}
+
+func NewSubstitutionHandler(commands usecase.SubstitutionCommands, queries usecase.SubstitutionQueries) *SubstitutionHandler {
+	return &SubstitutionHandler{commands: commands, queries: queries}
+}
+
+// Routes son las rutas de /api/matches/{id}/substitutions
+func (h *SubstitutionHandler) Routes() []Route {
+	const base = "/api/matches/{id}/substitutions"
+	return []Route{
+		{Method: http.MethodGet, Pattern: base, Handler: uuidParam("id", "match", h.GetAll), Summary: "Cambios del partido", Response: []domain.Substitution{}},
+		{Method: http.MethodPost, Pattern: base, Handler: uuidParam("id", "match", h.Create), Summary: "Registra un cambio", Idempotent: true, Request: substitutionInput{}, Response: domain.Substitution{}},
+		{Method: http.MethodGet, Pattern: base + "/{substitutionId}", Handler: uuidParams("id", "match", "substitutionId", "substitution", h.GetByID), Summary: "Obtiene un cambio", Response: domain.Substitution{}},
+		{Method: http.MethodDelete, Pattern: base + "/{substitutionId}", Handler: uuidParams("id", "match", "substitutionId", "substitution", h.Delete), Summary: "Anula un cambio", Response: map[string]string{}},
+	}
+}
+
+// substitutionInput es el cuerpo de POST /api/matches/{id}/substitutions
+type substitutionInput struct {
+	TeamID      string `json:"team_id"`
+	PlayerOutID string `json:"player_out_id"`
+	PlayerInID  string `json:"player_in_id"`
+	Minute      int    `json:"minute"`
+}
+
+func (h *SubstitutionHandler) Create(w http.ResponseWriter, r *http.Request, matchID uuid.UUID) {
+	var input substitutionInput
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	teamID, err := parseUUID(input.TeamID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid team_id")
+		return
+	}
+
+	playerOutID, err := parseUUID(input.PlayerOutID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid player_out_id")
+		return
+	}
+
+	playerInID, err := parseUUID(input.PlayerInID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid player_in_id")
+		return
+	}
+
+	substitution := domain.NewSubstitution(matchID, teamID, playerOutID, playerInID, input.Minute)
+	if err := h.commands.CreateSubstitution(substitution); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, substitution)
+}
+
+func (h *SubstitutionHandler) GetAll(w http.ResponseWriter, r *http.Request, matchID uuid.UUID) {
+	substitutions, err := h.queries.GetMatchSubstitutions(matchID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, substitutions)
+}
+
+func (h *SubstitutionHandler) GetByID(w http.ResponseWriter, r *http.Request, matchID, substitutionID uuid.UUID) {
+	substitution, err := h.queries.GetSubstitution(matchID, substitutionID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, substitution)
+}
+
+func (h *SubstitutionHandler) Delete(w http.ResponseWriter, r *http.Request, matchID, substitutionID uuid.UUID) {
+	if err := h.commands.DeleteSubstitution(matchID, substitutionID); err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Substitution deleted"})
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// SyncConflictHandler atiende /api/conflicts: los conflictos que dejan los
+// clientes que cargaron partidos sin conexión y su resolución
+type SyncConflictHandler struct {
+	commands usecase.SyncConflictCommands
+	queries  usecase.SyncConflictQueries
+}
+
+func NewSyncConflictHandler(commands usecase.SyncConflictCommands, queries usecase.SyncConflictQueries) *SyncConflictHandler {
+	return &SyncConflictHandler{commands: commands, queries: queries}
+}
+
+// Routes son las rutas de /api/conflicts
+func (h *SyncConflictHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/conflicts", Handler: h.GetAll, Summary: "Lista los conflictos de sincronización (?status=open)", Response: []domain.SyncConflict{}},
+		{Method: http.MethodGet, Pattern: "/api/conflicts/{id}", Handler: uuidParam("id", "conflict", h.GetByID), Summary: "Obtiene un conflicto", Response: domain.SyncConflict{}},
+		{Method: http.MethodPost, Pattern: "/api/conflicts/{id}/resolve", Handler: uuidParam("id", "conflict", h.Resolve), Summary: "Resuelve un conflicto", Request: conflictResolutionInput{}, Response: domain.SyncConflict{}},
+	}
+}
+
+func (h *SyncConflictHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	conflicts, err := h.queries.GetConflicts(r.URL.Query().Get("status"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, conflicts)
+}
+
+func (h *SyncConflictHandler) GetByID(w http.ResponseWriter, r *http.Request, conflictID uuid.UUID) {
+	conflict, err := h.queries.GetConflictByID(conflictID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, conflict)
+}
+
+// conflictResolutionInput es el cuerpo de POST /api/conflicts/{id}/resolve
+type conflictResolutionInput struct {
+	Resolution string `json:"resolution"`
+}
+
+func (h *SyncConflictHandler) Resolve(w http.ResponseWriter, r *http.Request, conflictID uuid.UUID) {
+	var input conflictResolutionInput
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	conflict, err := h.commands.ResolveConflict(conflictID, input.Resolution)
+	if err != nil {
+		respondWithSyncError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, conflict)
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+)
+
+// SyncHandler atiende /api/sync/batch, usado por las tablets de los
+// planilleros que acumulan operaciones mientras no tienen conexión
+type SyncHandler struct {
+	commands usecase.SyncCommands
+}
+
+func NewSyncHandler(commands usecase.SyncCommands) *SyncHandler {
+	return &SyncHandler{commands: commands}
+}
+
+// Routes son las rutas de /api/sync
+func (h *SyncHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPost, Pattern: "/api/sync/batch", Handler: h.Batch, Summary: "Aplica un lote de operaciones cargadas sin conexión", Request: syncBatchInput{}, Response: syncBatchResponse{}},
+	}
+}
+
+// syncBatchResponse es la respuesta de POST /api/sync/batch, en el orden
+// de las operaciones
+type syncBatchResponse struct {
+	Results []domain.SyncOpResult `json:"results"`
+}
+
+// syncBatchInput es el lote de POST /api/sync/batch
+type syncBatchInput struct {
+	Operations []domain.SyncOperation `json:"operations"`
+}
+
+// Batch responde 200 con un resultado por operación aunque algunas se
+// rechacen; solo un lote vacío o mal formado devuelve 400
+func (h *SyncHandler) Batch(w http.ResponseWriter, r *http.Request) {
+	var input syncBatchInput
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	results, err := h.commands.ApplyBatch(input.Operations)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, syncBatchResponse{Results: results})
+}
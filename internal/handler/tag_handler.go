@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// TagHandler atiende /api/tags y las etiquetas de cada entidad
+// (/api/teams/{id}/tags, /api/players/{id}/tags, /api/matches/{id}/tags)
+type TagHandler struct {
+	commands usecase.TagCommands
+	queries  usecase.TagQueries
+}
+
+func NewTagHandler(commands usecase.TagCommands, queries usecase.TagQueries) *TagHandler {
+	return &TagHandler{commands: commands, queries: queries}
+}
+
+type tagInput struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// Routes son las rutas de /api/tags y las de las etiquetas de cada entidad
+func (h *TagHandler) Routes() []Route {
+	routes := []Route{
+		{Method: http.MethodGet, Pattern: "/api/tags", Handler: h.GetAll, Summary: "Lista las etiquetas", Response: []domain.Tag{}},
+		{Method: http.MethodPost, Pattern: "/api/tags", Handler: h.Create, Summary: "Crea una etiqueta", Idempotent: true, Request: tagInput{}, Response: domain.Tag{}},
+		{Method: http.MethodGet, Pattern: "/api/tags/{id}", Handler: pathParam("id", h.GetByID), Summary: "Obtiene una etiqueta", Response: domain.Tag{}},
+		{Method: http.MethodPut, Pattern: "/api/tags/{id}", Handler: pathParam("id", h.Update), Summary: "Modifica una etiqueta", Request: tagInput{}, Response: domain.Tag{}},
+		{Method: http.MethodDelete, Pattern: "/api/tags/{id}", Handler: pathParam("id", h.Delete), Summary: "Borra una etiqueta", Response: map[string]string{}},
+	}
+
+	for _, entity := range []struct{ name, prefix, label, noun string }{
+		{domain.TagEntityTeam, "/api/teams", "team", "equipo"},
+		{domain.TagEntityPlayer, "/api/players", "player", "jugador"},
+		{domain.TagEntityMatch, "/api/matches", "match", "partido"},
+	} {
+		routes = append(routes,
+			Route{Method: http.MethodGet, Pattern: entity.prefix + "/{id}/tags", Handler: uuidParam("id", entity.label, h.entityTags(entity.name)), Summary: "Etiquetas del " + entity.noun, Response: []domain.Tag{}},
+			Route{Method: http.MethodPut, Pattern: entity.prefix + "/{id}/tags/{tagId}", Handler: uuidParams("id", entity.label, "tagId", "tag", h.attachTag(entity.name)), Summary: "Etiqueta el " + entity.noun, Response: map[string]string{}},
+			Route{Method: http.MethodDelete, Pattern: entity.prefix + "/{id}/tags/{tagId}", Handler: uuidParams("id", entity.label, "tagId", "tag", h.detachTag(entity.name)), Summary: "Quita la etiqueta del " + entity.noun, Response: map[string]string{}},
+		)
+	}
+	return routes
+}
+
+func (h *TagHandler) decode(w http.ResponseWriter, r *http.Request) (*domain.Tag, bool) {
+	var input tagInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return nil, false
+	}
+
+	if err := sanitizeFields(textField{"name", &input.Name, maxTagNameLength}); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+
+	return domain.NewTag(input.Name, strings.TrimSpace(input.Color)), true
+}
+
+func (h *TagHandler) Create(w http.ResponseWriter, r *http.Request) {
+	tag, ok := h.decode(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.commands.CreateTag(tag); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, tag)
+}
+
+func (h *TagHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	tags, err := h.queries.GetAllTags()
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, tags)
+}
+
+func (h *TagHandler) GetByID(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
+		return
+	}
+
+	tag, err := h.queries.GetTagByID(id)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, tag)
+}
+
+func (h *TagHandler) Update(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
+		return
+	}
+
+	tag, ok := h.decode(w, r)
+	if !ok {
+		return
+	}
+	tag.ID = id
+
+	if err := h.commands.UpdateTag(tag); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, tag)
+}
+
+func (h *TagHandler) Delete(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
+		return
+	}
+
+	if err := h.commands.DeleteTag(id); err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Tag deleted"})
+}
+
+// entityTags lista las etiquetas de la entidad
+func (h *TagHandler) entityTags(entity string) func(http.ResponseWriter, *http.Request, uuid.UUID) {
+	return func(w http.ResponseWriter, r *http.Request, entityID uuid.UUID) {
+		tags, err := h.queries.GetEntityTags(entity, entityID)
+		if err != nil {
+			respondWithError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		respondWithJSON(w, http.StatusOK, tags)
+	}
+}
+
+func (h *TagHandler) attachTag(entity string) func(http.ResponseWriter, *http.Request, uuid.UUID, uuid.UUID) {
+	return func(w http.ResponseWriter, r *http.Request, entityID, tagID uuid.UUID) {
+		if err := h.commands.AttachTag(entity, entityID, tagID); err != nil {
+			respondWithError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		respondWithJSON(w, http.StatusOK, map[string]string{"message": "Tag attached"})
+	}
+}
+
+func (h *TagHandler) detachTag(entity string) func(http.ResponseWriter, *http.Request, uuid.UUID, uuid.UUID) {
+	return func(w http.ResponseWriter, r *http.Request, entityID, tagID uuid.UUID) {
+		if err := h.commands.DetachTag(entity, entityID, tagID); err != nil {
+			respondWithError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		respondWithJSON(w, http.StatusOK, map[string]string{"message": "Tag removed"})
+	}
+}
+
+// taggedIDs resuelve el filtro ?tag= (ID o nombre) de los listados. Sin
+// filtro devuelve nil; si la etiqueta no existe responde 404 y ok es false.
+func (h *TagHandler) taggedIDs(w http.ResponseWriter, r *http.Request, entity string) (map[uuid.UUID]bool, bool) {
+	tag := r.URL.Query().Get("tag")
+	if tag == "" {
+		return nil, true
+	}
+
+	ids, err := h.queries.GetTaggedIDs(entity, tag)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return nil, false
+	}
+
+	tagged := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		tagged[id] = true
+	}
+	return tagged, true
+}
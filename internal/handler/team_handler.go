@@ -2,8 +2,8 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
-	"strings"
 
 	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
 	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
@@ -11,88 +11,79 @@ import (
 )
 
 type TeamHandler struct {
-	useCase *usecase.TeamUseCase
+	commands usecase.TeamCommands
+	queries  usecase.TeamQueries
+	tags     *TagHandler
 }
 
-func NewTeamHandler(useCase *usecase.TeamUseCase) *TeamHandler {
-	return &TeamHandler{useCase: useCase}
+func NewTeamHandler(commands usecase.TeamCommands, queries usecase.TeamQueries, tags *TagHandler) *TeamHandler {
+	return &TeamHandler{commands: commands, queries: queries, tags: tags}
 }
 
-func (h *TeamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	path := strings.TrimPrefix(r.URL.Path, "/api/teams")
-	path = strings.Trim(path, "/")
-	segments := strings.Split(path, "/")
-
-	// Manejar rutas como /api/teams/{id}/players/{playerId}
-	if len(segments) >= 3 && segments[1] == "players" {
-		teamID, err := uuid.Parse(segments[0])
-		if err != nil {
-			respondWithError(w, http.StatusBadRequest, "Invalid team UUID")
-			return
-		}
-
-		playerID, err := uuid.Parse(segments[2])
-		if err != nil {
-			respondWithError(w, http.StatusBadRequest, "Invalid player UUID")
-			return
-		}
+// Routes son las rutas de /api/teams y de su plantilla
+func (h *TeamHandler) Routes() []Route {
+	const member = "/api/teams/{id}/players/{playerId}"
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/teams/check-name", Handler: h.CheckName, Summary: "Comprueba si un nombre de equipo está libre", Response: domain.NameCheck{}},
+		{Method: http.MethodGet, Pattern: "/api/teams", Handler: h.GetAll, Summary: "Lista los equipos (paginado, ?tag=)", Response: []domain.Team{}},
+		{Method: http.MethodPost, Pattern: "/api/teams", Handler: h.Create, Summary: "Crea un equipo", Request: teamInput{}, Response: domain.Team{}, Idempotent: true},
+		{Method: http.MethodGet, Pattern: "/api/teams/{id}", Handler: pathParam("id", h.GetByID), Summary: "Obtiene un equipo", Response: domain.Team{}},
+		{Method: http.MethodPut, Pattern: "/api/teams/{id}", Handler: pathParam("id", h.Update), Summary: "Modifica un equipo", Request: teamUpdateInput{}, Response: domain.Team{}},
+		{Method: http.MethodDelete, Pattern: "/api/teams/{id}", Handler: pathParam("id", h.Delete), Summary: "Borra un equipo", Response: map[string]string{}},
+		{Method: http.MethodGet, Pattern: "/api/teams/{id}/players", Handler: uuidParam("id", "team", h.GetTeamPlayers), Summary: "Plantilla del equipo", Response: []domain.Player{}},
+		{Method: http.MethodPost, Pattern: member, Handler: uuidParams("id", "team", "playerId", "player", h.AddPlayer), Summary: "Agrega un jugador a la plantilla", Response: map[string]string{}},
+		{Method: http.MethodPut, Pattern: member, Handler: uuidParams("id", "team", "playerId", "player", h.SetJerseyNumber), Summary: "Cambia el dorsal de un jugador", Request: jerseyNumberInput{}, Response: map[string]string{}},
+		{Method: http.MethodDelete, Pattern: member, Handler: uuidParams("id", "team", "playerId", "player", h.RemovePlayer), Summary: "Quita un jugador de la plantilla", Response: map[string]string{}},
+		{Method: http.MethodPut, Pattern: member + "/roles", Handler: uuidParams("id", "team", "playerId", "player", h.SetPlayerRoles), Summary: "Asigna capitán y otros roles", Request: playerRolesInput{}, Response: map[string]string{}},
+	}
+}
 
-		switch r.Method {
-		case http.MethodPost:
-			h.AddPlayer(w, r, teamID, playerID)
-		case http.MethodDelete:
-			h.RemovePlayer(w, r, teamID, playerID)
-		default:
-			respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
-		}
+func (h *TeamHandler) CheckName(w http.ResponseWriter, r *http.Request) {
+	name, err := nameToCheck(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	// Manejar rutas como /api/teams/{id}/players
-	if len(segments) == 2 && segments[1] == "players" {
-		teamID, err := uuid.Parse(segments[0])
-		if err != nil {
-			respondWithError(w, http.StatusBadRequest, "Invalid team UUID")
-			return
-		}
-
-		if r.Method == http.MethodGet {
-			h.GetTeamPlayers(w, r, teamID)
-			return
-		}
+	excludeID, err := parseOptionalUUID(r.URL.Query().Get("exclude_id"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid exclude_id UUID")
+		return
 	}
 
-	// Rutas estándar CRUD
-	switch r.Method {
-	case http.MethodGet:
-		if path == "" {
-			h.GetAll(w, r)
-		} else {
-			h.GetByID(w, r, path)
-		}
-	case http.MethodPost:
-		h.Create(w, r)
-	case http.MethodPut:
-		h.Update(w, r, path)
-	case http.MethodDelete:
-		h.Delete(w, r, path)
-	default:
-		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	check, err := h.queries.CheckTeamName(name, excludeID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
 	}
+
+	respondWithJSON(w, http.StatusOK, check)
+}
+
+// teamInput es el cuerpo de POST /api/teams
+type teamInput struct {
+	Name   string `json:"name"`
+	IsTest bool   `json:"is_test"`
 }
 
 func (h *TeamHandler) Create(w http.ResponseWriter, r *http.Request) {
-	var input struct {
-		Name string `json:"name"`
-	}
+	var input teamInput
 
 	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
 		return
 	}
 
+	if err := sanitizeFields(
+		textField{"name", &input.Name, maxNameLength},
+	); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	team := domain.NewTeam(input.Name)
-	if err := h.useCase.CreateTeam(team); err != nil {
+	team.IsTest = input.IsTest
+	if err := h.commands.CreateTeam(team); err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -100,24 +91,60 @@ func (h *TeamHandler) Create(w http.ResponseWriter, r *http.Request) {
 	respondWithJSON(w, http.StatusCreated, team)
 }
 
+// GetAll lista los equipos; ?tag= (ID o nombre) filtra por etiqueta y
+// ?format=csv exporta el listado completo
 func (h *TeamHandler) GetAll(w http.ResponseWriter, r *http.Request) {
-	teams, err := h.useCase.GetAllTeams()
+	page, err := parsePage(r, domain.TeamSortFields)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	tagged, ok := h.tags.taggedIDs(w, r, domain.TagEntityTeam)
+	if !ok {
+		return
+	}
+
+	if tagged == nil && !wantsCSV(r) {
+		paged, err := h.queries.GetTeamsPage(page)
+		if err != nil {
+			respondWithError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		respondWithPage(w, r, paged)
+		return
+	}
+
+	// Con etiqueta se filtra el listado completo y se pagina el resultado;
+	// el CSV lo exporta entero
+	teams, err := h.queries.GetAllTeams()
 	if err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	respondWithJSON(w, http.StatusOK, teams)
+	filtered := []domain.Team{}
+	for _, team := range teams {
+		if tagged == nil || tagged[team.ID] {
+			filtered = append(filtered, team)
+		}
+	}
+	domain.SortTeams(filtered, page.Sort)
+
+	if wantsCSV(r) {
+		respondWithCSV(w, "teams.csv", teamCSVColumns, filtered)
+		return
+	}
+	respondWithPage(w, r, domain.SlicePage(filtered, page))
 }
 
 func (h *TeamHandler) GetByID(w http.ResponseWriter, r *http.Request, idStr string) {
-	id, err := uuid.Parse(idStr)
+	id, err := parseUUID(idStr)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
 		return
 	}
 
-	team, err := h.useCase.GetTeamByID(id)
+	team, err := h.queries.GetTeamByID(id)
 	if err != nil {
 		respondWithError(w, http.StatusNotFound, err.Error())
 		return
@@ -126,24 +153,34 @@ func (h *TeamHandler) GetByID(w http.ResponseWriter, r *http.Request, idStr stri
 	respondWithJSON(w, http.StatusOK, team)
 }
 
+// teamUpdateInput es el cuerpo de PUT /api/teams/{id}
+type teamUpdateInput struct {
+	Name string `json:"name"`
+}
+
 func (h *TeamHandler) Update(w http.ResponseWriter, r *http.Request, idStr string) {
-	id, err := uuid.Parse(idStr)
+	id, err := parseUUID(idStr)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
 		return
 	}
 
-	var input struct {
-		Name string `json:"name"`
-	}
+	var input teamUpdateInput
 
 	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
 		return
 	}
 
+	if err := sanitizeFields(
+		textField{"name", &input.Name, maxNameLength},
+	); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	team := &domain.Team{ID: id, Name: input.Name}
-	if err := h.useCase.UpdateTeam(team); err != nil {
+	if err := h.commands.UpdateTeam(team); err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -152,13 +189,13 @@ func (h *TeamHandler) Update(w http.ResponseWriter, r *http.Request, idStr strin
 }
 
 func (h *TeamHandler) Delete(w http.ResponseWriter, r *http.Request, idStr string) {
-	id, err := uuid.Parse(idStr)
+	id, err := parseUUID(idStr)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
 		return
 	}
 
-	if err := h.useCase.DeleteTeam(id); err != nil {
+	if err := h.commands.DeleteTeam(id); err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -167,7 +204,16 @@ func (h *TeamHandler) Delete(w http.ResponseWriter, r *http.Request, idStr strin
 }
 
 func (h *TeamHandler) AddPlayer(w http.ResponseWriter, r *http.Request, teamID, playerID uuid.UUID) {
-	if err := h.useCase.AddPlayerToTeam(teamID, playerID); err != nil {
+	if err := h.commands.AddPlayerToTeam(teamID, playerID); err != nil {
+		var fullErr *domain.RosterFullError
+		if errors.As(err, &fullErr) {
+			respondWithJSON(w, http.StatusConflict, map[string]interface{}{
+				"error":          err.Error(),
+				"max_squad_size": fullErr.MaxSquadSize,
+				"tournament_id":  fullErr.TournamentID,
+			})
+			return
+		}
 		respondWithError(w, http.StatusBadRequest, err.Error())
 		return
 	}
@@ -175,8 +221,53 @@ func (h *TeamHandler) AddPlayer(w http.ResponseWriter, r *http.Request, teamID,
 	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Player added to team"})
 }
 
+// jerseyNumberInput es el cuerpo de PUT /api/teams/{id}/players/{playerId}
+type jerseyNumberInput struct {
+	JerseyNumber *int `json:"jersey_number"`
+}
+
+// SetJerseyNumber asigna el dorsal del jugador en el equipo ({"jersey_number": 10} o null)
+func (h *TeamHandler) SetJerseyNumber(w http.ResponseWriter, r *http.Request, teamID, playerID uuid.UUID) {
+	var input jerseyNumberInput
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := h.commands.SetJerseyNumber(teamID, playerID, input.JerseyNumber); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Jersey number updated"})
+}
+
+// playerRolesInput es el cuerpo de PUT /api/teams/{id}/players/{playerId}/roles
+type playerRolesInput struct {
+	Roles []string `json:"roles"`
+}
+
+// SetPlayerRoles reemplaza los roles del jugador en el equipo
+// ({"roles": ["captain", "goalkeeper"]}; [] los quita)
+func (h *TeamHandler) SetPlayerRoles(w http.ResponseWriter, r *http.Request, teamID, playerID uuid.UUID) {
+	var input playerRolesInput
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := h.commands.SetPlayerRoles(teamID, playerID, input.Roles); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Player roles updated"})
+}
+
 func (h *TeamHandler) RemovePlayer(w http.ResponseWriter, r *http.Request, teamID, playerID uuid.UUID) {
-	if err := h.useCase.RemovePlayerFromTeam(teamID, playerID); err != nil {
+	if err := h.commands.RemovePlayerFromTeam(teamID, playerID); err != nil {
 		respondWithError(w, http.StatusBadRequest, err.Error())
 		return
 	}
@@ -185,11 +276,11 @@ func (h *TeamHandler) RemovePlayer(w http.ResponseWriter, r *http.Request, teamI
 }
 
 func (h *TeamHandler) GetTeamPlayers(w http.ResponseWriter, r *http.Request, teamID uuid.UUID) {
-	players, err := h.useCase.GetTeamPlayers(teamID)
+	players, err := h.queries.GetTeamPlayers(teamID)
 	if err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	respondWithJSON(w, http.StatusOK, players)
+	respondWithFields(w, r, http.StatusOK, players)
 }
@@ -3,6 +3,7 @@ package handler
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
@@ -10,89 +11,102 @@ import (
 	"github.com/google/uuid"
 )
 
+// TournamentHandler atiende /api/tournaments
 type TournamentHandler struct {
-	useCase *usecase.TournamentUseCase
+	commands usecase.TournamentCommands
+	queries  usecase.TournamentQueries
+	auth     *OrganizerAuth
 }
 
-func NewTournamentHandler(useCase *usecase.TournamentUseCase) *TournamentHandler {
-	return &TournamentHandler{useCase: useCase}
+func NewTournamentHandler(commands usecase.TournamentCommands, queries usecase.TournamentQueries, auth *OrganizerAuth) *TournamentHandler {
+	return &TournamentHandler{commands: commands, queries: queries, auth: auth}
 }
 
-func (h *TournamentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	path := strings.TrimPrefix(r.URL.Path, "/api/tournaments")
-	path = strings.Trim(path, "/")
-	segments := strings.Split(path, "/")
-
-	// Manejar /api/tournaments/{id}/teams/{teamId}
-	if len(segments) >= 3 && segments[1] == "teams" {
-		tournamentID, err := uuid.Parse(segments[0])
-		if err != nil {
-			respondWithError(w, http.StatusBadRequest, "Invalid tournament UUID")
-			return
-		}
-
-		teamID, err := uuid.Parse(segments[2])
-		if err != nil {
-			respondWithError(w, http.StatusBadRequest, "Invalid team UUID")
-			return
-		}
-
-		switch r.Method {
-		case http.MethodPost:
-			h.AddTeam(w, r, tournamentID, teamID)
-		case http.MethodDelete:
-			h.RemoveTeam(w, r, tournamentID, teamID)
-		default:
-			respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
-		}
-		return
+// Routes son las rutas de /api/tournaments; las sub-rutas de calendario,
+// sorteos, fases, etc. las declaran sus propios handlers
+func (h *TournamentHandler) Routes() []Route {
+	routes := []Route{
+		{Method: http.MethodGet, Pattern: "/api/tournaments/check-name", Handler: h.CheckName, Summary: "Comprueba si un nombre de torneo está libre", Response: domain.NameCheck{}},
+		{Method: http.MethodGet, Pattern: "/api/tournaments", Handler: h.GetAll, Summary: "Lista los torneos (paginado, ?season=)", Response: []domain.Tournament{}},
+		{Method: http.MethodPost, Pattern: "/api/tournaments", Handler: h.Create, Summary: "Crea un torneo", Request: tournamentInput{}, Response: domain.Tournament{}, Idempotent: true},
+		{Method: http.MethodGet, Pattern: "/api/tournaments/{id}", Handler: pathParam("id", h.GetByID), Summary: "Obtiene un torneo", Response: domain.Tournament{}},
+		{Method: http.MethodPut, Pattern: "/api/tournaments/{id}", Handler: pathParam("id", h.Update), Summary: "Modifica un torneo", Request: tournamentUpdateInput{}, Response: domain.Tournament{}},
+		{Method: http.MethodDelete, Pattern: "/api/tournaments/{id}", Handler: pathParam("id", h.Delete), Summary: "Borra un torneo", Response: map[string]string{}},
+		{Method: http.MethodPost, Pattern: "/api/tournaments/{id}/archive", Handler: uuidParam("id", "tournament", h.Archive), Summary: "Archiva el torneo", Response: domain.Tournament{}},
+		{Method: http.MethodPost, Pattern: "/api/tournaments/{id}/restore", Handler: uuidParam("id", "tournament", h.Restore), Summary: "Restaura un torneo archivado", Response: domain.Tournament{}},
+		{Method: http.MethodGet, Pattern: "/api/tournaments/{id}/rules", Handler: uuidParam("id", "tournament", h.GetRules), Summary: "Reglamento del torneo", Response: domain.TournamentRules{}},
+		{Method: http.MethodPut, Pattern: "/api/tournaments/{id}/rules", Handler: uuidParam("id", "tournament", h.SetRules), Summary: "Cambia el reglamento", Request: rulesInput{}, Response: domain.TournamentRules{}},
+		{Method: http.MethodPut, Pattern: "/api/tournaments/{id}/attendance", Handler: uuidParam("id", "tournament", h.SetAttendance), Summary: "Cambia la restricción de público del torneo", Request: domain.AttendanceRestriction{}, Response: domain.TournamentRules{}},
+		{Method: http.MethodGet, Pattern: "/api/tournaments/{id}/standings", Handler: uuidParam("id", "tournament", h.GetStandings), Summary: "Tabla de posiciones (?round=)", Response: []domain.Standing{}},
+		{Method: http.MethodGet, Pattern: "/api/tournaments/{id}/teams", Handler: uuidParam("id", "tournament", h.GetTournamentTeams), Summary: "Equipos inscritos", Response: []domain.Team{}},
+		{Method: http.MethodPost, Pattern: "/api/tournaments/{id}/teams/{teamId}", Handler: uuidParams("id", "tournament", "teamId", "team", h.AddTeam), Summary: "Inscribe un equipo", Response: map[string]string{}},
+		{Method: http.MethodDelete, Pattern: "/api/tournaments/{id}/teams/{teamId}", Handler: uuidParams("id", "tournament", "teamId", "team", h.RemoveTeam), Summary: "Da de baja un equipo", Response: map[string]string{}},
 	}
 
-	// Manejar /api/tournaments/{id}/teams
-	if len(segments) == 2 && segments[1] == "teams" {
-		tournamentID, err := uuid.Parse(segments[0])
-		if err != nil {
-			respondWithError(w, http.StatusBadRequest, "Invalid tournament UUID")
-			return
-		}
-
-		if r.Method == http.MethodGet {
-			h.GetTournamentTeams(w, r, tournamentID)
-			return
-		}
+	// Una ruta por transición, en orden fijo (el mapa no lo tiene)
+	for _, action := range []string{"open-registration", "start", "complete", "cancel"} {
+		status := tournamentTransitionActions[action]
+		routes = append(routes, Route{
+			Method:  http.MethodPost,
+			Pattern: "/api/tournaments/{id}/" + action,
+			Handler: uuidParam("id", "tournament", func(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+				h.ChangeStatus(w, r, tournamentID, status)
+			}),
+			Summary:  "Pasa el torneo a " + status,
+			Response: domain.Tournament{},
+		})
 	}
+	return routes
+}
 
-	// Rutas CRUD estándar
-	switch r.Method {
-	case http.MethodGet:
-		if path == "" {
-			h.GetAll(w, r)
-		} else {
-			h.GetByID(w, r, path)
-		}
-	case http.MethodPost:
-		h.Create(w, r)
-	case http.MethodPut:
-		h.Update(w, r, path)
-	case http.MethodDelete:
-		h.Delete(w, r, path)
-	default:
-		respondWithError(w, http.StatusMethodNotAllowed, "Method not allowed")
-	}
+// tournamentInput es el cuerpo de POST /api/tournaments
+type tournamentInput struct {
+	Name                string `json:"name"`
+	ResultsDelayMinutes int    `json:"results_delay_minutes"`
+	SeasonID            string `json:"season_id"`
+	DivisionID          string `json:"division_id"`
+	ParentTournamentID  string `json:"parent_tournament_id"`
+	IsTest              bool   `json:"is_test"`
 }
 
 func (h *TournamentHandler) Create(w http.ResponseWriter, r *http.Request) {
-	var input struct {
-		Name string `json:"name"`
-	}
+	var input tournamentInput
 
 	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
 		return
 	}
 
+	if err := sanitizeFields(
+		textField{"name", &input.Name, maxNameLength},
+	); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	seasonID, err := parseOptionalUUID(input.SeasonID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid season_id UUID")
+		return
+	}
+	divisionID, err := parseOptionalUUID(input.DivisionID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid division_id UUID")
+		return
+	}
+	parentID, err := parseOptionalUUID(input.ParentTournamentID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid parent_tournament_id UUID")
+		return
+	}
+
 	tournament := domain.NewTournament(input.Name)
-	if err := h.useCase.CreateTournament(tournament); err != nil {
+	tournament.ResultsDelayMinutes = input.ResultsDelayMinutes
+	tournament.SeasonID = seasonID
+	tournament.DivisionID = divisionID
+	tournament.ParentTournamentID = parentID
+	tournament.IsTest = input.IsTest
+	if err := h.commands.CreateTournament(tournament); err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -100,24 +114,43 @@ func (h *TournamentHandler) Create(w http.ResponseWriter, r *http.Request) {
 	respondWithJSON(w, http.StatusCreated, tournament)
 }
 
+// GetAll lista los torneos; ?season= (ID o nombre) filtra por temporada y
+// ?archived=true incluye los archivados
 func (h *TournamentHandler) GetAll(w http.ResponseWriter, r *http.Request) {
-	tournaments, err := h.useCase.GetAllTournaments()
+	page, err := parsePage(r, domain.TournamentSortFields)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if season := r.URL.Query().Get("season"); season != "" {
+		tournaments, err := h.queries.GetTournamentsBySeason(season)
+		if err != nil {
+			respondWithError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		domain.SortTournaments(tournaments, page.Sort)
+		respondWithPage(w, r, domain.SlicePage(tournaments, page))
+		return
+	}
+
+	paged, err := h.queries.GetTournamentsPage(includeArchived(r), page)
 	if err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	respondWithJSON(w, http.StatusOK, tournaments)
+	respondWithPage(w, r, paged)
 }
 
 func (h *TournamentHandler) GetByID(w http.ResponseWriter, r *http.Request, idStr string) {
-	id, err := uuid.Parse(idStr)
+	id, err := parseUUID(idStr)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
 		return
 	}
 
-	tournament, err := h.useCase.GetTournamentByID(id)
+	tournament, err := h.queries.GetTournamentByID(id)
 	if err != nil {
 		respondWithError(w, http.StatusNotFound, err.Error())
 		return
@@ -126,24 +159,54 @@ func (h *TournamentHandler) GetByID(w http.ResponseWriter, r *http.Request, idSt
 	respondWithJSON(w, http.StatusOK, tournament)
 }
 
+// tournamentUpdateInput es el cuerpo de PUT /api/tournaments/{id}
+type tournamentUpdateInput struct {
+	Name                string `json:"name"`
+	ResultsDelayMinutes int    `json:"results_delay_minutes"`
+	SeasonID            string `json:"season_id"`
+	DivisionID          string `json:"division_id"`
+	ParentTournamentID  string `json:"parent_tournament_id"`
+}
+
 func (h *TournamentHandler) Update(w http.ResponseWriter, r *http.Request, idStr string) {
-	id, err := uuid.Parse(idStr)
+	id, err := parseUUID(idStr)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
 		return
 	}
 
-	var input struct {
-		Name string `json:"name"`
-	}
+	var input tournamentUpdateInput
 
 	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
 		return
 	}
 
-	tournament := &domain.Tournament{ID: id, Name: input.Name}
-	if err := h.useCase.UpdateTournament(tournament); err != nil {
+	if err := sanitizeFields(
+		textField{"name", &input.Name, maxNameLength},
+	); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	seasonID, err := parseOptionalUUID(input.SeasonID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid season_id UUID")
+		return
+	}
+	divisionID, err := parseOptionalUUID(input.DivisionID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid division_id UUID")
+		return
+	}
+	parentID, err := parseOptionalUUID(input.ParentTournamentID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid parent_tournament_id UUID")
+		return
+	}
+
+	tournament := &domain.Tournament{ID: id, Name: input.Name, ResultsDelayMinutes: input.ResultsDelayMinutes, SeasonID: seasonID, DivisionID: divisionID, ParentTournamentID: parentID}
+	if err := h.commands.UpdateTournament(tournament); err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -152,13 +215,13 @@ func (h *TournamentHandler) Update(w http.ResponseWriter, r *http.Request, idStr
 }
 
 func (h *TournamentHandler) Delete(w http.ResponseWriter, r *http.Request, idStr string) {
-	id, err := uuid.Parse(idStr)
+	id, err := parseUUID(idStr)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
 		return
 	}
 
-	if err := h.useCase.DeleteTournament(id); err != nil {
+	if err := h.commands.DeleteTournament(id); err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -166,8 +229,151 @@ func (h *TournamentHandler) Delete(w http.ResponseWriter, r *http.Request, idStr
 	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Tournament deleted"})
 }
 
+func (h *TournamentHandler) CheckName(w http.ResponseWriter, r *http.Request) {
+	name, err := nameToCheck(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	seasonID, err := parseOptionalUUID(r.URL.Query().Get("season_id"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid season_id UUID")
+		return
+	}
+
+	excludeID, err := parseOptionalUUID(r.URL.Query().Get("exclude_id"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid exclude_id UUID")
+		return
+	}
+
+	check, err := h.queries.CheckTournamentName(name, seasonID, excludeID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, check)
+}
+
+// tournamentTransitionActions asocia cada endpoint de transición con el estado destino
+var tournamentTransitionActions = map[string]string{
+	"open-registration": domain.TournamentRegistrationOpen,
+	"start":             domain.TournamentInProgress,
+	"complete":          domain.TournamentCompleted,
+	"cancel":            domain.TournamentCancelled,
+}
+
+func (h *TournamentHandler) ChangeStatus(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID, status string) {
+	tournament, err := h.commands.ChangeTournamentStatus(tournamentID, status)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, tournament)
+}
+
+func (h *TournamentHandler) GetRules(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	rules, err := h.queries.GetTournamentRules(tournamentID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	formatMoney(r, &rules.RegistrationFee)
+	respondWithJSON(w, http.StatusOK, rules)
+}
+
+// rulesInput es el cuerpo de PUT /api/tournaments/{id}/rules
+type rulesInput struct {
+	MinSquadSize      int    `json:"min_squad_size"`
+	MaxSquadSize      int    `json:"max_squad_size"`
+	MaxForeignPlayers *int   `json:"max_foreign_players"`
+	Country           string `json:"country"`
+	MatchDuration     int    `json:"match_duration_minutes"`
+	KnockoutTiebreak  string `json:"knockout_tiebreak"`
+	// RegistrationFee va en unidades menores: {"amount": 25000, "currency": "EUR"}
+	RegistrationFee domain.Money                 `json:"registration_fee"`
+	Attendance      domain.AttendanceRestriction `json:"attendance"`
+}
+
+// SetRules reemplaza las reglas del torneo; los campos omitidos no imponen límites
+func (h *TournamentHandler) SetRules(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	var input rulesInput
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	rules := domain.NewTournamentRules(tournamentID)
+	rules.MinSquadSize = input.MinSquadSize
+	rules.MaxSquadSize = input.MaxSquadSize
+	rules.MaxForeignPlayers = input.MaxForeignPlayers
+	rules.Country = strings.ToUpper(strings.TrimSpace(input.Country))
+	rules.MatchDuration = input.MatchDuration
+	rules.KnockoutTiebreak = strings.TrimSpace(input.KnockoutTiebreak)
+	normalizeMoney(&input.RegistrationFee)
+	rules.RegistrationFee = input.RegistrationFee
+	if err := sanitizeFields(textField{"attendance.reason", &input.Attendance.Reason, maxCaptionLength}); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	rules.Attendance = input.Attendance
+
+	if err := h.commands.SetTournamentRules(rules); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	formatMoney(r, &rules.RegistrationFee)
+	respondWithJSON(w, http.StatusOK, rules)
+}
+
+// SetAttendance cambia solo la restricción de público del reglamento; el
+// resto de las reglas queda como estaba
+func (h *TournamentHandler) SetAttendance(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	restriction, ok := decodeAttendance(w, r)
+	if !ok {
+		return
+	}
+
+	rules, err := h.commands.SetTournamentAttendance(tournamentID, restriction)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	formatMoney(r, &rules.RegistrationFee)
+	respondWithJSON(w, http.StatusOK, rules)
+}
+
+// Archive saca el torneo y sus partidos de los listados activos
+func (h *TournamentHandler) Archive(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	tournament, err := h.commands.ArchiveTournament(tournamentID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, tournament)
+}
+
+// Restore devuelve un torneo archivado a los listados activos
+func (h *TournamentHandler) Restore(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	tournament, err := h.commands.RestoreTournament(tournamentID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, tournament)
+}
+
 func (h *TournamentHandler) AddTeam(w http.ResponseWriter, r *http.Request, tournamentID, teamID uuid.UUID) {
-	if err := h.useCase.AddTeamToTournament(tournamentID, teamID); err != nil {
+	if err := h.commands.AddTeamToTournament(tournamentID, teamID); err != nil {
 		respondWithError(w, http.StatusBadRequest, err.Error())
 		return
 	}
@@ -176,7 +382,7 @@ func (h *TournamentHandler) AddTeam(w http.ResponseWriter, r *http.Request, tour
 }
 
 func (h *TournamentHandler) RemoveTeam(w http.ResponseWriter, r *http.Request, tournamentID, teamID uuid.UUID) {
-	if err := h.useCase.RemoveTeamFromTournament(tournamentID, teamID); err != nil {
+	if err := h.commands.RemoveTeamFromTournament(tournamentID, teamID); err != nil {
 		respondWithError(w, http.StatusBadRequest, err.Error())
 		return
 	}
@@ -185,11 +391,35 @@ func (h *TournamentHandler) RemoveTeam(w http.ResponseWriter, r *http.Request, t
 }
 
 func (h *TournamentHandler) GetTournamentTeams(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
-	teams, err := h.useCase.GetTournamentTeams(tournamentID)
+	teams, err := h.queries.GetTournamentTeams(tournamentID)
 	if err != nil {
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	respondWithJSON(w, http.StatusOK, teams)
+	respondWithFields(w, r, http.StatusOK, teams)
+}
+
+func (h *TournamentHandler) GetStandings(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	maxRound := 0
+	if raw := r.URL.Query().Get("round"); raw != "" {
+		round, err := strconv.Atoi(raw)
+		if err != nil || round < 0 {
+			respondWithError(w, http.StatusBadRequest, "Invalid round number")
+			return
+		}
+		maxRound = round
+	}
+
+	standings, err := h.queries.GetStandings(tournamentID, maxRound, h.auth.IsOrganizer(r))
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	if wantsCSV(r) {
+		respondWithCSV(w, "standings.csv", standingCSVColumns, standings)
+		return
+	}
+	respondWithFields(w, r, http.StatusOK, standings)
 }
@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// VenueHandler atiende /api/venues; las canchas están en PitchHandler y el
+// marcador de las pantallas en ScoreboardHandler
+type VenueHandler struct {
+	commands usecase.VenueCommands
+	queries  usecase.VenueQueries
+}
+
+func NewVenueHandler(commands usecase.VenueCommands, queries usecase.VenueQueries) *VenueHandler {
+	return &VenueHandler{commands: commands, queries: queries}
+}
+
+type venueInput struct {
+	Name     string `json:"name"`
+	Address  string `json:"address"`
+	Capacity int    `json:"capacity"`
+	// Attendance es la restricción de público; se puede cambiar sola con
+	// PUT /api/venues/{id}/attendance
+	Attendance domain.AttendanceRestriction `json:"attendance"`
+}
+
+// Routes son las rutas de /api/venues
+func (h *VenueHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/venues", Handler: h.GetAll, Summary: "Lista las sedes", Response: []domain.Venue{}},
+		{Method: http.MethodPost, Pattern: "/api/venues", Handler: h.Create, Summary: "Crea una sede", Request: venueInput{}, Response: domain.Venue{}, Idempotent: true},
+		{Method: http.MethodGet, Pattern: "/api/venues/{id}", Handler: pathParam("id", h.GetByID), Summary: "Obtiene una sede", Response: domain.Venue{}},
+		{Method: http.MethodPut, Pattern: "/api/venues/{id}", Handler: pathParam("id", h.Update), Summary: "Modifica una sede", Request: venueInput{}, Response: domain.Venue{}},
+		{Method: http.MethodDelete, Pattern: "/api/venues/{id}", Handler: pathParam("id", h.Delete), Summary: "Borra una sede", Response: map[string]string{}},
+		{Method: http.MethodPut, Pattern: "/api/venues/{id}/attendance", Handler: uuidParam("id", "venue", h.SetAttendance), Summary: "Cambia la restricción de público de la sede", Request: domain.AttendanceRestriction{}, Response: domain.Venue{}},
+	}
+}
+
+func (h *VenueHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var input venueInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := sanitizeFields(
+		textField{"name", &input.Name, maxNameLength},
+		textField{"address", &input.Address, maxAddressLength},
+		textField{"attendance.reason", &input.Attendance.Reason, maxCaptionLength},
+	); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	venue := domain.NewVenue(input.Name, input.Address, input.Capacity)
+	venue.Attendance = input.Attendance
+	if err := h.commands.CreateVenue(venue); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, venue)
+}
+
+func (h *VenueHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	venues, err := h.queries.GetAllVenues()
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithFields(w, r, http.StatusOK, venues)
+}
+
+func (h *VenueHandler) GetByID(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
+		return
+	}
+
+	venue, err := h.queries.GetVenueByID(id)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, venue)
+}
+
+func (h *VenueHandler) Update(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
+		return
+	}
+
+	var input venueInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := sanitizeFields(
+		textField{"name", &input.Name, maxNameLength},
+		textField{"address", &input.Address, maxAddressLength},
+		textField{"attendance.reason", &input.Attendance.Reason, maxCaptionLength},
+	); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	venue := &domain.Venue{
+		ID:         id,
+		Name:       input.Name,
+		Address:    input.Address,
+		Capacity:   input.Capacity,
+		Attendance: input.Attendance,
+	}
+	if err := h.commands.UpdateVenue(venue); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, venue)
+}
+
+func (h *VenueHandler) Delete(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := parseUUID(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid UUID")
+		return
+	}
+
+	if err := h.commands.DeleteVenue(id); err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Venue deleted"})
+}
+
+// SetAttendance cambia solo la restricción de público (aforo, puertas
+// cerradas); se usa durante la temporada sin reenviar el resto de la sede
+func (h *VenueHandler) SetAttendance(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	restriction, ok := decodeAttendance(w, r)
+	if !ok {
+		return
+	}
+
+	venue, err := h.commands.SetVenueAttendance(id, restriction)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, venue)
+}
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/xlsx"
+	"github.com/google/uuid"
+)
+
+// WorkbookHandler atiende /api/tournaments/{id}/workbook.xlsx
+type WorkbookHandler struct {
+	queries usecase.WorkbookQueries
+	auth    *OrganizerAuth
+}
+
+func NewWorkbookHandler(queries usecase.WorkbookQueries, auth *OrganizerAuth) *WorkbookHandler {
+	return &WorkbookHandler{queries: queries, auth: auth}
+}
+
+// Routes son las rutas de la planilla del torneo
+func (h *WorkbookHandler) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Pattern: "/api/tournaments/{id}/workbook.xlsx", Handler: uuidParam("id", "tournament", h.Get), Summary: "Planilla Excel con tabla, fixture y goleadores"},
+	}
+}
+
+// Get descarga la planilla. Se arma entera en memoria antes de responder
+// para poder devolver un 500 si falla, en vez de un archivo cortado.
+func (h *WorkbookHandler) Get(w http.ResponseWriter, r *http.Request, tournamentID uuid.UUID) {
+	workbook, err := h.queries.GetTournamentWorkbook(tournamentID, h.auth.IsOrganizer(r))
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := newTournamentWorkbook(workbook).Write(&buf); err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", xlsx.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=tournament-%s.xlsx", tournamentID))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
+
+// workbookDate es el formato de fecha de la planilla; las horas van en UTC
+// como en el resto de la API
+const workbookDate = "2006-01-02 15:04"
+
+// matchStatusLabels traduce el estado del partido para la planilla impresa
+var matchStatusLabels = map[string]string{
+	domain.MatchScheduled: "Por jugar",
+	domain.MatchLive:      "En juego",
+	domain.MatchFinished:  "Finalizado",
+}
+
+// newTournamentWorkbook arma las tres hojas: Posiciones, Fixture y Goleadores
+func newTournamentWorkbook(data *domain.TournamentWorkbook) *xlsx.Workbook {
+	title := func(section string) string {
+		return fmt.Sprintf("%s — %s (al %s UTC)", data.Tournament.Name, section, data.GeneratedAt.Format(workbookDate))
+	}
+
+	standings := xlsx.Sheet{
+		Name:  "Posiciones",
+		Title: title("Tabla de posiciones"),
+		Columns: []xlsx.Column{
+			{Header: "Pos", Width: 6}, {Header: "Equipo", Width: 28}, {Header: "PJ", Width: 6},
+			{Header: "PG", Width: 6}, {Header: "PE", Width: 6}, {Header: "PP", Width: 6},
+			{Header: "GF", Width: 6}, {Header: "GC", Width: 6}, {Header: "DG", Width: 6},
+			{Header: "Pts", Width: 7},
+		},
+	}
+	for _, s := range data.Standings {
+		standings.Rows = append(standings.Rows, []xlsx.Cell{
+			xlsx.Int(s.Position), xlsx.Text(s.TeamName), xlsx.Int(s.Played),
+			xlsx.Int(s.Won), xlsx.Int(s.Drawn), xlsx.Int(s.Lost),
+			xlsx.Int(s.GoalsFor), xlsx.Int(s.GoalsAgainst), xlsx.Int(s.GoalDifference),
+			xlsx.Int(s.Points),
+		})
+	}
+
+	fixture := xlsx.Sheet{
+		Name:  "Fixture",
+		Title: title("Fixture"),
+		Columns: []xlsx.Column{
+			{Header: "Fecha (UTC)", Width: 17}, {Header: "Jornada", Width: 9}, {Header: "Local", Width: 26},
+			{Header: "Resultado", Width: 18}, {Header: "Visitante", Width: 26}, {Header: "Sede", Width: 24},
+			{Header: "Estado", Width: 12},
+		},
+	}
+	for _, f := range data.Fixtures {
+		round := xlsx.Text("")
+		if f.Match.Round > 0 {
+			round = xlsx.Int(f.Match.Round)
+		}
+		fixture.Rows = append(fixture.Rows, []xlsx.Cell{
+			xlsx.Text(f.Match.Date.UTC().Format(workbookDate)), round, xlsx.Text(f.Home),
+			xlsx.Text(f.Score), xlsx.Text(f.Away), xlsx.Text(f.Venue),
+			xlsx.Text(matchStatusLabels[f.Status]),
+		})
+	}
+
+	scorers := xlsx.Sheet{
+		Name:  "Goleadores",
+		Title: title("Goleadores"),
+		Columns: []xlsx.Column{
+			{Header: "Pos", Width: 6}, {Header: "Jugador", Width: 28}, {Header: "Equipo", Width: 26},
+			{Header: "Goles", Width: 8}, {Header: "De penal", Width: 10},
+		},
+	}
+	for _, s := range data.TopScorers {
+		scorers.Rows = append(scorers.Rows, []xlsx.Cell{
+			xlsx.Int(s.Position), xlsx.Text(s.PlayerName), xlsx.Text(s.TeamName),
+			xlsx.Int(s.Goals), xlsx.Int(s.Penalties),
+		})
+	}
+
+	return &xlsx.Workbook{Sheets: []xlsx.Sheet{standings, fixture, scorers}}
+}
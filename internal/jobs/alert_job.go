@@ -0,0 +1,28 @@
+package jobs
+
+import (
+	"log"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+)
+
+// AlertJob revisa periódicamente las situaciones que requieren atención de
+// los organizadores y actualiza la lista de alertas
+type AlertJob struct {
+	periodic
+	commands usecase.AlertCommands
+}
+
+// NewAlertJob crea el job; con interval <= 0 no hace nada al iniciarse
+func NewAlertJob(commands usecase.AlertCommands, interval time.Duration) *AlertJob {
+	j := &AlertJob{commands: commands}
+	j.periodic = periodic{interval: interval, run: j.refresh}
+	return j
+}
+
+func (j *AlertJob) refresh() {
+	if _, err := j.commands.RefreshAlerts(); err != nil {
+		log.Printf("⚠️  Alert refresh failed: %v", err)
+	}
+}
@@ -0,0 +1,30 @@
+// Package jobs contiene los procesos periódicos que corren junto con la API.
+// Cada job cumple app.Component, como un IHostedService en C#.
+package jobs
+
+import (
+	"log"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+)
+
+// AnalyticsJob recalcula periódicamente las estadísticas agregadas de los torneos
+type AnalyticsJob struct {
+	periodic
+	commands usecase.AnalyticsCommands
+}
+
+// NewAnalyticsJob crea el job; con interval <= 0 no hace nada al iniciarse
+func NewAnalyticsJob(commands usecase.AnalyticsCommands, interval time.Duration) *AnalyticsJob {
+	j := &AnalyticsJob{commands: commands}
+	j.periodic = periodic{interval: interval, run: j.refresh}
+	return j
+}
+
+func (j *AnalyticsJob) refresh() {
+	refreshed, err := j.commands.RefreshAllAnalytics()
+	if err != nil {
+		log.Printf("⚠️  Analytics refresh failed after %d tournaments: %v", refreshed, err)
+	}
+}
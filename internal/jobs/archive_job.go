@@ -0,0 +1,41 @@
+package jobs
+
+import (
+	"log"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+)
+
+// archiveInterval es cada cuánto se buscan temporadas para archivar
+const archiveInterval = 24 * time.Hour
+
+// ArchiveJob archiva una vez por día los torneos de las temporadas que
+// quedaron fuera de las keepSeasons más recientes
+type ArchiveJob struct {
+	periodic
+	commands    usecase.TournamentCommands
+	keepSeasons int
+}
+
+// NewArchiveJob crea el job; con keepSeasons <= 0 no hace nada al iniciarse
+func NewArchiveJob(commands usecase.TournamentCommands, keepSeasons int) *ArchiveJob {
+	j := &ArchiveJob{commands: commands, keepSeasons: keepSeasons}
+	interval := archiveInterval
+	if keepSeasons <= 0 {
+		interval = 0
+	}
+	j.periodic = periodic{interval: interval, run: j.archive}
+	return j
+}
+
+func (j *ArchiveJob) archive() {
+	archived, err := j.commands.ArchiveOldSeasons(j.keepSeasons)
+	if err != nil {
+		log.Printf("⚠️  Archival failed after %d tournaments: %v", archived, err)
+		return
+	}
+	if archived > 0 {
+		log.Printf("🗄️  Archived %d tournaments from old seasons", archived)
+	}
+}
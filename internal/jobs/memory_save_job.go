@@ -0,0 +1,52 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+)
+
+// MemorySaveJob guarda en disco los datos del modo demo para no perderlos
+// al reiniciar
+type MemorySaveJob struct {
+	periodic
+	store *repository.MemoryStore
+	path  string
+
+	mu sync.Mutex
+	// saved es la versión del store guardada por última vez
+	saved uint64
+}
+
+// NewMemorySaveJob crea el job; con interval <= 0 solo guarda al detenerse
+func NewMemorySaveJob(store *repository.MemoryStore, path string, interval time.Duration) *MemorySaveJob {
+	j := &MemorySaveJob{store: store, path: path}
+	j.periodic = periodic{interval: interval, run: j.save}
+	return j
+}
+
+// Stop detiene el ciclo y guarda una última vez
+func (j *MemorySaveJob) Stop(ctx context.Context) error {
+	err := j.periodic.Stop(ctx)
+	j.save()
+	return err
+}
+
+// save escribe el archivo solo si hubo escrituras desde el último guardado
+func (j *MemorySaveJob) save() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	version := j.store.Version()
+	if version == j.saved {
+		return
+	}
+	if err := j.store.Save(j.path); err != nil {
+		log.Printf("⚠️  Demo data save to %s failed: %v", j.path, err)
+		return
+	}
+	j.saved = version
+}
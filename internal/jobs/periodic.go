@@ -0,0 +1,80 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// periodic ejecuta run al iniciarse y luego cada interval hasta que se
+// detiene. Los jobs lo embeben para cumplir app.Component.
+type periodic struct {
+	interval time.Duration
+	run      func()
+	// wake adelanta la próxima ejecución; es opcional (ver trigger)
+	wake chan struct{}
+
+	cancel context.CancelFunc
+	done   sync.WaitGroup
+}
+
+// Start lanza el ciclo en segundo plano; con interval <= 0 no hace nada
+func (p *periodic) Start(ctx context.Context) error {
+	if p.interval <= 0 {
+		return nil
+	}
+
+	loopCtx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	p.done.Add(1)
+	go p.loop(loopCtx)
+	return nil
+}
+
+// Stop cancela el ciclo y espera a que termine la ejecución en curso
+func (p *periodic) Stop(ctx context.Context) error {
+	if p.cancel == nil {
+		return nil
+	}
+	p.cancel()
+
+	finished := make(chan struct{})
+	go func() {
+		p.done.Wait()
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *periodic) loop(ctx context.Context) {
+	defer p.done.Done()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.run()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-p.wake:
+		}
+	}
+}
+
+// trigger pide una ejecución sin esperar al intervalo. No bloquea: varios
+// pedidos seguidos se resuelven con una sola ejecución.
+func (p *periodic) trigger() {
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
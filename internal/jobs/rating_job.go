@@ -0,0 +1,41 @@
+package jobs
+
+import (
+	"log"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+)
+
+// ratingInterval recalcula aunque no haya cambios, para sumar los
+// resultados cuyo embargo se levantó
+const ratingInterval = time.Hour
+
+// RatingJob recalcula los ratings Elo en segundo plano. Implementa
+// usecase.MatchPublisher: cada resultado guardado pide un recálculo, y los
+// pedidos que llegan mientras se calcula se juntan en uno solo.
+type RatingJob struct {
+	periodic
+	commands usecase.RatingCommands
+}
+
+func NewRatingJob(commands usecase.RatingCommands) *RatingJob {
+	j := &RatingJob{commands: commands}
+	j.periodic = periodic{interval: ratingInterval, run: j.recalculate, wake: make(chan struct{}, 1)}
+	return j
+}
+
+// PublishMatchUpdate pide un recálculo cuando cambia un marcador o se borra
+// un partido
+func (j *RatingJob) PublishMatchUpdate(update domain.MatchUpdate) {
+	if update.Type == domain.MatchUpdateScore || update.Type == domain.MatchUpdateDeleted {
+		j.trigger()
+	}
+}
+
+func (j *RatingJob) recalculate() {
+	if err := j.commands.RecalculateRatings(); err != nil {
+		log.Printf("⚠️  Rating recalculation failed: %v", err)
+	}
+}
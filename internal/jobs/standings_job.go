@@ -0,0 +1,71 @@
+package jobs
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// standingsInterval revisa aunque no haya resultados nuevos: una jornada se
+// completa cuando pasa la fecha de su último partido
+const standingsInterval = time.Hour
+
+// StandingsJob guarda la tabla de cada jornada que se completa. Implementa
+// usecase.MatchPublisher: un resultado corregido de una jornada ya guardada
+// hace que se vuelva a tomar esa jornada y las siguientes.
+type StandingsJob struct {
+	periodic
+	commands usecase.TournamentCommands
+
+	mu sync.Mutex
+	// corrected es la primera jornada con cambios de cada torneo
+	corrected map[uuid.UUID]int
+}
+
+func NewStandingsJob(commands usecase.TournamentCommands) *StandingsJob {
+	j := &StandingsJob{commands: commands, corrected: make(map[uuid.UUID]int)}
+	j.periodic = periodic{interval: standingsInterval, run: j.snapshot, wake: make(chan struct{}, 1)}
+	return j
+}
+
+// PublishMatchUpdate anota la jornada del marcador que cambió o del partido
+// borrado
+func (j *StandingsJob) PublishMatchUpdate(update domain.MatchUpdate) {
+	if (update.Type != domain.MatchUpdateScore && update.Type != domain.MatchUpdateDeleted) || update.Match == nil || update.Match.TournamentID == nil || update.Match.Round < 1 {
+		return
+	}
+
+	j.mu.Lock()
+	tournamentID := *update.Match.TournamentID
+	if round, ok := j.corrected[tournamentID]; !ok || update.Match.Round < round {
+		j.corrected[tournamentID] = update.Match.Round
+	}
+	j.mu.Unlock()
+	j.trigger()
+}
+
+func (j *StandingsJob) snapshot() {
+	j.mu.Lock()
+	corrected := j.corrected
+	j.corrected = make(map[uuid.UUID]int)
+	j.mu.Unlock()
+
+	for tournamentID, round := range corrected {
+		if _, err := j.commands.SnapshotStandings(tournamentID, round); err != nil {
+			log.Printf("⚠️  Standings snapshot failed for tournament %s: %v", tournamentID, err)
+		}
+	}
+
+	taken, err := j.commands.SnapshotAllStandings()
+	if err != nil {
+		log.Printf("⚠️  Standings snapshots failed after %d rounds: %v", taken, err)
+		return
+	}
+	if taken > 0 {
+		log.Printf("📸 Saved %d standings snapshots", taken)
+	}
+}
@@ -0,0 +1,105 @@
+// Package realtime difunde las actualizaciones de partidos a los clientes
+// conectados en vivo (WebSocket). El Hub es el equivalente a un Hub de
+// SignalR en C#: los casos de uso publican y cada conexión se suscribe.
+package realtime
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// subscriptionBuffer es cuántas actualizaciones puede acumular un cliente
+// lento antes de que se lo desconecte
+const subscriptionBuffer = 64
+
+// Hub reparte cada actualización publicada entre las suscripciones activas
+type Hub struct {
+	mu            sync.Mutex
+	subscriptions map[*Subscription]struct{}
+	closed        bool
+}
+
+// NewHub crea un hub sin suscriptores
+func NewHub() *Hub {
+	return &Hub{subscriptions: make(map[*Subscription]struct{})}
+}
+
+// Subscription recibe las actualizaciones por C. El canal se cierra cuando
+// la suscripción termina: por Close, porque el hub se detuvo o porque el
+// cliente no consumía a tiempo.
+type Subscription struct {
+	C       <-chan domain.MatchUpdate
+	ch      chan domain.MatchUpdate
+	matchID *uuid.UUID
+	hub     *Hub
+}
+
+// Subscribe registra una suscripción. Con matchID solo recibe las
+// actualizaciones de ese partido; con nil recibe todas.
+func (h *Hub) Subscribe(matchID *uuid.UUID) *Subscription {
+	ch := make(chan domain.MatchUpdate, subscriptionBuffer)
+	sub := &Subscription{C: ch, ch: ch, matchID: matchID, hub: h}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		close(ch)
+		return sub
+	}
+	h.subscriptions[sub] = struct{}{}
+	return sub
+}
+
+// Close da de baja la suscripción. Se puede llamar más de una vez.
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	s.hub.remove(s)
+}
+
+// remove cierra el canal de la suscripción; requiere tener h.mu
+func (h *Hub) remove(sub *Subscription) {
+	if _, ok := h.subscriptions[sub]; !ok {
+		return
+	}
+	delete(h.subscriptions, sub)
+	close(sub.ch)
+}
+
+// PublishMatchUpdate entrega la actualización sin bloquear: un suscriptor
+// con el buffer lleno se desconecta para no frenar a los demás
+func (h *Hub) PublishMatchUpdate(update domain.MatchUpdate) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscriptions {
+		if sub.matchID != nil && *sub.matchID != update.MatchID {
+			continue
+		}
+		select {
+		case sub.ch <- update:
+		default:
+			h.remove(sub)
+		}
+	}
+}
+
+// Start no tiene procesos en segundo plano; existe para cumplir app.Component
+func (h *Hub) Start(ctx context.Context) error {
+	return nil
+}
+
+// Stop cierra todas las suscripciones para que las conexiones terminen
+func (h *Hub) Stop(ctx context.Context) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for sub := range h.subscriptions {
+		h.remove(sub)
+	}
+	return nil
+}
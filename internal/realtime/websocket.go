@@ -0,0 +1,217 @@
+package realtime
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// websocketGUID es la constante del handshake definida en RFC 6455
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// Opcodes de los frames WebSocket
+const (
+	opText  = 0x1
+	opClose = 0x8
+	opPing  = 0x9
+	opPong  = 0xA
+)
+
+const (
+	// writeTimeout limita cada escritura a un cliente
+	writeTimeout = 10 * time.Second
+	// maxControlPayload es el máximo que permite el protocolo en ping/pong/close
+	maxControlPayload = 125
+)
+
+// ErrNotWebSocket indica que la petición no pide un upgrade a WebSocket
+var ErrNotWebSocket = errors.New("expected a WebSocket upgrade request")
+
+// Conn es una conexión WebSocket del lado del servidor. Implementa solo lo
+// que necesita la difusión en vivo: enviar texto, responder pings y cerrar.
+type Conn struct {
+	conn    net.Conn
+	reader  *bufio.Reader
+	writeMu sync.Mutex
+}
+
+// Upgrade completa el handshake y toma la conexión. Si devuelve
+// ErrNotWebSocket todavía no se escribió nada y se puede responder por HTTP.
+func Upgrade(w http.ResponseWriter, r *http.Request) (*Conn, error) {
+	if r.Method != http.MethodGet ||
+		!headerHasToken(r.Header, "Connection", "upgrade") ||
+		!headerHasToken(r.Header, "Upgrade", "websocket") ||
+		r.Header.Get("Sec-WebSocket-Version") != "13" ||
+		r.Header.Get("Sec-WebSocket-Key") == "" {
+		return nil, ErrNotWebSocket
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		return nil, fmt.Errorf("response writer does not support hijacking")
+	}
+	netConn, rw, err := hijacker.Hijack()
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha1.Sum([]byte(r.Header.Get("Sec-WebSocket-Key") + websocketGUID))
+	response := "HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + base64.StdEncoding.EncodeToString(sum[:]) + "\r\n\r\n"
+
+	netConn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if _, err := rw.WriteString(response); err != nil {
+		netConn.Close()
+		return nil, err
+	}
+	if err := rw.Flush(); err != nil {
+		netConn.Close()
+		return nil, err
+	}
+
+	return &Conn{conn: netConn, reader: rw.Reader}, nil
+}
+
+// headerHasToken busca un valor en un header con lista separada por comas
+func headerHasToken(header http.Header, name, token string) bool {
+	for _, value := range header.Values(name) {
+		for _, part := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(part), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WriteJSON envía v como un mensaje de texto
+func (c *Conn) WriteJSON(v interface{}) error {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return c.writeFrame(opText, payload)
+}
+
+// Ping envía un ping; el cliente responde con pong automáticamente
+func (c *Conn) Ping() error {
+	return c.writeFrame(opPing, nil)
+}
+
+// Close envía el frame de cierre y cierra la conexión
+func (c *Conn) Close() error {
+	c.writeFrame(opClose, nil)
+	return c.conn.Close()
+}
+
+func (c *Conn) writeFrame(opcode byte, payload []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	// El servidor no enmascara sus frames
+	header := []byte{0x80 | opcode}
+	switch length := len(payload); {
+	case length < 126:
+		header = append(header, byte(length))
+	case length <= 0xFFFF:
+		header = append(header, 126, 0, 0)
+		binary.BigEndian.PutUint16(header[2:], uint16(length))
+	default:
+		header = append(header, 127, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(header[2:], uint64(length))
+	}
+
+	c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if _, err := c.conn.Write(header); err != nil {
+		return err
+	}
+	_, err := c.conn.Write(payload)
+	return err
+}
+
+// ReadLoop lee los frames del cliente hasta que cierra la conexión. Responde
+// los pings y descarta los mensajes, porque el canal es solo de salida.
+func (c *Conn) ReadLoop() error {
+	for {
+		opcode, payload, err := c.readFrame()
+		if err != nil {
+			return err
+		}
+		switch opcode {
+		case opClose:
+			c.writeFrame(opClose, nil)
+			return io.EOF
+		case opPing:
+			if err := c.writeFrame(opPong, payload); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// readFrame lee un frame. Los frames de datos se descartan sin guardarlos en
+// memoria; solo se devuelve el contenido de los frames de control.
+func (c *Conn) readFrame() (byte, []byte, error) {
+	var head [2]byte
+	if _, err := io.ReadFull(c.reader, head[:]); err != nil {
+		return 0, nil, err
+	}
+	opcode := head[0] & 0x0F
+	masked := head[1]&0x80 != 0
+	length := uint64(head[1] & 0x7F)
+
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(c.reader, ext[:]); err != nil {
+			return 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(c.reader, ext[:]); err != nil {
+			return 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+
+	// RFC 6455: todo frame del cliente viene enmascarado
+	if !masked {
+		return 0, nil, fmt.Errorf("client frame is not masked")
+	}
+	var mask [4]byte
+	if _, err := io.ReadFull(c.reader, mask[:]); err != nil {
+		return 0, nil, err
+	}
+
+	if opcode < opClose {
+		if _, err := io.CopyN(io.Discard, c.reader, int64(length)); err != nil {
+			return 0, nil, err
+		}
+		return opcode, nil, nil
+	}
+
+	if length > maxControlPayload {
+		return 0, nil, fmt.Errorf("control frame too large")
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.reader, payload); err != nil {
+		return 0, nil, err
+	}
+	for i := range payload {
+		payload[i] ^= mask[i%4]
+	}
+	return opcode, payload, nil
+}
@@ -0,0 +1,238 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// AlertRepository detecta y guarda las alertas de los organizadores
+type AlertRepository interface {
+	// Detect busca las situaciones que requieren atención: partidos que
+	// empezaron antes de missingSince sin resultado, planteles con menos de
+	// minSquadSize jugadores y resultados provisorios pendientes
+	Detect(missingSince time.Time, minSquadSize int) ([]domain.Alert, error)
+	// Sync guarda las alertas detectadas y borra las que ya no aplican; las
+	// que siguen vigentes conservan su ID, fecha y descarte
+	Sync(alerts []domain.Alert) error
+	GetAll(includeDismissed bool) ([]domain.Alert, error)
+	Dismiss(id uuid.UUID, at time.Time) error
+}
+
+type PostgresAlertRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresAlertRepository(db *sql.DB) AlertRepository {
+	return &PostgresAlertRepository{db: db}
+}
+
+// alertColumns debe mantenerse en el mismo orden que scanAlert
+const alertColumns = `id, type, entity_id, tournament_id, message, detected_at, dismissed_at`
+
+func scanAlert(row rowScanner, alert *domain.Alert) error {
+	return row.Scan(
+		&alert.ID,
+		&alert.Type,
+		&alert.EntityID,
+		&alert.TournamentID,
+		&alert.Message,
+		&alert.DetectedAt,
+		&alert.DismissedAt,
+	)
+}
+
+func (r *PostgresAlertRepository) Detect(missingSince time.Time, minSquadSize int) ([]domain.Alert, error) {
+	alerts := []domain.Alert{}
+
+	missing, err := r.detectMissingResults(missingSince)
+	if err != nil {
+		return nil, err
+	}
+	alerts = append(alerts, missing...)
+
+	short, err := r.detectShortSquads(minSquadSize)
+	if err != nil {
+		return nil, err
+	}
+	alerts = append(alerts, short...)
+
+	unconfirmed, err := r.detectUnconfirmedResults()
+	if err != nil {
+		return nil, err
+	}
+	return append(alerts, unconfirmed...), nil
+}
+
+// detectMissingResults considera sin resultado a un partido que no tiene el
+// resultado confirmado ni adjudicado por walkover (así no cuenta para la
+// tabla) y no tiene un resultado provisorio pendiente (ese ya genera su
+// propia alerta)
+func (r *PostgresAlertRepository) detectMissingResults(missingSince time.Time) ([]domain.Alert, error) {
+	query := `
+		SELECT m.id, m.tournament_id, m.match_number, m.date, t1.name, t2.name
+		FROM matches m
+		INNER JOIN teams t1 ON t1.id = m.team1_id
+		INNER JOIN teams t2 ON t2.id = m.team2_id
+		LEFT JOIN tournaments t ON t.id = m.tournament_id
+		WHERE m.parent_match_id IS NULL
+		  AND NOT m.archived
+		  AND m.date <= $1
+		  AND m.result_confirmed_at IS NULL
+		  AND m.result_type <> $4
+		  AND (t.id IS NULL OR t.status <> $2)
+		  AND NOT EXISTS (SELECT 1 FROM provisional_results p WHERE p.match_id = m.id AND p.status = $3)
+		ORDER BY m.date
+	`
+	rows, err := r.db.Query(query, missingSince, domain.TournamentCancelled, domain.ProvisionalPending, domain.ResultForfeit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var alerts []domain.Alert
+	for rows.Next() {
+		var match domain.Match
+		var team1, team2 string
+		if err := rows.Scan(&match.ID, &match.TournamentID, &match.MatchNumber, &match.Date, &team1, &team2); err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, domain.NewMissingResultAlert(&match, team1, team2))
+	}
+	return alerts, rows.Err()
+}
+
+// detectShortSquads revisa los equipos de los torneos con inscripción abierta
+// o en curso que no estén archivados
+func (r *PostgresAlertRepository) detectShortSquads(minSquadSize int) ([]domain.Alert, error) {
+	query := `
+		SELECT t.id, tt.tournament_id, t.name, COUNT(tp.player_id)
+		FROM tournament_teams tt
+		INNER JOIN tournaments tr ON tr.id = tt.tournament_id
+		INNER JOIN teams t ON t.id = tt.team_id
+		LEFT JOIN team_players tp ON tp.team_id = t.id
+		WHERE tr.status IN ($1, $2) AND tr.archived_at IS NULL
+		GROUP BY t.id, tt.tournament_id, t.name
+		HAVING COUNT(tp.player_id) < $3
+		ORDER BY t.name
+	`
+	rows, err := r.db.Query(query, domain.TournamentRegistrationOpen, domain.TournamentInProgress, minSquadSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var alerts []domain.Alert
+	for rows.Next() {
+		var teamID, tournamentID uuid.UUID
+		var name string
+		var players int
+		if err := rows.Scan(&teamID, &tournamentID, &name, &players); err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, domain.NewShortSquadAlert(teamID, tournamentID, name, players))
+	}
+	return alerts, rows.Err()
+}
+
+func (r *PostgresAlertRepository) detectUnconfirmedResults() ([]domain.Alert, error) {
+	query := `
+		SELECT p.id, p.match_id, p.goal_scored_team1, p.goal_scored_team2, p.source, m.match_number, m.tournament_id
+		FROM provisional_results p
+		INNER JOIN matches m ON m.id = p.match_id
+		WHERE p.status = $1
+		ORDER BY p.created_at
+	`
+	rows, err := r.db.Query(query, domain.ProvisionalPending)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var alerts []domain.Alert
+	for rows.Next() {
+		var result domain.ProvisionalResult
+		var matchNumber int
+		var tournamentID *uuid.UUID
+		if err := rows.Scan(&result.ID, &result.MatchID, &result.GoalScoredTeam1, &result.GoalScoredTeam2,
+			&result.Source, &matchNumber, &tournamentID); err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, domain.NewUnconfirmedResultAlert(&result, matchNumber, tournamentID))
+	}
+	return alerts, rows.Err()
+}
+
+func (r *PostgresAlertRepository) Sync(alerts []domain.Alert) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	insert := `
+		INSERT INTO admin_alerts (id, type, entity_id, tournament_id, message, detected_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (type, entity_id) DO UPDATE
+		SET tournament_id = EXCLUDED.tournament_id, message = EXCLUDED.message
+	`
+	keys := make([]string, 0, len(alerts))
+	for _, alert := range alerts {
+		if _, err := tx.Exec(insert, alert.ID, alert.Type, alert.EntityID, alert.TournamentID, alert.Message, alert.DetectedAt); err != nil {
+			return err
+		}
+		keys = append(keys, alert.Type+":"+alert.EntityID.String())
+	}
+
+	// Las alertas que no se detectaron esta vez ya se resolvieron
+	remove := `DELETE FROM admin_alerts WHERE NOT (type || ':' || entity_id::text = ANY($1))`
+	if _, err := tx.Exec(remove, pq.Array(keys)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresAlertRepository) GetAll(includeDismissed bool) ([]domain.Alert, error) {
+	query := `
+		SELECT ` + alertColumns + `
+		FROM admin_alerts
+		WHERE $1 OR dismissed_at IS NULL
+		ORDER BY detected_at, type
+	`
+	rows, err := r.db.Query(query, includeDismissed)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	alerts := []domain.Alert{}
+	for rows.Next() {
+		var alert domain.Alert
+		if err := scanAlert(rows, &alert); err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, alert)
+	}
+	return alerts, rows.Err()
+}
+
+func (r *PostgresAlertRepository) Dismiss(id uuid.UUID, at time.Time) error {
+	query := `UPDATE admin_alerts SET dismissed_at = COALESCE(dismissed_at, $2) WHERE id = $1`
+	result, err := r.db.Exec(query, id, at)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("alert not found")
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// AnalyticsRepository guarda las estadísticas agregadas ya calculadas
+type AnalyticsRepository interface {
+	// Save reemplaza las estadísticas guardadas del torneo
+	Save(analytics *domain.TournamentAnalytics) error
+	GetByTournament(tournamentID uuid.UUID) (*domain.TournamentAnalytics, error)
+}
+
+type PostgresAnalyticsRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresAnalyticsRepository(db *sql.DB) AnalyticsRepository {
+	return &PostgresAnalyticsRepository{db: db}
+}
+
+func (r *PostgresAnalyticsRepository) Save(analytics *domain.TournamentAnalytics) error {
+	matchdays, err := json.Marshal(analytics.GoalsPerMatchday)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO tournament_analytics (tournament_id, matches, goals, home_wins, away_wins, draws, goals_per_matchday, computed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (tournament_id) DO UPDATE
+		SET matches = EXCLUDED.matches, goals = EXCLUDED.goals, home_wins = EXCLUDED.home_wins,
+		    away_wins = EXCLUDED.away_wins, draws = EXCLUDED.draws,
+		    goals_per_matchday = EXCLUDED.goals_per_matchday, computed_at = EXCLUDED.computed_at
+	`
+	_, err = r.db.Exec(query,
+		analytics.TournamentID,
+		analytics.Matches,
+		analytics.Goals,
+		analytics.HomeWins,
+		analytics.AwayWins,
+		analytics.Draws,
+		matchdays,
+		analytics.ComputedAt,
+	)
+	return err
+}
+
+func (r *PostgresAnalyticsRepository) GetByTournament(tournamentID uuid.UUID) (*domain.TournamentAnalytics, error) {
+	query := `
+		SELECT tournament_id, matches, goals, home_wins, away_wins, draws, goals_per_matchday, computed_at
+		FROM tournament_analytics
+		WHERE tournament_id = $1
+	`
+	var analytics domain.TournamentAnalytics
+	var matchdays []byte
+	err := r.db.QueryRow(query, tournamentID).Scan(
+		&analytics.TournamentID,
+		&analytics.Matches,
+		&analytics.Goals,
+		&analytics.HomeWins,
+		&analytics.AwayWins,
+		&analytics.Draws,
+		&matchdays,
+		&analytics.ComputedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("analytics not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(matchdays, &analytics.GoalsPerMatchday); err != nil {
+		return nil, err
+	}
+
+	analytics.ComputeRates()
+	return &analytics, nil
+}
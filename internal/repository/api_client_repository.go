@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// APIClientRepository guarda los clientes OAuth y sus tokens de acceso
+type APIClientRepository interface {
+	Create(client *domain.APIClient) error
+	GetByID(id uuid.UUID) (*domain.APIClient, error)
+	GetAll() ([]domain.APIClient, error)
+	// Revoke da de baja el cliente y borra sus tokens
+	Revoke(id uuid.UUID, revokedAt time.Time) error
+	// CreateToken guarda un token y borra los vencidos
+	CreateToken(token *domain.APIToken) error
+	// GetToken busca un token vigente de un cliente no revocado
+	GetToken(tokenHash string) (*domain.APIToken, error)
+}
+
+type PostgresAPIClientRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresAPIClientRepository(db *sql.DB) APIClientRepository {
+	return &PostgresAPIClientRepository{db: db}
+}
+
+// apiClientColumns debe mantenerse en el mismo orden que scanAPIClient
+const apiClientColumns = `id, name, secret_hash, scopes, rate_limit_per_minute, created_at, revoked_at`
+
+func scanAPIClient(row rowScanner, client *domain.APIClient) error {
+	return row.Scan(
+		&client.ID,
+		&client.Name,
+		&client.SecretHash,
+		pq.Array(&client.Scopes),
+		&client.RateLimitPerMinute,
+		&client.CreatedAt,
+		&client.RevokedAt,
+	)
+}
+
+func (r *PostgresAPIClientRepository) Create(client *domain.APIClient) error {
+	query := `
+		INSERT INTO api_clients (id, name, secret_hash, scopes, rate_limit_per_minute, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err := r.db.Exec(query, client.ID, client.Name, client.SecretHash, pq.Array(client.Scopes), client.RateLimitPerMinute, client.CreatedAt)
+	return err
+}
+
+func (r *PostgresAPIClientRepository) GetByID(id uuid.UUID) (*domain.APIClient, error) {
+	query := `SELECT ` + apiClientColumns + ` FROM api_clients WHERE id = $1`
+	var client domain.APIClient
+	err := scanAPIClient(r.db.QueryRow(query, id), &client)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("api client not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &client, nil
+}
+
+func (r *PostgresAPIClientRepository) GetAll() ([]domain.APIClient, error) {
+	query := `SELECT ` + apiClientColumns + ` FROM api_clients ORDER BY created_at DESC`
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	clients := []domain.APIClient{}
+	for rows.Next() {
+		var client domain.APIClient
+		if err := scanAPIClient(rows, &client); err != nil {
+			return nil, err
+		}
+		clients = append(clients, client)
+	}
+	return clients, rows.Err()
+}
+
+func (r *PostgresAPIClientRepository) Revoke(id uuid.UUID, revokedAt time.Time) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`UPDATE api_clients SET revoked_at = $2 WHERE id = $1 AND revoked_at IS NULL`, id, revokedAt)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("api client not found or already revoked")
+	}
+	if _, err := tx.Exec(`DELETE FROM api_tokens WHERE client_id = $1`, id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *PostgresAPIClientRepository) CreateToken(token *domain.APIToken) error {
+	if _, err := r.db.Exec(`DELETE FROM api_tokens WHERE expires_at <= NOW()`); err != nil {
+		return err
+	}
+	query := `INSERT INTO api_tokens (token_hash, client_id, scopes, expires_at) VALUES ($1, $2, $3, $4)`
+	_, err := r.db.Exec(query, token.TokenHash, token.ClientID, pq.Array(token.Scopes), token.ExpiresAt)
+	return err
+}
+
+func (r *PostgresAPIClientRepository) GetToken(tokenHash string) (*domain.APIToken, error) {
+	query := `
+		SELECT t.token_hash, t.client_id, t.scopes, t.expires_at, c.rate_limit_per_minute
+		FROM api_tokens t
+		INNER JOIN api_clients c ON c.id = t.client_id
+		WHERE t.token_hash = $1 AND t.expires_at > NOW() AND c.revoked_at IS NULL
+	`
+	var token domain.APIToken
+	err := r.db.QueryRow(query, tokenHash).Scan(
+		&token.TokenHash,
+		&token.ClientID,
+		pq.Array(&token.Scopes),
+		&token.ExpiresAt,
+		&token.RateLimitPerMinute,
+	)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("invalid or expired token")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
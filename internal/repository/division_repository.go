@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+type DivisionRepository interface {
+	Create(division *domain.Division) error
+	GetByID(id uuid.UUID) (*domain.Division, error)
+	// GetAll lista las divisiones de la más alta a la más baja
+	GetAll() ([]domain.Division, error)
+	Update(division *domain.Division) error
+	Delete(id uuid.UUID) error
+}
+
+type PostgresDivisionRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresDivisionRepository(db *sql.DB) DivisionRepository {
+	return &PostgresDivisionRepository{db: db}
+}
+
+// divisionColumns debe mantenerse en el mismo orden que scanDivision
+const divisionColumns = `id, name, level, promoted, relegated, created_at`
+
+func scanDivision(row rowScanner, division *domain.Division) error {
+	return row.Scan(
+		&division.ID,
+		&division.Name,
+		&division.Level,
+		&division.Promoted,
+		&division.Relegated,
+		&division.CreatedAt,
+	)
+}
+
+func (r *PostgresDivisionRepository) Create(division *domain.Division) error {
+	query := `
+		INSERT INTO divisions (id, name, level, promoted, relegated, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err := r.db.Exec(query, division.ID, division.Name, division.Level, division.Promoted, division.Relegated, division.CreatedAt)
+	return err
+}
+
+func (r *PostgresDivisionRepository) GetByID(id uuid.UUID) (*domain.Division, error) {
+	query := `SELECT ` + divisionColumns + ` FROM divisions WHERE id = $1`
+	var division domain.Division
+	err := scanDivision(r.db.QueryRow(query, id), &division)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("division not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &division, nil
+}
+
+func (r *PostgresDivisionRepository) GetAll() ([]domain.Division, error) {
+	query := `SELECT ` + divisionColumns + ` FROM divisions ORDER BY level`
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	divisions := []domain.Division{}
+	for rows.Next() {
+		var division domain.Division
+		if err := scanDivision(rows, &division); err != nil {
+			return nil, err
+		}
+		divisions = append(divisions, division)
+	}
+	return divisions, rows.Err()
+}
+
+func (r *PostgresDivisionRepository) Update(division *domain.Division) error {
+	query := `UPDATE divisions SET name = $2, level = $3, promoted = $4, relegated = $5 WHERE id = $1`
+	result, err := r.db.Exec(query, division.ID, division.Name, division.Level, division.Promoted, division.Relegated)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("division not found")
+	}
+	return nil
+}
+
+// Delete borra la división; sus torneos quedan sin división
+func (r *PostgresDivisionRepository) Delete(id uuid.UUID) error {
+	query := `DELETE FROM divisions WHERE id = $1`
+	result, err := r.db.Exec(query, id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("division not found")
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// DrawRepository solo permite crear y leer: los sorteos son inmutables
+type DrawRepository interface {
+	Create(draw *domain.Draw) error
+	GetByID(id uuid.UUID) (*domain.Draw, error)
+	GetByTournament(tournamentID uuid.UUID) ([]domain.Draw, error)
+}
+
+type PostgresDrawRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresDrawRepository(db *sql.DB) DrawRepository {
+	return &PostgresDrawRepository{db: db}
+}
+
+// Create guarda el sorteo y todas sus extracciones en una transacción
+func (r *PostgresDrawRepository) Create(draw *domain.Draw) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO draws (id, tournament_id, mode, group_count, seed, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	if _, err := tx.Exec(query, draw.ID, draw.TournamentID, draw.Mode, draw.GroupCount, draw.Seed, draw.CreatedAt); err != nil {
+		return err
+	}
+
+	pickQuery := `INSERT INTO draw_picks (draw_id, pick_order, team_id, pot, slot) VALUES ($1, $2, $3, $4, $5)`
+	for _, pick := range draw.Picks {
+		if _, err := tx.Exec(pickQuery, draw.ID, pick.Order, pick.TeamID, pick.Pot, pick.Slot); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresDrawRepository) GetByID(id uuid.UUID) (*domain.Draw, error) {
+	query := `SELECT id, tournament_id, mode, group_count, seed, created_at FROM draws WHERE id = $1`
+	var draw domain.Draw
+	err := r.db.QueryRow(query, id).Scan(&draw.ID, &draw.TournamentID, &draw.Mode, &draw.GroupCount, &draw.Seed, &draw.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("draw not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	picks, err := r.getPicks(draw.ID)
+	if err != nil {
+		return nil, err
+	}
+	draw.Picks = picks
+	return &draw, nil
+}
+
+func (r *PostgresDrawRepository) GetByTournament(tournamentID uuid.UUID) ([]domain.Draw, error) {
+	query := `
+		SELECT id, tournament_id, mode, group_count, seed, created_at
+		FROM draws
+		WHERE tournament_id = $1
+		ORDER BY created_at
+	`
+	rows, err := r.db.Query(query, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var draws []domain.Draw
+	for rows.Next() {
+		var draw domain.Draw
+		if err := rows.Scan(&draw.ID, &draw.TournamentID, &draw.Mode, &draw.GroupCount, &draw.Seed, &draw.CreatedAt); err != nil {
+			return nil, err
+		}
+		draws = append(draws, draw)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range draws {
+		picks, err := r.getPicks(draws[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		draws[i].Picks = picks
+	}
+	return draws, nil
+}
+
+func (r *PostgresDrawRepository) getPicks(drawID uuid.UUID) ([]domain.DrawPick, error) {
+	query := `
+		SELECT pick_order, team_id, pot, slot
+		FROM draw_picks
+		WHERE draw_id = $1
+		ORDER BY pick_order
+	`
+	rows, err := r.db.Query(query, drawID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	picks := []domain.DrawPick{}
+	for rows.Next() {
+		var pick domain.DrawPick
+		if err := rows.Scan(&pick.Order, &pick.TeamID, &pick.Pot, &pick.Slot); err != nil {
+			return nil, err
+		}
+		picks = append(picks, pick)
+	}
+	return picks, rows.Err()
+}
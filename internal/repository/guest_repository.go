@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// GuestRepository lee y reasigna los invitados sin ficha de un equipo. Los
+// nombres se comparan sin distinguir mayúsculas.
+type GuestRepository interface {
+	// GetByTeam junta los invitados de los eventos y las alineaciones del equipo
+	GetByTeam(teamID uuid.UUID) ([]domain.Guest, error)
+	// Promote asigna al jugador los eventos del invitado y lo suma al banco de
+	// las alineaciones donde figuraba, quitándolo de la lista de invitados
+	Promote(teamID uuid.UUID, name string, playerID uuid.UUID) (*domain.GuestPromotion, error)
+}
+
+type PostgresGuestRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresGuestRepository(db *sql.DB) GuestRepository {
+	return &PostgresGuestRepository{db: db}
+}
+
+func (r *PostgresGuestRepository) GetByTeam(teamID uuid.UUID) ([]domain.Guest, error) {
+	query := `
+		SELECT MIN(name), COUNT(DISTINCT match_id), COALESCE(SUM(goals), 0)
+		FROM (
+			SELECT guest_name AS name, match_id,
+			       CASE WHEN type IN ($2, $3) THEN 1 ELSE 0 END AS goals
+			FROM match_events
+			WHERE team_id = $1 AND guest_name <> ''
+			UNION ALL
+			SELECT g.name, l.match_id, 0
+			FROM lineups l, unnest(l.guests) AS g(name)
+			WHERE l.team_id = $1
+		) appearances
+		GROUP BY LOWER(name)
+		ORDER BY LOWER(name)
+	`
+	rows, err := r.db.Query(query, teamID, domain.EventGoal, domain.EventPenaltyGoal)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	guests := []domain.Guest{}
+	for rows.Next() {
+		var guest domain.Guest
+		if err := rows.Scan(&guest.Name, &guest.Matches, &guest.Goals); err != nil {
+			return nil, err
+		}
+		guests = append(guests, guest)
+	}
+	return guests, rows.Err()
+}
+
+// Promote reasigna todo en una transacción para no dejar al invitado a medias
+func (r *PostgresGuestRepository) Promote(teamID uuid.UUID, name string, playerID uuid.UUID) (*domain.GuestPromotion, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	promotion := &domain.GuestPromotion{Name: name, PlayerID: playerID}
+
+	result, err := tx.Exec(`
+		UPDATE match_events SET player_id = $3, guest_name = ''
+		WHERE team_id = $1 AND guest_name <> '' AND LOWER(guest_name) = LOWER($2)
+	`, teamID, name, playerID)
+	if err != nil {
+		return nil, err
+	}
+	events, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	promotion.Events = int(events)
+
+	// Si el jugador ya estaba en la alineación se conserva su lugar
+	_, err = tx.Exec(`
+		INSERT INTO lineup_players (match_id, team_id, player_id, role, slot)
+		SELECT l.match_id, l.team_id, $3, $4,
+		       (SELECT COALESCE(MAX(lp.slot), 0) + 1 FROM lineup_players lp
+		        WHERE lp.match_id = l.match_id AND lp.team_id = l.team_id AND lp.role = $4)
+		FROM lineups l
+		WHERE l.team_id = $1 AND EXISTS (SELECT 1 FROM unnest(l.guests) g WHERE LOWER(g) = LOWER($2))
+		ON CONFLICT (match_id, player_id) DO NOTHING
+	`, teamID, name, playerID, lineupRoleBench)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err = tx.Exec(`
+		UPDATE lineups SET guests = ARRAY(SELECT g FROM unnest(guests) g WHERE LOWER(g) <> LOWER($2))
+		WHERE team_id = $1 AND EXISTS (SELECT 1 FROM unnest(guests) g WHERE LOWER(g) = LOWER($2))
+	`, teamID, name)
+	if err != nil {
+		return nil, err
+	}
+	lineups, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	promotion.Lineups = int(lineups)
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return promotion, nil
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+)
+
+// IdempotencyRepository guarda las claves Idempotency-Key y sus respuestas.
+// Una clave se identifica por el par (Caller, Key).
+type IdempotencyRepository interface {
+	// Reserve guarda la clave en curso y borra las vencidas. Si la clave ya
+	// existe y no se puede reemplazar (ver IdempotencyRecord.Replaceable)
+	// devuelve el registro guardado y no reserva nada.
+	Reserve(record *domain.IdempotencyRecord, now time.Time) (*domain.IdempotencyRecord, error)
+	// Complete guarda la respuesta de una clave en curso
+	Complete(record *domain.IdempotencyRecord) error
+	// Release borra una clave en curso para que un reintento la vuelva a usar
+	Release(caller, key string) error
+}
+
+type PostgresIdempotencyRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresIdempotencyRepository(db *sql.DB) IdempotencyRepository {
+	return &PostgresIdempotencyRepository{db: db}
+}
+
+func (r *PostgresIdempotencyRepository) Reserve(record *domain.IdempotencyRecord, now time.Time) (*domain.IdempotencyRecord, error) {
+	if _, err := r.db.Exec(`DELETE FROM idempotency_keys WHERE expires_at <= $1`, now); err != nil {
+		return nil, err
+	}
+
+	// El WHERE del DO UPDATE repite IdempotencyRecord.Replaceable: solo se
+	// pisa una reserva colgada (las vencidas ya se borraron)
+	query := `
+		INSERT INTO idempotency_keys (caller, key, fingerprint, status_code, created_at, expires_at)
+		VALUES ($6, $1, $2, 0, $3, $4)
+		ON CONFLICT (caller, key) DO UPDATE SET
+			fingerprint = EXCLUDED.fingerprint, status_code = 0, content_type = '', body = NULL,
+			created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.status_code = 0 AND idempotency_keys.created_at <= $5
+	`
+	result, err := r.db.Exec(query, record.Key, record.Fingerprint, record.CreatedAt, record.ExpiresAt, now.Add(-domain.IdempotencyLockTimeout), record.Caller)
+	if err != nil {
+		return nil, err
+	}
+	if rows, err := result.RowsAffected(); err != nil || rows == 1 {
+		return nil, err
+	}
+
+	var existing domain.IdempotencyRecord
+	var body []byte
+	err = r.db.QueryRow(`
+		SELECT caller, key, fingerprint, status_code, content_type, body, created_at, expires_at
+		FROM idempotency_keys WHERE caller = $1 AND key = $2
+	`, record.Caller, record.Key).Scan(
+		&existing.Caller,
+		&existing.Key,
+		&existing.Fingerprint,
+		&existing.StatusCode,
+		&existing.ContentType,
+		&body,
+		&existing.CreatedAt,
+		&existing.ExpiresAt,
+	)
+	if err == sql.ErrNoRows {
+		// La otra petición liberó la clave entre el INSERT y el SELECT:
+		// se informa como en curso y el cliente reintenta
+		return &domain.IdempotencyRecord{Caller: record.Caller, Key: record.Key, Fingerprint: record.Fingerprint}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	existing.Body = body
+	return &existing, nil
+}
+
+func (r *PostgresIdempotencyRepository) Complete(record *domain.IdempotencyRecord) error {
+	query := `
+		UPDATE idempotency_keys SET status_code = $3, content_type = $4, body = $5
+		WHERE caller = $1 AND key = $2 AND status_code = 0
+	`
+	_, err := r.db.Exec(query, record.Caller, record.Key, record.StatusCode, record.ContentType, record.Body)
+	return err
+}
+
+func (r *PostgresIdempotencyRepository) Release(caller, key string) error {
+	_, err := r.db.Exec(`DELETE FROM idempotency_keys WHERE caller = $1 AND key = $2 AND status_code = 0`, caller, key)
+	return err
+}
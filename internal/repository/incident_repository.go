@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+type IncidentRepository interface {
+	Create(incident *domain.Incident) error
+	GetByID(id uuid.UUID) (*domain.Incident, error)
+	// GetActive devuelve los incidentes sin resolver, el más reciente primero
+	GetActive() ([]domain.Incident, error)
+	Resolve(id uuid.UUID, at time.Time) error
+}
+
+type PostgresIncidentRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresIncidentRepository(db *sql.DB) IncidentRepository {
+	return &PostgresIncidentRepository{db: db}
+}
+
+// incidentColumns debe mantenerse en el mismo orden que scanIncident
+const incidentColumns = `id, title, message, severity, started_at, resolved_at`
+
+func scanIncident(row rowScanner, incident *domain.Incident) error {
+	return row.Scan(
+		&incident.ID,
+		&incident.Title,
+		&incident.Message,
+		&incident.Severity,
+		&incident.StartedAt,
+		&incident.ResolvedAt,
+	)
+}
+
+func (r *PostgresIncidentRepository) Create(incident *domain.Incident) error {
+	query := `
+		INSERT INTO status_incidents (id, title, message, severity, started_at, resolved_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err := r.db.Exec(query,
+		incident.ID,
+		incident.Title,
+		incident.Message,
+		incident.Severity,
+		incident.StartedAt,
+		incident.ResolvedAt,
+	)
+	return err
+}
+
+func (r *PostgresIncidentRepository) GetByID(id uuid.UUID) (*domain.Incident, error) {
+	query := `SELECT ` + incidentColumns + ` FROM status_incidents WHERE id = $1`
+	var incident domain.Incident
+	err := scanIncident(r.db.QueryRow(query, id), &incident)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("incident not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &incident, nil
+}
+
+func (r *PostgresIncidentRepository) GetActive() ([]domain.Incident, error) {
+	query := `SELECT ` + incidentColumns + ` FROM status_incidents WHERE resolved_at IS NULL ORDER BY started_at DESC`
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	incidents := []domain.Incident{}
+	for rows.Next() {
+		var incident domain.Incident
+		if err := scanIncident(rows, &incident); err != nil {
+			return nil, err
+		}
+		incidents = append(incidents, incident)
+	}
+	return incidents, rows.Err()
+}
+
+func (r *PostgresIncidentRepository) Resolve(id uuid.UUID, at time.Time) error {
+	query := `UPDATE status_incidents SET resolved_at = $2 WHERE id = $1 AND resolved_at IS NULL`
+	result, err := r.db.Exec(query, id, at)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("incident not found or already resolved")
+	}
+	return nil
+}
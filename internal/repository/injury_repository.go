@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type InjuryRepository interface {
+	Create(injury *domain.Injury) error
+	GetByID(id uuid.UUID) (*domain.Injury, error)
+	GetByPlayer(playerID uuid.UUID) ([]domain.Injury, error)
+	// GetActive devuelve las lesiones vigentes en at de los jugadores indicados
+	GetActive(playerIDs []uuid.UUID, at time.Time) ([]domain.Injury, error)
+	Update(injury *domain.Injury) error
+	Delete(id uuid.UUID) error
+}
+
+type PostgresInjuryRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresInjuryRepository(db *sql.DB) InjuryRepository {
+	return &PostgresInjuryRepository{db: db}
+}
+
+// injuryColumns debe mantenerse en el mismo orden que scanInjury
+const injuryColumns = `id, player_id, type, start_date, expected_return, created_at`
+
+func scanInjury(row rowScanner, injury *domain.Injury) error {
+	return row.Scan(
+		&injury.ID,
+		&injury.PlayerID,
+		&injury.Type,
+		&injury.StartDate,
+		&injury.ExpectedReturn,
+		&injury.CreatedAt,
+	)
+}
+
+func (r *PostgresInjuryRepository) Create(injury *domain.Injury) error {
+	query := `
+		INSERT INTO injuries (id, player_id, type, start_date, expected_return, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err := r.db.Exec(query,
+		injury.ID,
+		injury.PlayerID,
+		injury.Type,
+		injury.StartDate,
+		injury.ExpectedReturn,
+		injury.CreatedAt,
+	)
+	return err
+}
+
+func (r *PostgresInjuryRepository) GetByID(id uuid.UUID) (*domain.Injury, error) {
+	query := `SELECT ` + injuryColumns + ` FROM injuries WHERE id = $1`
+	var injury domain.Injury
+	err := scanInjury(r.db.QueryRow(query, id), &injury)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("injury not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &injury, nil
+}
+
+func (r *PostgresInjuryRepository) GetByPlayer(playerID uuid.UUID) ([]domain.Injury, error) {
+	query := `
+		SELECT ` + injuryColumns + `
+		FROM injuries
+		WHERE player_id = $1
+		ORDER BY start_date DESC
+	`
+	return r.queryInjuries(query, playerID)
+}
+
+func (r *PostgresInjuryRepository) GetActive(playerIDs []uuid.UUID, at time.Time) ([]domain.Injury, error) {
+	ids := make([]string, len(playerIDs))
+	for i, id := range playerIDs {
+		ids[i] = id.String()
+	}
+
+	query := `
+		SELECT ` + injuryColumns + `
+		FROM injuries
+		WHERE player_id = ANY($1::uuid[])
+		  AND start_date <= $2
+		  AND (expected_return IS NULL OR expected_return > $2)
+		ORDER BY start_date
+	`
+	return r.queryInjuries(query, pq.Array(ids), at)
+}
+
+func (r *PostgresInjuryRepository) queryInjuries(query string, args ...interface{}) ([]domain.Injury, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	injuries := []domain.Injury{}
+	for rows.Next() {
+		var injury domain.Injury
+		if err := scanInjury(rows, &injury); err != nil {
+			return nil, err
+		}
+		injuries = append(injuries, injury)
+	}
+	return injuries, rows.Err()
+}
+
+func (r *PostgresInjuryRepository) Update(injury *domain.Injury) error {
+	query := `
+		UPDATE injuries
+		SET type = $2, start_date = $3, expected_return = $4
+		WHERE id = $1
+	`
+	result, err := r.db.Exec(query, injury.ID, injury.Type, injury.StartDate, injury.ExpectedReturn)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("injury not found")
+	}
+	return nil
+}
+
+func (r *PostgresInjuryRepository) Delete(id uuid.UUID) error {
+	query := `DELETE FROM injuries WHERE id = $1`
+	result, err := r.db.Exec(query, id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("injury not found")
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Roles de un jugador dentro de la alineación
+const (
+	lineupRoleStarter = "starter"
+	lineupRoleBench   = "bench"
+)
+
+type LineupRepository interface {
+	// Save guarda la alineación del equipo reemplazando la anterior si existía
+	Save(lineup *domain.Lineup) error
+	GetByMatch(matchID uuid.UUID) ([]domain.Lineup, error)
+}
+
+type PostgresLineupRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresLineupRepository(db *sql.DB) LineupRepository {
+	return &PostgresLineupRepository{db: db}
+}
+
+// Save reemplaza la alineación y sus jugadores en una transacción
+func (r *PostgresLineupRepository) Save(lineup *domain.Lineup) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Los jugadores se borran en cascada
+	if _, err := tx.Exec(`DELETE FROM lineups WHERE match_id = $1 AND team_id = $2`, lineup.MatchID, lineup.TeamID); err != nil {
+		return err
+	}
+
+	query := `INSERT INTO lineups (match_id, team_id, formation, guests, created_at) VALUES ($1, $2, $3, $4, $5)`
+	if _, err := tx.Exec(query, lineup.MatchID, lineup.TeamID, lineup.Formation, pq.Array(lineup.Guests), lineup.CreatedAt); err != nil {
+		return err
+	}
+
+	playerQuery := `INSERT INTO lineup_players (match_id, team_id, player_id, role, slot) VALUES ($1, $2, $3, $4, $5)`
+	for i, playerID := range lineup.Starting {
+		if _, err := tx.Exec(playerQuery, lineup.MatchID, lineup.TeamID, playerID, lineupRoleStarter, i+1); err != nil {
+			return err
+		}
+	}
+	for i, playerID := range lineup.Bench {
+		if _, err := tx.Exec(playerQuery, lineup.MatchID, lineup.TeamID, playerID, lineupRoleBench, i+1); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresLineupRepository) GetByMatch(matchID uuid.UUID) ([]domain.Lineup, error) {
+	query := `
+		SELECT team_id, formation, guests, created_at
+		FROM lineups
+		WHERE match_id = $1
+		ORDER BY created_at
+	`
+	rows, err := r.db.Query(query, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	lineups := []domain.Lineup{}
+	index := make(map[uuid.UUID]int)
+	for rows.Next() {
+		lineup := domain.Lineup{MatchID: matchID, Starting: []uuid.UUID{}, Bench: []uuid.UUID{}}
+		if err := rows.Scan(&lineup.TeamID, &lineup.Formation, pq.Array(&lineup.Guests), &lineup.CreatedAt); err != nil {
+			return nil, err
+		}
+		index[lineup.TeamID] = len(lineups)
+		lineups = append(lineups, lineup)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	playerQuery := `
+		SELECT team_id, player_id, role
+		FROM lineup_players
+		WHERE match_id = $1
+		ORDER BY role DESC, slot
+	`
+	playerRows, err := r.db.Query(playerQuery, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer playerRows.Close()
+
+	for playerRows.Next() {
+		var teamID, playerID uuid.UUID
+		var role string
+		if err := playerRows.Scan(&teamID, &playerID, &role); err != nil {
+			return nil, err
+		}
+		lineup := &lineups[index[teamID]]
+		if role == lineupRoleStarter {
+			lineup.Starting = append(lineup.Starting, playerID)
+		} else {
+			lineup.Bench = append(lineup.Bench, playerID)
+		}
+	}
+	return lineups, playerRows.Err()
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+type MatchClockRepository interface {
+	// Get devuelve el reloj del partido; error si nunca se usó
+	Get(matchID uuid.UUID) (*domain.MatchClock, error)
+	// Save crea o reemplaza el reloj del partido
+	Save(clock *domain.MatchClock) error
+}
+
+type PostgresMatchClockRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresMatchClockRepository(db *sql.DB) MatchClockRepository {
+	return &PostgresMatchClockRepository{db: db}
+}
+
+func (r *PostgresMatchClockRepository) Get(matchID uuid.UUID) (*domain.MatchClock, error) {
+	query := `
+		SELECT match_id, status, period, duration_minutes, elapsed_ms, running_since, stoppage_minutes, updated_at
+		FROM match_clocks
+		WHERE match_id = $1
+	`
+	var clock domain.MatchClock
+	err := r.db.QueryRow(query, matchID).Scan(
+		&clock.MatchID,
+		&clock.Status,
+		&clock.Period,
+		&clock.DurationMinutes,
+		&clock.ElapsedMs,
+		&clock.RunningSince,
+		&clock.StoppageMinutes,
+		&clock.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("match clock not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &clock, nil
+}
+
+func (r *PostgresMatchClockRepository) Save(clock *domain.MatchClock) error {
+	query := `
+		INSERT INTO match_clocks (match_id, status, period, duration_minutes, elapsed_ms, running_since, stoppage_minutes, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (match_id) DO UPDATE SET
+			status = EXCLUDED.status,
+			period = EXCLUDED.period,
+			duration_minutes = EXCLUDED.duration_minutes,
+			elapsed_ms = EXCLUDED.elapsed_ms,
+			running_since = EXCLUDED.running_since,
+			stoppage_minutes = EXCLUDED.stoppage_minutes,
+			updated_at = EXCLUDED.updated_at
+	`
+	_, err := r.db.Exec(query,
+		clock.MatchID,
+		clock.Status,
+		clock.Period,
+		clock.DurationMinutes,
+		clock.ElapsedMs,
+		clock.RunningSince,
+		clock.StoppageMinutes,
+		clock.UpdatedAt,
+	)
+	return err
+}
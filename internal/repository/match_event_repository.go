@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+type MatchEventRepository interface {
+	Create(event *domain.MatchEvent) error
+	GetByID(id uuid.UUID) (*domain.MatchEvent, error)
+	GetByMatch(matchID uuid.UUID) ([]domain.MatchEvent, error)
+	Delete(id uuid.UUID) error
+}
+
+type PostgresMatchEventRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresMatchEventRepository(db *sql.DB) MatchEventRepository {
+	return &PostgresMatchEventRepository{db: db}
+}
+
+// matchEventColumns debe mantenerse en el mismo orden que scanMatchEvent
+const matchEventColumns = `id, match_id, type, minute, team_id, player_id, guest_name, assist_player_id, created_at`
+
+func scanMatchEvent(row rowScanner, event *domain.MatchEvent) error {
+	return row.Scan(
+		&event.ID,
+		&event.MatchID,
+		&event.Type,
+		&event.Minute,
+		&event.TeamID,
+		&event.PlayerID,
+		&event.GuestName,
+		&event.AssistPlayerID,
+		&event.CreatedAt,
+	)
+}
+
+func (r *PostgresMatchEventRepository) Create(event *domain.MatchEvent) error {
+	query := `
+		INSERT INTO match_events (id, match_id, type, minute, team_id, player_id, guest_name, assist_player_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	_, err := r.db.Exec(query,
+		event.ID,
+		event.MatchID,
+		event.Type,
+		event.Minute,
+		event.TeamID,
+		event.PlayerID,
+		event.GuestName,
+		event.AssistPlayerID,
+		event.CreatedAt,
+	)
+	return err
+}
+
+func (r *PostgresMatchEventRepository) GetByID(id uuid.UUID) (*domain.MatchEvent, error) {
+	query := `SELECT ` + matchEventColumns + ` FROM match_events WHERE id = $1`
+	var event domain.MatchEvent
+	err := scanMatchEvent(r.db.QueryRow(query, id), &event)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("match event not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (r *PostgresMatchEventRepository) GetByMatch(matchID uuid.UUID) ([]domain.MatchEvent, error) {
+	query := `
+		SELECT ` + matchEventColumns + `
+		FROM match_events
+		WHERE match_id = $1
+		ORDER BY minute, created_at
+	`
+	rows, err := r.db.Query(query, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []domain.MatchEvent{}
+	for rows.Next() {
+		var event domain.MatchEvent
+		if err := scanMatchEvent(rows, &event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+func (r *PostgresMatchEventRepository) Delete(id uuid.UUID) error {
+	query := `DELETE FROM match_events WHERE id = $1`
+	result, err := r.db.Exec(query, id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("match event not found")
+	}
+	return nil
+}
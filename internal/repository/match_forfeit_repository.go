@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// MatchForfeitRepository guarda las adjudicaciones por walkover. Award y
+// Revoke cambian el resultado del partido en la misma transacción que el
+// historial.
+type MatchForfeitRepository interface {
+	// Award registra la adjudicación y guarda en el partido el resultado ya
+	// aplicado (forfeit.Apply)
+	Award(forfeit *domain.MatchForfeit, match *domain.Match) error
+	// Revoke marca la adjudicación como revocada y guarda en el partido el
+	// resultado restaurado (forfeit.Restore)
+	Revoke(forfeit *domain.MatchForfeit, match *domain.Match) error
+	// GetActive devuelve la adjudicación vigente del partido
+	GetActive(matchID uuid.UUID) (*domain.MatchForfeit, error)
+	// GetByMatch devuelve el historial del partido, de la más reciente a la más antigua
+	GetByMatch(matchID uuid.UUID) ([]domain.MatchForfeit, error)
+}
+
+type PostgresMatchForfeitRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresMatchForfeitRepository(db *sql.DB) MatchForfeitRepository {
+	return &PostgresMatchForfeitRepository{db: db}
+}
+
+// matchForfeitColumns debe mantenerse en el mismo orden que scanMatchForfeit
+const matchForfeitColumns = `id, match_id, forfeiting_team_id, awarded_team_id, awarded_goals, reason,
+	previous_goals_team1, previous_goals_team2, previous_extra_time_team1, previous_extra_time_team2,
+	previous_penalties_team1, previous_penalties_team2, awarded_at, revoked_at`
+
+func scanMatchForfeit(row rowScanner, forfeit *domain.MatchForfeit) error {
+	return row.Scan(
+		&forfeit.ID,
+		&forfeit.MatchID,
+		&forfeit.ForfeitingTeamID,
+		&forfeit.AwardedTeamID,
+		&forfeit.AwardedGoals,
+		&forfeit.Reason,
+		&forfeit.PreviousGoalsTeam1,
+		&forfeit.PreviousGoalsTeam2,
+		&forfeit.PreviousExtraTimeTeam1,
+		&forfeit.PreviousExtraTimeTeam2,
+		&forfeit.PreviousPenaltiesTeam1,
+		&forfeit.PreviousPenaltiesTeam2,
+		&forfeit.AwardedAt,
+		&forfeit.RevokedAt,
+	)
+}
+
+func (r *PostgresMatchForfeitRepository) Award(forfeit *domain.MatchForfeit, match *domain.Match) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO match_forfeits (` + matchForfeitColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	`
+	_, err = tx.Exec(query,
+		forfeit.ID,
+		forfeit.MatchID,
+		forfeit.ForfeitingTeamID,
+		forfeit.AwardedTeamID,
+		forfeit.AwardedGoals,
+		forfeit.Reason,
+		forfeit.PreviousGoalsTeam1,
+		forfeit.PreviousGoalsTeam2,
+		forfeit.PreviousExtraTimeTeam1,
+		forfeit.PreviousExtraTimeTeam2,
+		forfeit.PreviousPenaltiesTeam1,
+		forfeit.PreviousPenaltiesTeam2,
+		forfeit.AwardedAt,
+		forfeit.RevokedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := updateMatchResult(tx, match); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *PostgresMatchForfeitRepository) Revoke(forfeit *domain.MatchForfeit, match *domain.Match) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`UPDATE match_forfeits SET revoked_at = $2 WHERE id = $1 AND revoked_at IS NULL`, forfeit.ID, forfeit.RevokedAt)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("forfeit not found")
+	}
+
+	if err := updateMatchResult(tx, match); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// updateMatchResult guarda el marcador y el tipo de resultado del partido y
+// actualiza su versión
+func updateMatchResult(tx *sql.Tx, match *domain.Match) error {
+	query := `
+		UPDATE matches
+		SET goal_scored_team1 = $2, goal_scored_team2 = $3, extra_time_team1 = $4, extra_time_team2 = $5,
+		    penalties_team1 = $6, penalties_team2 = $7, result_type = $8, updated_at = $9
+		WHERE id = $1
+	`
+	match.UpdatedAt = time.Now().UTC().Truncate(time.Microsecond)
+	result, err := tx.Exec(query,
+		match.ID,
+		match.GoalScoredTeam1,
+		match.GoalScoredTeam2,
+		match.ExtraTimeTeam1,
+		match.ExtraTimeTeam2,
+		match.PenaltiesTeam1,
+		match.PenaltiesTeam2,
+		match.ResultType,
+		match.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("match not found")
+	}
+	return nil
+}
+
+func (r *PostgresMatchForfeitRepository) GetActive(matchID uuid.UUID) (*domain.MatchForfeit, error) {
+	query := `SELECT ` + matchForfeitColumns + ` FROM match_forfeits WHERE match_id = $1 AND revoked_at IS NULL`
+	var forfeit domain.MatchForfeit
+	err := scanMatchForfeit(r.db.QueryRow(query, matchID), &forfeit)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("match has no active forfeit")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &forfeit, nil
+}
+
+func (r *PostgresMatchForfeitRepository) GetByMatch(matchID uuid.UUID) ([]domain.MatchForfeit, error) {
+	query := `SELECT ` + matchForfeitColumns + ` FROM match_forfeits WHERE match_id = $1 ORDER BY awarded_at DESC`
+	rows, err := r.db.Query(query, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	forfeits := []domain.MatchForfeit{}
+	for rows.Next() {
+		var forfeit domain.MatchForfeit
+		if err := scanMatchForfeit(rows, &forfeit); err != nil {
+			return nil, err
+		}
+		forfeits = append(forfeits, forfeit)
+	}
+	return forfeits, rows.Err()
+}
@@ -3,6 +3,8 @@ package repository
 import (
 	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
 	"github.com/google/uuid"
@@ -11,7 +13,23 @@ import (
 type MatchRepository interface {
 	Create(match *domain.Match) error
 	GetByID(id uuid.UUID) (*domain.Match, error)
-	GetAll() ([]domain.Match, error)
+	// GetAll lista los partidos activos; con includeArchived también los de
+	// torneos archivados
+	GetAll(includeArchived bool) ([]domain.Match, error)
+	// Find devuelve los partidos que cumplen el filtro, del más reciente al
+	// más antiguo
+	Find(filter domain.MatchFilter) ([]domain.Match, error)
+	// FindPage devuelve una página de Find junto con el total de partidos
+	FindPage(filter domain.MatchFilter, page domain.Page) (domain.Paged[domain.Match], error)
+	GetByTournament(tournamentID uuid.UUID) ([]domain.Match, error)
+	GetSubMatches(parentID uuid.UUID) ([]domain.Match, error)
+	// GetPlayedByTeam devuelve los últimos partidos del equipo (sin
+	// mini-juegos) cuyo resultado cuenta en before (ver
+	// domain.Match.ResultCountsAt), del más reciente al más antiguo
+	GetPlayedByTeam(teamID uuid.UUID, before time.Time, limit int) ([]domain.Match, error)
+	// GetByVenue devuelve los partidos de la sede (sin mini-juegos) que
+	// empiezan entre from y to, ordenados por fecha
+	GetByVenue(venueID uuid.UUID, from, to time.Time) ([]domain.Match, error)
 	Update(match *domain.Match) error
 	Delete(id uuid.UUID) error
 }
@@ -24,41 +42,89 @@ func NewPostgresMatchRepository(db *sql.DB) MatchRepository {
 	return &PostgresMatchRepository{db: db}
 }
 
+// matchColumns es la lista de columnas que leen todas las consultas de partidos
+// y debe mantenerse en el mismo orden que scanMatch
+const matchColumns = `id, tournament_id, parent_match_id, venue_id, stage_id, round, match_number, date, team1_id, team2_id,
+	goal_scored_team1, goal_scored_team2, extra_time_team1, extra_time_team2, penalties_team1, penalties_team2,
+	created_at, updated_at, pitch_id, stream_url, broadcaster, stream_embargo_until, is_friendly, result_type, result_confirmed_at`
+
+// rowScanner abstrae *sql.Row y *sql.Rows para reutilizar el mapeo de filas
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanMatch(row rowScanner, match *domain.Match) error {
+	return row.Scan(
+		&match.ID,
+		&match.TournamentID,
+		&match.ParentMatchID,
+		&match.VenueID,
+		&match.StageID,
+		&match.Round,
+		&match.MatchNumber,
+		&match.Date,
+		&match.Team1ID,
+		&match.Team2ID,
+		&match.GoalScoredTeam1,
+		&match.GoalScoredTeam2,
+		&match.ExtraTimeTeam1,
+		&match.ExtraTimeTeam2,
+		&match.PenaltiesTeam1,
+		&match.PenaltiesTeam2,
+		&match.CreatedAt,
+		&match.UpdatedAt,
+		&match.PitchID,
+		&match.StreamURL,
+		&match.Broadcaster,
+		&match.StreamEmbargoUntil,
+		&match.IsFriendly,
+		&match.ResultType,
+		&match.ResultConfirmedAt,
+	)
+}
+
 func (r *PostgresMatchRepository) Create(match *domain.Match) error {
 	query := `
-		INSERT INTO matches (id, match_number, date, team1_id, team2_id, goal_scored_team1, goal_scored_team2, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO matches (id, tournament_id, parent_match_id, venue_id, stage_id, round, match_number, date, team1_id, team2_id,
+		                     goal_scored_team1, goal_scored_team2, extra_time_team1, extra_time_team2,
+		                     penalties_team1, penalties_team2, created_at, updated_at, pitch_id,
+		                     stream_url, broadcaster, stream_embargo_until, is_friendly, result_type, result_confirmed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25)
 	`
 	_, err := r.db.Exec(query,
 		match.ID,
+		match.TournamentID,
+		match.ParentMatchID,
+		match.VenueID,
+		match.StageID,
+		match.Round,
 		match.MatchNumber,
 		match.Date,
 		match.Team1ID,
 		match.Team2ID,
 		match.GoalScoredTeam1,
 		match.GoalScoredTeam2,
+		match.ExtraTimeTeam1,
+		match.ExtraTimeTeam2,
+		match.PenaltiesTeam1,
+		match.PenaltiesTeam2,
 		match.CreatedAt,
+		match.UpdatedAt,
+		match.PitchID,
+		match.StreamURL,
+		match.Broadcaster,
+		match.StreamEmbargoUntil,
+		match.IsFriendly,
+		match.ResultType,
+		match.ResultConfirmedAt,
 	)
 	return err
 }
 
 func (r *PostgresMatchRepository) GetByID(id uuid.UUID) (*domain.Match, error) {
-	query := `
-		SELECT id, match_number, date, team1_id, team2_id, goal_scored_team1, goal_scored_team2, created_at
-		FROM matches
-		WHERE id = $1
-	`
+	query := `SELECT ` + matchColumns + ` FROM matches WHERE id = $1`
 	var match domain.Match
-	err := r.db.QueryRow(query, id).Scan(
-		&match.ID,
-		&match.MatchNumber,
-		&match.Date,
-		&match.Team1ID,
-		&match.Team2ID,
-		&match.GoalScoredTeam1,
-		&match.GoalScoredTeam2,
-		&match.CreatedAt,
-	)
+	err := scanMatch(r.db.QueryRow(query, id), &match)
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("match not found")
 	}
@@ -68,13 +134,120 @@ func (r *PostgresMatchRepository) GetByID(id uuid.UUID) (*domain.Match, error) {
 	return &match, nil
 }
 
-func (r *PostgresMatchRepository) GetAll() ([]domain.Match, error) {
+func (r *PostgresMatchRepository) GetAll(includeArchived bool) ([]domain.Match, error) {
+	query := `SELECT ` + matchColumns + ` FROM matches WHERE $1 OR NOT archived ORDER BY date DESC`
+	return r.queryMatches(query, includeArchived)
+}
+
+func (r *PostgresMatchRepository) Find(filter domain.MatchFilter) ([]domain.Match, error) {
+	where, args := matchFilterWhere(filter)
+	query := `SELECT ` + matchColumns + ` FROM matches WHERE ` + where + ` ORDER BY date DESC, id`
+	return r.queryMatches(query, args...)
+}
+
+func (r *PostgresMatchRepository) FindPage(filter domain.MatchFilter, page domain.Page) (domain.Paged[domain.Match], error) {
+	where, args := matchFilterWhere(filter)
+	result := domain.Paged[domain.Match]{Page: page}
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM matches WHERE `+where, args...).Scan(&result.Total); err != nil {
+		return result, err
+	}
+
+	order, err := orderBy(domain.MatchSortFields.Of(page), matchSortColumns)
+	if err != nil {
+		return result, err
+	}
+	query := fmt.Sprintf(`SELECT `+matchColumns+` FROM matches WHERE %s %s LIMIT $%d OFFSET $%d`, where, order, len(args)+1, len(args)+2)
+	result.Items, err = r.queryMatches(query, append(args, page.Limit, page.Offset)...)
+	return result, err
+}
+
+// matchFilterWhere arma la condición WHERE del filtro; los parámetros
+// empiezan en $1
+func matchFilterWhere(filter domain.MatchFilter) (string, []interface{}) {
+	conditions := []string{"TRUE"}
+	var args []interface{}
+	param := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if !filter.IncludeArchived {
+		conditions = append(conditions, "NOT archived")
+	}
+	if filter.TeamID != nil {
+		team := param(*filter.TeamID)
+		conditions = append(conditions, "(team1_id = "+team+" OR team2_id = "+team+")")
+	}
+	if filter.TournamentID != nil {
+		conditions = append(conditions, "tournament_id = "+param(*filter.TournamentID))
+	}
+	if filter.SeasonID != nil {
+		conditions = append(conditions, "tournament_id IN (SELECT id FROM tournaments WHERE season_id = "+param(*filter.SeasonID)+")")
+	}
+	if filter.From != nil {
+		conditions = append(conditions, "date >= "+param(*filter.From))
+	}
+	if filter.To != nil {
+		conditions = append(conditions, "date <= "+param(*filter.To))
+	}
+	after, notAfter := filter.StatusBounds()
+	if after != nil {
+		conditions = append(conditions, "date > "+param(*after))
+	}
+	if notAfter != nil {
+		conditions = append(conditions, "date <= "+param(*notAfter))
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+func (r *PostgresMatchRepository) GetByTournament(tournamentID uuid.UUID) ([]domain.Match, error) {
 	query := `
-		SELECT id, match_number, date, team1_id, team2_id, goal_scored_team1, goal_scored_team2, created_at
+		SELECT ` + matchColumns + `
 		FROM matches
+		WHERE tournament_id = $1 AND parent_match_id IS NULL
+		ORDER BY round, date, match_number
+	`
+	return r.queryMatches(query, tournamentID)
+}
+
+func (r *PostgresMatchRepository) GetSubMatches(parentID uuid.UUID) ([]domain.Match, error) {
+	query := `
+		SELECT ` + matchColumns + `
+		FROM matches
+		WHERE parent_match_id = $1
+		ORDER BY match_number
+	`
+	return r.queryMatches(query, parentID)
+}
+
+func (r *PostgresMatchRepository) GetPlayedByTeam(teamID uuid.UUID, before time.Time, limit int) ([]domain.Match, error) {
+	query := `
+		SELECT ` + matchColumns + `
+		FROM matches
+		WHERE (team1_id = $1 OR team2_id = $1)
+		  AND parent_match_id IS NULL
+		  AND (result_type = $4
+		       OR (result_confirmed_at IS NOT NULL
+		           AND date + COALESCE((SELECT results_delay_minutes FROM tournaments WHERE id = matches.tournament_id), 0) * INTERVAL '1 minute' <= $2))
 		ORDER BY date DESC
+		LIMIT $3
+	`
+	return r.queryMatches(query, teamID, before, limit, domain.ResultForfeit)
+}
+
+func (r *PostgresMatchRepository) GetByVenue(venueID uuid.UUID, from, to time.Time) ([]domain.Match, error) {
+	query := `
+		SELECT ` + matchColumns + `
+		FROM matches
+		WHERE venue_id = $1 AND parent_match_id IS NULL AND date >= $2 AND date <= $3
+		ORDER BY date, match_number
 	`
-	rows, err := r.db.Query(query)
+	return r.queryMatches(query, venueID, from, to)
+}
+
+// queryMatches ejecuta una consulta que devuelve matchColumns y mapea el resultado
+func (r *PostgresMatchRepository) queryMatches(query string, args ...interface{}) ([]domain.Match, error) {
+	rows, err := r.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -83,16 +256,7 @@ func (r *PostgresMatchRepository) GetAll() ([]domain.Match, error) {
 	var matches []domain.Match
 	for rows.Next() {
 		var match domain.Match
-		if err := rows.Scan(
-			&match.ID,
-			&match.MatchNumber,
-			&match.Date,
-			&match.Team1ID,
-			&match.Team2ID,
-			&match.GoalScoredTeam1,
-			&match.GoalScoredTeam2,
-			&match.CreatedAt,
-		); err != nil {
+		if err := scanMatch(rows, &match); err != nil {
 			return nil, err
 		}
 		matches = append(matches, match)
@@ -100,21 +264,43 @@ func (r *PostgresMatchRepository) GetAll() ([]domain.Match, error) {
 	return matches, rows.Err()
 }
 
+// Update guarda el partido y le asigna una nueva versión (updated_at)
 func (r *PostgresMatchRepository) Update(match *domain.Match) error {
 	query := `
 		UPDATE matches
-		SET match_number = $2, date = $3, team1_id = $4, team2_id = $5, 
-		    goal_scored_team1 = $6, goal_scored_team2 = $7
+		SET tournament_id = $2, round = $3, match_number = $4, date = $5, team1_id = $6, team2_id = $7,
+		    goal_scored_team1 = $8, goal_scored_team2 = $9, updated_at = $10, venue_id = $11, stage_id = $12,
+		    extra_time_team1 = $13, extra_time_team2 = $14, penalties_team1 = $15, penalties_team2 = $16,
+		    pitch_id = $17, stream_url = $18, broadcaster = $19, stream_embargo_until = $20, is_friendly = $21,
+		    result_confirmed_at = $22
 		WHERE id = $1
 	`
+	// PostgreSQL guarda microsegundos; se trunca para que la versión que ve
+	// el cliente coincida con la almacenada
+	updatedAt := time.Now().UTC().Truncate(time.Microsecond)
 	result, err := r.db.Exec(query,
 		match.ID,
+		match.TournamentID,
+		match.Round,
 		match.MatchNumber,
 		match.Date,
 		match.Team1ID,
 		match.Team2ID,
 		match.GoalScoredTeam1,
 		match.GoalScoredTeam2,
+		updatedAt,
+		match.VenueID,
+		match.StageID,
+		match.ExtraTimeTeam1,
+		match.ExtraTimeTeam2,
+		match.PenaltiesTeam1,
+		match.PenaltiesTeam2,
+		match.PitchID,
+		match.StreamURL,
+		match.Broadcaster,
+		match.StreamEmbargoUntil,
+		match.IsFriendly,
+		match.ResultConfirmedAt,
 	)
 	if err != nil {
 		return err
@@ -126,6 +312,7 @@ func (r *PostgresMatchRepository) Update(match *domain.Match) error {
 	if rows == 0 {
 		return fmt.Errorf("match not found")
 	}
+	match.UpdatedAt = updatedAt
 	return nil
 }
 
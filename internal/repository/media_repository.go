@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+type MediaRepository interface {
+	Create(media *domain.MatchMedia) error
+	GetByID(id uuid.UUID) (*domain.MatchMedia, error)
+	// GetByMatch devuelve la galería en orden
+	GetByMatch(matchID uuid.UUID) ([]domain.MatchMedia, error)
+	Update(media *domain.MatchMedia) error
+	Delete(id uuid.UUID) error
+	// Reorder asigna las posiciones 1..n en el orden de ids
+	Reorder(matchID uuid.UUID, ids []uuid.UUID) error
+}
+
+type PostgresMediaRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresMediaRepository(db *sql.DB) MediaRepository {
+	return &PostgresMediaRepository{db: db}
+}
+
+// mediaColumns debe mantenerse en el mismo orden que scanMedia
+const mediaColumns = `id, match_id, type, url, caption, position, created_at`
+
+func scanMedia(row rowScanner, media *domain.MatchMedia) error {
+	return row.Scan(
+		&media.ID,
+		&media.MatchID,
+		&media.Type,
+		&media.URL,
+		&media.Caption,
+		&media.Position,
+		&media.CreatedAt,
+	)
+}
+
+func (r *PostgresMediaRepository) Create(media *domain.MatchMedia) error {
+	query := `
+		INSERT INTO match_media (id, match_id, type, url, caption, position, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err := r.db.Exec(query,
+		media.ID,
+		media.MatchID,
+		media.Type,
+		media.URL,
+		media.Caption,
+		media.Position,
+		media.CreatedAt,
+	)
+	return err
+}
+
+func (r *PostgresMediaRepository) GetByID(id uuid.UUID) (*domain.MatchMedia, error) {
+	query := `SELECT ` + mediaColumns + ` FROM match_media WHERE id = $1`
+	var media domain.MatchMedia
+	err := scanMedia(r.db.QueryRow(query, id), &media)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("media not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &media, nil
+}
+
+func (r *PostgresMediaRepository) GetByMatch(matchID uuid.UUID) ([]domain.MatchMedia, error) {
+	query := `SELECT ` + mediaColumns + ` FROM match_media WHERE match_id = $1 ORDER BY position, created_at`
+	rows, err := r.db.Query(query, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	gallery := []domain.MatchMedia{}
+	for rows.Next() {
+		var media domain.MatchMedia
+		if err := scanMedia(rows, &media); err != nil {
+			return nil, err
+		}
+		gallery = append(gallery, media)
+	}
+	return gallery, rows.Err()
+}
+
+func (r *PostgresMediaRepository) Update(media *domain.MatchMedia) error {
+	query := `UPDATE match_media SET type = $2, url = $3, caption = $4 WHERE id = $1`
+	result, err := r.db.Exec(query, media.ID, media.Type, media.URL, media.Caption)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("media not found")
+	}
+	return nil
+}
+
+func (r *PostgresMediaRepository) Delete(id uuid.UUID) error {
+	query := `DELETE FROM match_media WHERE id = $1`
+	result, err := r.db.Exec(query, id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("media not found")
+	}
+	return nil
+}
+
+func (r *PostgresMediaRepository) Reorder(matchID uuid.UUID, ids []uuid.UUID) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE match_media SET position = $3 WHERE id = $1 AND match_id = $2`
+	for i, id := range ids {
+		if _, err := tx.Exec(query, id, matchID, i+1); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,915 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// Repositorios en memoria de la organización de los torneos (sorteos, fases,
+// reglas, fichajes, sponsors, lesiones) y de la administración (alertas,
+// datos de prueba, incidentes, clientes de la API).
+
+type MemoryDrawRepository struct{ store *MemoryStore }
+
+func NewMemoryDrawRepository(store *MemoryStore) DrawRepository {
+	return &MemoryDrawRepository{store: store}
+}
+
+func (r *MemoryDrawRepository) Create(draw *domain.Draw) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Tournaments[draw.TournamentID]; !ok {
+			return fmt.Errorf("tournament not found")
+		}
+		stored := *draw
+		stored.Picks = slices.Clone(draw.Picks)
+		slices.SortStableFunc(stored.Picks, func(a, b domain.DrawPick) int { return a.Order - b.Order })
+		d.Draws[draw.ID] = stored
+		return nil
+	})
+}
+
+func (r *MemoryDrawRepository) GetByID(id uuid.UUID) (*domain.Draw, error) {
+	var draw domain.Draw
+	var ok bool
+	r.store.read(func(d *memoryData) { draw, ok = d.Draws[id] })
+	if !ok {
+		return nil, fmt.Errorf("draw not found")
+	}
+	draw.Picks = append([]domain.DrawPick{}, draw.Picks...)
+	return &draw, nil
+}
+
+func (r *MemoryDrawRepository) GetByTournament(tournamentID uuid.UUID) ([]domain.Draw, error) {
+	var draws []domain.Draw
+	r.store.read(func(d *memoryData) {
+		draws = sortedValues(d.Draws,
+			func(dr domain.Draw) bool { return dr.TournamentID == tournamentID },
+			func(a, b domain.Draw) bool { return a.CreatedAt.Before(b.CreatedAt) },
+		)
+	})
+	for i := range draws {
+		draws[i].Picks = append([]domain.DrawPick{}, draws[i].Picks...)
+	}
+	return draws, nil
+}
+
+type MemorySponsorRepository struct{ store *MemoryStore }
+
+func NewMemorySponsorRepository(store *MemoryStore) SponsorRepository {
+	return &MemorySponsorRepository{store: store}
+}
+
+func (r *MemorySponsorRepository) Create(sponsor *domain.Sponsor) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Tournaments[sponsor.TournamentID]; !ok {
+			return fmt.Errorf("tournament not found")
+		}
+		d.Sponsors[sponsor.ID] = *sponsor
+		return nil
+	})
+}
+
+func (r *MemorySponsorRepository) GetByID(id uuid.UUID) (*domain.Sponsor, error) {
+	var sponsor domain.Sponsor
+	var ok bool
+	r.store.read(func(d *memoryData) { sponsor, ok = d.Sponsors[id] })
+	if !ok {
+		return nil, fmt.Errorf("sponsor not found")
+	}
+	return &sponsor, nil
+}
+
+func (r *MemorySponsorRepository) GetByTournament(tournamentID uuid.UUID) ([]domain.Sponsor, error) {
+	var sponsors []domain.Sponsor
+	r.store.read(func(d *memoryData) {
+		sponsors = sortedValues(d.Sponsors,
+			func(s domain.Sponsor) bool { return s.TournamentID == tournamentID },
+			func(a, b domain.Sponsor) bool {
+				if a.Placement != b.Placement {
+					return a.Placement < b.Placement
+				}
+				return a.StartsAt.Before(b.StartsAt)
+			},
+		)
+	})
+	return sponsors, nil
+}
+
+// GetActive devuelve los patrocinadores vigentes; placement vacío no filtra
+func (r *MemorySponsorRepository) GetActive(tournamentID uuid.UUID, placement string, at time.Time) ([]domain.Sponsor, error) {
+	var sponsors []domain.Sponsor
+	r.store.read(func(d *memoryData) {
+		sponsors = sortedValues(d.Sponsors,
+			func(s domain.Sponsor) bool {
+				return s.TournamentID == tournamentID &&
+					(placement == "" || s.Placement == placement) &&
+					!s.StartsAt.After(at) && !s.EndsAt.Before(at)
+			},
+			func(a, b domain.Sponsor) bool {
+				if a.Placement != b.Placement {
+					return a.Placement < b.Placement
+				}
+				return a.Name < b.Name
+			},
+		)
+	})
+	return sponsors, nil
+}
+
+func (r *MemorySponsorRepository) Update(sponsor *domain.Sponsor) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.Sponsors[sponsor.ID]
+		if !ok {
+			return fmt.Errorf("sponsor not found")
+		}
+		stored.Name = sponsor.Name
+		stored.LogoURL = sponsor.LogoURL
+		stored.LinkURL = sponsor.LinkURL
+		stored.Placement = sponsor.Placement
+		stored.StartsAt = sponsor.StartsAt
+		stored.EndsAt = sponsor.EndsAt
+		d.Sponsors[sponsor.ID] = stored
+		return nil
+	})
+}
+
+func (r *MemorySponsorRepository) Delete(id uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Sponsors[id]; !ok {
+			return fmt.Errorf("sponsor not found")
+		}
+		delete(d.Sponsors, id)
+		return nil
+	})
+}
+
+type MemoryStageRepository struct{ store *MemoryStore }
+
+func NewMemoryStageRepository(store *MemoryStore) StageRepository {
+	return &MemoryStageRepository{store: store}
+}
+
+func (r *MemoryStageRepository) Create(stage *domain.Stage) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Tournaments[stage.TournamentID]; !ok {
+			return fmt.Errorf("tournament not found")
+		}
+		d.Stages[stage.ID] = *stage
+		return nil
+	})
+}
+
+func (r *MemoryStageRepository) GetByID(id uuid.UUID) (*domain.Stage, error) {
+	var stage domain.Stage
+	var ok bool
+	r.store.read(func(d *memoryData) { stage, ok = d.Stages[id] })
+	if !ok {
+		return nil, fmt.Errorf("stage not found")
+	}
+	return &stage, nil
+}
+
+func (r *MemoryStageRepository) GetByTournament(tournamentID uuid.UUID) ([]domain.Stage, error) {
+	stages := []domain.Stage{}
+	r.store.read(func(d *memoryData) {
+		stages = append(stages, sortedValues(d.Stages,
+			func(s domain.Stage) bool { return s.TournamentID == tournamentID },
+			func(a, b domain.Stage) bool { return a.Position < b.Position },
+		)...)
+	})
+	return stages, nil
+}
+
+func (r *MemoryStageRepository) UpdateStatuses(stages []domain.Stage) error {
+	return r.store.write(func(d *memoryData) error {
+		for _, stage := range stages {
+			if stored, ok := d.Stages[stage.ID]; ok {
+				stored.Status = stage.Status
+				d.Stages[stage.ID] = stored
+			}
+		}
+		return nil
+	})
+}
+
+func (r *MemoryStageRepository) Delete(id uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Stages[id]; !ok {
+			return fmt.Errorf("stage not found")
+		}
+		delete(d.Stages, id)
+		for matchID, match := range d.Matches {
+			clearID(&match.StageID, id)
+			d.Matches[matchID] = match
+		}
+		return nil
+	})
+}
+
+type MemoryTournamentRulesRepository struct{ store *MemoryStore }
+
+func NewMemoryTournamentRulesRepository(store *MemoryStore) TournamentRulesRepository {
+	return &MemoryTournamentRulesRepository{store: store}
+}
+
+func (r *MemoryTournamentRulesRepository) Get(tournamentID uuid.UUID) (*domain.TournamentRules, error) {
+	var rules domain.TournamentRules
+	var ok bool
+	r.store.read(func(d *memoryData) { rules, ok = d.Rules[tournamentID] })
+	if !ok {
+		return domain.NewTournamentRules(tournamentID), nil
+	}
+	return &rules, nil
+}
+
+func (r *MemoryTournamentRulesRepository) Save(rules *domain.TournamentRules) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Tournaments[rules.TournamentID]; !ok {
+			return fmt.Errorf("tournament not found")
+		}
+		d.Rules[rules.TournamentID] = *rules
+		return nil
+	})
+}
+
+func (r *MemoryTournamentRulesRepository) GetByTeam(teamID uuid.UUID) ([]domain.TournamentRules, error) {
+	all := []domain.TournamentRules{}
+	r.store.read(func(d *memoryData) {
+		for _, tt := range d.TournamentTeams {
+			if tt.TeamID != teamID {
+				continue
+			}
+			rules, ok := d.Rules[tt.TournamentID]
+			if !ok {
+				continue
+			}
+			tournament := d.Tournaments[tt.TournamentID]
+			if tournament.Status == domain.TournamentCompleted || tournament.Status == domain.TournamentCancelled || tournament.ArchivedAt != nil {
+				continue
+			}
+			all = append(all, rules)
+		}
+	})
+	return all, nil
+}
+
+type MemoryRegistrationRepository struct{ store *MemoryStore }
+
+func NewMemoryRegistrationRepository(store *MemoryStore) RegistrationRepository {
+	return &MemoryRegistrationRepository{store: store}
+}
+
+func (r *MemoryRegistrationRepository) Create(registration *domain.Registration) error {
+	return r.store.write(func(d *memoryData) error {
+		if d.tournamentTeam(registration.TournamentID, registration.TeamID) == nil {
+			return fmt.Errorf("team is not registered in the tournament")
+		}
+		if _, ok := d.Players[registration.PlayerID]; !ok {
+			return fmt.Errorf("player not found")
+		}
+		if d.registration(registration.TournamentID, registration.PlayerID) != nil {
+			return fmt.Errorf("player is already registered in the tournament")
+		}
+		stored := *registration
+		stored.PlayerName = ""
+		d.Registrations = append(d.Registrations, stored)
+		return nil
+	})
+}
+
+// registration devuelve el fichaje del jugador en el torneo, o nil
+func (d *memoryData) registration(tournamentID, playerID uuid.UUID) *domain.Registration {
+	for i := range d.Registrations {
+		if d.Registrations[i].TournamentID == tournamentID && d.Registrations[i].PlayerID == playerID {
+			return &d.Registrations[i]
+		}
+	}
+	return nil
+}
+
+func (r *MemoryRegistrationRepository) GetByPlayer(tournamentID, playerID uuid.UUID) (*domain.Registration, error) {
+	var found *domain.Registration
+	r.store.read(func(d *memoryData) {
+		if registration := d.registration(tournamentID, playerID); registration != nil {
+			copied := *registration
+			copied.PlayerName = d.Players[playerID].Name
+			found = &copied
+		}
+	})
+	if found == nil {
+		return nil, fmt.Errorf("registration not found")
+	}
+	return found, nil
+}
+
+func (r *MemoryRegistrationRepository) GetByTournament(tournamentID uuid.UUID, teamID *uuid.UUID) ([]domain.Registration, error) {
+	registrations := []domain.Registration{}
+	r.store.read(func(d *memoryData) {
+		for _, registration := range d.Registrations {
+			if registration.TournamentID != tournamentID || (teamID != nil && registration.TeamID != *teamID) {
+				continue
+			}
+			registration.PlayerName = d.Players[registration.PlayerID].Name
+			registrations = append(registrations, registration)
+		}
+	})
+	slices.SortStableFunc(registrations, func(a, b domain.Registration) int {
+		if c := strings.Compare(a.TeamID.String(), b.TeamID.String()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.PlayerName, b.PlayerName)
+	})
+	return registrations, nil
+}
+
+func (r *MemoryRegistrationRepository) RegisterSquad(tournamentID, teamID uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		if d.tournamentTeam(tournamentID, teamID) == nil {
+			return fmt.Errorf("team is not registered in the tournament")
+		}
+		now := time.Now().UTC()
+		for _, tp := range d.TeamPlayers {
+			if tp.TeamID != teamID || d.registration(tournamentID, tp.PlayerID) != nil {
+				continue
+			}
+			d.Registrations = append(d.Registrations, domain.Registration{
+				TournamentID: tournamentID,
+				TeamID:       teamID,
+				PlayerID:     tp.PlayerID,
+				RegisteredAt: now,
+			})
+		}
+		return nil
+	})
+}
+
+func (r *MemoryRegistrationRepository) Delete(tournamentID, playerID uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		removed := removeFromSlice(&d.Registrations, func(reg domain.Registration) bool {
+			return reg.TournamentID == tournamentID && reg.PlayerID == playerID
+		})
+		if removed == 0 {
+			return fmt.Errorf("registration not found")
+		}
+		return nil
+	})
+}
+
+type MemoryInjuryRepository struct{ store *MemoryStore }
+
+func NewMemoryInjuryRepository(store *MemoryStore) InjuryRepository {
+	return &MemoryInjuryRepository{store: store}
+}
+
+func (r *MemoryInjuryRepository) Create(injury *domain.Injury) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Players[injury.PlayerID]; !ok {
+			return fmt.Errorf("player not found")
+		}
+		d.Injuries[injury.ID] = *injury
+		return nil
+	})
+}
+
+func (r *MemoryInjuryRepository) GetByID(id uuid.UUID) (*domain.Injury, error) {
+	var injury domain.Injury
+	var ok bool
+	r.store.read(func(d *memoryData) { injury, ok = d.Injuries[id] })
+	if !ok {
+		return nil, fmt.Errorf("injury not found")
+	}
+	return &injury, nil
+}
+
+func (r *MemoryInjuryRepository) GetByPlayer(playerID uuid.UUID) ([]domain.Injury, error) {
+	injuries := []domain.Injury{}
+	r.store.read(func(d *memoryData) {
+		injuries = append(injuries, sortedValues(d.Injuries,
+			func(i domain.Injury) bool { return i.PlayerID == playerID },
+			func(a, b domain.Injury) bool { return a.StartDate.After(b.StartDate) },
+		)...)
+	})
+	return injuries, nil
+}
+
+func (r *MemoryInjuryRepository) GetActive(playerIDs []uuid.UUID, at time.Time) ([]domain.Injury, error) {
+	injuries := []domain.Injury{}
+	r.store.read(func(d *memoryData) {
+		injuries = append(injuries, sortedValues(d.Injuries,
+			func(i domain.Injury) bool {
+				return slices.Contains(playerIDs, i.PlayerID) &&
+					!i.StartDate.After(at) &&
+					(i.ExpectedReturn == nil || i.ExpectedReturn.After(at))
+			},
+			func(a, b domain.Injury) bool { return a.StartDate.Before(b.StartDate) },
+		)...)
+	})
+	return injuries, nil
+}
+
+func (r *MemoryInjuryRepository) Update(injury *domain.Injury) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.Injuries[injury.ID]
+		if !ok {
+			return fmt.Errorf("injury not found")
+		}
+		stored.Type = injury.Type
+		stored.StartDate = injury.StartDate
+		stored.ExpectedReturn = injury.ExpectedReturn
+		d.Injuries[injury.ID] = stored
+		return nil
+	})
+}
+
+func (r *MemoryInjuryRepository) Delete(id uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Injuries[id]; !ok {
+			return fmt.Errorf("injury not found")
+		}
+		delete(d.Injuries, id)
+		return nil
+	})
+}
+
+type MemoryAlertRepository struct{ store *MemoryStore }
+
+func NewMemoryAlertRepository(store *MemoryStore) AlertRepository {
+	return &MemoryAlertRepository{store: store}
+}
+
+// Detect aplica los mismos criterios que PostgresAlertRepository
+func (r *MemoryAlertRepository) Detect(missingSince time.Time, minSquadSize int) ([]domain.Alert, error) {
+	alerts := []domain.Alert{}
+	r.store.read(func(d *memoryData) {
+		alerts = append(alerts, d.detectMissingResults(missingSince)...)
+		alerts = append(alerts, d.detectShortSquads(minSquadSize)...)
+		alerts = append(alerts, d.detectUnconfirmedResults()...)
+	})
+	return alerts, nil
+}
+
+func (d *memoryData) detectMissingResults(missingSince time.Time) []domain.Alert {
+	hasPending := make(map[uuid.UUID]bool)
+	for _, result := range d.Provisional {
+		if result.Status == domain.ProvisionalPending {
+			hasPending[result.MatchID] = true
+		}
+	}
+
+	matches := sortedValues(d.Matches, func(m domain.Match) bool {
+		if m.ParentMatchID != nil || d.matchArchived(m) || m.Date.After(missingSince) || m.HasFinalResult() {
+			return false
+		}
+		if m.TournamentID != nil {
+			if tournament, ok := d.Tournaments[*m.TournamentID]; ok && tournament.Status == domain.TournamentCancelled {
+				return false
+			}
+		}
+		return !hasPending[m.ID]
+	}, func(a, b domain.Match) bool { return a.Date.Before(b.Date) })
+
+	var alerts []domain.Alert
+	for _, match := range matches {
+		alerts = append(alerts, domain.NewMissingResultAlert(&match, d.Teams[match.Team1ID].Name, d.Teams[match.Team2ID].Name))
+	}
+	return alerts
+}
+
+func (d *memoryData) detectShortSquads(minSquadSize int) []domain.Alert {
+	squadSize := make(map[uuid.UUID]int)
+	for _, tp := range d.TeamPlayers {
+		squadSize[tp.TeamID]++
+	}
+
+	type shortSquad struct {
+		team         domain.Team
+		tournamentID uuid.UUID
+	}
+	var short []shortSquad
+	for _, tt := range d.TournamentTeams {
+		tournament, ok := d.Tournaments[tt.TournamentID]
+		if !ok || tournament.ArchivedAt != nil {
+			continue
+		}
+		if tournament.Status != domain.TournamentRegistrationOpen && tournament.Status != domain.TournamentInProgress {
+			continue
+		}
+		team, ok := d.Teams[tt.TeamID]
+		if ok && squadSize[team.ID] < minSquadSize {
+			short = append(short, shortSquad{team: team, tournamentID: tt.TournamentID})
+		}
+	}
+	slices.SortStableFunc(short, func(a, b shortSquad) int { return strings.Compare(a.team.Name, b.team.Name) })
+
+	var alerts []domain.Alert
+	for _, s := range short {
+		alerts = append(alerts, domain.NewShortSquadAlert(s.team.ID, s.tournamentID, s.team.Name, squadSize[s.team.ID]))
+	}
+	return alerts
+}
+
+func (d *memoryData) detectUnconfirmedResults() []domain.Alert {
+	pending := sortedValues(d.Provisional,
+		func(p domain.ProvisionalResult) bool { return p.Status == domain.ProvisionalPending },
+		func(a, b domain.ProvisionalResult) bool { return a.CreatedAt.Before(b.CreatedAt) },
+	)
+
+	var alerts []domain.Alert
+	for _, result := range pending {
+		match, ok := d.Matches[result.MatchID]
+		if !ok {
+			continue
+		}
+		alerts = append(alerts, domain.NewUnconfirmedResultAlert(&result, match.MatchNumber, match.TournamentID))
+	}
+	return alerts
+}
+
+func (r *MemoryAlertRepository) Sync(alerts []domain.Alert) error {
+	return r.store.write(func(d *memoryData) error {
+		synced := make([]domain.Alert, 0, len(alerts))
+		for _, alert := range alerts {
+			// Las que siguen vigentes conservan su ID, fecha y descarte
+			i := slices.IndexFunc(d.Alerts, func(a domain.Alert) bool { return a.Type == alert.Type && a.EntityID == alert.EntityID })
+			if i >= 0 {
+				existing := d.Alerts[i]
+				existing.TournamentID = alert.TournamentID
+				existing.Message = alert.Message
+				alert = existing
+			} else {
+				alert.DismissedAt = nil
+			}
+			synced = append(synced, alert)
+		}
+		d.Alerts = synced
+		return nil
+	})
+}
+
+func (r *MemoryAlertRepository) GetAll(includeDismissed bool) ([]domain.Alert, error) {
+	alerts := []domain.Alert{}
+	r.store.read(func(d *memoryData) {
+		for _, alert := range d.Alerts {
+			if includeDismissed || alert.DismissedAt == nil {
+				alerts = append(alerts, alert)
+			}
+		}
+	})
+	slices.SortStableFunc(alerts, func(a, b domain.Alert) int {
+		if c := a.DetectedAt.Compare(b.DetectedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Type, b.Type)
+	})
+	return alerts, nil
+}
+
+func (r *MemoryAlertRepository) Dismiss(id uuid.UUID, at time.Time) error {
+	return r.store.write(func(d *memoryData) error {
+		i := slices.IndexFunc(d.Alerts, func(a domain.Alert) bool { return a.ID == id })
+		if i < 0 {
+			return fmt.Errorf("alert not found")
+		}
+		if d.Alerts[i].DismissedAt == nil {
+			d.Alerts[i].DismissedAt = &at
+		}
+		return nil
+	})
+}
+
+type MemoryTestDataRepository struct{ store *MemoryStore }
+
+func NewMemoryTestDataRepository(store *MemoryStore) TestDataRepository {
+	return &MemoryTestDataRepository{store: store}
+}
+
+func (r *MemoryTestDataRepository) Count() (*domain.PurgeReport, error) {
+	var report *domain.PurgeReport
+	r.store.read(func(d *memoryData) { report = d.countTestData() })
+	return report, nil
+}
+
+func (d *memoryData) countTestData() *domain.PurgeReport {
+	report := &domain.PurgeReport{}
+	for _, tournament := range d.Tournaments {
+		if tournament.IsTest {
+			report.Tournaments++
+		}
+	}
+	for _, team := range d.Teams {
+		if team.IsTest {
+			report.Teams++
+		}
+	}
+	for _, player := range d.Players {
+		if player.IsTest {
+			report.Players++
+		}
+	}
+	for _, match := range d.Matches {
+		testTournament := match.TournamentID != nil && d.Tournaments[*match.TournamentID].IsTest
+		if testTournament || d.Teams[match.Team1ID].IsTest || d.Teams[match.Team2ID].IsTest {
+			report.Matches++
+		}
+	}
+	return report
+}
+
+// Purge borra en el mismo orden que en PostgreSQL: torneos, equipos y jugadores
+func (r *MemoryTestDataRepository) Purge() (*domain.PurgeReport, error) {
+	var report *domain.PurgeReport
+	err := r.store.write(func(d *memoryData) error {
+		report = d.countTestData()
+		for id, tournament := range d.Tournaments {
+			if tournament.IsTest {
+				d.deleteTournament(id)
+			}
+		}
+		for id, team := range d.Teams {
+			if team.IsTest {
+				d.deleteTeam(id)
+			}
+		}
+		for id, player := range d.Players {
+			if player.IsTest {
+				d.deletePlayer(id)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+type MemoryIncidentRepository struct{ store *MemoryStore }
+
+func NewMemoryIncidentRepository(store *MemoryStore) IncidentRepository {
+	return &MemoryIncidentRepository{store: store}
+}
+
+func (r *MemoryIncidentRepository) Create(incident *domain.Incident) error {
+	return r.store.write(func(d *memoryData) error {
+		d.Incidents[incident.ID] = *incident
+		return nil
+	})
+}
+
+func (r *MemoryIncidentRepository) GetByID(id uuid.UUID) (*domain.Incident, error) {
+	var incident domain.Incident
+	var ok bool
+	r.store.read(func(d *memoryData) { incident, ok = d.Incidents[id] })
+	if !ok {
+		return nil, fmt.Errorf("incident not found")
+	}
+	return &incident, nil
+}
+
+func (r *MemoryIncidentRepository) GetActive() ([]domain.Incident, error) {
+	incidents := []domain.Incident{}
+	r.store.read(func(d *memoryData) {
+		incidents = append(incidents, sortedValues(d.Incidents,
+			func(i domain.Incident) bool { return i.ResolvedAt == nil },
+			func(a, b domain.Incident) bool { return a.StartedAt.After(b.StartedAt) },
+		)...)
+	})
+	return incidents, nil
+}
+
+func (r *MemoryIncidentRepository) Resolve(id uuid.UUID, at time.Time) error {
+	return r.store.write(func(d *memoryData) error {
+		incident, ok := d.Incidents[id]
+		if !ok || incident.ResolvedAt != nil {
+			return fmt.Errorf("incident not found or already resolved")
+		}
+		incident.ResolvedAt = &at
+		d.Incidents[id] = incident
+		return nil
+	})
+}
+
+type MemoryAPIClientRepository struct{ store *MemoryStore }
+
+func NewMemoryAPIClientRepository(store *MemoryStore) APIClientRepository {
+	return &MemoryAPIClientRepository{store: store}
+}
+
+func (r *MemoryAPIClientRepository) Create(client *domain.APIClient) error {
+	return r.store.write(func(d *memoryData) error {
+		stored := *client
+		stored.Scopes = slices.Clone(client.Scopes)
+		stored.RevokedAt = nil
+		d.APIClients[client.ID] = memoryAPIClient{Client: stored, SecretHash: client.SecretHash}
+		return nil
+	})
+}
+
+// client devuelve una copia del cliente con su hash
+func (c memoryAPIClient) client() domain.APIClient {
+	client := c.Client
+	client.Scopes = slices.Clone(c.Client.Scopes)
+	client.SecretHash = c.SecretHash
+	return client
+}
+
+func (r *MemoryAPIClientRepository) GetByID(id uuid.UUID) (*domain.APIClient, error) {
+	var stored memoryAPIClient
+	var ok bool
+	r.store.read(func(d *memoryData) { stored, ok = d.APIClients[id] })
+	if !ok {
+		return nil, fmt.Errorf("api client not found")
+	}
+	client := stored.client()
+	return &client, nil
+}
+
+func (r *MemoryAPIClientRepository) GetAll() ([]domain.APIClient, error) {
+	clients := []domain.APIClient{}
+	r.store.read(func(d *memoryData) {
+		for _, stored := range d.APIClients {
+			clients = append(clients, stored.client())
+		}
+	})
+	slices.SortStableFunc(clients, func(a, b domain.APIClient) int { return b.CreatedAt.Compare(a.CreatedAt) })
+	return clients, nil
+}
+
+func (r *MemoryAPIClientRepository) Revoke(id uuid.UUID, revokedAt time.Time) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.APIClients[id]
+		if !ok || stored.Client.RevokedAt != nil {
+			return fmt.Errorf("api client not found or already revoked")
+		}
+		stored.Client.RevokedAt = &revokedAt
+		d.APIClients[id] = stored
+		for hash, token := range d.APITokens {
+			if token.Token.ClientID == id {
+				delete(d.APITokens, hash)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *MemoryAPIClientRepository) CreateToken(token *domain.APIToken) error {
+	return r.store.write(func(d *memoryData) error {
+		now := time.Now()
+		for hash, stored := range d.APITokens {
+			if !stored.Token.ExpiresAt.After(now) {
+				delete(d.APITokens, hash)
+			}
+		}
+		if _, ok := d.APIClients[token.ClientID]; !ok {
+			return fmt.Errorf("api client not found")
+		}
+		stored := *token
+		stored.Scopes = slices.Clone(token.Scopes)
+		stored.RateLimitPerMinute = 0
+		d.APITokens[token.TokenHash] = memoryAPIToken{Token: stored}
+		return nil
+	})
+}
+
+func (r *MemoryAPIClientRepository) GetToken(tokenHash string) (*domain.APIToken, error) {
+	var found *domain.APIToken
+	r.store.read(func(d *memoryData) {
+		stored, ok := d.APITokens[tokenHash]
+		if !ok || !stored.Token.ExpiresAt.After(time.Now()) {
+			return
+		}
+		client, ok := d.APIClients[stored.Token.ClientID]
+		if !ok || client.Client.RevokedAt != nil {
+			return
+		}
+		token := stored.Token
+		token.TokenHash = tokenHash
+		token.Scopes = slices.Clone(stored.Token.Scopes)
+		token.RateLimitPerMinute = client.Client.RateLimitPerMinute
+		found = &token
+	})
+	if found == nil {
+		return nil, fmt.Errorf("invalid or expired token")
+	}
+	return found, nil
+}
+
+type MemorySanctionRepository struct{ store *MemoryStore }
+
+func NewMemorySanctionRepository(store *MemoryStore) SanctionRepository {
+	return &MemorySanctionRepository{store: store}
+}
+
+func (r *MemorySanctionRepository) Create(sanction *domain.Sanction) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Tournaments[sanction.TournamentID]; !ok {
+			return fmt.Errorf("tournament not found")
+		}
+		if sanction.TeamID != nil {
+			if _, ok := d.Teams[*sanction.TeamID]; !ok {
+				return fmt.Errorf("team not found")
+			}
+		}
+		if sanction.PlayerID != nil {
+			if _, ok := d.Players[*sanction.PlayerID]; !ok {
+				return fmt.Errorf("player not found")
+			}
+		}
+		d.Sanctions[sanction.ID] = *sanction
+		return nil
+	})
+}
+
+func (r *MemorySanctionRepository) GetByID(id uuid.UUID) (*domain.Sanction, error) {
+	var sanction domain.Sanction
+	var ok bool
+	r.store.read(func(d *memoryData) { sanction, ok = d.Sanctions[id] })
+	if !ok {
+		return nil, fmt.Errorf("sanction not found")
+	}
+	return &sanction, nil
+}
+
+func (r *MemorySanctionRepository) GetByTournament(tournamentID uuid.UUID) ([]domain.Sanction, error) {
+	sanctions := []domain.Sanction{}
+	r.store.read(func(d *memoryData) {
+		sanctions = append(sanctions, sortedValues(d.Sanctions,
+			func(s domain.Sanction) bool { return s.TournamentID == tournamentID },
+			func(a, b domain.Sanction) bool { return a.DecidedAt.After(b.DecidedAt) },
+		)...)
+	})
+	return sanctions, nil
+}
+
+func (r *MemorySanctionRepository) Revoke(id uuid.UUID, revokedAt time.Time) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.Sanctions[id]
+		if !ok || stored.RevokedAt != nil {
+			return fmt.Errorf("sanction not found or already revoked")
+		}
+		stored.RevokedAt = &revokedAt
+		d.Sanctions[id] = stored
+		return nil
+	})
+}
+
+type MemoryIdempotencyRepository struct{ store *MemoryStore }
+
+// idempotencyMapKey es la clave primaria (caller, key) del mapa; Caller es un
+// hash hexadecimal, así la barra no puede aparecer en él
+func idempotencyMapKey(caller, key string) string {
+	return caller + "/" + key
+}
+
+func NewMemoryIdempotencyRepository(store *MemoryStore) IdempotencyRepository {
+	return &MemoryIdempotencyRepository{store: store}
+}
+
+func (r *MemoryIdempotencyRepository) Reserve(record *domain.IdempotencyRecord, now time.Time) (*domain.IdempotencyRecord, error) {
+	var existing *domain.IdempotencyRecord
+	err := r.store.write(func(d *memoryData) error {
+		for key, stored := range d.Idempotency {
+			if !stored.ExpiresAt.After(now) {
+				delete(d.Idempotency, key)
+			}
+		}
+		if stored, ok := d.Idempotency[idempotencyMapKey(record.Caller, record.Key)]; ok && !stored.Replaceable(now) {
+			stored.Body = slices.Clone(stored.Body)
+			existing = &stored
+			return nil
+		}
+		reserved := *record
+		reserved.StatusCode, reserved.ContentType, reserved.Body = 0, "", nil
+		d.Idempotency[idempotencyMapKey(record.Caller, record.Key)] = reserved
+		return nil
+	})
+	return existing, err
+}
+
+func (r *MemoryIdempotencyRepository) Complete(record *domain.IdempotencyRecord) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.Idempotency[idempotencyMapKey(record.Caller, record.Key)]
+		if !ok || stored.Completed() {
+			return nil
+		}
+		stored.StatusCode = record.StatusCode
+		stored.ContentType = record.ContentType
+		stored.Body = slices.Clone(record.Body)
+		d.Idempotency[idempotencyMapKey(record.Caller, record.Key)] = stored
+		return nil
+	})
+}
+
+func (r *MemoryIdempotencyRepository) Release(caller, key string) error {
+	return r.store.write(func(d *memoryData) error {
+		if stored, ok := d.Idempotency[idempotencyMapKey(caller, key)]; ok && !stored.Completed() {
+			delete(d.Idempotency, idempotencyMapKey(caller, key))
+		}
+		return nil
+	})
+}
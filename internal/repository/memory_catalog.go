@@ -0,0 +1,1284 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// Repositorios en memoria de las entidades de catálogo: jugadores, equipos,
+// torneos, temporadas, divisiones, sedes, árbitros, etiquetas y staff.
+
+type MemoryPlayerRepository struct{ store *MemoryStore }
+
+func NewMemoryPlayerRepository(store *MemoryStore) PlayerRepository {
+	return &MemoryPlayerRepository{store: store}
+}
+
+func (r *MemoryPlayerRepository) Create(player *domain.Player) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Players[player.ID]; ok {
+			return fmt.Errorf("player already exists")
+		}
+		stored := *player
+		stored.JerseyNumber, stored.Roles = nil, nil
+		d.Players[player.ID] = stored
+		return nil
+	})
+}
+
+func (r *MemoryPlayerRepository) CreateMany(players []*domain.Player) error {
+	return r.store.write(func(d *memoryData) error {
+		// Primero se revisan todos: write no deshace cambios a medias
+		for _, player := range players {
+			if _, ok := d.Players[player.ID]; ok {
+				return fmt.Errorf("player already exists")
+			}
+		}
+		for _, player := range players {
+			stored := *player
+			stored.JerseyNumber, stored.Roles = nil, nil
+			d.Players[player.ID] = stored
+		}
+		return nil
+	})
+}
+
+func (r *MemoryPlayerRepository) GetByID(id uuid.UUID) (*domain.Player, error) {
+	var player domain.Player
+	var ok bool
+	r.store.read(func(d *memoryData) { player, ok = d.Players[id] })
+	if !ok {
+		return nil, fmt.Errorf("player not found")
+	}
+	return &player, nil
+}
+
+func (r *MemoryPlayerRepository) GetAll() ([]domain.Player, error) {
+	var players []domain.Player
+	r.store.read(func(d *memoryData) {
+		players = sortedValues(d.Players, nil, func(a, b domain.Player) bool { return a.CreatedAt.After(b.CreatedAt) })
+	})
+	return players, nil
+}
+
+func (r *MemoryPlayerRepository) GetPage(page domain.Page) (domain.Paged[domain.Player], error) {
+	var players []domain.Player
+	r.store.read(func(d *memoryData) {
+		players = sortedValues(d.Players, nil, nil)
+	})
+	domain.SortPlayers(players, domain.PlayerSortFields.Of(page))
+	return domain.SlicePage(players, page), nil
+}
+
+func (r *MemoryPlayerRepository) Search(query domain.SearchQuery) ([]domain.Player, error) {
+	var players []domain.Player
+	r.store.read(func(d *memoryData) {
+		players = searchValues(d.Players, query, func(p domain.Player) string { return p.Name }, func(p domain.Player) uuid.UUID { return p.ID })
+	})
+	return players, nil
+}
+
+func (r *MemoryPlayerRepository) Update(player *domain.Player) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.Players[player.ID]
+		if !ok {
+			return fmt.Errorf("player not found")
+		}
+		stored.Name = player.Name
+		stored.DateBirth = player.DateBirth
+		stored.Position = player.Position
+		stored.PreferredFoot = player.PreferredFoot
+		stored.Nationality = player.Nationality
+		d.Players[player.ID] = stored
+		return nil
+	})
+}
+
+func (r *MemoryPlayerRepository) Delete(id uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Players[id]; !ok {
+			return fmt.Errorf("player not found")
+		}
+		d.deletePlayer(id)
+		return nil
+	})
+}
+
+type MemoryTeamRepository struct{ store *MemoryStore }
+
+func NewMemoryTeamRepository(store *MemoryStore) TeamRepository {
+	return &MemoryTeamRepository{store: store}
+}
+
+func (r *MemoryTeamRepository) Create(team *domain.Team) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Teams[team.ID]; ok {
+			return fmt.Errorf("team already exists")
+		}
+		if d.teamNameTaken(team.Name, team.ID) {
+			return fmt.Errorf("team name %q already exists", team.Name)
+		}
+		stored := *team
+		stored.Players = nil
+		d.Teams[team.ID] = stored
+		return nil
+	})
+}
+
+// teamNameTaken replica la restricción UNIQUE de teams.name
+func (d *memoryData) teamNameTaken(name string, except uuid.UUID) bool {
+	for _, team := range d.Teams {
+		if team.Name == name && team.ID != except {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *MemoryTeamRepository) GetByID(id uuid.UUID) (*domain.Team, error) {
+	var team domain.Team
+	var ok bool
+	r.store.read(func(d *memoryData) { team, ok = d.Teams[id] })
+	if !ok {
+		return nil, fmt.Errorf("team not found")
+	}
+	return &team, nil
+}
+
+func (r *MemoryTeamRepository) GetAll() ([]domain.Team, error) {
+	var teams []domain.Team
+	r.store.read(func(d *memoryData) {
+		teams = sortedValues(d.Teams, nil, func(a, b domain.Team) bool { return a.CreatedAt.After(b.CreatedAt) })
+	})
+	return teams, nil
+}
+
+func (r *MemoryTeamRepository) GetPage(page domain.Page) (domain.Paged[domain.Team], error) {
+	var teams []domain.Team
+	r.store.read(func(d *memoryData) {
+		teams = sortedValues(d.Teams, nil, nil)
+	})
+	domain.SortTeams(teams, domain.TeamSortFields.Of(page))
+	return domain.SlicePage(teams, page), nil
+}
+
+func (r *MemoryTeamRepository) FindByName(name string) ([]domain.Team, error) {
+	teams := []domain.Team{}
+	r.store.read(func(d *memoryData) {
+		for _, team := range d.Teams {
+			if strings.EqualFold(team.Name, name) {
+				teams = append(teams, team)
+			}
+		}
+	})
+	return teams, nil
+}
+
+func (r *MemoryTeamRepository) Search(query domain.SearchQuery) ([]domain.Team, error) {
+	var teams []domain.Team
+	r.store.read(func(d *memoryData) {
+		teams = searchValues(d.Teams, query, func(t domain.Team) string { return t.Name }, func(t domain.Team) uuid.UUID { return t.ID })
+	})
+	return teams, nil
+}
+
+func (r *MemoryTeamRepository) Update(team *domain.Team) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.Teams[team.ID]
+		if !ok {
+			return fmt.Errorf("team not found")
+		}
+		if d.teamNameTaken(team.Name, team.ID) {
+			return fmt.Errorf("team name %q already exists", team.Name)
+		}
+		stored.Name = team.Name
+		d.Teams[team.ID] = stored
+		return nil
+	})
+}
+
+func (r *MemoryTeamRepository) Delete(id uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Teams[id]; !ok {
+			return fmt.Errorf("team not found")
+		}
+		d.deleteTeam(id)
+		return nil
+	})
+}
+
+func (r *MemoryTeamRepository) AddPlayer(teamID, playerID uuid.UUID, transfer *domain.Transfer) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Teams[teamID]; !ok {
+			return fmt.Errorf("team not found")
+		}
+		if _, ok := d.Players[playerID]; !ok {
+			return fmt.Errorf("player not found")
+		}
+		if d.teamPlayer(teamID, playerID) != nil {
+			return fmt.Errorf("player is already in the team")
+		}
+		d.TeamPlayers = append(d.TeamPlayers, memoryTeamPlayer{TeamID: teamID, PlayerID: playerID, JoinedAt: transfer.TransferredAt})
+		d.Transfers = append(d.Transfers, *transfer)
+		return nil
+	})
+}
+
+// teamPlayer devuelve la ficha del jugador en el equipo, o nil
+func (d *memoryData) teamPlayer(teamID, playerID uuid.UUID) *memoryTeamPlayer {
+	for i := range d.TeamPlayers {
+		if d.TeamPlayers[i].TeamID == teamID && d.TeamPlayers[i].PlayerID == playerID {
+			return &d.TeamPlayers[i]
+		}
+	}
+	return nil
+}
+
+func (r *MemoryTeamRepository) RemovePlayer(teamID, playerID uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		removeFromSlice(&d.TeamPlayers, func(tp memoryTeamPlayer) bool { return tp.TeamID == teamID && tp.PlayerID == playerID })
+		return nil
+	})
+}
+
+func (r *MemoryTeamRepository) GetTeamPlayers(teamID uuid.UUID) ([]domain.Player, error) {
+	var players []domain.Player
+	r.store.read(func(d *memoryData) {
+		players = d.teamPlayers(teamID)
+	})
+	return players, nil
+}
+
+// teamPlayers devuelve el plantel ordenado por nombre, con dorsal y roles
+func (d *memoryData) teamPlayers(teamID uuid.UUID) []domain.Player {
+	var players []domain.Player
+	for _, tp := range d.TeamPlayers {
+		if tp.TeamID != teamID {
+			continue
+		}
+		player, ok := d.Players[tp.PlayerID]
+		if !ok {
+			continue
+		}
+		player.IsTest = false
+		player.JerseyNumber = tp.JerseyNumber
+		player.Roles = slices.Clone(tp.Roles)
+		if player.Roles == nil {
+			player.Roles = []string{}
+		}
+		players = append(players, player)
+	}
+	slices.SortStableFunc(players, func(a, b domain.Player) int { return strings.Compare(a.Name, b.Name) })
+	return players
+}
+
+func (r *MemoryTeamRepository) SetJerseyNumber(teamID, playerID uuid.UUID, number *int) error {
+	return r.store.write(func(d *memoryData) error {
+		tp := d.teamPlayer(teamID, playerID)
+		if tp == nil {
+			return fmt.Errorf("player is not in the team")
+		}
+		if number != nil {
+			for _, other := range d.TeamPlayers {
+				if other.TeamID == teamID && other.PlayerID != playerID && other.JerseyNumber != nil && *other.JerseyNumber == *number {
+					return fmt.Errorf("jersey number %d is already taken in the team", *number)
+				}
+			}
+			value := *number
+			number = &value
+		}
+		tp.JerseyNumber = number
+		return nil
+	})
+}
+
+func (r *MemoryTeamRepository) SetRoles(teamID, playerID uuid.UUID, roles []string) error {
+	return r.store.write(func(d *memoryData) error {
+		tp := d.teamPlayer(teamID, playerID)
+		if tp == nil {
+			return fmt.Errorf("player is not in the team")
+		}
+		tp.Roles = slices.Clone(roles)
+		return nil
+	})
+}
+
+type MemoryTransferRepository struct{ store *MemoryStore }
+
+func NewMemoryTransferRepository(store *MemoryStore) TransferRepository {
+	return &MemoryTransferRepository{store: store}
+}
+
+func (r *MemoryTransferRepository) GetByPlayer(playerID uuid.UUID) ([]domain.Transfer, error) {
+	transfers := []domain.Transfer{}
+	r.store.read(func(d *memoryData) {
+		for _, transfer := range d.Transfers {
+			if transfer.PlayerID != playerID {
+				continue
+			}
+			transfer.ToTeamName = d.Teams[transfer.ToTeamID].Name
+			if transfer.FromTeamID != nil {
+				transfer.FromTeamName = d.Teams[*transfer.FromTeamID].Name
+			}
+			transfers = append(transfers, transfer)
+		}
+	})
+	slices.SortStableFunc(transfers, func(a, b domain.Transfer) int {
+		if c := a.TransferredAt.Compare(b.TransferredAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID.String(), b.ID.String())
+	})
+	return transfers, nil
+}
+
+type MemoryTournamentRepository struct{ store *MemoryStore }
+
+func NewMemoryTournamentRepository(store *MemoryStore) TournamentRepository {
+	return &MemoryTournamentRepository{store: store}
+}
+
+func (r *MemoryTournamentRepository) Create(tournament *domain.Tournament) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Tournaments[tournament.ID]; ok {
+			return fmt.Errorf("tournament already exists")
+		}
+		if err := d.checkSeasonDivision(tournament); err != nil {
+			return err
+		}
+		stored := *tournament
+		stored.Teams = nil
+		stored.ArchivedAt = nil
+		d.Tournaments[tournament.ID] = stored
+		return nil
+	})
+}
+
+// checkSeasonDivision replica el índice único de un torneo por división y temporada
+func (d *memoryData) checkSeasonDivision(tournament *domain.Tournament) error {
+	if tournament.SeasonID == nil || tournament.DivisionID == nil {
+		return nil
+	}
+	for _, other := range d.Tournaments {
+		if other.ID != tournament.ID && sameID(other.SeasonID, *tournament.SeasonID) && sameID(other.DivisionID, *tournament.DivisionID) {
+			return fmt.Errorf("season already has a tournament for the division")
+		}
+	}
+	return nil
+}
+
+func (r *MemoryTournamentRepository) GetByID(id uuid.UUID) (*domain.Tournament, error) {
+	var tournament domain.Tournament
+	var ok bool
+	r.store.read(func(d *memoryData) { tournament, ok = d.Tournaments[id] })
+	if !ok {
+		return nil, fmt.Errorf("tournament not found")
+	}
+	return &tournament, nil
+}
+
+func (r *MemoryTournamentRepository) GetAll(includeArchived bool) ([]domain.Tournament, error) {
+	return r.query(
+		func(t domain.Tournament) bool { return includeArchived || t.ArchivedAt == nil },
+		func(a, b domain.Tournament) bool { return a.CreatedAt.After(b.CreatedAt) },
+	), nil
+}
+
+func (r *MemoryTournamentRepository) GetPage(includeArchived bool, page domain.Page) (domain.Paged[domain.Tournament], error) {
+	tournaments := r.query(func(t domain.Tournament) bool { return includeArchived || t.ArchivedAt == nil }, nil)
+	domain.SortTournaments(tournaments, domain.TournamentSortFields.Of(page))
+	return domain.SlicePage(tournaments, page), nil
+}
+
+func (r *MemoryTournamentRepository) GetBySeason(seasonID uuid.UUID) ([]domain.Tournament, error) {
+	return r.query(
+		func(t domain.Tournament) bool { return sameID(t.SeasonID, seasonID) },
+		func(a, b domain.Tournament) bool { return a.CreatedAt.After(b.CreatedAt) },
+	), nil
+}
+
+func (r *MemoryTournamentRepository) GetChildren(parentID uuid.UUID) ([]domain.Tournament, error) {
+	var children []domain.Tournament
+	r.store.read(func(d *memoryData) {
+		// Los torneos sin división van al final (NULLS LAST)
+		level := func(t domain.Tournament) int {
+			if t.DivisionID != nil {
+				if division, ok := d.Divisions[*t.DivisionID]; ok {
+					return division.Level
+				}
+			}
+			return int(^uint(0) >> 1)
+		}
+		children = sortedValues(d.Tournaments,
+			func(t domain.Tournament) bool { return sameID(t.ParentTournamentID, parentID) },
+			func(a, b domain.Tournament) bool {
+				if la, lb := level(a), level(b); la != lb {
+					return la < lb
+				}
+				return a.Name < b.Name
+			},
+		)
+	})
+	return children, nil
+}
+
+func (r *MemoryTournamentRepository) FindByName(name string) ([]domain.Tournament, error) {
+	return r.query(func(t domain.Tournament) bool { return strings.EqualFold(t.Name, name) }, nil), nil
+}
+
+func (r *MemoryTournamentRepository) Search(query domain.SearchQuery) ([]domain.Tournament, error) {
+	var tournaments []domain.Tournament
+	r.store.read(func(d *memoryData) {
+		tournaments = searchValues(d.Tournaments, query, func(t domain.Tournament) string { return t.Name }, func(t domain.Tournament) uuid.UUID { return t.ID })
+	})
+	return tournaments, nil
+}
+
+func (r *MemoryTournamentRepository) query(keep func(domain.Tournament) bool, less func(a, b domain.Tournament) bool) []domain.Tournament {
+	var tournaments []domain.Tournament
+	r.store.read(func(d *memoryData) {
+		tournaments = sortedValues(d.Tournaments, keep, less)
+	})
+	return tournaments
+}
+
+func (r *MemoryTournamentRepository) Update(tournament *domain.Tournament) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.Tournaments[tournament.ID]
+		if !ok {
+			return fmt.Errorf("tournament not found")
+		}
+		if err := d.checkSeasonDivision(tournament); err != nil {
+			return err
+		}
+		stored.Name = tournament.Name
+		stored.ResultsDelayMinutes = tournament.ResultsDelayMinutes
+		stored.SeasonID = tournament.SeasonID
+		stored.DivisionID = tournament.DivisionID
+		stored.ParentTournamentID = tournament.ParentTournamentID
+		d.Tournaments[tournament.ID] = stored
+		return nil
+	})
+}
+
+func (r *MemoryTournamentRepository) UpdateStatus(id uuid.UUID, status string) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.Tournaments[id]
+		if !ok {
+			return fmt.Errorf("tournament not found")
+		}
+		stored.Status = status
+		d.Tournaments[id] = stored
+		return nil
+	})
+}
+
+// SetArchived solo marca el torneo: los partidos archivados se reconocen por
+// su torneo (ver memoryData.matchArchived)
+func (r *MemoryTournamentRepository) SetArchived(id uuid.UUID, archivedAt *time.Time) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.Tournaments[id]
+		if !ok {
+			return fmt.Errorf("tournament not found")
+		}
+		stored.ArchivedAt = archivedAt
+		d.Tournaments[id] = stored
+		return nil
+	})
+}
+
+func (r *MemoryTournamentRepository) Delete(id uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Tournaments[id]; !ok {
+			return fmt.Errorf("tournament not found")
+		}
+		d.deleteTournament(id)
+		return nil
+	})
+}
+
+func (r *MemoryTournamentRepository) AddTeam(tournamentID, teamID uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Tournaments[tournamentID]; !ok {
+			return fmt.Errorf("tournament not found")
+		}
+		if _, ok := d.Teams[teamID]; !ok {
+			return fmt.Errorf("team not found")
+		}
+		if d.tournamentTeam(tournamentID, teamID) != nil {
+			return fmt.Errorf("team is already registered in the tournament")
+		}
+		d.TournamentTeams = append(d.TournamentTeams, memoryTournamentTeam{TournamentID: tournamentID, TeamID: teamID})
+		return nil
+	})
+}
+
+// tournamentTeam devuelve la inscripción del equipo en el torneo, o nil
+func (d *memoryData) tournamentTeam(tournamentID, teamID uuid.UUID) *memoryTournamentTeam {
+	for i := range d.TournamentTeams {
+		if d.TournamentTeams[i].TournamentID == tournamentID && d.TournamentTeams[i].TeamID == teamID {
+			return &d.TournamentTeams[i]
+		}
+	}
+	return nil
+}
+
+func (r *MemoryTournamentRepository) RemoveTeam(tournamentID, teamID uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		removeFromSlice(&d.TournamentTeams, func(tt memoryTournamentTeam) bool {
+			return tt.TournamentID == tournamentID && tt.TeamID == teamID
+		})
+		// Las fichas del torneo dependen de la inscripción del equipo
+		removeFromSlice(&d.Registrations, func(reg domain.Registration) bool {
+			return reg.TournamentID == tournamentID && reg.TeamID == teamID
+		})
+		return nil
+	})
+}
+
+func (r *MemoryTournamentRepository) GetTournamentTeams(tournamentID uuid.UUID) ([]domain.Team, error) {
+	var teams []domain.Team
+	r.store.read(func(d *memoryData) {
+		for _, tt := range d.TournamentTeams {
+			if team, ok := d.Teams[tt.TeamID]; ok && tt.TournamentID == tournamentID {
+				team.IsTest = false
+				teams = append(teams, team)
+			}
+		}
+	})
+	slices.SortStableFunc(teams, func(a, b domain.Team) int { return strings.Compare(a.Name, b.Name) })
+	return teams, nil
+}
+
+func (r *MemoryTournamentRepository) SetInitialPoints(tournamentID, teamID uuid.UUID, points int) error {
+	return r.store.write(func(d *memoryData) error {
+		tt := d.tournamentTeam(tournamentID, teamID)
+		if tt == nil {
+			return fmt.Errorf("team is not registered in the tournament")
+		}
+		tt.InitialPoints = points
+		return nil
+	})
+}
+
+// GetStandings sigue las mismas reglas que la consulta de PostgreSQL: sin
+// mini-juegos ni amistosos, solo los partidos cuyo resultado cuenta según
+// domain.Match.ResultCountsAt con el embargo de embargoMinutes, con
+// maxRound > 0 solo hasta esa jornada y restando las quitas de puntos vigentes
+func (r *MemoryTournamentRepository) GetStandings(tournamentID uuid.UUID, maxRound, embargoMinutes int) ([]domain.Standing, error) {
+	var standings []domain.Standing
+	now := time.Now()
+	r.store.read(func(d *memoryData) {
+		for _, tt := range d.TournamentTeams {
+			if tt.TournamentID != tournamentID {
+				continue
+			}
+			team, ok := d.Teams[tt.TeamID]
+			if !ok {
+				continue
+			}
+			s := domain.Standing{TeamID: team.ID, TeamName: team.Name, CarriedPoints: tt.InitialPoints}
+			for _, sanction := range d.Sanctions {
+				if sanction.TournamentID == tournamentID && sameID(sanction.TeamID, team.ID) &&
+					sanction.Type == domain.SanctionPointDeduction && sanction.IsActive() &&
+					(maxRound == 0 || sanction.Round <= maxRound) {
+					s.DeductedPoints += sanction.Points
+				}
+			}
+			for _, m := range d.Matches {
+				if !sameID(m.TournamentID, tournamentID) || m.ParentMatchID != nil || m.IsFriendly {
+					continue
+				}
+				if m.Team1ID != team.ID && m.Team2ID != team.ID {
+					continue
+				}
+				if !m.ResultCountsAt(now, time.Duration(embargoMinutes)*time.Minute) {
+					continue
+				}
+				if maxRound != 0 && m.Round > maxRound {
+					continue
+				}
+
+				goalsFor, goalsAgainst := m.GoalScoredTeam1, m.GoalScoredTeam2
+				if m.Team1ID != team.ID {
+					goalsFor, goalsAgainst = goalsAgainst, goalsFor
+				}
+				s.Played++
+				s.GoalsFor += goalsFor
+				s.GoalsAgainst += goalsAgainst
+				switch {
+				case goalsFor > goalsAgainst:
+					s.Won++
+				case goalsFor < goalsAgainst:
+					s.Lost++
+				default:
+					s.Drawn++
+				}
+			}
+			s.GoalDifference = s.GoalsFor - s.GoalsAgainst
+			s.Points = s.CarriedPoints + s.Won*domain.PointsWin + s.Drawn*domain.PointsDraw - s.DeductedPoints
+			standings = append(standings, s)
+		}
+	})
+
+	domain.SortStandings(standings)
+	return standings, nil
+}
+
+type MemorySeasonRepository struct{ store *MemoryStore }
+
+func NewMemorySeasonRepository(store *MemoryStore) SeasonRepository {
+	return &MemorySeasonRepository{store: store}
+}
+
+func (r *MemorySeasonRepository) Create(season *domain.Season) error {
+	return r.store.write(func(d *memoryData) error {
+		if d.seasonNameTaken(season.Name, season.ID) {
+			return fmt.Errorf("season name %q already exists", season.Name)
+		}
+		d.Seasons[season.ID] = *season
+		return nil
+	})
+}
+
+func (d *memoryData) seasonNameTaken(name string, except uuid.UUID) bool {
+	for _, season := range d.Seasons {
+		if season.Name == name && season.ID != except {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *MemorySeasonRepository) GetByID(id uuid.UUID) (*domain.Season, error) {
+	return r.find(func(s domain.Season) bool { return s.ID == id })
+}
+
+func (r *MemorySeasonRepository) GetByName(name string) (*domain.Season, error) {
+	return r.find(func(s domain.Season) bool { return s.Name == name })
+}
+
+func (r *MemorySeasonRepository) find(match func(domain.Season) bool) (*domain.Season, error) {
+	var found []domain.Season
+	r.store.read(func(d *memoryData) { found = sortedValues(d.Seasons, match, nil) })
+	if len(found) == 0 {
+		return nil, fmt.Errorf("season not found")
+	}
+	return &found[0], nil
+}
+
+func (r *MemorySeasonRepository) GetAll() ([]domain.Season, error) {
+	var seasons []domain.Season
+	r.store.read(func(d *memoryData) {
+		seasons = sortedValues(d.Seasons, nil, func(a, b domain.Season) bool { return a.StartsOn.After(b.StartsOn) })
+	})
+	return seasons, nil
+}
+
+func (r *MemorySeasonRepository) Update(season *domain.Season) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.Seasons[season.ID]
+		if !ok {
+			return fmt.Errorf("season not found")
+		}
+		if d.seasonNameTaken(season.Name, season.ID) {
+			return fmt.Errorf("season name %q already exists", season.Name)
+		}
+		stored.Name = season.Name
+		stored.StartsOn = season.StartsOn
+		stored.EndsOn = season.EndsOn
+		d.Seasons[season.ID] = stored
+		return nil
+	})
+}
+
+func (r *MemorySeasonRepository) Delete(id uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Seasons[id]; !ok {
+			return fmt.Errorf("season not found")
+		}
+		delete(d.Seasons, id)
+		for tournamentID, tournament := range d.Tournaments {
+			clearID(&tournament.SeasonID, id)
+			d.Tournaments[tournamentID] = tournament
+		}
+		return nil
+	})
+}
+
+type MemoryDivisionRepository struct{ store *MemoryStore }
+
+func NewMemoryDivisionRepository(store *MemoryStore) DivisionRepository {
+	return &MemoryDivisionRepository{store: store}
+}
+
+func (r *MemoryDivisionRepository) Create(division *domain.Division) error {
+	return r.store.write(func(d *memoryData) error {
+		if err := d.checkDivision(division); err != nil {
+			return err
+		}
+		d.Divisions[division.ID] = *division
+		return nil
+	})
+}
+
+// checkDivision replica las restricciones UNIQUE de nombre y nivel
+func (d *memoryData) checkDivision(division *domain.Division) error {
+	for _, other := range d.Divisions {
+		if other.ID == division.ID {
+			continue
+		}
+		if other.Name == division.Name {
+			return fmt.Errorf("division name %q already exists", division.Name)
+		}
+		if other.Level == division.Level {
+			return fmt.Errorf("division level %d already exists", division.Level)
+		}
+	}
+	return nil
+}
+
+func (r *MemoryDivisionRepository) GetByID(id uuid.UUID) (*domain.Division, error) {
+	var division domain.Division
+	var ok bool
+	r.store.read(func(d *memoryData) { division, ok = d.Divisions[id] })
+	if !ok {
+		return nil, fmt.Errorf("division not found")
+	}
+	return &division, nil
+}
+
+func (r *MemoryDivisionRepository) GetAll() ([]domain.Division, error) {
+	divisions := []domain.Division{}
+	r.store.read(func(d *memoryData) {
+		divisions = append(divisions, sortedValues(d.Divisions, nil, func(a, b domain.Division) bool { return a.Level < b.Level })...)
+	})
+	return divisions, nil
+}
+
+func (r *MemoryDivisionRepository) Update(division *domain.Division) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.Divisions[division.ID]
+		if !ok {
+			return fmt.Errorf("division not found")
+		}
+		if err := d.checkDivision(division); err != nil {
+			return err
+		}
+		stored.Name = division.Name
+		stored.Level = division.Level
+		stored.Promoted = division.Promoted
+		stored.Relegated = division.Relegated
+		d.Divisions[division.ID] = stored
+		return nil
+	})
+}
+
+func (r *MemoryDivisionRepository) Delete(id uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Divisions[id]; !ok {
+			return fmt.Errorf("division not found")
+		}
+		delete(d.Divisions, id)
+		for tournamentID, tournament := range d.Tournaments {
+			clearID(&tournament.DivisionID, id)
+			d.Tournaments[tournamentID] = tournament
+		}
+		return nil
+	})
+}
+
+type MemoryVenueRepository struct{ store *MemoryStore }
+
+func NewMemoryVenueRepository(store *MemoryStore) VenueRepository {
+	return &MemoryVenueRepository{store: store}
+}
+
+func (r *MemoryVenueRepository) Create(venue *domain.Venue) error {
+	return r.store.write(func(d *memoryData) error {
+		d.Venues[venue.ID] = *venue
+		return nil
+	})
+}
+
+func (r *MemoryVenueRepository) GetByID(id uuid.UUID) (*domain.Venue, error) {
+	var venue domain.Venue
+	var ok bool
+	r.store.read(func(d *memoryData) { venue, ok = d.Venues[id] })
+	if !ok {
+		return nil, fmt.Errorf("venue not found")
+	}
+	return &venue, nil
+}
+
+func (r *MemoryVenueRepository) GetAll() ([]domain.Venue, error) {
+	var venues []domain.Venue
+	r.store.read(func(d *memoryData) {
+		venues = sortedValues(d.Venues, nil, func(a, b domain.Venue) bool { return a.Name < b.Name })
+	})
+	return venues, nil
+}
+
+func (r *MemoryVenueRepository) Update(venue *domain.Venue) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.Venues[venue.ID]
+		if !ok {
+			return fmt.Errorf("venue not found")
+		}
+		stored.Name = venue.Name
+		stored.Address = venue.Address
+		stored.Capacity = venue.Capacity
+		stored.Attendance = venue.Attendance
+		d.Venues[venue.ID] = stored
+		return nil
+	})
+}
+
+func (r *MemoryVenueRepository) Delete(id uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Venues[id]; !ok {
+			return fmt.Errorf("venue not found")
+		}
+		delete(d.Venues, id)
+		for _, pitchID := range removeFromMap(d.Pitches, func(p domain.Pitch) bool { return p.VenueID == id }) {
+			d.clearMatchPitch(pitchID)
+		}
+		for matchID, match := range d.Matches {
+			clearID(&match.VenueID, id)
+			d.Matches[matchID] = match
+		}
+		return nil
+	})
+}
+
+func (d *memoryData) clearMatchPitch(pitchID uuid.UUID) {
+	for matchID, match := range d.Matches {
+		clearID(&match.PitchID, pitchID)
+		d.Matches[matchID] = match
+	}
+}
+
+type MemoryPitchRepository struct{ store *MemoryStore }
+
+func NewMemoryPitchRepository(store *MemoryStore) PitchRepository {
+	return &MemoryPitchRepository{store: store}
+}
+
+func (r *MemoryPitchRepository) Create(pitch *domain.Pitch) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Venues[pitch.VenueID]; !ok {
+			return fmt.Errorf("venue not found")
+		}
+		if d.pitchNameTaken(pitch) {
+			return fmt.Errorf("venue already has a pitch named %q", pitch.Name)
+		}
+		d.Pitches[pitch.ID] = *pitch
+		return nil
+	})
+}
+
+func (d *memoryData) pitchNameTaken(pitch *domain.Pitch) bool {
+	for _, other := range d.Pitches {
+		if other.ID != pitch.ID && other.VenueID == pitch.VenueID && strings.EqualFold(other.Name, pitch.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *MemoryPitchRepository) GetByID(id uuid.UUID) (*domain.Pitch, error) {
+	var pitch domain.Pitch
+	var ok bool
+	r.store.read(func(d *memoryData) { pitch, ok = d.Pitches[id] })
+	if !ok {
+		return nil, fmt.Errorf("pitch not found")
+	}
+	return &pitch, nil
+}
+
+func (r *MemoryPitchRepository) GetByVenue(venueID uuid.UUID) ([]domain.Pitch, error) {
+	var pitches []domain.Pitch
+	r.store.read(func(d *memoryData) {
+		pitches = sortedValues(d.Pitches,
+			func(p domain.Pitch) bool { return p.VenueID == venueID },
+			func(a, b domain.Pitch) bool { return a.Name < b.Name },
+		)
+	})
+	return pitches, nil
+}
+
+func (r *MemoryPitchRepository) Update(pitch *domain.Pitch) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.Pitches[pitch.ID]
+		if !ok {
+			return fmt.Errorf("pitch not found")
+		}
+		stored.Name = pitch.Name
+		if d.pitchNameTaken(&stored) {
+			return fmt.Errorf("venue already has a pitch named %q", pitch.Name)
+		}
+		d.Pitches[pitch.ID] = stored
+		return nil
+	})
+}
+
+func (r *MemoryPitchRepository) Delete(id uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Pitches[id]; !ok {
+			return fmt.Errorf("pitch not found")
+		}
+		delete(d.Pitches, id)
+		d.clearMatchPitch(id)
+		return nil
+	})
+}
+
+type MemoryRefereeRepository struct{ store *MemoryStore }
+
+func NewMemoryRefereeRepository(store *MemoryStore) RefereeRepository {
+	return &MemoryRefereeRepository{store: store}
+}
+
+func (r *MemoryRefereeRepository) Create(referee *domain.Referee) error {
+	return r.store.write(func(d *memoryData) error {
+		if d.refereeEmailTaken(referee) {
+			return fmt.Errorf("referee email %q already exists", referee.Email)
+		}
+		d.Referees[referee.ID] = *referee
+		return nil
+	})
+}
+
+func (d *memoryData) refereeEmailTaken(referee *domain.Referee) bool {
+	if referee.Email == "" {
+		return false
+	}
+	for _, other := range d.Referees {
+		if other.ID != referee.ID && strings.EqualFold(other.Email, referee.Email) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *MemoryRefereeRepository) GetByID(id uuid.UUID) (*domain.Referee, error) {
+	return r.find(func(rf domain.Referee) bool { return rf.ID == id })
+}
+
+func (r *MemoryRefereeRepository) GetAll() ([]domain.Referee, error) {
+	var referees []domain.Referee
+	r.store.read(func(d *memoryData) {
+		referees = sortedValues(d.Referees, nil, func(a, b domain.Referee) bool { return a.Name < b.Name })
+	})
+	return referees, nil
+}
+
+func (r *MemoryRefereeRepository) GetByEmail(email string) (*domain.Referee, error) {
+	return r.find(func(rf domain.Referee) bool { return rf.Email != "" && strings.EqualFold(rf.Email, email) })
+}
+
+func (r *MemoryRefereeRepository) find(match func(domain.Referee) bool) (*domain.Referee, error) {
+	var found []domain.Referee
+	r.store.read(func(d *memoryData) { found = sortedValues(d.Referees, match, nil) })
+	if len(found) == 0 {
+		return nil, fmt.Errorf("referee not found")
+	}
+	return &found[0], nil
+}
+
+func (r *MemoryRefereeRepository) GetAssignedMatchIDs(refereeID uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	r.store.read(func(d *memoryData) {
+		for matchID, referees := range d.MatchReferees {
+			for _, referee := range referees {
+				if referee.RefereeID == refereeID {
+					ids = append(ids, matchID)
+					break
+				}
+			}
+		}
+	})
+	return ids, nil
+}
+
+func (r *MemoryRefereeRepository) Update(referee *domain.Referee) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.Referees[referee.ID]
+		if !ok {
+			return fmt.Errorf("referee not found")
+		}
+		if d.refereeEmailTaken(referee) {
+			return fmt.Errorf("referee email %q already exists", referee.Email)
+		}
+		stored.Name = referee.Name
+		stored.LicenseNumber = referee.LicenseNumber
+		stored.Email = referee.Email
+		d.Referees[referee.ID] = stored
+		return nil
+	})
+}
+
+func (r *MemoryRefereeRepository) Delete(id uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Referees[id]; !ok {
+			return fmt.Errorf("referee not found")
+		}
+		delete(d.Referees, id)
+		for matchID, referees := range d.MatchReferees {
+			d.MatchReferees[matchID] = filterSlice(referees, func(mr domain.MatchReferee) bool { return mr.RefereeID != id })
+		}
+		for resultID, result := range d.Provisional {
+			clearID(&result.RefereeID, id)
+			d.Provisional[resultID] = result
+		}
+		return nil
+	})
+}
+
+// SetMatchReferees reemplaza la designación conservando el orden de referees
+func (r *MemoryRefereeRepository) SetMatchReferees(matchID uuid.UUID, referees []domain.MatchReferee) error {
+	return r.store.write(func(d *memoryData) error {
+		assigned := make([]domain.MatchReferee, 0, len(referees))
+		for _, referee := range referees {
+			if _, ok := d.Referees[referee.RefereeID]; !ok {
+				return fmt.Errorf("referee not found")
+			}
+			assigned = append(assigned, domain.MatchReferee{RefereeID: referee.RefereeID, Role: referee.Role})
+		}
+		d.MatchReferees[matchID] = assigned
+		return nil
+	})
+}
+
+func (r *MemoryRefereeRepository) GetByMatch(matchID uuid.UUID) ([]domain.MatchReferee, error) {
+	byMatch, err := r.GetByMatches([]uuid.UUID{matchID})
+	if err != nil {
+		return nil, err
+	}
+	if referees, ok := byMatch[matchID]; ok {
+		return referees, nil
+	}
+	return []domain.MatchReferee{}, nil
+}
+
+func (r *MemoryRefereeRepository) GetByMatches(matchIDs []uuid.UUID) (map[uuid.UUID][]domain.MatchReferee, error) {
+	byMatch := make(map[uuid.UUID][]domain.MatchReferee)
+	r.store.read(func(d *memoryData) {
+		for _, matchID := range matchIDs {
+			for _, referee := range d.MatchReferees[matchID] {
+				referee.Name = d.Referees[referee.RefereeID].Name
+				byMatch[matchID] = append(byMatch[matchID], referee)
+			}
+		}
+	})
+	return byMatch, nil
+}
+
+type MemoryTagRepository struct{ store *MemoryStore }
+
+func NewMemoryTagRepository(store *MemoryStore) TagRepository {
+	return &MemoryTagRepository{store: store}
+}
+
+func (r *MemoryTagRepository) Create(tag *domain.Tag) error {
+	return r.store.write(func(d *memoryData) error {
+		if d.tagNameTaken(tag) {
+			return fmt.Errorf("tag %q already exists", tag.Name)
+		}
+		d.Tags[tag.ID] = *tag
+		return nil
+	})
+}
+
+func (d *memoryData) tagNameTaken(tag *domain.Tag) bool {
+	for _, other := range d.Tags {
+		if other.ID != tag.ID && strings.EqualFold(other.Name, tag.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *MemoryTagRepository) GetByID(id uuid.UUID) (*domain.Tag, error) {
+	return r.find(func(t domain.Tag) bool { return t.ID == id })
+}
+
+func (r *MemoryTagRepository) GetByName(name string) (*domain.Tag, error) {
+	return r.find(func(t domain.Tag) bool { return strings.EqualFold(t.Name, name) })
+}
+
+func (r *MemoryTagRepository) find(match func(domain.Tag) bool) (*domain.Tag, error) {
+	var found []domain.Tag
+	r.store.read(func(d *memoryData) { found = sortedValues(d.Tags, match, nil) })
+	if len(found) == 0 {
+		return nil, fmt.Errorf("tag not found")
+	}
+	return &found[0], nil
+}
+
+func (r *MemoryTagRepository) GetAll() ([]domain.Tag, error) {
+	var tags []domain.Tag
+	r.store.read(func(d *memoryData) {
+		tags = sortedValues(d.Tags, nil, func(a, b domain.Tag) bool { return a.Name < b.Name })
+	})
+	return tags, nil
+}
+
+func (r *MemoryTagRepository) Update(tag *domain.Tag) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.Tags[tag.ID]
+		if !ok {
+			return fmt.Errorf("tag not found")
+		}
+		if d.tagNameTaken(tag) {
+			return fmt.Errorf("tag %q already exists", tag.Name)
+		}
+		stored.Name = tag.Name
+		stored.Color = tag.Color
+		d.Tags[tag.ID] = stored
+		return nil
+	})
+}
+
+func (r *MemoryTagRepository) Delete(id uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Tags[id]; !ok {
+			return fmt.Errorf("tag not found")
+		}
+		delete(d.Tags, id)
+		removeFromSlice(&d.TagLinks, func(l memoryTagLink) bool { return l.TagID == id })
+		return nil
+	})
+}
+
+func (r *MemoryTagRepository) Attach(tagID uuid.UUID, entity string, entityID uuid.UUID) error {
+	if _, _, err := tagTable(entity); err != nil {
+		return err
+	}
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Tags[tagID]; !ok {
+			return fmt.Errorf("tag not found")
+		}
+		link := memoryTagLink{TagID: tagID, Entity: entity, EntityID: entityID}
+		if !slices.Contains(d.TagLinks, link) {
+			d.TagLinks = append(d.TagLinks, link)
+		}
+		return nil
+	})
+}
+
+func (r *MemoryTagRepository) Detach(tagID uuid.UUID, entity string, entityID uuid.UUID) error {
+	if _, _, err := tagTable(entity); err != nil {
+		return err
+	}
+	return r.store.write(func(d *memoryData) error {
+		link := memoryTagLink{TagID: tagID, Entity: entity, EntityID: entityID}
+		if removeFromSlice(&d.TagLinks, func(l memoryTagLink) bool { return l == link }) == 0 {
+			return fmt.Errorf("%s does not have the tag", entity)
+		}
+		return nil
+	})
+}
+
+func (r *MemoryTagRepository) GetByEntity(entity string, entityID uuid.UUID) ([]domain.Tag, error) {
+	if _, _, err := tagTable(entity); err != nil {
+		return nil, err
+	}
+	var tags []domain.Tag
+	r.store.read(func(d *memoryData) {
+		for _, link := range d.TagLinks {
+			if tag, ok := d.Tags[link.TagID]; ok && link.Entity == entity && link.EntityID == entityID {
+				tags = append(tags, tag)
+			}
+		}
+	})
+	slices.SortStableFunc(tags, func(a, b domain.Tag) int { return strings.Compare(a.Name, b.Name) })
+	return tags, nil
+}
+
+func (r *MemoryTagRepository) GetTaggedIDs(tagID uuid.UUID, entity string) ([]uuid.UUID, error) {
+	if _, _, err := tagTable(entity); err != nil {
+		return nil, err
+	}
+	var ids []uuid.UUID
+	r.store.read(func(d *memoryData) {
+		for _, link := range d.TagLinks {
+			if link.TagID == tagID && link.Entity == entity {
+				ids = append(ids, link.EntityID)
+			}
+		}
+	})
+	return ids, nil
+}
+
+type MemoryStaffRepository struct{ store *MemoryStore }
+
+func NewMemoryStaffRepository(store *MemoryStore) StaffRepository {
+	return &MemoryStaffRepository{store: store}
+}
+
+func (r *MemoryStaffRepository) Create(staff *domain.Staff) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Teams[staff.TeamID]; !ok {
+			return fmt.Errorf("team not found")
+		}
+		d.Staff[staff.ID] = *staff
+		return nil
+	})
+}
+
+func (r *MemoryStaffRepository) GetByID(id uuid.UUID) (*domain.Staff, error) {
+	var staff domain.Staff
+	var ok bool
+	r.store.read(func(d *memoryData) { staff, ok = d.Staff[id] })
+	if !ok {
+		return nil, fmt.Errorf("staff member not found")
+	}
+	return &staff, nil
+}
+
+func (r *MemoryStaffRepository) GetByTeam(teamID uuid.UUID) ([]domain.Staff, error) {
+	var staff []domain.Staff
+	r.store.read(func(d *memoryData) {
+		staff = sortedValues(d.Staff,
+			func(s domain.Staff) bool { return s.TeamID == teamID },
+			func(a, b domain.Staff) bool {
+				if a.Role != b.Role {
+					return a.Role < b.Role
+				}
+				return a.Name < b.Name
+			},
+		)
+	})
+	return staff, nil
+}
+
+func (r *MemoryStaffRepository) Update(staff *domain.Staff) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.Staff[staff.ID]
+		if !ok {
+			return fmt.Errorf("staff member not found")
+		}
+		stored.Name = staff.Name
+		stored.Role = staff.Role
+		stored.Email = staff.Email
+		stored.Phone = staff.Phone
+		d.Staff[staff.ID] = stored
+		return nil
+	})
+}
+
+func (r *MemoryStaffRepository) Delete(id uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Staff[id]; !ok {
+			return fmt.Errorf("staff member not found")
+		}
+		delete(d.Staff, id)
+		return nil
+	})
+}
@@ -0,0 +1,822 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// Repositorios en memoria de los partidos y de lo que se registra en ellos:
+// eventos, cambios, alineaciones, reloj, walkovers, galería, sincronización
+// e invitados.
+
+type MemoryMatchRepository struct{ store *MemoryStore }
+
+func NewMemoryMatchRepository(store *MemoryStore) MatchRepository {
+	return &MemoryMatchRepository{store: store}
+}
+
+// storedMatch quita del partido los campos que las consultas no devuelven
+func storedMatch(match *domain.Match) domain.Match {
+	stored := *match
+	stored.Team1, stored.Team2 = nil, nil
+	stored.Referees = nil
+	stored.WinnerID, stored.DecidedBy = nil, ""
+	stored.ResultEmbargoedUntil = nil
+	stored.Odds = nil
+	stored.Attendance = nil
+	return stored
+}
+
+// matchArchived indica si el partido pertenece a un torneo archivado; en
+// PostgreSQL es la columna matches.archived
+func (d *memoryData) matchArchived(match domain.Match) bool {
+	if match.TournamentID == nil {
+		return false
+	}
+	tournament, ok := d.Tournaments[*match.TournamentID]
+	return ok && tournament.ArchivedAt != nil
+}
+
+func (r *MemoryMatchRepository) Create(match *domain.Match) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Matches[match.ID]; ok {
+			return fmt.Errorf("match already exists")
+		}
+		if _, ok := d.Teams[match.Team1ID]; !ok {
+			return fmt.Errorf("team not found")
+		}
+		if _, ok := d.Teams[match.Team2ID]; !ok {
+			return fmt.Errorf("team not found")
+		}
+		d.Matches[match.ID] = storedMatch(match)
+		return nil
+	})
+}
+
+func (r *MemoryMatchRepository) GetByID(id uuid.UUID) (*domain.Match, error) {
+	var match domain.Match
+	var ok bool
+	r.store.read(func(d *memoryData) { match, ok = d.Matches[id] })
+	if !ok {
+		return nil, fmt.Errorf("match not found")
+	}
+	return &match, nil
+}
+
+func (r *MemoryMatchRepository) GetAll(includeArchived bool) ([]domain.Match, error) {
+	var matches []domain.Match
+	r.store.read(func(d *memoryData) {
+		matches = sortedValues(d.Matches,
+			func(m domain.Match) bool { return includeArchived || !d.matchArchived(m) },
+			byDateDesc,
+		)
+	})
+	return matches, nil
+}
+
+func (r *MemoryMatchRepository) Find(filter domain.MatchFilter) ([]domain.Match, error) {
+	return r.find(filter, domain.MatchSortFields.Default), nil
+}
+
+func (r *MemoryMatchRepository) FindPage(filter domain.MatchFilter, page domain.Page) (domain.Paged[domain.Match], error) {
+	return domain.SlicePage(r.find(filter, domain.MatchSortFields.Of(page)), page), nil
+}
+
+// find filtra los partidos y los ordena según sort
+func (r *MemoryMatchRepository) find(filter domain.MatchFilter, sort domain.Sort) []domain.Match {
+	var matches []domain.Match
+	r.store.read(func(d *memoryData) {
+		matches = sortedValues(d.Matches, func(m domain.Match) bool {
+			if !filter.IncludeArchived && d.matchArchived(m) {
+				return false
+			}
+			if filter.SeasonID != nil && (m.TournamentID == nil || !sameID(d.Tournaments[*m.TournamentID].SeasonID, *filter.SeasonID)) {
+				return false
+			}
+			return filter.Keep(&m)
+		}, nil)
+	})
+	domain.SortMatches(matches, sort)
+	return matches
+}
+
+func byDateDesc(a, b domain.Match) bool { return a.Date.After(b.Date) }
+
+// byDateAndNumber ordena por fecha y número de partido
+func byDateAndNumber(a, b domain.Match) bool {
+	if !a.Date.Equal(b.Date) {
+		return a.Date.Before(b.Date)
+	}
+	return a.MatchNumber < b.MatchNumber
+}
+
+func (r *MemoryMatchRepository) GetByTournament(tournamentID uuid.UUID) ([]domain.Match, error) {
+	return r.query(
+		func(m domain.Match) bool { return sameID(m.TournamentID, tournamentID) && m.ParentMatchID == nil },
+		func(a, b domain.Match) bool {
+			if a.Round != b.Round {
+				return a.Round < b.Round
+			}
+			return byDateAndNumber(a, b)
+		},
+	), nil
+}
+
+func (r *MemoryMatchRepository) GetSubMatches(parentID uuid.UUID) ([]domain.Match, error) {
+	return r.query(
+		func(m domain.Match) bool { return sameID(m.ParentMatchID, parentID) },
+		func(a, b domain.Match) bool { return a.MatchNumber < b.MatchNumber },
+	), nil
+}
+
+func (r *MemoryMatchRepository) GetPlayedByTeam(teamID uuid.UUID, before time.Time, limit int) ([]domain.Match, error) {
+	var matches []domain.Match
+	r.store.read(func(d *memoryData) {
+		matches = sortedValues(d.Matches, func(m domain.Match) bool {
+			if (m.Team1ID != teamID && m.Team2ID != teamID) || m.ParentMatchID != nil {
+				return false
+			}
+			return m.ResultCountsAt(before, d.resultsDelay(m))
+		}, byDateDesc)
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches, nil
+}
+
+// resultsDelay es la demora de resultados del torneo del partido
+func (d *memoryData) resultsDelay(match domain.Match) time.Duration {
+	if match.TournamentID == nil {
+		return 0
+	}
+	return time.Duration(d.Tournaments[*match.TournamentID].ResultsDelayMinutes) * time.Minute
+}
+
+func (r *MemoryMatchRepository) GetByVenue(venueID uuid.UUID, from, to time.Time) ([]domain.Match, error) {
+	return r.query(func(m domain.Match) bool {
+		return sameID(m.VenueID, venueID) && m.ParentMatchID == nil && !m.Date.Before(from) && !m.Date.After(to)
+	}, byDateAndNumber), nil
+}
+
+func (r *MemoryMatchRepository) query(keep func(domain.Match) bool, less func(a, b domain.Match) bool) []domain.Match {
+	var matches []domain.Match
+	r.store.read(func(d *memoryData) {
+		matches = sortedValues(d.Matches, keep, less)
+	})
+	return matches
+}
+
+// Update guarda el partido y le asigna una nueva versión (updated_at); el
+// tipo de resultado solo lo cambia MatchForfeitRepository
+func (r *MemoryMatchRepository) Update(match *domain.Match) error {
+	updatedAt := time.Now().UTC().Truncate(time.Microsecond)
+	err := r.store.write(func(d *memoryData) error {
+		stored, ok := d.Matches[match.ID]
+		if !ok {
+			return fmt.Errorf("match not found")
+		}
+		updated := storedMatch(match)
+		updated.ParentMatchID = stored.ParentMatchID
+		updated.CreatedAt = stored.CreatedAt
+		updated.ResultType = stored.ResultType
+		updated.UpdatedAt = updatedAt
+		d.Matches[match.ID] = updated
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	match.UpdatedAt = updatedAt
+	return nil
+}
+
+func (r *MemoryMatchRepository) Delete(id uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Matches[id]; !ok {
+			return fmt.Errorf("match not found")
+		}
+		d.deleteMatch(id)
+		return nil
+	})
+}
+
+type MemoryMatchEventRepository struct{ store *MemoryStore }
+
+func NewMemoryMatchEventRepository(store *MemoryStore) MatchEventRepository {
+	return &MemoryMatchEventRepository{store: store}
+}
+
+func (r *MemoryMatchEventRepository) Create(event *domain.MatchEvent) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.MatchEvents[event.ID]; ok {
+			return fmt.Errorf("match event already exists")
+		}
+		return d.insertEvent(event)
+	})
+}
+
+func (d *memoryData) insertEvent(event *domain.MatchEvent) error {
+	if _, ok := d.Matches[event.MatchID]; !ok {
+		return fmt.Errorf("match not found")
+	}
+	d.MatchEvents[event.ID] = *event
+	return nil
+}
+
+func (r *MemoryMatchEventRepository) GetByID(id uuid.UUID) (*domain.MatchEvent, error) {
+	var event domain.MatchEvent
+	var ok bool
+	r.store.read(func(d *memoryData) { event, ok = d.MatchEvents[id] })
+	if !ok {
+		return nil, fmt.Errorf("match event not found")
+	}
+	return &event, nil
+}
+
+func (r *MemoryMatchEventRepository) GetByMatch(matchID uuid.UUID) ([]domain.MatchEvent, error) {
+	events := []domain.MatchEvent{}
+	r.store.read(func(d *memoryData) {
+		events = append(events, sortedValues(d.MatchEvents,
+			func(e domain.MatchEvent) bool { return e.MatchID == matchID },
+			func(a, b domain.MatchEvent) bool {
+				if a.Minute != b.Minute {
+					return a.Minute < b.Minute
+				}
+				return a.CreatedAt.Before(b.CreatedAt)
+			},
+		)...)
+	})
+	return events, nil
+}
+
+func (r *MemoryMatchEventRepository) Delete(id uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.MatchEvents[id]; !ok {
+			return fmt.Errorf("match event not found")
+		}
+		delete(d.MatchEvents, id)
+		return nil
+	})
+}
+
+type MemorySubstitutionRepository struct{ store *MemoryStore }
+
+func NewMemorySubstitutionRepository(store *MemoryStore) SubstitutionRepository {
+	return &MemorySubstitutionRepository{store: store}
+}
+
+func (r *MemorySubstitutionRepository) Create(substitution *domain.Substitution) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Matches[substitution.MatchID]; !ok {
+			return fmt.Errorf("match not found")
+		}
+		d.Substitutions[substitution.ID] = *substitution
+		return nil
+	})
+}
+
+func (r *MemorySubstitutionRepository) GetByID(id uuid.UUID) (*domain.Substitution, error) {
+	var substitution domain.Substitution
+	var ok bool
+	r.store.read(func(d *memoryData) { substitution, ok = d.Substitutions[id] })
+	if !ok {
+		return nil, fmt.Errorf("substitution not found")
+	}
+	return &substitution, nil
+}
+
+func (r *MemorySubstitutionRepository) GetByMatch(matchID uuid.UUID) ([]domain.Substitution, error) {
+	substitutions := []domain.Substitution{}
+	r.store.read(func(d *memoryData) {
+		substitutions = append(substitutions, sortedValues(d.Substitutions,
+			func(s domain.Substitution) bool { return s.MatchID == matchID },
+			func(a, b domain.Substitution) bool {
+				if a.Minute != b.Minute {
+					return a.Minute < b.Minute
+				}
+				return a.CreatedAt.Before(b.CreatedAt)
+			},
+		)...)
+	})
+	return substitutions, nil
+}
+
+func (r *MemorySubstitutionRepository) Delete(id uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Substitutions[id]; !ok {
+			return fmt.Errorf("substitution not found")
+		}
+		delete(d.Substitutions, id)
+		return nil
+	})
+}
+
+type MemoryLineupRepository struct{ store *MemoryStore }
+
+func NewMemoryLineupRepository(store *MemoryStore) LineupRepository {
+	return &MemoryLineupRepository{store: store}
+}
+
+func (r *MemoryLineupRepository) Save(lineup *domain.Lineup) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Matches[lineup.MatchID]; !ok {
+			return fmt.Errorf("match not found")
+		}
+		// Un jugador figura una sola vez por partido (PK de lineup_players)
+		for _, other := range d.Lineups {
+			if other.MatchID != lineup.MatchID || other.TeamID == lineup.TeamID {
+				continue
+			}
+			for _, playerID := range slices.Concat(lineup.Starting, lineup.Bench) {
+				if slices.Contains(other.Starting, playerID) || slices.Contains(other.Bench, playerID) {
+					return fmt.Errorf("player %s is already in the other lineup", playerID)
+				}
+			}
+		}
+
+		removeFromSlice(&d.Lineups, func(l domain.Lineup) bool { return l.MatchID == lineup.MatchID && l.TeamID == lineup.TeamID })
+		stored := *lineup
+		stored.Starting = append([]uuid.UUID{}, lineup.Starting...)
+		stored.Bench = append([]uuid.UUID{}, lineup.Bench...)
+		stored.Guests = slices.Clone(lineup.Guests)
+		d.Lineups = append(d.Lineups, stored)
+		return nil
+	})
+}
+
+func (r *MemoryLineupRepository) GetByMatch(matchID uuid.UUID) ([]domain.Lineup, error) {
+	lineups := []domain.Lineup{}
+	r.store.read(func(d *memoryData) {
+		for _, lineup := range d.Lineups {
+			if lineup.MatchID != matchID {
+				continue
+			}
+			lineup.Starting = slices.Clone(lineup.Starting)
+			lineup.Bench = slices.Clone(lineup.Bench)
+			lineup.Guests = slices.Clone(lineup.Guests)
+			lineups = append(lineups, lineup)
+		}
+	})
+	slices.SortStableFunc(lineups, func(a, b domain.Lineup) int { return a.CreatedAt.Compare(b.CreatedAt) })
+	return lineups, nil
+}
+
+type MemoryMatchClockRepository struct{ store *MemoryStore }
+
+func NewMemoryMatchClockRepository(store *MemoryStore) MatchClockRepository {
+	return &MemoryMatchClockRepository{store: store}
+}
+
+func (r *MemoryMatchClockRepository) Get(matchID uuid.UUID) (*domain.MatchClock, error) {
+	var clock domain.MatchClock
+	var ok bool
+	r.store.read(func(d *memoryData) { clock, ok = d.Clocks[matchID] })
+	if !ok {
+		return nil, fmt.Errorf("match clock not found")
+	}
+	return &clock, nil
+}
+
+func (r *MemoryMatchClockRepository) Save(clock *domain.MatchClock) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Matches[clock.MatchID]; !ok {
+			return fmt.Errorf("match not found")
+		}
+		stored := *clock
+		stored.Minute, stored.AddedMinute = 0, 0
+		d.Clocks[clock.MatchID] = stored
+		return nil
+	})
+}
+
+type MemoryMatchForfeitRepository struct{ store *MemoryStore }
+
+func NewMemoryMatchForfeitRepository(store *MemoryStore) MatchForfeitRepository {
+	return &MemoryMatchForfeitRepository{store: store}
+}
+
+func (r *MemoryMatchForfeitRepository) Award(forfeit *domain.MatchForfeit, match *domain.Match) error {
+	updatedAt := time.Now().UTC().Truncate(time.Microsecond)
+	err := r.store.write(func(d *memoryData) error {
+		for _, other := range d.Forfeits {
+			if other.MatchID == forfeit.MatchID && other.RevokedAt == nil {
+				return fmt.Errorf("match already has an active forfeit")
+			}
+		}
+		if err := d.updateMatchResult(match, updatedAt); err != nil {
+			return err
+		}
+		d.Forfeits[forfeit.ID] = *forfeit
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	match.UpdatedAt = updatedAt
+	return nil
+}
+
+func (r *MemoryMatchForfeitRepository) Revoke(forfeit *domain.MatchForfeit, match *domain.Match) error {
+	updatedAt := time.Now().UTC().Truncate(time.Microsecond)
+	err := r.store.write(func(d *memoryData) error {
+		stored, ok := d.Forfeits[forfeit.ID]
+		if !ok || stored.RevokedAt != nil {
+			return fmt.Errorf("forfeit not found")
+		}
+		if err := d.updateMatchResult(match, updatedAt); err != nil {
+			return err
+		}
+		stored.RevokedAt = forfeit.RevokedAt
+		d.Forfeits[forfeit.ID] = stored
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	match.UpdatedAt = updatedAt
+	return nil
+}
+
+// updateMatchResult guarda el marcador y el tipo de resultado del partido y
+// actualiza su versión
+func (d *memoryData) updateMatchResult(match *domain.Match, updatedAt time.Time) error {
+	stored, ok := d.Matches[match.ID]
+	if !ok {
+		return fmt.Errorf("match not found")
+	}
+	stored.GoalScoredTeam1 = match.GoalScoredTeam1
+	stored.GoalScoredTeam2 = match.GoalScoredTeam2
+	stored.ExtraTimeTeam1 = match.ExtraTimeTeam1
+	stored.ExtraTimeTeam2 = match.ExtraTimeTeam2
+	stored.PenaltiesTeam1 = match.PenaltiesTeam1
+	stored.PenaltiesTeam2 = match.PenaltiesTeam2
+	stored.ResultType = match.ResultType
+	stored.UpdatedAt = updatedAt
+	d.Matches[match.ID] = stored
+	return nil
+}
+
+func (r *MemoryMatchForfeitRepository) GetActive(matchID uuid.UUID) (*domain.MatchForfeit, error) {
+	var active []domain.MatchForfeit
+	r.store.read(func(d *memoryData) {
+		active = sortedValues(d.Forfeits, func(f domain.MatchForfeit) bool { return f.MatchID == matchID && f.RevokedAt == nil }, nil)
+	})
+	if len(active) == 0 {
+		return nil, fmt.Errorf("match has no active forfeit")
+	}
+	return &active[0], nil
+}
+
+func (r *MemoryMatchForfeitRepository) GetByMatch(matchID uuid.UUID) ([]domain.MatchForfeit, error) {
+	forfeits := []domain.MatchForfeit{}
+	r.store.read(func(d *memoryData) {
+		forfeits = append(forfeits, sortedValues(d.Forfeits,
+			func(f domain.MatchForfeit) bool { return f.MatchID == matchID },
+			func(a, b domain.MatchForfeit) bool { return a.AwardedAt.After(b.AwardedAt) },
+		)...)
+	})
+	return forfeits, nil
+}
+
+type MemoryMediaRepository struct{ store *MemoryStore }
+
+func NewMemoryMediaRepository(store *MemoryStore) MediaRepository {
+	return &MemoryMediaRepository{store: store}
+}
+
+func (r *MemoryMediaRepository) Create(media *domain.MatchMedia) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Matches[media.MatchID]; !ok {
+			return fmt.Errorf("match not found")
+		}
+		d.Media[media.ID] = *media
+		return nil
+	})
+}
+
+func (r *MemoryMediaRepository) GetByID(id uuid.UUID) (*domain.MatchMedia, error) {
+	var media domain.MatchMedia
+	var ok bool
+	r.store.read(func(d *memoryData) { media, ok = d.Media[id] })
+	if !ok {
+		return nil, fmt.Errorf("media not found")
+	}
+	return &media, nil
+}
+
+func (r *MemoryMediaRepository) GetByMatch(matchID uuid.UUID) ([]domain.MatchMedia, error) {
+	gallery := []domain.MatchMedia{}
+	r.store.read(func(d *memoryData) {
+		gallery = append(gallery, sortedValues(d.Media,
+			func(m domain.MatchMedia) bool { return m.MatchID == matchID },
+			func(a, b domain.MatchMedia) bool {
+				if a.Position != b.Position {
+					return a.Position < b.Position
+				}
+				return a.CreatedAt.Before(b.CreatedAt)
+			},
+		)...)
+	})
+	return gallery, nil
+}
+
+func (r *MemoryMediaRepository) Update(media *domain.MatchMedia) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.Media[media.ID]
+		if !ok {
+			return fmt.Errorf("media not found")
+		}
+		stored.Type = media.Type
+		stored.URL = media.URL
+		stored.Caption = media.Caption
+		d.Media[media.ID] = stored
+		return nil
+	})
+}
+
+func (r *MemoryMediaRepository) Delete(id uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Media[id]; !ok {
+			return fmt.Errorf("media not found")
+		}
+		delete(d.Media, id)
+		return nil
+	})
+}
+
+func (r *MemoryMediaRepository) Reorder(matchID uuid.UUID, ids []uuid.UUID) error {
+	return r.store.write(func(d *memoryData) error {
+		for i, id := range ids {
+			if media, ok := d.Media[id]; ok && media.MatchID == matchID {
+				media.Position = i + 1
+				d.Media[id] = media
+			}
+		}
+		return nil
+	})
+}
+
+type MemorySyncRepository struct{ store *MemoryStore }
+
+func NewMemorySyncRepository(store *MemoryStore) SyncRepository {
+	return &MemorySyncRepository{store: store}
+}
+
+// ApplyMatchOperations valida todas las operaciones antes de aplicar la
+// primera, así un error no deja el lote a medias
+func (r *MemorySyncRepository) ApplyMatchOperations(matchID uuid.UUID, ops []domain.SyncOperation) error {
+	return r.store.write(func(d *memoryData) error {
+		for _, op := range ops {
+			var err error
+			switch op.Type {
+			case domain.SyncOpCreateEvent:
+				if _, ok := d.Matches[op.Event.MatchID]; !ok {
+					err = fmt.Errorf("match not found")
+				}
+			case domain.SyncOpUpdateScore:
+				if _, ok := d.Matches[matchID]; !ok {
+					err = fmt.Errorf("match not found")
+				}
+			case domain.SyncOpCheckIn:
+				if _, ok := d.Matches[op.CheckIn.MatchID]; !ok {
+					err = fmt.Errorf("match not found")
+				}
+			default:
+				err = fmt.Errorf("unknown operation type: %s", op.Type)
+			}
+			if err != nil {
+				return fmt.Errorf("operation %s: %w", op.OpID, err)
+			}
+		}
+
+		for _, op := range ops {
+			switch op.Type {
+			case domain.SyncOpCreateEvent:
+				if _, ok := d.MatchEvents[op.Event.ID]; !ok {
+					d.MatchEvents[op.Event.ID] = *op.Event
+				}
+			case domain.SyncOpUpdateScore:
+				match := d.Matches[matchID]
+				match.GoalScoredTeam1 = op.Score.GoalScoredTeam1
+				match.GoalScoredTeam2 = op.Score.GoalScoredTeam2
+				match.UpdatedAt = time.Now().UTC().Truncate(time.Microsecond)
+				d.Matches[matchID] = match
+			case domain.SyncOpCheckIn:
+				checkedIn := slices.ContainsFunc(d.CheckIns, func(c domain.CheckIn) bool {
+					return c.MatchID == op.CheckIn.MatchID && c.PlayerID == op.CheckIn.PlayerID
+				})
+				if !checkedIn {
+					d.CheckIns = append(d.CheckIns, *op.CheckIn)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+type MemorySyncConflictRepository struct{ store *MemoryStore }
+
+func NewMemorySyncConflictRepository(store *MemoryStore) SyncConflictRepository {
+	return &MemorySyncConflictRepository{store: store}
+}
+
+func (r *MemorySyncConflictRepository) Create(conflict *domain.SyncConflict) error {
+	return r.store.write(func(d *memoryData) error {
+		d.SyncConflicts[conflict.ID] = *conflict
+		return nil
+	})
+}
+
+func (r *MemorySyncConflictRepository) GetByID(id uuid.UUID) (*domain.SyncConflict, error) {
+	var conflict domain.SyncConflict
+	var ok bool
+	r.store.read(func(d *memoryData) { conflict, ok = d.SyncConflicts[id] })
+	if !ok {
+		return nil, fmt.Errorf("sync conflict not found")
+	}
+	return &conflict, nil
+}
+
+func (r *MemorySyncConflictRepository) GetAll(status string) ([]domain.SyncConflict, error) {
+	conflicts := []domain.SyncConflict{}
+	r.store.read(func(d *memoryData) {
+		conflicts = append(conflicts, sortedValues(d.SyncConflicts,
+			func(c domain.SyncConflict) bool { return status == "" || c.Status == status },
+			func(a, b domain.SyncConflict) bool { return a.CreatedAt.Before(b.CreatedAt) },
+		)...)
+	})
+	return conflicts, nil
+}
+
+func (r *MemorySyncConflictRepository) Resolve(conflict *domain.SyncConflict) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.SyncConflicts[conflict.ID]
+		if !ok || stored.Status != domain.ConflictOpen {
+			return fmt.Errorf("sync conflict not found or already resolved")
+		}
+		stored.Status = conflict.Status
+		stored.Resolution = conflict.Resolution
+		stored.ResolvedAt = conflict.ResolvedAt
+		d.SyncConflicts[conflict.ID] = stored
+		return nil
+	})
+}
+
+type MemoryProvisionalResultRepository struct{ store *MemoryStore }
+
+func NewMemoryProvisionalResultRepository(store *MemoryStore) ProvisionalResultRepository {
+	return &MemoryProvisionalResultRepository{store: store}
+}
+
+func (r *MemoryProvisionalResultRepository) Create(result *domain.ProvisionalResult) error {
+	return r.store.write(func(d *memoryData) error {
+		if _, ok := d.Matches[result.MatchID]; !ok {
+			return fmt.Errorf("match not found")
+		}
+		d.Provisional[result.ID] = *result
+		return nil
+	})
+}
+
+func (r *MemoryProvisionalResultRepository) GetByID(id uuid.UUID) (*domain.ProvisionalResult, error) {
+	var result domain.ProvisionalResult
+	var ok bool
+	r.store.read(func(d *memoryData) { result, ok = d.Provisional[id] })
+	if !ok {
+		return nil, fmt.Errorf("provisional result not found")
+	}
+	return &result, nil
+}
+
+func (r *MemoryProvisionalResultRepository) GetAll(status string) ([]domain.ProvisionalResult, error) {
+	results := []domain.ProvisionalResult{}
+	r.store.read(func(d *memoryData) {
+		results = append(results, sortedValues(d.Provisional,
+			func(p domain.ProvisionalResult) bool { return status == "" || p.Status == status },
+			func(a, b domain.ProvisionalResult) bool { return a.CreatedAt.Before(b.CreatedAt) },
+		)...)
+	})
+	return results, nil
+}
+
+func (r *MemoryProvisionalResultRepository) Review(result *domain.ProvisionalResult) error {
+	return r.store.write(func(d *memoryData) error {
+		stored, ok := d.Provisional[result.ID]
+		if !ok || stored.Status != domain.ProvisionalPending {
+			return fmt.Errorf("provisional result not found or already reviewed")
+		}
+		stored.Status = result.Status
+		stored.ReviewedAt = result.ReviewedAt
+		d.Provisional[result.ID] = stored
+		return nil
+	})
+}
+
+type MemoryGuestRepository struct{ store *MemoryStore }
+
+func NewMemoryGuestRepository(store *MemoryStore) GuestRepository {
+	return &MemoryGuestRepository{store: store}
+}
+
+// GetByTeam agrupa los invitados sin distinguir mayúsculas y muestra el
+// primer nombre en orden alfabético, igual que MIN(name) en PostgreSQL
+func (r *MemoryGuestRepository) GetByTeam(teamID uuid.UUID) ([]domain.Guest, error) {
+	type appearances struct {
+		guest   domain.Guest
+		matches map[uuid.UUID]bool
+	}
+	byName := make(map[string]*appearances)
+	add := func(name string, matchID uuid.UUID, goals int) {
+		key := strings.ToLower(name)
+		entry, ok := byName[key]
+		if !ok {
+			entry = &appearances{guest: domain.Guest{Name: name}, matches: make(map[uuid.UUID]bool)}
+			byName[key] = entry
+		}
+		if name < entry.guest.Name {
+			entry.guest.Name = name
+		}
+		entry.matches[matchID] = true
+		entry.guest.Goals += goals
+	}
+
+	r.store.read(func(d *memoryData) {
+		for _, event := range d.MatchEvents {
+			if event.TeamID != teamID || event.GuestName == "" {
+				continue
+			}
+			goals := 0
+			if event.Type == domain.EventGoal || event.Type == domain.EventPenaltyGoal {
+				goals = 1
+			}
+			add(event.GuestName, event.MatchID, goals)
+		}
+		for _, lineup := range d.Lineups {
+			if lineup.TeamID != teamID {
+				continue
+			}
+			for _, name := range lineup.Guests {
+				add(name, lineup.MatchID, 0)
+			}
+		}
+	})
+
+	keys := make([]string, 0, len(byName))
+	for key := range byName {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	guests := []domain.Guest{}
+	for _, key := range keys {
+		entry := byName[key]
+		entry.guest.Matches = len(entry.matches)
+		guests = append(guests, entry.guest)
+	}
+	return guests, nil
+}
+
+func (r *MemoryGuestRepository) Promote(teamID uuid.UUID, name string, playerID uuid.UUID) (*domain.GuestPromotion, error) {
+	promotion := &domain.GuestPromotion{Name: name, PlayerID: playerID}
+	isGuest := func(guest string) bool { return strings.EqualFold(guest, name) }
+
+	err := r.store.write(func(d *memoryData) error {
+		for id, event := range d.MatchEvents {
+			if event.TeamID != teamID || event.GuestName == "" || !isGuest(event.GuestName) {
+				continue
+			}
+			event.PlayerID = &playerID
+			event.GuestName = ""
+			d.MatchEvents[id] = event
+			promotion.Events++
+		}
+
+		for i := range d.Lineups {
+			lineup := &d.Lineups[i]
+			if lineup.TeamID != teamID || !slices.ContainsFunc(lineup.Guests, isGuest) {
+				continue
+			}
+			// Si el jugador ya estaba en alguna alineación del partido se
+			// conserva su lugar
+			inMatch := slices.ContainsFunc(d.Lineups, func(l domain.Lineup) bool {
+				return l.MatchID == lineup.MatchID && (slices.Contains(l.Starting, playerID) || slices.Contains(l.Bench, playerID))
+			})
+			if !inMatch {
+				lineup.Bench = append(slices.Clone(lineup.Bench), playerID)
+			}
+			lineup.Guests = slices.DeleteFunc(slices.Clone(lineup.Guests), isGuest)
+			promotion.Lineups++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return promotion, nil
+}
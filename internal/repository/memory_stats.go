@@ -0,0 +1,397 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// Repositorios en memoria de lo que se calcula a partir de los partidos:
+// tablas de jugadores, vallas invictas, estadísticas, ratings y fotos de la
+// tabla por jornada.
+
+type MemoryStatsRepository struct{ store *MemoryStore }
+
+func NewMemoryStatsRepository(store *MemoryStore) StatsRepository {
+	return &MemoryStatsRepository{store: store}
+}
+
+// countedMatch indica si el partido suma para las tablas de jugadores: del
+// torneo, no amistoso y con el resultado fuera del embargo
+func countedMatch(match domain.Match, tournamentID uuid.UUID, embargoMinutes int, now time.Time) bool {
+	return sameID(match.TournamentID, tournamentID) &&
+		!match.IsFriendly &&
+		!match.Date.Add(time.Duration(embargoMinutes)*time.Minute).After(now)
+}
+
+func (r *MemoryStatsRepository) GetTopScorers(tournamentID uuid.UUID, embargoMinutes int) ([]domain.TopScorer, error) {
+	// Los invitados se agrupan por nombre sin distinguir mayúsculas
+	type scorerKey struct {
+		playerID uuid.UUID
+		guest    string
+		teamID   uuid.UUID
+	}
+	byScorer := make(map[scorerKey]*domain.TopScorer)
+	var order []scorerKey
+	now := time.Now()
+
+	r.store.read(func(d *memoryData) {
+		for _, event := range d.MatchEvents {
+			if event.Type != domain.EventGoal && event.Type != domain.EventPenaltyGoal {
+				continue
+			}
+			if event.PlayerID == nil && event.GuestName == "" {
+				continue
+			}
+			match, ok := d.Matches[event.MatchID]
+			if !ok || !countedMatch(match, tournamentID, embargoMinutes, now) {
+				continue
+			}
+			team, ok := d.Teams[event.TeamID]
+			if !ok {
+				continue
+			}
+
+			key := scorerKey{teamID: team.ID}
+			if event.PlayerID != nil {
+				key.playerID = *event.PlayerID
+			} else {
+				key.guest = strings.ToLower(event.GuestName)
+			}
+			scorer, ok := byScorer[key]
+			if !ok {
+				scorer = &domain.TopScorer{TeamID: team.ID, TeamName: team.Name}
+				if event.PlayerID != nil {
+					playerID := *event.PlayerID
+					scorer.PlayerID = &playerID
+					scorer.PlayerName = d.Players[playerID].Name
+				} else {
+					scorer.PlayerName = event.GuestName
+					scorer.Guest = true
+				}
+				byScorer[key] = scorer
+				order = append(order, key)
+			}
+			if scorer.Guest && event.GuestName < scorer.PlayerName {
+				scorer.PlayerName = event.GuestName
+			}
+			scorer.Goals++
+			if event.Type == domain.EventPenaltyGoal {
+				scorer.Penalties++
+			}
+		}
+	})
+
+	scorers := []domain.TopScorer{}
+	for _, key := range order {
+		scorers = append(scorers, *byScorer[key])
+	}
+	domain.SortTopScorers(scorers)
+	return scorers, nil
+}
+
+func (r *MemoryStatsRepository) GetTopAssists(tournamentID uuid.UUID, embargoMinutes int) ([]domain.TopAssister, error) {
+	type assisterKey struct{ playerID, teamID uuid.UUID }
+	byAssister := make(map[assisterKey]*domain.TopAssister)
+	var order []assisterKey
+	now := time.Now()
+
+	r.store.read(func(d *memoryData) {
+		for _, event := range d.MatchEvents {
+			if event.AssistPlayerID == nil {
+				continue
+			}
+			match, ok := d.Matches[event.MatchID]
+			if !ok || !countedMatch(match, tournamentID, embargoMinutes, now) {
+				continue
+			}
+			player, ok := d.Players[*event.AssistPlayerID]
+			if !ok {
+				continue
+			}
+			team, ok := d.Teams[event.TeamID]
+			if !ok {
+				continue
+			}
+
+			key := assisterKey{playerID: player.ID, teamID: team.ID}
+			assister, ok := byAssister[key]
+			if !ok {
+				assister = &domain.TopAssister{PlayerID: player.ID, PlayerName: player.Name, TeamID: team.ID, TeamName: team.Name}
+				byAssister[key] = assister
+				order = append(order, key)
+			}
+			assister.Assists++
+		}
+	})
+
+	assisters := []domain.TopAssister{}
+	for _, key := range order {
+		assisters = append(assisters, *byAssister[key])
+	}
+	domain.SortTopAssisters(assisters)
+	return assisters, nil
+}
+
+// GetCleanSheets sigue las mismas reglas que concededSides: sin mini-juegos,
+// amistosos ni walkovers. El arquero es el primer titular de la alineación.
+func (r *MemoryStatsRepository) GetCleanSheets(tournamentID uuid.UUID, embargoMinutes int) (*domain.CleanSheets, error) {
+	result := &domain.CleanSheets{
+		Teams:       []domain.TeamCleanSheet{},
+		Goalkeepers: []domain.GoalkeeperCleanSheet{},
+	}
+	type goalkeeperKey struct{ playerID, teamID uuid.UUID }
+	teams := make(map[uuid.UUID]*domain.TeamCleanSheet)
+	goalkeepers := make(map[goalkeeperKey]*domain.GoalkeeperCleanSheet)
+	var teamOrder []uuid.UUID
+	var goalkeeperOrder []goalkeeperKey
+	now := time.Now()
+
+	r.store.read(func(d *memoryData) {
+		addSide := func(matchID, teamID uuid.UUID, conceded int) {
+			team, ok := d.Teams[teamID]
+			if !ok {
+				return
+			}
+			clean := 0
+			if conceded == 0 {
+				clean = 1
+			}
+
+			row, ok := teams[teamID]
+			if !ok {
+				row = &domain.TeamCleanSheet{TeamID: team.ID, TeamName: team.Name}
+				teams[teamID] = row
+				teamOrder = append(teamOrder, teamID)
+			}
+			row.Played++
+			row.GoalsConceded += conceded
+			row.CleanSheets += clean
+
+			for _, lineup := range d.Lineups {
+				if lineup.MatchID != matchID || lineup.TeamID != teamID || len(lineup.Starting) == 0 {
+					continue
+				}
+				player, ok := d.Players[lineup.Starting[0]]
+				if !ok {
+					break
+				}
+				key := goalkeeperKey{playerID: player.ID, teamID: teamID}
+				gk, ok := goalkeepers[key]
+				if !ok {
+					gk = &domain.GoalkeeperCleanSheet{PlayerID: player.ID, PlayerName: player.Name, TeamID: team.ID, TeamName: team.Name}
+					goalkeepers[key] = gk
+					goalkeeperOrder = append(goalkeeperOrder, key)
+				}
+				gk.Played++
+				gk.GoalsConceded += conceded
+				gk.CleanSheets += clean
+				break
+			}
+		}
+
+		for _, match := range d.Matches {
+			if match.ParentMatchID != nil || match.IsForfeit() || !countedMatch(match, tournamentID, embargoMinutes, now) {
+				continue
+			}
+			addSide(match.ID, match.Team1ID, match.GoalScoredTeam2)
+			addSide(match.ID, match.Team2ID, match.GoalScoredTeam1)
+		}
+	})
+
+	for _, teamID := range teamOrder {
+		result.Teams = append(result.Teams, *teams[teamID])
+	}
+	for _, key := range goalkeeperOrder {
+		result.Goalkeepers = append(result.Goalkeepers, *goalkeepers[key])
+	}
+	result.Sort()
+	return result, nil
+}
+
+type MemoryAnalyticsRepository struct{ store *MemoryStore }
+
+func NewMemoryAnalyticsRepository(store *MemoryStore) AnalyticsRepository {
+	return &MemoryAnalyticsRepository{store: store}
+}
+
+func (r *MemoryAnalyticsRepository) Save(analytics *domain.TournamentAnalytics) error {
+	return r.store.write(func(d *memoryData) error {
+		stored := *analytics
+		stored.GoalsPerMatchday = slices.Clone(analytics.GoalsPerMatchday)
+		d.Analytics[analytics.TournamentID] = stored
+		return nil
+	})
+}
+
+func (r *MemoryAnalyticsRepository) GetByTournament(tournamentID uuid.UUID) (*domain.TournamentAnalytics, error) {
+	var analytics domain.TournamentAnalytics
+	var ok bool
+	r.store.read(func(d *memoryData) { analytics, ok = d.Analytics[tournamentID] })
+	if !ok {
+		return nil, fmt.Errorf("analytics not found")
+	}
+	analytics.GoalsPerMatchday = slices.Clone(analytics.GoalsPerMatchday)
+	analytics.ComputeRates()
+	return &analytics, nil
+}
+
+type MemoryRatingRepository struct{ store *MemoryStore }
+
+func NewMemoryRatingRepository(store *MemoryStore) RatingRepository {
+	return &MemoryRatingRepository{store: store}
+}
+
+func (r *MemoryRatingRepository) Replace(ratings []domain.TeamRating, changes []domain.RatingChange) error {
+	return r.store.write(func(d *memoryData) error {
+		replaced := make(map[uuid.UUID]domain.TeamRating, len(ratings))
+		for _, rating := range ratings {
+			if _, ok := d.Teams[rating.TeamID]; !ok {
+				return fmt.Errorf("team not found")
+			}
+			replaced[rating.TeamID] = domain.TeamRating{
+				TeamID:    rating.TeamID,
+				Rating:    rating.Rating,
+				Matches:   rating.Matches,
+				UpdatedAt: rating.UpdatedAt,
+			}
+		}
+		d.Ratings = replaced
+		d.RatingHistory = slices.Clone(changes)
+		return nil
+	})
+}
+
+func (r *MemoryRatingRepository) GetByTeam(teamID uuid.UUID) (*domain.TeamRating, error) {
+	var rating domain.TeamRating
+	var ok bool
+	r.store.read(func(d *memoryData) {
+		rating, ok = d.Ratings[teamID]
+		rating.TeamName = d.Teams[teamID].Name
+	})
+	if !ok {
+		return nil, fmt.Errorf("rating not found")
+	}
+	return &rating, nil
+}
+
+func (r *MemoryRatingRepository) GetRanking() ([]domain.TeamRating, error) {
+	ratings := []domain.TeamRating{}
+	r.store.read(func(d *memoryData) {
+		for _, rating := range d.Ratings {
+			rating.TeamName = d.Teams[rating.TeamID].Name
+			ratings = append(ratings, rating)
+		}
+	})
+	domain.SortRatings(ratings)
+	return ratings, nil
+}
+
+func (r *MemoryRatingRepository) GetHistory(teamID uuid.UUID) ([]domain.RatingChange, error) {
+	var changes []domain.RatingChange
+	r.store.read(func(d *memoryData) {
+		changes = filterSlice(d.RatingHistory, func(c domain.RatingChange) bool { return c.TeamID == teamID })
+	})
+	if changes == nil {
+		changes = []domain.RatingChange{}
+	}
+	slices.SortStableFunc(changes, func(a, b domain.RatingChange) int { return a.PlayedAt.Compare(b.PlayedAt) })
+	return changes, nil
+}
+
+type MemoryStandingsSnapshotRepository struct{ store *MemoryStore }
+
+func NewMemoryStandingsSnapshotRepository(store *MemoryStore) StandingsSnapshotRepository {
+	return &MemoryStandingsSnapshotRepository{store: store}
+}
+
+func (r *MemoryStandingsSnapshotRepository) Save(snapshot *domain.StandingsSnapshot) error {
+	return r.store.write(func(d *memoryData) error {
+		stored := *snapshot
+		stored.Standings = slices.Clone(snapshot.Standings)
+		removeFromSlice(&d.Snapshots, func(s domain.StandingsSnapshot) bool {
+			return s.TournamentID == snapshot.TournamentID && s.Round == snapshot.Round
+		})
+		d.Snapshots = append(d.Snapshots, stored)
+		return nil
+	})
+}
+
+func (r *MemoryStandingsSnapshotRepository) Get(tournamentID uuid.UUID, round int) (*domain.StandingsSnapshot, error) {
+	var found *domain.StandingsSnapshot
+	r.store.read(func(d *memoryData) {
+		for _, snapshot := range d.Snapshots {
+			if snapshot.TournamentID == tournamentID && snapshot.Round == round {
+				snapshot.Standings = slices.Clone(snapshot.Standings)
+				found = &snapshot
+				return
+			}
+		}
+	})
+	if found == nil {
+		return nil, fmt.Errorf("standings snapshot not found")
+	}
+	return found, nil
+}
+
+func (r *MemoryStandingsSnapshotRepository) GetRounds(tournamentID uuid.UUID) ([]int, error) {
+	var rounds []int
+	r.store.read(func(d *memoryData) {
+		for _, snapshot := range d.Snapshots {
+			if snapshot.TournamentID == tournamentID {
+				rounds = append(rounds, snapshot.Round)
+			}
+		}
+	})
+	slices.Sort(rounds)
+	return rounds, nil
+}
+
+// standingsMatches devuelve los partidos que cuentan para la tabla: sin
+// mini-juegos ni amistosos
+func (d *memoryData) standingsMatches(tournamentID uuid.UUID) []domain.Match {
+	return sortedValues(d.Matches, func(m domain.Match) bool {
+		return sameID(m.TournamentID, tournamentID) && m.ParentMatchID == nil && !m.IsFriendly
+	}, nil)
+}
+
+func (r *MemoryStandingsSnapshotRepository) CompletedRounds(tournamentID uuid.UUID) ([]int, error) {
+	lastDate := make(map[int]time.Time)
+	r.store.read(func(d *memoryData) {
+		for _, match := range d.standingsMatches(tournamentID) {
+			if match.Round < 1 {
+				continue
+			}
+			if last, ok := lastDate[match.Round]; !ok || match.Date.After(last) {
+				lastDate[match.Round] = match.Date
+			}
+		}
+	})
+
+	var rounds []int
+	now := time.Now()
+	for round, last := range lastDate {
+		if !last.After(now) {
+			rounds = append(rounds, round)
+		}
+	}
+	slices.Sort(rounds)
+	return rounds, nil
+}
+
+func (r *MemoryStandingsSnapshotRepository) LastPlayedRound(tournamentID uuid.UUID) (int, error) {
+	round := 0
+	now := time.Now()
+	r.store.read(func(d *memoryData) {
+		for _, match := range d.standingsMatches(tournamentID) {
+			if !match.Date.After(now) && match.Round > round {
+				round = match.Round
+			}
+		}
+	})
+	return round, nil
+}
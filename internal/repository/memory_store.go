@@ -0,0 +1,426 @@
+package repository
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// MemoryStore guarda todos los datos en memoria para el modo demo. Los
+// repositorios NewMemoryXRepository comparten un mismo store, así las
+// consultas que en PostgreSQL cruzan tablas (tabla de posiciones,
+// estadísticas) ven los mismos datos. Un único RWMutex protege todo: las
+// escrituras son pocas y así cada operación es atómica como una transacción.
+type MemoryStore struct {
+	mu   sync.RWMutex
+	data memoryData
+	// version cuenta las escrituras para saber si hay algo nuevo que guardar
+	version uint64
+}
+
+// memoryData son las "tablas" del store. Es lo que se guarda en disco, por
+// eso los campos se exportan. Las entidades se guardan sin los campos que
+// las consultas completan cruzando tablas (Team.Players, Match.Team1...).
+type memoryData struct {
+	Players         map[uuid.UUID]domain.Player              `json:"players"`
+	Teams           map[uuid.UUID]domain.Team                `json:"teams"`
+	TeamPlayers     []memoryTeamPlayer                       `json:"team_players"`
+	Transfers       []domain.Transfer                        `json:"transfers"`
+	Tournaments     map[uuid.UUID]domain.Tournament          `json:"tournaments"`
+	TournamentTeams []memoryTournamentTeam                   `json:"tournament_teams"`
+	Matches         map[uuid.UUID]domain.Match               `json:"matches"`
+	Draws           map[uuid.UUID]domain.Draw                `json:"draws"`
+	Sponsors        map[uuid.UUID]domain.Sponsor             `json:"sponsors"`
+	MatchEvents     map[uuid.UUID]domain.MatchEvent          `json:"match_events"`
+	Substitutions   map[uuid.UUID]domain.Substitution        `json:"substitutions"`
+	Lineups         []domain.Lineup                          `json:"lineups"`
+	SyncConflicts   map[uuid.UUID]domain.SyncConflict        `json:"sync_conflicts"`
+	CheckIns        []domain.CheckIn                         `json:"check_ins"`
+	Referees        map[uuid.UUID]domain.Referee             `json:"referees"`
+	MatchReferees   map[uuid.UUID][]domain.MatchReferee      `json:"match_referees"`
+	Venues          map[uuid.UUID]domain.Venue               `json:"venues"`
+	Pitches         map[uuid.UUID]domain.Pitch               `json:"pitches"`
+	Seasons         map[uuid.UUID]domain.Season              `json:"seasons"`
+	Stages          map[uuid.UUID]domain.Stage               `json:"stages"`
+	Provisional     map[uuid.UUID]domain.ProvisionalResult   `json:"provisional_results"`
+	Analytics       map[uuid.UUID]domain.TournamentAnalytics `json:"analytics"`
+	Ratings         map[uuid.UUID]domain.TeamRating          `json:"ratings"`
+	RatingHistory   []domain.RatingChange                    `json:"rating_history"`
+	Injuries        map[uuid.UUID]domain.Injury              `json:"injuries"`
+	Alerts          []domain.Alert                           `json:"alerts"`
+	Tags            map[uuid.UUID]domain.Tag                 `json:"tags"`
+	TagLinks        []memoryTagLink                          `json:"tag_links"`
+	Staff           map[uuid.UUID]domain.Staff               `json:"staff"`
+	Media           map[uuid.UUID]domain.MatchMedia          `json:"media"`
+	Rules           map[uuid.UUID]domain.TournamentRules     `json:"tournament_rules"`
+	Registrations   []domain.Registration                    `json:"registrations"`
+	Clocks          map[uuid.UUID]domain.MatchClock          `json:"match_clocks"`
+	Incidents       map[uuid.UUID]domain.Incident            `json:"incidents"`
+	Snapshots       []domain.StandingsSnapshot               `json:"standings_snapshots"`
+	Divisions       map[uuid.UUID]domain.Division            `json:"divisions"`
+	APIClients      map[uuid.UUID]memoryAPIClient            `json:"api_clients"`
+	APITokens       map[string]memoryAPIToken                `json:"api_tokens"`
+	Forfeits        map[uuid.UUID]domain.MatchForfeit        `json:"match_forfeits"`
+	Sanctions       map[uuid.UUID]domain.Sanction            `json:"sanctions"`
+	Idempotency     map[string]domain.IdempotencyRecord      `json:"idempotency_keys"`
+}
+
+// memoryTeamPlayer es la ficha de un jugador en un equipo (team_players)
+type memoryTeamPlayer struct {
+	TeamID       uuid.UUID `json:"team_id"`
+	PlayerID     uuid.UUID `json:"player_id"`
+	JoinedAt     time.Time `json:"joined_at"`
+	JerseyNumber *int      `json:"jersey_number,omitempty"`
+	Roles        []string  `json:"roles,omitempty"`
+}
+
+// memoryTournamentTeam es la inscripción de un equipo en un torneo
+type memoryTournamentTeam struct {
+	TournamentID  uuid.UUID `json:"tournament_id"`
+	TeamID        uuid.UUID `json:"team_id"`
+	InitialPoints int       `json:"initial_points"`
+}
+
+// memoryTagLink relaciona una etiqueta con un equipo, jugador o partido
+type memoryTagLink struct {
+	TagID    uuid.UUID `json:"tag_id"`
+	Entity   string    `json:"entity"`
+	EntityID uuid.UUID `json:"entity_id"`
+}
+
+// memoryAPIClient guarda aparte el hash del secreto, que el dominio no
+// serializa
+type memoryAPIClient struct {
+	Client     domain.APIClient `json:"client"`
+	SecretHash string           `json:"secret_hash"`
+}
+
+// memoryAPIToken guarda el token por su hash, que el dominio no serializa
+type memoryAPIToken struct {
+	Token domain.APIToken `json:"token"`
+}
+
+// NewMemoryStore crea un store vacío
+func NewMemoryStore() *MemoryStore {
+	store := &MemoryStore{}
+	store.data.init()
+	return store
+}
+
+// init crea los mapas que falten (un archivo guardado por una versión
+// anterior puede no tener todas las tablas)
+func (d *memoryData) init() {
+	initMap(&d.Players)
+	initMap(&d.Teams)
+	initMap(&d.Tournaments)
+	initMap(&d.Matches)
+	initMap(&d.Draws)
+	initMap(&d.Sponsors)
+	initMap(&d.MatchEvents)
+	initMap(&d.Substitutions)
+	initMap(&d.SyncConflicts)
+	initMap(&d.Referees)
+	initMap(&d.MatchReferees)
+	initMap(&d.Venues)
+	initMap(&d.Pitches)
+	initMap(&d.Seasons)
+	initMap(&d.Stages)
+	initMap(&d.Provisional)
+	initMap(&d.Analytics)
+	initMap(&d.Ratings)
+	initMap(&d.Injuries)
+	initMap(&d.Tags)
+	initMap(&d.Staff)
+	initMap(&d.Media)
+	initMap(&d.Rules)
+	initMap(&d.Clocks)
+	initMap(&d.Incidents)
+	initMap(&d.Divisions)
+	initMap(&d.APIClients)
+	initMap(&d.APITokens)
+	initMap(&d.Forfeits)
+	initMap(&d.Sanctions)
+	initMap(&d.Idempotency)
+}
+
+func initMap[K comparable, V any](m *map[K]V) {
+	if *m == nil {
+		*m = make(map[K]V)
+	}
+}
+
+// Load reemplaza los datos del store por los guardados en path
+func (s *MemoryStore) Load(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var data memoryData
+	if err := json.Unmarshal(content, &data); err != nil {
+		return fmt.Errorf("invalid data file %s: %w", path, err)
+	}
+	data.init()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data = data
+	s.version++
+	return nil
+}
+
+// Save escribe los datos en path. Escribe primero un archivo temporal y lo
+// renombra, así un corte a mitad de camino no deja el archivo a medias.
+func (s *MemoryStore) Save(path string) error {
+	s.mu.RLock()
+	content, err := json.MarshalIndent(s.data, "", "  ")
+	s.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Version cambia con cada escritura; sirve para no guardar si no hubo cambios
+func (s *MemoryStore) Version() uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.version
+}
+
+// read ejecuta fn con el store bloqueado para lectura
+func (s *MemoryStore) read(fn func(d *memoryData)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fn(&s.data)
+}
+
+// write ejecuta fn con el store bloqueado para escritura. Si fn devuelve
+// error se asume que no cambió nada.
+func (s *MemoryStore) write(fn func(d *memoryData) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := fn(&s.data); err != nil {
+		return err
+	}
+	s.version++
+	return nil
+}
+
+// sortedValues devuelve los valores del mapa ordenados con less; con nil
+// los devuelve sin un orden definido, igual que SQL sin ORDER BY
+func sortedValues[T any](m map[uuid.UUID]T, keep func(T) bool, less func(a, b T) bool) []T {
+	var values []T
+	for _, value := range m {
+		if keep == nil || keep(value) {
+			values = append(values, value)
+		}
+	}
+	if less != nil {
+		sort.SliceStable(values, func(i, j int) bool { return less(values[i], values[j]) })
+	}
+	return values
+}
+
+// filterSlice devuelve los elementos que cumplen keep, en el mismo orden
+func filterSlice[T any](values []T, keep func(T) bool) []T {
+	var kept []T
+	for _, value := range values {
+		if keep(value) {
+			kept = append(kept, value)
+		}
+	}
+	return kept
+}
+
+// removeFromSlice quita los elementos que cumplen remove y devuelve cuántos quitó
+func removeFromSlice[T any](values *[]T, remove func(T) bool) int {
+	kept := (*values)[:0]
+	removed := 0
+	for _, value := range *values {
+		if remove(value) {
+			removed++
+			continue
+		}
+		kept = append(kept, value)
+	}
+	*values = kept
+	return removed
+}
+
+// removeFromMap quita las entradas que cumplen remove y devuelve sus claves
+func removeFromMap[T any](m map[uuid.UUID]T, remove func(T) bool) []uuid.UUID {
+	var removed []uuid.UUID
+	for id, value := range m {
+		if remove(value) {
+			delete(m, id)
+			removed = append(removed, id)
+		}
+	}
+	return removed
+}
+
+// sameID compara un ID opcional con uno obligatorio
+func sameID(optional *uuid.UUID, id uuid.UUID) bool {
+	return optional != nil && *optional == id
+}
+
+// clearID pone en nil el ID opcional si apunta a id (ON DELETE SET NULL)
+func clearID(optional **uuid.UUID, id uuid.UUID) {
+	if sameID(*optional, id) {
+		*optional = nil
+	}
+}
+
+// Los borrados en cascada replican las claves foráneas de las migraciones:
+// lo que en PostgreSQL es ON DELETE CASCADE se borra y lo que es ON DELETE
+// SET NULL queda sin referencia.
+
+func (d *memoryData) deletePlayer(id uuid.UUID) {
+	delete(d.Players, id)
+	removeFromSlice(&d.TeamPlayers, func(tp memoryTeamPlayer) bool { return tp.PlayerID == id })
+	removeFromSlice(&d.Transfers, func(t domain.Transfer) bool { return t.PlayerID == id })
+	removeFromSlice(&d.CheckIns, func(c domain.CheckIn) bool { return c.PlayerID == id })
+	removeFromSlice(&d.Registrations, func(r domain.Registration) bool { return r.PlayerID == id })
+	removeFromSlice(&d.TagLinks, func(l memoryTagLink) bool { return l.Entity == domain.TagEntityPlayer && l.EntityID == id })
+	removeFromMap(d.Substitutions, func(s domain.Substitution) bool { return s.PlayerOutID == id || s.PlayerInID == id })
+	removeFromMap(d.Injuries, func(i domain.Injury) bool { return i.PlayerID == id })
+	removeFromMap(d.Sanctions, func(s domain.Sanction) bool { return sameID(s.PlayerID, id) })
+	for eventID, event := range d.MatchEvents {
+		clearID(&event.PlayerID, id)
+		clearID(&event.AssistPlayerID, id)
+		d.MatchEvents[eventID] = event
+	}
+	for i := range d.Lineups {
+		d.Lineups[i].Starting = removeID(d.Lineups[i].Starting, id)
+		d.Lineups[i].Bench = removeID(d.Lineups[i].Bench, id)
+	}
+}
+
+func (d *memoryData) deleteTeam(id uuid.UUID) {
+	delete(d.Teams, id)
+	delete(d.Ratings, id)
+	removeFromSlice(&d.TeamPlayers, func(tp memoryTeamPlayer) bool { return tp.TeamID == id })
+	removeFromSlice(&d.TournamentTeams, func(tt memoryTournamentTeam) bool { return tt.TeamID == id })
+	removeFromSlice(&d.Registrations, func(r domain.Registration) bool { return r.TeamID == id })
+	removeFromSlice(&d.Transfers, func(t domain.Transfer) bool { return t.ToTeamID == id })
+	for i := range d.Transfers {
+		clearID(&d.Transfers[i].FromTeamID, id)
+	}
+	removeFromSlice(&d.RatingHistory, func(c domain.RatingChange) bool { return c.TeamID == id || c.OpponentID == id })
+	removeFromSlice(&d.Lineups, func(l domain.Lineup) bool { return l.TeamID == id })
+	removeFromSlice(&d.CheckIns, func(c domain.CheckIn) bool { return c.TeamID == id })
+	removeFromSlice(&d.TagLinks, func(l memoryTagLink) bool { return l.Entity == domain.TagEntityTeam && l.EntityID == id })
+	removeFromMap(d.MatchEvents, func(e domain.MatchEvent) bool { return e.TeamID == id })
+	removeFromMap(d.Substitutions, func(s domain.Substitution) bool { return s.TeamID == id })
+	removeFromMap(d.Staff, func(s domain.Staff) bool { return s.TeamID == id })
+	removeFromMap(d.Forfeits, func(f domain.MatchForfeit) bool { return f.ForfeitingTeamID == id || f.AwardedTeamID == id })
+	removeFromMap(d.Sanctions, func(s domain.Sanction) bool { return sameID(s.TeamID, id) })
+	for _, draw := range d.Draws {
+		draw.Picks = filterSlice(draw.Picks, func(p domain.DrawPick) bool { return p.TeamID != id })
+		d.Draws[draw.ID] = draw
+	}
+	for _, matchID := range removeFromMap(d.Matches, func(m domain.Match) bool { return m.Team1ID == id || m.Team2ID == id }) {
+		d.deleteMatchDependents(matchID)
+	}
+}
+
+func (d *memoryData) deleteTournament(id uuid.UUID) {
+	delete(d.Tournaments, id)
+	delete(d.Analytics, id)
+	delete(d.Rules, id)
+	removeFromSlice(&d.TournamentTeams, func(tt memoryTournamentTeam) bool { return tt.TournamentID == id })
+	removeFromSlice(&d.Registrations, func(r domain.Registration) bool { return r.TournamentID == id })
+	removeFromSlice(&d.Snapshots, func(s domain.StandingsSnapshot) bool { return s.TournamentID == id })
+	removeFromSlice(&d.Alerts, func(a domain.Alert) bool { return sameID(a.TournamentID, id) })
+	removeFromMap(d.Draws, func(dr domain.Draw) bool { return dr.TournamentID == id })
+	removeFromMap(d.Sponsors, func(s domain.Sponsor) bool { return s.TournamentID == id })
+	removeFromMap(d.Stages, func(s domain.Stage) bool { return s.TournamentID == id })
+	removeFromMap(d.Sanctions, func(s domain.Sanction) bool { return s.TournamentID == id })
+	for tournamentID, tournament := range d.Tournaments {
+		clearID(&tournament.ParentTournamentID, id)
+		d.Tournaments[tournamentID] = tournament
+	}
+	for _, matchID := range removeFromMap(d.Matches, func(m domain.Match) bool { return sameID(m.TournamentID, id) }) {
+		d.deleteMatchDependents(matchID)
+	}
+}
+
+func (d *memoryData) deleteMatch(id uuid.UUID) {
+	delete(d.Matches, id)
+	d.deleteMatchDependents(id)
+}
+
+// deleteMatchDependents borra lo que cuelga de un partido ya borrado,
+// incluidos sus mini-juegos
+func (d *memoryData) deleteMatchDependents(id uuid.UUID) {
+	delete(d.MatchReferees, id)
+	delete(d.Clocks, id)
+	removeFromSlice(&d.Lineups, func(l domain.Lineup) bool { return l.MatchID == id })
+	removeFromSlice(&d.CheckIns, func(c domain.CheckIn) bool { return c.MatchID == id })
+	removeFromSlice(&d.RatingHistory, func(c domain.RatingChange) bool { return c.MatchID == id })
+	removeFromSlice(&d.TagLinks, func(l memoryTagLink) bool { return l.Entity == domain.TagEntityMatch && l.EntityID == id })
+	removeFromMap(d.MatchEvents, func(e domain.MatchEvent) bool { return e.MatchID == id })
+	removeFromMap(d.Substitutions, func(s domain.Substitution) bool { return s.MatchID == id })
+	removeFromMap(d.SyncConflicts, func(c domain.SyncConflict) bool { return c.MatchID == id })
+	removeFromMap(d.Provisional, func(p domain.ProvisionalResult) bool { return p.MatchID == id })
+	removeFromMap(d.Media, func(m domain.MatchMedia) bool { return m.MatchID == id })
+	removeFromMap(d.Forfeits, func(f domain.MatchForfeit) bool { return f.MatchID == id })
+	for _, subID := range removeFromMap(d.Matches, func(m domain.Match) bool { return sameID(m.ParentMatchID, id) }) {
+		d.deleteMatchDependents(subID)
+	}
+}
+
+// removeID devuelve ids sin id, en una copia
+func removeID(ids []uuid.UUID, id uuid.UUID) []uuid.UUID {
+	kept := make([]uuid.UUID, 0, len(ids))
+	for _, current := range ids {
+		if current != id {
+			kept = append(kept, current)
+		}
+	}
+	return kept
+}
+
+// searchValues es la búsqueda por nombre en memoria (ver search.go): los
+// valores cuyo nombre coincide, ordenados por Rank y nombre y recortados a
+// query.Limit
+func searchValues[T any](m map[uuid.UUID]T, query domain.SearchQuery, name func(T) string, id func(T) uuid.UUID) []T {
+	found := sortedValues(m,
+		func(value T) bool { return query.Rank(name(value)) >= 0 },
+		func(a, b T) bool {
+			if ra, rb := query.Rank(name(a)), query.Rank(name(b)); ra != rb {
+				return ra < rb
+			}
+			if la, lb := strings.ToLower(name(a)), strings.ToLower(name(b)); la != lb {
+				return la < lb
+			}
+			aID, bID := id(a), id(b)
+			return bytes.Compare(aID[:], bID[:]) < 0
+		},
+	)
+	return found[:min(len(found), query.Limit)]
+}
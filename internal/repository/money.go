@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+)
+
+// nullMoney lee un importe opcional guardado en dos columnas (unidades
+// menores y moneda); NULL en ambas es el importe cero, sin importe
+type nullMoney struct {
+	Amount   sql.NullInt64
+	Currency sql.NullString
+}
+
+func (n nullMoney) Money() domain.Money {
+	if !n.Amount.Valid {
+		return domain.Money{}
+	}
+	return domain.Money{Amount: n.Amount.Int64, Currency: n.Currency.String}
+}
+
+// moneyArgs son los parámetros de las dos columnas; el importe cero se
+// guarda como NULL
+func moneyArgs(money domain.Money) (amount, currency interface{}) {
+	if money == (domain.Money{}) {
+		return nil, nil
+	}
+	return money.Amount, money.Currency
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+)
+
+// Columnas de cada campo ordenable (ver domain.SortFields). Es la lista
+// blanca que separa el parámetro ?sort= del SQL: el ORDER BY solo se arma
+// con valores de estos mapas.
+var (
+	playerSortColumns = map[string]string{
+		"name":        "LOWER(name)",
+		"position":    "position",
+		"nationality": "nationality",
+		"created_at":  "created_at",
+	}
+	teamSortColumns = map[string]string{
+		"name":       "LOWER(name)",
+		"created_at": "created_at",
+	}
+	tournamentSortColumns = map[string]string{
+		"name":       "LOWER(name)",
+		"status":     "status",
+		"created_at": "created_at",
+	}
+	matchSortColumns = map[string]string{
+		"date":         "date",
+		"round":        "round",
+		"match_number": "match_number",
+		"created_at":   "created_at",
+	}
+)
+
+// orderBy arma el ORDER BY del orden pedido; el id desempata las filas con
+// el mismo valor para que las páginas no se solapen
+func orderBy(sort domain.Sort, columns map[string]string) (string, error) {
+	column, ok := columns[sort.Field]
+	if !ok {
+		return "", fmt.Errorf("cannot sort by %s", sort.Field)
+	}
+	direction := "ASC"
+	if sort.Desc {
+		direction = "DESC"
+	}
+	return fmt.Sprintf("ORDER BY %s %s, id", column, direction), nil
+}
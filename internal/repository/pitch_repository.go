@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+type PitchRepository interface {
+	Create(pitch *domain.Pitch) error
+	GetByID(id uuid.UUID) (*domain.Pitch, error)
+	// GetByVenue devuelve las canchas de la sede ordenadas por nombre
+	GetByVenue(venueID uuid.UUID) ([]domain.Pitch, error)
+	Update(pitch *domain.Pitch) error
+	Delete(id uuid.UUID) error
+}
+
+type PostgresPitchRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresPitchRepository(db *sql.DB) PitchRepository {
+	return &PostgresPitchRepository{db: db}
+}
+
+// pitchColumns debe mantenerse en el mismo orden que scanPitch
+const pitchColumns = `id, venue_id, name, created_at`
+
+func scanPitch(row rowScanner, pitch *domain.Pitch) error {
+	return row.Scan(
+		&pitch.ID,
+		&pitch.VenueID,
+		&pitch.Name,
+		&pitch.CreatedAt,
+	)
+}
+
+func (r *PostgresPitchRepository) Create(pitch *domain.Pitch) error {
+	query := `
+		INSERT INTO pitches (id, venue_id, name, created_at)
+		VALUES ($1, $2, $3, $4)
+	`
+	_, err := r.db.Exec(query, pitch.ID, pitch.VenueID, pitch.Name, pitch.CreatedAt)
+	return err
+}
+
+func (r *PostgresPitchRepository) GetByID(id uuid.UUID) (*domain.Pitch, error) {
+	query := `SELECT ` + pitchColumns + ` FROM pitches WHERE id = $1`
+	var pitch domain.Pitch
+	err := scanPitch(r.db.QueryRow(query, id), &pitch)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("pitch not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pitch, nil
+}
+
+func (r *PostgresPitchRepository) GetByVenue(venueID uuid.UUID) ([]domain.Pitch, error) {
+	query := `SELECT ` + pitchColumns + ` FROM pitches WHERE venue_id = $1 ORDER BY name`
+	rows, err := r.db.Query(query, venueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	pitches := []domain.Pitch{}
+	for rows.Next() {
+		var pitch domain.Pitch
+		if err := scanPitch(rows, &pitch); err != nil {
+			return nil, err
+		}
+		pitches = append(pitches, pitch)
+	}
+	return pitches, rows.Err()
+}
+
+func (r *PostgresPitchRepository) Update(pitch *domain.Pitch) error {
+	query := `UPDATE pitches SET name = $2 WHERE id = $1`
+	result, err := r.db.Exec(query, pitch.ID, pitch.Name)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("pitch not found")
+	}
+	return nil
+}
+
+// Delete borra la cancha; los partidos asignados a ella quedan solo con la sede
+func (r *PostgresPitchRepository) Delete(id uuid.UUID) error {
+	query := `DELETE FROM pitches WHERE id = $1`
+	result, err := r.db.Exec(query, id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("pitch not found")
+	}
+	return nil
+}
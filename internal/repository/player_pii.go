@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/fieldcrypt"
+)
+
+// Los repositorios de jugadores y equipos reciben el llavero al crearse: lo
+// aplican al guardar y lo revierten al leer, así los casos de uso siguen
+// viendo los valores en claro. Sin llavero los datos se guardan en claro.
+
+// encodeDateBirth devuelve los valores para date_birth y date_birth_encrypted:
+// con llavero se guarda solo la versión cifrada
+func encodeDateBirth(keyring *fieldcrypt.Keyring, dateBirth time.Time) (sql.NullTime, sql.NullString, error) {
+	if keyring == nil {
+		return sql.NullTime{Time: dateBirth, Valid: true}, sql.NullString{}, nil
+	}
+	encrypted, err := keyring.Encrypt(dateBirth.UTC().Format(time.RFC3339Nano))
+	if err != nil {
+		return sql.NullTime{}, sql.NullString{}, err
+	}
+	return sql.NullTime{}, sql.NullString{String: encrypted, Valid: true}, nil
+}
+
+// decodeDateBirth lee la fecha de nacimiento de cualquiera de las dos
+// columnas; las filas guardadas antes de activar el cifrado siguen en claro
+func decodeDateBirth(keyring *fieldcrypt.Keyring, plain sql.NullTime, encrypted sql.NullString) (time.Time, error) {
+	if !encrypted.Valid {
+		return plain.Time, nil
+	}
+	if keyring == nil {
+		return time.Time{}, fmt.Errorf("player data is encrypted but PII_ENCRYPTION_KEYS is not configured")
+	}
+	value, err := keyring.Decrypt(encrypted.String)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339Nano, value)
+}
+
+// RotatePlayerPII cifra con la clave vigente las fechas de nacimiento que
+// están en claro o cifradas con una clave anterior, y devuelve cuántas filas
+// actualizó. Una vez terminado, las claves anteriores se pueden retirar.
+func RotatePlayerPII(db *sql.DB, keyring *fieldcrypt.Keyring) (int, error) {
+	if keyring == nil {
+		return 0, fmt.Errorf("PII_ENCRYPTION_KEYS is not configured")
+	}
+
+	query := `
+		SELECT id, date_birth, date_birth_encrypted
+		FROM players
+		WHERE date_birth_encrypted IS NULL OR LEFT(date_birth_encrypted, LENGTH($1)) <> $1
+	`
+	rows, err := db.Query(query, "enc:v1:"+keyring.CurrentKeyID()+":")
+	if err != nil {
+		return 0, err
+	}
+
+	type pending struct {
+		id        string
+		dateBirth time.Time
+	}
+	var players []pending
+	for rows.Next() {
+		var p pending
+		var plain sql.NullTime
+		var encrypted sql.NullString
+		if err := rows.Scan(&p.id, &plain, &encrypted); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		if p.dateBirth, err = decodeDateBirth(keyring, plain, encrypted); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("player %s: %w", p.id, err)
+		}
+		players = append(players, p)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for i, p := range players {
+		plain, encrypted, err := encodeDateBirth(keyring, p.dateBirth)
+		if err != nil {
+			return i, err
+		}
+		if _, err := db.Exec(`UPDATE players SET date_birth = $2, date_birth_encrypted = $3 WHERE id = $1`, p.id, plain, encrypted); err != nil {
+			return i, err
+		}
+	}
+	return len(players), nil
+}
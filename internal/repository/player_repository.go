@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/fieldcrypt"
 	"github.com/google/uuid"
 )
 
@@ -12,43 +13,94 @@ import (
 // En C# esto sería una interfaz IPlayerRepository
 type PlayerRepository interface {
 	Create(player *domain.Player) error
+	// CreateMany guarda todos los jugadores en una transacción: si uno
+	// falla no se guarda ninguno
+	CreateMany(players []*domain.Player) error
 	GetByID(id uuid.UUID) (*domain.Player, error)
 	GetAll() ([]domain.Player, error)
+	// GetPage devuelve una página de GetAll junto con el total de jugadores
+	GetPage(page domain.Page) (domain.Paged[domain.Player], error)
+	// Search busca jugadores por nombre (ver domain.SearchQuery)
+	Search(query domain.SearchQuery) ([]domain.Player, error)
 	Update(player *domain.Player) error
 	Delete(id uuid.UUID) error
 }
 
 // PostgresPlayerRepository implementa PlayerRepository para PostgreSQL
 type PostgresPlayerRepository struct {
-	db *sql.DB
+	db      *sql.DB
+	keyring *fieldcrypt.Keyring
 }
 
-// NewPostgresPlayerRepository crea una nueva instancia del repositorio
-func NewPostgresPlayerRepository(db *sql.DB) PlayerRepository {
-	return &PostgresPlayerRepository{db: db}
+// NewPostgresPlayerRepository crea una nueva instancia del repositorio. Con
+// keyring los datos personales de los jugadores se guardan cifrados.
+func NewPostgresPlayerRepository(db *sql.DB, keyring *fieldcrypt.Keyring) PlayerRepository {
+	return &PostgresPlayerRepository{db: db, keyring: keyring}
+}
+
+const insertPlayerQuery = `
+	INSERT INTO players (id, name, date_birth, date_birth_encrypted, position, preferred_foot, nationality, created_at, is_test)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+`
+
+// insertPlayerArgs son los parámetros de insertPlayerQuery; la fecha de
+// nacimiento se cifra si hay clave configurada
+func (r *PostgresPlayerRepository) insertPlayerArgs(player *domain.Player) ([]any, error) {
+	dateBirth, dateBirthEncrypted, err := encodeDateBirth(r.keyring, player.DateBirth)
+	if err != nil {
+		return nil, err
+	}
+	return []any{player.ID, player.Name, dateBirth, dateBirthEncrypted, player.Position, player.PreferredFoot, player.Nationality, player.CreatedAt, player.IsTest}, nil
 }
 
 func (r *PostgresPlayerRepository) Create(player *domain.Player) error {
-	query := `
-		INSERT INTO players (id, name, date_birth, created_at)
-		VALUES ($1, $2, $3, $4)
-	`
-	_, err := r.db.Exec(query, player.ID, player.Name, player.DateBirth, player.CreatedAt)
+	args, err := r.insertPlayerArgs(player)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.Exec(insertPlayerQuery, args...)
 	return err
 }
 
+func (r *PostgresPlayerRepository) CreateMany(players []*domain.Player) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, player := range players {
+		args, err := r.insertPlayerArgs(player)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(insertPlayerQuery, args...); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
 func (r *PostgresPlayerRepository) GetByID(id uuid.UUID) (*domain.Player, error) {
 	query := `
-		SELECT id, name, date_birth, created_at
+		SELECT id, name, date_birth, date_birth_encrypted, position, preferred_foot, nationality, created_at, is_test
 		FROM players
 		WHERE id = $1
 	`
 	var player domain.Player
+	var dateBirth sql.NullTime
+	var dateBirthEncrypted sql.NullString
 	err := r.db.QueryRow(query, id).Scan(
 		&player.ID,
 		&player.Name,
-		&player.DateBirth,
+		&dateBirth,
+		&dateBirthEncrypted,
+		&player.Position,
+		&player.PreferredFoot,
+		&player.Nationality,
 		&player.CreatedAt,
+		&player.IsTest,
 	)
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("player not found")
@@ -56,16 +108,53 @@ func (r *PostgresPlayerRepository) GetByID(id uuid.UUID) (*domain.Player, error)
 	if err != nil {
 		return nil, err
 	}
+	if player.DateBirth, err = decodeDateBirth(r.keyring, dateBirth, dateBirthEncrypted); err != nil {
+		return nil, err
+	}
 	return &player, nil
 }
 
 func (r *PostgresPlayerRepository) GetAll() ([]domain.Player, error) {
 	query := `
-		SELECT id, name, date_birth, created_at
+		SELECT id, name, date_birth, date_birth_encrypted, position, preferred_foot, nationality, created_at, is_test
 		FROM players
 		ORDER BY created_at DESC
 	`
-	rows, err := r.db.Query(query)
+	return r.queryPlayers(query)
+}
+
+func (r *PostgresPlayerRepository) GetPage(page domain.Page) (domain.Paged[domain.Player], error) {
+	result := domain.Paged[domain.Player]{Page: page}
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM players`).Scan(&result.Total); err != nil {
+		return result, err
+	}
+
+	order, err := orderBy(domain.PlayerSortFields.Of(page), playerSortColumns)
+	if err != nil {
+		return result, err
+	}
+	query := `
+		SELECT id, name, date_birth, date_birth_encrypted, position, preferred_foot, nationality, created_at, is_test
+		FROM players
+		` + order + `
+		LIMIT $1 OFFSET $2
+	`
+	result.Items, err = r.queryPlayers(query, page.Limit, page.Offset)
+	return result, err
+}
+
+func (r *PostgresPlayerRepository) Search(search domain.SearchQuery) ([]domain.Player, error) {
+	query := `
+		SELECT id, name, date_birth, date_birth_encrypted, position, preferred_foot, nationality, created_at, is_test
+		FROM players
+		WHERE ` + searchWhere + `
+		` + searchOrder
+	return r.queryPlayers(query, searchArgs(search)...)
+}
+
+// queryPlayers ejecuta una consulta que devuelve las columnas de players
+func (r *PostgresPlayerRepository) queryPlayers(query string, args ...interface{}) ([]domain.Player, error) {
+	rows, err := r.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +163,12 @@ func (r *PostgresPlayerRepository) GetAll() ([]domain.Player, error) {
 	var players []domain.Player
 	for rows.Next() {
 		var player domain.Player
-		if err := rows.Scan(&player.ID, &player.Name, &player.DateBirth, &player.CreatedAt); err != nil {
+		var dateBirth sql.NullTime
+		var dateBirthEncrypted sql.NullString
+		if err := rows.Scan(&player.ID, &player.Name, &dateBirth, &dateBirthEncrypted, &player.Position, &player.PreferredFoot, &player.Nationality, &player.CreatedAt, &player.IsTest); err != nil {
+			return nil, err
+		}
+		if player.DateBirth, err = decodeDateBirth(r.keyring, dateBirth, dateBirthEncrypted); err != nil {
 			return nil, err
 		}
 		players = append(players, player)
@@ -85,10 +179,14 @@ func (r *PostgresPlayerRepository) GetAll() ([]domain.Player, error) {
 func (r *PostgresPlayerRepository) Update(player *domain.Player) error {
 	query := `
 		UPDATE players
-		SET name = $2, date_birth = $3
+		SET name = $2, date_birth = $3, date_birth_encrypted = $4, position = $5, preferred_foot = $6, nationality = $7
 		WHERE id = $1
 	`
-	result, err := r.db.Exec(query, player.ID, player.Name, player.DateBirth)
+	dateBirth, dateBirthEncrypted, err := encodeDateBirth(r.keyring, player.DateBirth)
+	if err != nil {
+		return err
+	}
+	result, err := r.db.Exec(query, player.ID, player.Name, dateBirth, dateBirthEncrypted, player.Position, player.PreferredFoot, player.Nationality)
 	if err != nil {
 		return err
 	}
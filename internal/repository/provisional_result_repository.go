@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+type ProvisionalResultRepository interface {
+	Create(result *domain.ProvisionalResult) error
+	GetByID(id uuid.UUID) (*domain.ProvisionalResult, error)
+	// GetAll lista los resultados; con status vacío devuelve todos
+	GetAll(status string) ([]domain.ProvisionalResult, error)
+	// Review guarda el estado final; solo afecta resultados pendientes
+	Review(result *domain.ProvisionalResult) error
+}
+
+type PostgresProvisionalResultRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresProvisionalResultRepository(db *sql.DB) ProvisionalResultRepository {
+	return &PostgresProvisionalResultRepository{db: db}
+}
+
+// provisionalResultColumns debe mantenerse en el mismo orden que scanProvisionalResult
+const provisionalResultColumns = `id, match_id, referee_id, goal_scored_team1, goal_scored_team2, source, sender, raw_line,
+	status, created_at, reviewed_at`
+
+func scanProvisionalResult(row rowScanner, result *domain.ProvisionalResult) error {
+	return row.Scan(
+		&result.ID,
+		&result.MatchID,
+		&result.RefereeID,
+		&result.GoalScoredTeam1,
+		&result.GoalScoredTeam2,
+		&result.Source,
+		&result.Sender,
+		&result.RawLine,
+		&result.Status,
+		&result.CreatedAt,
+		&result.ReviewedAt,
+	)
+}
+
+func (r *PostgresProvisionalResultRepository) Create(result *domain.ProvisionalResult) error {
+	query := `
+		INSERT INTO provisional_results (id, match_id, referee_id, goal_scored_team1, goal_scored_team2,
+		                                 source, sender, raw_line, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+	_, err := r.db.Exec(query,
+		result.ID,
+		result.MatchID,
+		result.RefereeID,
+		result.GoalScoredTeam1,
+		result.GoalScoredTeam2,
+		result.Source,
+		result.Sender,
+		result.RawLine,
+		result.Status,
+		result.CreatedAt,
+	)
+	return err
+}
+
+func (r *PostgresProvisionalResultRepository) GetByID(id uuid.UUID) (*domain.ProvisionalResult, error) {
+	query := `SELECT ` + provisionalResultColumns + ` FROM provisional_results WHERE id = $1`
+	var result domain.ProvisionalResult
+	err := scanProvisionalResult(r.db.QueryRow(query, id), &result)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("provisional result not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (r *PostgresProvisionalResultRepository) GetAll(status string) ([]domain.ProvisionalResult, error) {
+	query := `
+		SELECT ` + provisionalResultColumns + `
+		FROM provisional_results
+		WHERE $1 = '' OR status = $1
+		ORDER BY created_at
+	`
+	rows, err := r.db.Query(query, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := []domain.ProvisionalResult{}
+	for rows.Next() {
+		var result domain.ProvisionalResult
+		if err := scanProvisionalResult(rows, &result); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, rows.Err()
+}
+
+func (r *PostgresProvisionalResultRepository) Review(result *domain.ProvisionalResult) error {
+	query := `
+		UPDATE provisional_results
+		SET status = $2, reviewed_at = $3
+		WHERE id = $1 AND status = $4
+	`
+	res, err := r.db.Exec(query, result.ID, result.Status, result.ReviewedAt, domain.ProvisionalPending)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("provisional result not found or already reviewed")
+	}
+	return nil
+}
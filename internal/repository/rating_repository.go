@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// RatingRepository guarda los ratings Elo calculados y su historial
+type RatingRepository interface {
+	// Replace reemplaza todos los ratings y el historial en una transacción
+	Replace(ratings []domain.TeamRating, changes []domain.RatingChange) error
+	GetByTeam(teamID uuid.UUID) (*domain.TeamRating, error)
+	// GetRanking devuelve los ratings ordenados con el nombre de cada equipo
+	GetRanking() ([]domain.TeamRating, error)
+	GetHistory(teamID uuid.UUID) ([]domain.RatingChange, error)
+}
+
+type PostgresRatingRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresRatingRepository(db *sql.DB) RatingRepository {
+	return &PostgresRatingRepository{db: db}
+}
+
+func (r *PostgresRatingRepository) Replace(ratings []domain.TeamRating, changes []domain.RatingChange) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM rating_history`); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM team_ratings`); err != nil {
+		return err
+	}
+
+	ratingQuery := `INSERT INTO team_ratings (team_id, rating, matches, updated_at) VALUES ($1, $2, $3, $4)`
+	for _, rating := range ratings {
+		if _, err := tx.Exec(ratingQuery, rating.TeamID, rating.Rating, rating.Matches, rating.UpdatedAt); err != nil {
+			return err
+		}
+	}
+
+	historyQuery := `
+		INSERT INTO rating_history (team_id, match_id, opponent_id, rating_before, rating_after, delta, played_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	for _, change := range changes {
+		if _, err := tx.Exec(historyQuery,
+			change.TeamID,
+			change.MatchID,
+			change.OpponentID,
+			change.RatingBefore,
+			change.RatingAfter,
+			change.Delta,
+			change.PlayedAt,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresRatingRepository) GetByTeam(teamID uuid.UUID) (*domain.TeamRating, error) {
+	query := `
+		SELECT r.team_id, t.name, r.rating, r.matches, r.updated_at
+		FROM team_ratings r
+		JOIN teams t ON t.id = r.team_id
+		WHERE r.team_id = $1
+	`
+	var rating domain.TeamRating
+	err := r.db.QueryRow(query, teamID).Scan(&rating.TeamID, &rating.TeamName, &rating.Rating, &rating.Matches, &rating.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("rating not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rating, nil
+}
+
+func (r *PostgresRatingRepository) GetRanking() ([]domain.TeamRating, error) {
+	query := `
+		SELECT r.team_id, t.name, r.rating, r.matches, r.updated_at
+		FROM team_ratings r
+		JOIN teams t ON t.id = r.team_id
+	`
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ratings := []domain.TeamRating{}
+	for rows.Next() {
+		var rating domain.TeamRating
+		if err := rows.Scan(&rating.TeamID, &rating.TeamName, &rating.Rating, &rating.Matches, &rating.UpdatedAt); err != nil {
+			return nil, err
+		}
+		ratings = append(ratings, rating)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	domain.SortRatings(ratings)
+	return ratings, nil
+}
+
+func (r *PostgresRatingRepository) GetHistory(teamID uuid.UUID) ([]domain.RatingChange, error) {
+	query := `
+		SELECT team_id, match_id, opponent_id, rating_before, rating_after, delta, played_at
+		FROM rating_history
+		WHERE team_id = $1
+		ORDER BY played_at
+	`
+	rows, err := r.db.Query(query, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := []domain.RatingChange{}
+	for rows.Next() {
+		var change domain.RatingChange
+		if err := rows.Scan(
+			&change.TeamID,
+			&change.MatchID,
+			&change.OpponentID,
+			&change.RatingBefore,
+			&change.RatingAfter,
+			&change.Delta,
+			&change.PlayedAt,
+		); err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, rows.Err()
+}
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type RefereeRepository interface {
+	Create(referee *domain.Referee) error
+	GetByID(id uuid.UUID) (*domain.Referee, error)
+	GetAll() ([]domain.Referee, error)
+	// GetByEmail busca sin distinguir mayúsculas
+	GetByEmail(email string) (*domain.Referee, error)
+	// GetAssignedMatchIDs devuelve los partidos donde el árbitro está designado
+	GetAssignedMatchIDs(refereeID uuid.UUID) ([]uuid.UUID, error)
+	Update(referee *domain.Referee) error
+	Delete(id uuid.UUID) error
+	// SetMatchReferees reemplaza la terna arbitral de un partido
+	SetMatchReferees(matchID uuid.UUID, referees []domain.MatchReferee) error
+	GetByMatch(matchID uuid.UUID) ([]domain.MatchReferee, error)
+	// GetByMatches carga las designaciones de varios partidos en una sola consulta
+	GetByMatches(matchIDs []uuid.UUID) (map[uuid.UUID][]domain.MatchReferee, error)
+}
+
+type PostgresRefereeRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresRefereeRepository(db *sql.DB) RefereeRepository {
+	return &PostgresRefereeRepository{db: db}
+}
+
+// refereeColumns debe mantenerse en el mismo orden que scanReferee
+const refereeColumns = `id, name, license_number, email, created_at`
+
+func scanReferee(row rowScanner, referee *domain.Referee) error {
+	return row.Scan(
+		&referee.ID,
+		&referee.Name,
+		&referee.LicenseNumber,
+		&referee.Email,
+		&referee.CreatedAt,
+	)
+}
+
+func (r *PostgresRefereeRepository) Create(referee *domain.Referee) error {
+	query := `
+		INSERT INTO referees (id, name, license_number, email, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err := r.db.Exec(query, referee.ID, referee.Name, referee.LicenseNumber, referee.Email, referee.CreatedAt)
+	return err
+}
+
+func (r *PostgresRefereeRepository) GetByID(id uuid.UUID) (*domain.Referee, error) {
+	query := `SELECT ` + refereeColumns + ` FROM referees WHERE id = $1`
+	var referee domain.Referee
+	err := scanReferee(r.db.QueryRow(query, id), &referee)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("referee not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &referee, nil
+}
+
+func (r *PostgresRefereeRepository) GetAll() ([]domain.Referee, error) {
+	query := `SELECT ` + refereeColumns + ` FROM referees ORDER BY name`
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	referees := []domain.Referee{}
+	for rows.Next() {
+		var referee domain.Referee
+		if err := scanReferee(rows, &referee); err != nil {
+			return nil, err
+		}
+		referees = append(referees, referee)
+	}
+	return referees, rows.Err()
+}
+
+func (r *PostgresRefereeRepository) GetByEmail(email string) (*domain.Referee, error) {
+	query := `SELECT ` + refereeColumns + ` FROM referees WHERE email <> '' AND LOWER(email) = LOWER($1)`
+	var referee domain.Referee
+	err := scanReferee(r.db.QueryRow(query, email), &referee)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("referee not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &referee, nil
+}
+
+func (r *PostgresRefereeRepository) GetAssignedMatchIDs(refereeID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := r.db.Query(`SELECT match_id FROM match_referees WHERE referee_id = $1`, refereeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	matchIDs := []uuid.UUID{}
+	for rows.Next() {
+		var matchID uuid.UUID
+		if err := rows.Scan(&matchID); err != nil {
+			return nil, err
+		}
+		matchIDs = append(matchIDs, matchID)
+	}
+	return matchIDs, rows.Err()
+}
+
+func (r *PostgresRefereeRepository) Update(referee *domain.Referee) error {
+	query := `UPDATE referees SET name = $2, license_number = $3, email = $4 WHERE id = $1`
+	result, err := r.db.Exec(query, referee.ID, referee.Name, referee.LicenseNumber, referee.Email)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("referee not found")
+	}
+	return nil
+}
+
+func (r *PostgresRefereeRepository) Delete(id uuid.UUID) error {
+	query := `DELETE FROM referees WHERE id = $1`
+	result, err := r.db.Exec(query, id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("referee not found")
+	}
+	return nil
+}
+
+// SetMatchReferees borra la designación anterior e inserta la nueva en una
+// transacción. El orden de referees se conserva en la columna slot.
+func (r *PostgresRefereeRepository) SetMatchReferees(matchID uuid.UUID, referees []domain.MatchReferee) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM match_referees WHERE match_id = $1`, matchID); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO match_referees (match_id, referee_id, role, slot)
+		VALUES ($1, $2, $3, $4)
+	`
+	for slot, referee := range referees {
+		if _, err := tx.Exec(query, matchID, referee.RefereeID, referee.Role, slot); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresRefereeRepository) GetByMatch(matchID uuid.UUID) ([]domain.MatchReferee, error) {
+	byMatch, err := r.GetByMatches([]uuid.UUID{matchID})
+	if err != nil {
+		return nil, err
+	}
+	if referees, ok := byMatch[matchID]; ok {
+		return referees, nil
+	}
+	return []domain.MatchReferee{}, nil
+}
+
+func (r *PostgresRefereeRepository) GetByMatches(matchIDs []uuid.UUID) (map[uuid.UUID][]domain.MatchReferee, error) {
+	ids := make([]string, len(matchIDs))
+	for i, id := range matchIDs {
+		ids[i] = id.String()
+	}
+
+	query := `
+		SELECT mr.match_id, mr.referee_id, rf.name, mr.role
+		FROM match_referees mr
+		INNER JOIN referees rf ON rf.id = mr.referee_id
+		WHERE mr.match_id = ANY($1::uuid[])
+		ORDER BY mr.match_id, mr.slot
+	`
+	rows, err := r.db.Query(query, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	byMatch := make(map[uuid.UUID][]domain.MatchReferee)
+	for rows.Next() {
+		var matchID uuid.UUID
+		var referee domain.MatchReferee
+		if err := rows.Scan(&matchID, &referee.RefereeID, &referee.Name, &referee.Role); err != nil {
+			return nil, err
+		}
+		byMatch[matchID] = append(byMatch[matchID], referee)
+	}
+	return byMatch, rows.Err()
+}
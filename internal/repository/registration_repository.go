@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+type RegistrationRepository interface {
+	Create(registration *domain.Registration) error
+	// GetByPlayer devuelve el fichaje del jugador en el torneo
+	GetByPlayer(tournamentID, playerID uuid.UUID) (*domain.Registration, error)
+	// GetByTournament lista los fichajes del torneo; teamID filtra por equipo
+	GetByTournament(tournamentID uuid.UUID, teamID *uuid.UUID) ([]domain.Registration, error)
+	// RegisterSquad ficha a todo el plantel actual del equipo en el torneo;
+	// los jugadores ya fichados con otro equipo se omiten
+	RegisterSquad(tournamentID, teamID uuid.UUID) error
+	Delete(tournamentID, playerID uuid.UUID) error
+}
+
+type PostgresRegistrationRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresRegistrationRepository(db *sql.DB) RegistrationRepository {
+	return &PostgresRegistrationRepository{db: db}
+}
+
+// registrationColumns debe mantenerse en el mismo orden que scanRegistration
+const registrationColumns = `r.tournament_id, r.team_id, r.player_id, p.name, r.registered_at`
+
+func scanRegistration(row rowScanner, registration *domain.Registration) error {
+	return row.Scan(
+		&registration.TournamentID,
+		&registration.TeamID,
+		&registration.PlayerID,
+		&registration.PlayerName,
+		&registration.RegisteredAt,
+	)
+}
+
+func (r *PostgresRegistrationRepository) Create(registration *domain.Registration) error {
+	query := `
+		INSERT INTO player_registrations (tournament_id, team_id, player_id, registered_at)
+		VALUES ($1, $2, $3, $4)
+	`
+	_, err := r.db.Exec(query,
+		registration.TournamentID,
+		registration.TeamID,
+		registration.PlayerID,
+		registration.RegisteredAt,
+	)
+	return err
+}
+
+func (r *PostgresRegistrationRepository) GetByPlayer(tournamentID, playerID uuid.UUID) (*domain.Registration, error) {
+	query := `
+		SELECT ` + registrationColumns + `
+		FROM player_registrations r
+		INNER JOIN players p ON p.id = r.player_id
+		WHERE r.tournament_id = $1 AND r.player_id = $2
+	`
+	var registration domain.Registration
+	err := scanRegistration(r.db.QueryRow(query, tournamentID, playerID), &registration)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("registration not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &registration, nil
+}
+
+func (r *PostgresRegistrationRepository) GetByTournament(tournamentID uuid.UUID, teamID *uuid.UUID) ([]domain.Registration, error) {
+	query := `
+		SELECT ` + registrationColumns + `
+		FROM player_registrations r
+		INNER JOIN players p ON p.id = r.player_id
+		WHERE r.tournament_id = $1 AND ($2::uuid IS NULL OR r.team_id = $2)
+		ORDER BY r.team_id, p.name
+	`
+	rows, err := r.db.Query(query, tournamentID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	registrations := []domain.Registration{}
+	for rows.Next() {
+		var registration domain.Registration
+		if err := scanRegistration(rows, &registration); err != nil {
+			return nil, err
+		}
+		registrations = append(registrations, registration)
+	}
+	return registrations, rows.Err()
+}
+
+func (r *PostgresRegistrationRepository) RegisterSquad(tournamentID, teamID uuid.UUID) error {
+	query := `
+		INSERT INTO player_registrations (tournament_id, team_id, player_id, registered_at)
+		SELECT $1, $2, tp.player_id, NOW()
+		FROM team_players tp
+		WHERE tp.team_id = $2
+		ON CONFLICT (tournament_id, player_id) DO NOTHING
+	`
+	_, err := r.db.Exec(query, tournamentID, teamID)
+	return err
+}
+
+func (r *PostgresRegistrationRepository) Delete(tournamentID, playerID uuid.UUID) error {
+	query := `DELETE FROM player_registrations WHERE tournament_id = $1 AND player_id = $2`
+	result, err := r.db.Exec(query, tournamentID, playerID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("registration not found")
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// SanctionRepository guarda las sanciones disciplinarias. Las quitas de
+// puntos vigentes las resta TournamentRepository.GetStandings.
+type SanctionRepository interface {
+	Create(sanction *domain.Sanction) error
+	GetByID(id uuid.UUID) (*domain.Sanction, error)
+	// GetByTournament devuelve las sanciones del torneo, de la más reciente a
+	// la más antigua, incluidas las anuladas
+	GetByTournament(tournamentID uuid.UUID) ([]domain.Sanction, error)
+	// Revoke anula una sanción vigente
+	Revoke(id uuid.UUID, revokedAt time.Time) error
+}
+
+type PostgresSanctionRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresSanctionRepository(db *sql.DB) SanctionRepository {
+	return &PostgresSanctionRepository{db: db}
+}
+
+// sanctionColumns debe mantenerse en el mismo orden que scanSanction
+const sanctionColumns = `id, tournament_id, type, team_id, player_id, fine_amount, fine_currency, points, matches, round, reason, decided_at, revoked_at`
+
+func scanSanction(row rowScanner, sanction *domain.Sanction) error {
+	var fine nullMoney
+	err := row.Scan(
+		&sanction.ID,
+		&sanction.TournamentID,
+		&sanction.Type,
+		&sanction.TeamID,
+		&sanction.PlayerID,
+		&fine.Amount,
+		&fine.Currency,
+		&sanction.Points,
+		&sanction.Matches,
+		&sanction.Round,
+		&sanction.Reason,
+		&sanction.DecidedAt,
+		&sanction.RevokedAt,
+	)
+	sanction.Fine = fine.Money()
+	return err
+}
+
+func (r *PostgresSanctionRepository) Create(sanction *domain.Sanction) error {
+	query := `
+		INSERT INTO sanctions (` + sanctionColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+	fineAmount, fineCurrency := moneyArgs(sanction.Fine)
+	_, err := r.db.Exec(query,
+		sanction.ID,
+		sanction.TournamentID,
+		sanction.Type,
+		sanction.TeamID,
+		sanction.PlayerID,
+		fineAmount,
+		fineCurrency,
+		sanction.Points,
+		sanction.Matches,
+		sanction.Round,
+		sanction.Reason,
+		sanction.DecidedAt,
+		sanction.RevokedAt,
+	)
+	return err
+}
+
+func (r *PostgresSanctionRepository) GetByID(id uuid.UUID) (*domain.Sanction, error) {
+	query := `SELECT ` + sanctionColumns + ` FROM sanctions WHERE id = $1`
+	var sanction domain.Sanction
+	err := scanSanction(r.db.QueryRow(query, id), &sanction)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("sanction not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &sanction, nil
+}
+
+func (r *PostgresSanctionRepository) GetByTournament(tournamentID uuid.UUID) ([]domain.Sanction, error) {
+	query := `SELECT ` + sanctionColumns + ` FROM sanctions WHERE tournament_id = $1 ORDER BY decided_at DESC`
+	rows, err := r.db.Query(query, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sanctions := []domain.Sanction{}
+	for rows.Next() {
+		var sanction domain.Sanction
+		if err := scanSanction(rows, &sanction); err != nil {
+			return nil, err
+		}
+		sanctions = append(sanctions, sanction)
+	}
+	return sanctions, rows.Err()
+}
+
+func (r *PostgresSanctionRepository) Revoke(id uuid.UUID, revokedAt time.Time) error {
+	result, err := r.db.Exec(`UPDATE sanctions SET revoked_at = $2 WHERE id = $1 AND revoked_at IS NULL`, id, revokedAt)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("sanction not found or already revoked")
+	}
+	return nil
+}
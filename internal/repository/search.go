@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"strings"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+)
+
+// Búsqueda por nombre de jugadores, equipos y torneos (GET /api/search). En
+// Postgres es un ILIKE que aprovecha los índices de trigramas de la
+// migración 049; el orden replica domain.SearchQuery.Rank.
+
+// searchWhere filtra por nombre con $1 (el texto entre %) y searchOrder
+// ordena por igual a $2, empieza con $3 y nombre; $4 es el límite
+const (
+	searchWhere = `name ILIKE $1`
+	searchOrder = `ORDER BY LOWER(name) = LOWER($2) DESC, name ILIKE $3 DESC, LOWER(name), id LIMIT $4`
+)
+
+// searchArgs son los parámetros de searchWhere y searchOrder
+func searchArgs(query domain.SearchQuery) []interface{} {
+	text := escapeLike(query.Text)
+	return []interface{}{"%" + text + "%", query.Text, text + "%", query.Limit}
+}
+
+// escapeLike escapa los comodines de LIKE para buscar el texto literal
+func escapeLike(text string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(text)
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+type SeasonRepository interface {
+	Create(season *domain.Season) error
+	GetByID(id uuid.UUID) (*domain.Season, error)
+	GetByName(name string) (*domain.Season, error)
+	GetAll() ([]domain.Season, error)
+	Update(season *domain.Season) error
+	Delete(id uuid.UUID) error
+}
+
+type PostgresSeasonRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresSeasonRepository(db *sql.DB) SeasonRepository {
+	return &PostgresSeasonRepository{db: db}
+}
+
+// seasonColumns debe mantenerse en el mismo orden que scanSeason
+const seasonColumns = `id, name, starts_on, ends_on, created_at`
+
+func scanSeason(row rowScanner, season *domain.Season) error {
+	return row.Scan(
+		&season.ID,
+		&season.Name,
+		&season.StartsOn,
+		&season.EndsOn,
+		&season.CreatedAt,
+	)
+}
+
+func (r *PostgresSeasonRepository) Create(season *domain.Season) error {
+	query := `
+		INSERT INTO seasons (id, name, starts_on, ends_on, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err := r.db.Exec(query, season.ID, season.Name, season.StartsOn, season.EndsOn, season.CreatedAt)
+	return err
+}
+
+func (r *PostgresSeasonRepository) GetByID(id uuid.UUID) (*domain.Season, error) {
+	query := `SELECT ` + seasonColumns + ` FROM seasons WHERE id = $1`
+	return r.getOne(query, id)
+}
+
+func (r *PostgresSeasonRepository) GetByName(name string) (*domain.Season, error) {
+	query := `SELECT ` + seasonColumns + ` FROM seasons WHERE name = $1`
+	return r.getOne(query, name)
+}
+
+func (r *PostgresSeasonRepository) getOne(query string, arg interface{}) (*domain.Season, error) {
+	var season domain.Season
+	err := scanSeason(r.db.QueryRow(query, arg), &season)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("season not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &season, nil
+}
+
+func (r *PostgresSeasonRepository) GetAll() ([]domain.Season, error) {
+	query := `SELECT ` + seasonColumns + ` FROM seasons ORDER BY starts_on DESC`
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	seasons := []domain.Season{}
+	for rows.Next() {
+		var season domain.Season
+		if err := scanSeason(rows, &season); err != nil {
+			return nil, err
+		}
+		seasons = append(seasons, season)
+	}
+	return seasons, rows.Err()
+}
+
+func (r *PostgresSeasonRepository) Update(season *domain.Season) error {
+	query := `UPDATE seasons SET name = $2, starts_on = $3, ends_on = $4 WHERE id = $1`
+	result, err := r.db.Exec(query, season.ID, season.Name, season.StartsOn, season.EndsOn)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("season not found")
+	}
+	return nil
+}
+
+// Delete borra la temporada; sus torneos quedan sin temporada
+func (r *PostgresSeasonRepository) Delete(id uuid.UUID) error {
+	query := `DELETE FROM seasons WHERE id = $1`
+	result, err := r.db.Exec(query, id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("season not found")
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+type SponsorRepository interface {
+	Create(sponsor *domain.Sponsor) error
+	GetByID(id uuid.UUID) (*domain.Sponsor, error)
+	GetByTournament(tournamentID uuid.UUID) ([]domain.Sponsor, error)
+	GetActive(tournamentID uuid.UUID, placement string, at time.Time) ([]domain.Sponsor, error)
+	Update(sponsor *domain.Sponsor) error
+	Delete(id uuid.UUID) error
+}
+
+type PostgresSponsorRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresSponsorRepository(db *sql.DB) SponsorRepository {
+	return &PostgresSponsorRepository{db: db}
+}
+
+// sponsorColumns debe mantenerse en el mismo orden que scanSponsor
+const sponsorColumns = `id, tournament_id, name, logo_url, link_url, placement, starts_at, ends_at, created_at`
+
+func scanSponsor(row rowScanner, sponsor *domain.Sponsor) error {
+	return row.Scan(
+		&sponsor.ID,
+		&sponsor.TournamentID,
+		&sponsor.Name,
+		&sponsor.LogoURL,
+		&sponsor.LinkURL,
+		&sponsor.Placement,
+		&sponsor.StartsAt,
+		&sponsor.EndsAt,
+		&sponsor.CreatedAt,
+	)
+}
+
+func (r *PostgresSponsorRepository) Create(sponsor *domain.Sponsor) error {
+	query := `
+		INSERT INTO sponsors (id, tournament_id, name, logo_url, link_url, placement, starts_at, ends_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	_, err := r.db.Exec(query,
+		sponsor.ID,
+		sponsor.TournamentID,
+		sponsor.Name,
+		sponsor.LogoURL,
+		sponsor.LinkURL,
+		sponsor.Placement,
+		sponsor.StartsAt,
+		sponsor.EndsAt,
+		sponsor.CreatedAt,
+	)
+	return err
+}
+
+func (r *PostgresSponsorRepository) GetByID(id uuid.UUID) (*domain.Sponsor, error) {
+	query := `SELECT ` + sponsorColumns + ` FROM sponsors WHERE id = $1`
+	var sponsor domain.Sponsor
+	err := scanSponsor(r.db.QueryRow(query, id), &sponsor)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("sponsor not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &sponsor, nil
+}
+
+func (r *PostgresSponsorRepository) GetByTournament(tournamentID uuid.UUID) ([]domain.Sponsor, error) {
+	query := `
+		SELECT ` + sponsorColumns + `
+		FROM sponsors
+		WHERE tournament_id = $1
+		ORDER BY placement, starts_at
+	`
+	return r.querySponsors(query, tournamentID)
+}
+
+// GetActive devuelve los patrocinadores vigentes; placement vacío no filtra
+func (r *PostgresSponsorRepository) GetActive(tournamentID uuid.UUID, placement string, at time.Time) ([]domain.Sponsor, error) {
+	query := `
+		SELECT ` + sponsorColumns + `
+		FROM sponsors
+		WHERE tournament_id = $1
+		  AND ($2 = '' OR placement = $2)
+		  AND starts_at <= $3 AND ends_at >= $3
+		ORDER BY placement, name
+	`
+	return r.querySponsors(query, tournamentID, placement, at)
+}
+
+func (r *PostgresSponsorRepository) querySponsors(query string, args ...interface{}) ([]domain.Sponsor, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sponsors []domain.Sponsor
+	for rows.Next() {
+		var sponsor domain.Sponsor
+		if err := scanSponsor(rows, &sponsor); err != nil {
+			return nil, err
+		}
+		sponsors = append(sponsors, sponsor)
+	}
+	return sponsors, rows.Err()
+}
+
+func (r *PostgresSponsorRepository) Update(sponsor *domain.Sponsor) error {
+	query := `
+		UPDATE sponsors
+		SET name = $2, logo_url = $3, link_url = $4, placement = $5, starts_at = $6, ends_at = $7
+		WHERE id = $1
+	`
+	result, err := r.db.Exec(query,
+		sponsor.ID,
+		sponsor.Name,
+		sponsor.LogoURL,
+		sponsor.LinkURL,
+		sponsor.Placement,
+		sponsor.StartsAt,
+		sponsor.EndsAt,
+	)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("sponsor not found")
+	}
+	return nil
+}
+
+func (r *PostgresSponsorRepository) Delete(id uuid.UUID) error {
+	query := `DELETE FROM sponsors WHERE id = $1`
+	result, err := r.db.Exec(query, id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("sponsor not found")
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+type StaffRepository interface {
+	Create(staff *domain.Staff) error
+	GetByID(id uuid.UUID) (*domain.Staff, error)
+	// GetByTeam devuelve el cuerpo técnico ordenado por rol y nombre
+	GetByTeam(teamID uuid.UUID) ([]domain.Staff, error)
+	Update(staff *domain.Staff) error
+	Delete(id uuid.UUID) error
+}
+
+type PostgresStaffRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresStaffRepository(db *sql.DB) StaffRepository {
+	return &PostgresStaffRepository{db: db}
+}
+
+// staffColumns debe mantenerse en el mismo orden que scanStaff
+const staffColumns = `id, team_id, name, role, email, phone, created_at`
+
+func scanStaff(row rowScanner, staff *domain.Staff) error {
+	return row.Scan(
+		&staff.ID,
+		&staff.TeamID,
+		&staff.Name,
+		&staff.Role,
+		&staff.Email,
+		&staff.Phone,
+		&staff.CreatedAt,
+	)
+}
+
+func (r *PostgresStaffRepository) Create(staff *domain.Staff) error {
+	query := `
+		INSERT INTO team_staff (id, team_id, name, role, email, phone, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err := r.db.Exec(query,
+		staff.ID,
+		staff.TeamID,
+		staff.Name,
+		staff.Role,
+		staff.Email,
+		staff.Phone,
+		staff.CreatedAt,
+	)
+	return err
+}
+
+func (r *PostgresStaffRepository) GetByID(id uuid.UUID) (*domain.Staff, error) {
+	query := `SELECT ` + staffColumns + ` FROM team_staff WHERE id = $1`
+	var staff domain.Staff
+	err := scanStaff(r.db.QueryRow(query, id), &staff)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("staff member not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &staff, nil
+}
+
+func (r *PostgresStaffRepository) GetByTeam(teamID uuid.UUID) ([]domain.Staff, error) {
+	query := `SELECT ` + staffColumns + ` FROM team_staff WHERE team_id = $1 ORDER BY role, name`
+	rows, err := r.db.Query(query, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	members := []domain.Staff{}
+	for rows.Next() {
+		var staff domain.Staff
+		if err := scanStaff(rows, &staff); err != nil {
+			return nil, err
+		}
+		members = append(members, staff)
+	}
+	return members, rows.Err()
+}
+
+func (r *PostgresStaffRepository) Update(staff *domain.Staff) error {
+	query := `UPDATE team_staff SET name = $2, role = $3, email = $4, phone = $5 WHERE id = $1`
+	result, err := r.db.Exec(query, staff.ID, staff.Name, staff.Role, staff.Email, staff.Phone)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("staff member not found")
+	}
+	return nil
+}
+
+func (r *PostgresStaffRepository) Delete(id uuid.UUID) error {
+	query := `DELETE FROM team_staff WHERE id = $1`
+	result, err := r.db.Exec(query, id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("staff member not found")
+	}
+	return nil
+}
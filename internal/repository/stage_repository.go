@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+type StageRepository interface {
+	Create(stage *domain.Stage) error
+	GetByID(id uuid.UUID) (*domain.Stage, error)
+	GetByTournament(tournamentID uuid.UUID) ([]domain.Stage, error)
+	// UpdateStatuses guarda el estado de varias fases en una transacción
+	UpdateStatuses(stages []domain.Stage) error
+	Delete(id uuid.UUID) error
+}
+
+type PostgresStageRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresStageRepository(db *sql.DB) StageRepository {
+	return &PostgresStageRepository{db: db}
+}
+
+// stageColumns debe mantenerse en el mismo orden que scanStage
+const stageColumns = `id, tournament_id, name, position, status, created_at`
+
+func scanStage(row rowScanner, stage *domain.Stage) error {
+	return row.Scan(
+		&stage.ID,
+		&stage.TournamentID,
+		&stage.Name,
+		&stage.Position,
+		&stage.Status,
+		&stage.CreatedAt,
+	)
+}
+
+func (r *PostgresStageRepository) Create(stage *domain.Stage) error {
+	query := `
+		INSERT INTO stages (id, tournament_id, name, position, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err := r.db.Exec(query,
+		stage.ID,
+		stage.TournamentID,
+		stage.Name,
+		stage.Position,
+		stage.Status,
+		stage.CreatedAt,
+	)
+	return err
+}
+
+func (r *PostgresStageRepository) GetByID(id uuid.UUID) (*domain.Stage, error) {
+	query := `SELECT ` + stageColumns + ` FROM stages WHERE id = $1`
+	var stage domain.Stage
+	err := scanStage(r.db.QueryRow(query, id), &stage)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("stage not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &stage, nil
+}
+
+func (r *PostgresStageRepository) GetByTournament(tournamentID uuid.UUID) ([]domain.Stage, error) {
+	query := `
+		SELECT ` + stageColumns + `
+		FROM stages
+		WHERE tournament_id = $1
+		ORDER BY position
+	`
+	rows, err := r.db.Query(query, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stages := []domain.Stage{}
+	for rows.Next() {
+		var stage domain.Stage
+		if err := scanStage(rows, &stage); err != nil {
+			return nil, err
+		}
+		stages = append(stages, stage)
+	}
+	return stages, rows.Err()
+}
+
+func (r *PostgresStageRepository) UpdateStatuses(stages []domain.Stage) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, stage := range stages {
+		if _, err := tx.Exec(`UPDATE stages SET status = $2 WHERE id = $1`, stage.ID, stage.Status); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresStageRepository) Delete(id uuid.UUID) error {
+	query := `DELETE FROM stages WHERE id = $1`
+	result, err := r.db.Exec(query, id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("stage not found")
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// StandingsSnapshotRepository guarda la tabla de posiciones de cada jornada
+// completa y responde qué jornadas ya se jugaron
+type StandingsSnapshotRepository interface {
+	// Save reemplaza la foto de la jornada
+	Save(snapshot *domain.StandingsSnapshot) error
+	Get(tournamentID uuid.UUID, round int) (*domain.StandingsSnapshot, error)
+	// GetRounds lista las jornadas con foto guardada, en orden
+	GetRounds(tournamentID uuid.UUID) ([]int, error)
+	// CompletedRounds lista las jornadas cuyos partidos ya se jugaron todos
+	CompletedRounds(tournamentID uuid.UUID) ([]int, error)
+	// LastPlayedRound es la última jornada con algún partido jugado (0 si ninguna)
+	LastPlayedRound(tournamentID uuid.UUID) (int, error)
+}
+
+type PostgresStandingsSnapshotRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresStandingsSnapshotRepository(db *sql.DB) StandingsSnapshotRepository {
+	return &PostgresStandingsSnapshotRepository{db: db}
+}
+
+func (r *PostgresStandingsSnapshotRepository) Save(snapshot *domain.StandingsSnapshot) error {
+	standings, err := json.Marshal(snapshot.Standings)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO standings_snapshots (tournament_id, round, standings, taken_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (tournament_id, round) DO UPDATE
+		SET standings = EXCLUDED.standings, taken_at = EXCLUDED.taken_at
+	`
+	_, err = r.db.Exec(query, snapshot.TournamentID, snapshot.Round, standings, snapshot.TakenAt)
+	return err
+}
+
+func (r *PostgresStandingsSnapshotRepository) Get(tournamentID uuid.UUID, round int) (*domain.StandingsSnapshot, error) {
+	query := `
+		SELECT tournament_id, round, standings, taken_at
+		FROM standings_snapshots
+		WHERE tournament_id = $1 AND round = $2
+	`
+	var snapshot domain.StandingsSnapshot
+	var standings []byte
+	err := r.db.QueryRow(query, tournamentID, round).Scan(
+		&snapshot.TournamentID,
+		&snapshot.Round,
+		&standings,
+		&snapshot.TakenAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("standings snapshot not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(standings, &snapshot.Standings); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+func (r *PostgresStandingsSnapshotRepository) GetRounds(tournamentID uuid.UUID) ([]int, error) {
+	query := `SELECT round FROM standings_snapshots WHERE tournament_id = $1 ORDER BY round`
+	return r.queryRounds(query, tournamentID)
+}
+
+// CompletedRounds considera los partidos de la misma forma que la tabla:
+// sin mini-juegos y jugados si su fecha ya pasó
+func (r *PostgresStandingsSnapshotRepository) CompletedRounds(tournamentID uuid.UUID) ([]int, error) {
+	query := `
+		SELECT round
+		FROM matches
+		WHERE tournament_id = $1 AND parent_match_id IS NULL AND NOT is_friendly AND round >= 1
+		GROUP BY round
+		HAVING MAX(date) <= NOW()
+		ORDER BY round
+	`
+	return r.queryRounds(query, tournamentID)
+}
+
+func (r *PostgresStandingsSnapshotRepository) LastPlayedRound(tournamentID uuid.UUID) (int, error) {
+	query := `
+		SELECT COALESCE(MAX(round), 0)
+		FROM matches
+		WHERE tournament_id = $1 AND parent_match_id IS NULL AND NOT is_friendly AND date <= NOW()
+	`
+	var round int
+	err := r.db.QueryRow(query, tournamentID).Scan(&round)
+	return round, err
+}
+
+func (r *PostgresStandingsSnapshotRepository) queryRounds(query string, args ...interface{}) ([]int, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rounds []int
+	for rows.Next() {
+		var round int
+		if err := rows.Scan(&round); err != nil {
+			return nil, err
+		}
+		rounds = append(rounds, round)
+	}
+	return rounds, rows.Err()
+}
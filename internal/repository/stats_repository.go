@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// StatsRepository agrega los eventos de partido para las estadísticas de un torneo
+type StatsRepository interface {
+	// GetTopScorers suma los goles por jugador o invitado. Con embargoMinutes > 0 se
+	// excluyen los partidos cuyo resultado todavía está embargado.
+	GetTopScorers(tournamentID uuid.UUID, embargoMinutes int) ([]domain.TopScorer, error)
+	GetTopAssists(tournamentID uuid.UUID, embargoMinutes int) ([]domain.TopAssister, error)
+	GetCleanSheets(tournamentID uuid.UUID, embargoMinutes int) (*domain.CleanSheets, error)
+}
+
+type PostgresStatsRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresStatsRepository(db *sql.DB) StatsRepository {
+	return &PostgresStatsRepository{db: db}
+}
+
+func (r *PostgresStatsRepository) GetTopScorers(tournamentID uuid.UUID, embargoMinutes int) ([]domain.TopScorer, error) {
+	query := `
+		SELECT p.id, COALESCE(p.name, MIN(e.guest_name)), t.id, t.name,
+		       COUNT(*) AS goals,
+		       COUNT(*) FILTER (WHERE e.type = $2) AS penalties
+		FROM match_events e
+		INNER JOIN matches m ON m.id = e.match_id
+		LEFT JOIN players p ON p.id = e.player_id
+		INNER JOIN teams t ON t.id = e.team_id
+		WHERE m.tournament_id = $1
+		  AND NOT m.is_friendly
+		  AND e.type IN ($3, $2)
+		  AND (e.player_id IS NOT NULL OR e.guest_name <> '')
+		  AND m.date + make_interval(mins => $4) <= NOW()
+		GROUP BY p.id, p.name, LOWER(e.guest_name), t.id, t.name
+	`
+	rows, err := r.db.Query(query, tournamentID, domain.EventPenaltyGoal, domain.EventGoal, embargoMinutes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	scorers := []domain.TopScorer{}
+	for rows.Next() {
+		var s domain.TopScorer
+		if err := rows.Scan(&s.PlayerID, &s.PlayerName, &s.TeamID, &s.TeamName, &s.Goals, &s.Penalties); err != nil {
+			return nil, err
+		}
+		s.Guest = s.PlayerID == nil
+		scorers = append(scorers, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	domain.SortTopScorers(scorers)
+	return scorers, nil
+}
+
+func (r *PostgresStatsRepository) GetTopAssists(tournamentID uuid.UUID, embargoMinutes int) ([]domain.TopAssister, error) {
+	query := `
+		SELECT p.id, p.name, t.id, t.name, COUNT(*) AS assists
+		FROM match_events e
+		INNER JOIN matches m ON m.id = e.match_id
+		INNER JOIN players p ON p.id = e.assist_player_id
+		INNER JOIN teams t ON t.id = e.team_id
+		WHERE m.tournament_id = $1
+		  AND NOT m.is_friendly
+		  AND m.date + make_interval(mins => $2) <= NOW()
+		GROUP BY p.id, p.name, t.id, t.name
+	`
+	rows, err := r.db.Query(query, tournamentID, embargoMinutes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	assisters := []domain.TopAssister{}
+	for rows.Next() {
+		var a domain.TopAssister
+		if err := rows.Scan(&a.PlayerID, &a.PlayerName, &a.TeamID, &a.TeamName, &a.Assists); err != nil {
+			return nil, err
+		}
+		assisters = append(assisters, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	domain.SortTopAssisters(assisters)
+	return assisters, nil
+}
+
+// concededSides es una CTE con una fila por equipo y partido jugado y los
+// goles que recibió. Parámetros: $1 torneo, $2 minutos de embargo. Los
+// walkover no cuentan: no hubo arquero en la cancha.
+const concededSides = `
+	WITH played AS (
+		SELECT id, team1_id, team2_id, goal_scored_team1, goal_scored_team2
+		FROM matches
+		WHERE tournament_id = $1
+		  AND parent_match_id IS NULL
+		  AND NOT is_friendly
+		  AND result_type <> 'forfeit'
+		  AND date + make_interval(mins => $2) <= NOW()
+	), sides AS (
+		SELECT id AS match_id, team1_id AS team_id, goal_scored_team2 AS conceded FROM played
+		UNION ALL
+		SELECT id AS match_id, team2_id AS team_id, goal_scored_team1 AS conceded FROM played
+	)
+`
+
+// GetCleanSheets calcula las vallas invictas por equipo y por arquero. El
+// arquero es el primer titular de la alineación (slot 1).
+func (r *PostgresStatsRepository) GetCleanSheets(tournamentID uuid.UUID, embargoMinutes int) (*domain.CleanSheets, error) {
+	result := &domain.CleanSheets{
+		Teams:       []domain.TeamCleanSheet{},
+		Goalkeepers: []domain.GoalkeeperCleanSheet{},
+	}
+
+	teamQuery := concededSides + `
+		SELECT t.id, t.name, COUNT(*), COALESCE(SUM(s.conceded), 0), COUNT(*) FILTER (WHERE s.conceded = 0)
+		FROM sides s
+		INNER JOIN teams t ON t.id = s.team_id
+		GROUP BY t.id, t.name
+	`
+	rows, err := r.db.Query(teamQuery, tournamentID, embargoMinutes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var team domain.TeamCleanSheet
+		if err := rows.Scan(&team.TeamID, &team.TeamName, &team.Played, &team.GoalsConceded, &team.CleanSheets); err != nil {
+			return nil, err
+		}
+		result.Teams = append(result.Teams, team)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	goalkeeperQuery := concededSides + `
+		SELECT p.id, p.name, t.id, t.name, COUNT(*), COALESCE(SUM(s.conceded), 0), COUNT(*) FILTER (WHERE s.conceded = 0)
+		FROM sides s
+		INNER JOIN lineup_players lp ON lp.match_id = s.match_id AND lp.team_id = s.team_id
+		                             AND lp.role = 'starter' AND lp.slot = 1
+		INNER JOIN players p ON p.id = lp.player_id
+		INNER JOIN teams t ON t.id = s.team_id
+		GROUP BY p.id, p.name, t.id, t.name
+	`
+	gkRows, err := r.db.Query(goalkeeperQuery, tournamentID, embargoMinutes)
+	if err != nil {
+		return nil, err
+	}
+	defer gkRows.Close()
+
+	for gkRows.Next() {
+		var gk domain.GoalkeeperCleanSheet
+		if err := gkRows.Scan(&gk.PlayerID, &gk.PlayerName, &gk.TeamID, &gk.TeamName, &gk.Played, &gk.GoalsConceded, &gk.CleanSheets); err != nil {
+			return nil, err
+		}
+		result.Goalkeepers = append(result.Goalkeepers, gk)
+	}
+	if err := gkRows.Err(); err != nil {
+		return nil, err
+	}
+
+	result.Sort()
+	return result, nil
+}
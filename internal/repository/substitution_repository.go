@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+type SubstitutionRepository interface {
+	Create(substitution *domain.Substitution) error
+	GetByID(id uuid.UUID) (*domain.Substitution, error)
+	GetByMatch(matchID uuid.UUID) ([]domain.Substitution, error)
+	Delete(id uuid.UUID) error
+}
+
+type PostgresSubstitutionRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresSubstitutionRepository(db *sql.DB) SubstitutionRepository {
+	return &PostgresSubstitutionRepository{db: db}
+}
+
+// substitutionColumns debe mantenerse en el mismo orden que scanSubstitution
+const substitutionColumns = `id, match_id, team_id, player_out_id, player_in_id, minute, created_at`
+
+func scanSubstitution(row rowScanner, substitution *domain.Substitution) error {
+	return row.Scan(
+		&substitution.ID,
+		&substitution.MatchID,
+		&substitution.TeamID,
+		&substitution.PlayerOutID,
+		&substitution.PlayerInID,
+		&substitution.Minute,
+		&substitution.CreatedAt,
+	)
+}
+
+func (r *PostgresSubstitutionRepository) Create(substitution *domain.Substitution) error {
+	query := `
+		INSERT INTO substitutions (id, match_id, team_id, player_out_id, player_in_id, minute, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err := r.db.Exec(query,
+		substitution.ID,
+		substitution.MatchID,
+		substitution.TeamID,
+		substitution.PlayerOutID,
+		substitution.PlayerInID,
+		substitution.Minute,
+		substitution.CreatedAt,
+	)
+	return err
+}
+
+func (r *PostgresSubstitutionRepository) GetByID(id uuid.UUID) (*domain.Substitution, error) {
+	query := `SELECT ` + substitutionColumns + ` FROM substitutions WHERE id = $1`
+	var substitution domain.Substitution
+	err := scanSubstitution(r.db.QueryRow(query, id), &substitution)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("substitution not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &substitution, nil
+}
+
+func (r *PostgresSubstitutionRepository) GetByMatch(matchID uuid.UUID) ([]domain.Substitution, error) {
+	query := `
+		SELECT ` + substitutionColumns + `
+		FROM substitutions
+		WHERE match_id = $1
+		ORDER BY minute, created_at
+	`
+	rows, err := r.db.Query(query, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	substitutions := []domain.Substitution{}
+	for rows.Next() {
+		var substitution domain.Substitution
+		if err := scanSubstitution(rows, &substitution); err != nil {
+			return nil, err
+		}
+		substitutions = append(substitutions, substitution)
+	}
+	return substitutions, rows.Err()
+}
+
+func (r *PostgresSubstitutionRepository) Delete(id uuid.UUID) error {
+	query := `DELETE FROM substitutions WHERE id = $1`
+	result, err := r.db.Exec(query, id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("substitution not found")
+	}
+	return nil
+}
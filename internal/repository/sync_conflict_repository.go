@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+type SyncConflictRepository interface {
+	Create(conflict *domain.SyncConflict) error
+	GetByID(id uuid.UUID) (*domain.SyncConflict, error)
+	// GetAll lista los conflictos; con status vacío devuelve todos
+	GetAll(status string) ([]domain.SyncConflict, error)
+	Resolve(conflict *domain.SyncConflict) error
+}
+
+type PostgresSyncConflictRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresSyncConflictRepository(db *sql.DB) SyncConflictRepository {
+	return &PostgresSyncConflictRepository{db: db}
+}
+
+// syncConflictColumns debe mantenerse en el mismo orden que scanSyncConflict
+const syncConflictColumns = `id, kind, match_id, client_match, status, COALESCE(resolution, ''), created_at, resolved_at`
+
+// scanSyncConflict mapea una fila; la versión del cliente se guarda como JSONB
+func scanSyncConflict(row rowScanner, conflict *domain.SyncConflict) error {
+	var clientMatch []byte
+	err := row.Scan(
+		&conflict.ID,
+		&conflict.Kind,
+		&conflict.MatchID,
+		&clientMatch,
+		&conflict.Status,
+		&conflict.Resolution,
+		&conflict.CreatedAt,
+		&conflict.ResolvedAt,
+	)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(clientMatch, &conflict.ClientMatch)
+}
+
+func (r *PostgresSyncConflictRepository) Create(conflict *domain.SyncConflict) error {
+	clientMatch, err := json.Marshal(conflict.ClientMatch)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO sync_conflicts (id, kind, match_id, client_match, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err = r.db.Exec(query,
+		conflict.ID,
+		conflict.Kind,
+		conflict.MatchID,
+		clientMatch,
+		conflict.Status,
+		conflict.CreatedAt,
+	)
+	return err
+}
+
+func (r *PostgresSyncConflictRepository) GetByID(id uuid.UUID) (*domain.SyncConflict, error) {
+	query := `SELECT ` + syncConflictColumns + ` FROM sync_conflicts WHERE id = $1`
+	var conflict domain.SyncConflict
+	err := scanSyncConflict(r.db.QueryRow(query, id), &conflict)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("sync conflict not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &conflict, nil
+}
+
+func (r *PostgresSyncConflictRepository) GetAll(status string) ([]domain.SyncConflict, error) {
+	query := `
+		SELECT ` + syncConflictColumns + `
+		FROM sync_conflicts
+		WHERE $1 = '' OR status = $1
+		ORDER BY created_at
+	`
+	rows, err := r.db.Query(query, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	conflicts := []domain.SyncConflict{}
+	for rows.Next() {
+		var conflict domain.SyncConflict
+		if err := scanSyncConflict(rows, &conflict); err != nil {
+			return nil, err
+		}
+		conflicts = append(conflicts, conflict)
+	}
+	return conflicts, rows.Err()
+}
+
+// Resolve marca el conflicto como resuelto. Solo afecta conflictos abiertos
+// para que dos organizadores no lo resuelvan a la vez.
+func (r *PostgresSyncConflictRepository) Resolve(conflict *domain.SyncConflict) error {
+	query := `
+		UPDATE sync_conflicts
+		SET status = $2, resolution = $3, resolved_at = $4
+		WHERE id = $1 AND status = $5
+	`
+	result, err := r.db.Exec(query,
+		conflict.ID,
+		conflict.Status,
+		conflict.Resolution,
+		conflict.ResolvedAt,
+		domain.ConflictOpen,
+	)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("sync conflict not found or already resolved")
+	}
+	return nil
+}
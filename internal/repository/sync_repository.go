@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// SyncRepository aplica las operaciones de sincronización por lotes
+type SyncRepository interface {
+	// ApplyMatchOperations aplica las operaciones de un partido en una sola
+	// transacción: se aplican todas o ninguna
+	ApplyMatchOperations(matchID uuid.UUID, ops []domain.SyncOperation) error
+}
+
+type PostgresSyncRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresSyncRepository(db *sql.DB) SyncRepository {
+	return &PostgresSyncRepository{db: db}
+}
+
+// ApplyMatchOperations es idempotente: los eventos y presentes repetidos se
+// ignoran, así una tablet puede reenviar un lote si perdió la respuesta
+func (r *PostgresSyncRepository) ApplyMatchOperations(matchID uuid.UUID, ops []domain.SyncOperation) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, op := range ops {
+		switch op.Type {
+		case domain.SyncOpCreateEvent:
+			err = insertSyncEvent(tx, op.Event)
+		case domain.SyncOpUpdateScore:
+			err = updateSyncScore(tx, matchID, op.Score)
+		case domain.SyncOpCheckIn:
+			err = insertSyncCheckIn(tx, op.CheckIn)
+		default:
+			err = fmt.Errorf("unknown operation type: %s", op.Type)
+		}
+		if err != nil {
+			return fmt.Errorf("operation %s: %w", op.OpID, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func insertSyncEvent(tx *sql.Tx, event *domain.MatchEvent) error {
+	query := `
+		INSERT INTO match_events (id, match_id, type, minute, team_id, player_id, guest_name, assist_player_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (id) DO NOTHING
+	`
+	_, err := tx.Exec(query,
+		event.ID,
+		event.MatchID,
+		event.Type,
+		event.Minute,
+		event.TeamID,
+		event.PlayerID,
+		event.GuestName,
+		event.AssistPlayerID,
+		event.CreatedAt,
+	)
+	return err
+}
+
+func updateSyncScore(tx *sql.Tx, matchID uuid.UUID, score *domain.SyncScore) error {
+	query := `
+		UPDATE matches
+		SET goal_scored_team1 = $2, goal_scored_team2 = $3, updated_at = $4
+		WHERE id = $1
+	`
+	result, err := tx.Exec(query,
+		matchID,
+		score.GoalScoredTeam1,
+		score.GoalScoredTeam2,
+		time.Now().UTC().Truncate(time.Microsecond),
+	)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("match not found")
+	}
+	return nil
+}
+
+func insertSyncCheckIn(tx *sql.Tx, checkIn *domain.CheckIn) error {
+	query := `
+		INSERT INTO match_checkins (match_id, player_id, team_id, checked_in_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (match_id, player_id) DO NOTHING
+	`
+	_, err := tx.Exec(query, checkIn.MatchID, checkIn.PlayerID, checkIn.TeamID, checkIn.CheckedInAt)
+	return err
+}
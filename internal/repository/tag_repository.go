@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+type TagRepository interface {
+	Create(tag *domain.Tag) error
+	GetByID(id uuid.UUID) (*domain.Tag, error)
+	// GetByName busca sin distinguir mayúsculas
+	GetByName(name string) (*domain.Tag, error)
+	GetAll() ([]domain.Tag, error)
+	Update(tag *domain.Tag) error
+	Delete(id uuid.UUID) error
+	// Attach etiqueta la entidad; etiquetarla dos veces no hace nada
+	Attach(tagID uuid.UUID, entity string, entityID uuid.UUID) error
+	Detach(tagID uuid.UUID, entity string, entityID uuid.UUID) error
+	// GetByEntity devuelve las etiquetas de la entidad ordenadas por nombre
+	GetByEntity(entity string, entityID uuid.UUID) ([]domain.Tag, error)
+	// GetTaggedIDs devuelve los IDs de las entidades con la etiqueta
+	GetTaggedIDs(tagID uuid.UUID, entity string) ([]uuid.UUID, error)
+}
+
+type PostgresTagRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresTagRepository(db *sql.DB) TagRepository {
+	return &PostgresTagRepository{db: db}
+}
+
+// tagTables son la tabla de relación y su columna para cada entidad
+var tagTables = map[string]struct{ table, column string }{
+	domain.TagEntityTeam:   {"team_tags", "team_id"},
+	domain.TagEntityPlayer: {"player_tags", "player_id"},
+	domain.TagEntityMatch:  {"match_tags", "match_id"},
+}
+
+func tagTable(entity string) (string, string, error) {
+	t, ok := tagTables[entity]
+	if !ok {
+		return "", "", fmt.Errorf("entity %q cannot be tagged", entity)
+	}
+	return t.table, t.column, nil
+}
+
+// tagColumns debe mantenerse en el mismo orden que scanTag
+const tagColumns = `id, name, color, created_at`
+
+func scanTag(row rowScanner, tag *domain.Tag) error {
+	return row.Scan(
+		&tag.ID,
+		&tag.Name,
+		&tag.Color,
+		&tag.CreatedAt,
+	)
+}
+
+func (r *PostgresTagRepository) Create(tag *domain.Tag) error {
+	query := `
+		INSERT INTO tags (id, name, color, created_at)
+		VALUES ($1, $2, $3, $4)
+	`
+	_, err := r.db.Exec(query, tag.ID, tag.Name, tag.Color, tag.CreatedAt)
+	return err
+}
+
+func (r *PostgresTagRepository) GetByID(id uuid.UUID) (*domain.Tag, error) {
+	query := `SELECT ` + tagColumns + ` FROM tags WHERE id = $1`
+	return r.queryTag(query, id)
+}
+
+func (r *PostgresTagRepository) GetByName(name string) (*domain.Tag, error) {
+	query := `SELECT ` + tagColumns + ` FROM tags WHERE LOWER(name) = LOWER($1)`
+	return r.queryTag(query, strings.TrimSpace(name))
+}
+
+func (r *PostgresTagRepository) queryTag(query string, arg interface{}) (*domain.Tag, error) {
+	var tag domain.Tag
+	err := scanTag(r.db.QueryRow(query, arg), &tag)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("tag not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+func (r *PostgresTagRepository) GetAll() ([]domain.Tag, error) {
+	query := `SELECT ` + tagColumns + ` FROM tags ORDER BY name`
+	return r.queryTags(query)
+}
+
+func (r *PostgresTagRepository) queryTags(query string, args ...interface{}) ([]domain.Tag, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := []domain.Tag{}
+	for rows.Next() {
+		var tag domain.Tag
+		if err := scanTag(rows, &tag); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	return tags, rows.Err()
+}
+
+func (r *PostgresTagRepository) Update(tag *domain.Tag) error {
+	query := `UPDATE tags SET name = $2, color = $3 WHERE id = $1`
+	result, err := r.db.Exec(query, tag.ID, tag.Name, tag.Color)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("tag not found")
+	}
+	return nil
+}
+
+// Delete borra la etiqueta y la quita de todas las entidades
+func (r *PostgresTagRepository) Delete(id uuid.UUID) error {
+	query := `DELETE FROM tags WHERE id = $1`
+	result, err := r.db.Exec(query, id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("tag not found")
+	}
+	return nil
+}
+
+func (r *PostgresTagRepository) Attach(tagID uuid.UUID, entity string, entityID uuid.UUID) error {
+	table, column, err := tagTable(entity)
+	if err != nil {
+		return err
+	}
+	query := `INSERT INTO ` + table + ` (tag_id, ` + column + `) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	_, err = r.db.Exec(query, tagID, entityID)
+	return err
+}
+
+func (r *PostgresTagRepository) Detach(tagID uuid.UUID, entity string, entityID uuid.UUID) error {
+	table, column, err := tagTable(entity)
+	if err != nil {
+		return err
+	}
+	query := `DELETE FROM ` + table + ` WHERE tag_id = $1 AND ` + column + ` = $2`
+	result, err := r.db.Exec(query, tagID, entityID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("%s does not have the tag", entity)
+	}
+	return nil
+}
+
+func (r *PostgresTagRepository) GetByEntity(entity string, entityID uuid.UUID) ([]domain.Tag, error) {
+	table, column, err := tagTable(entity)
+	if err != nil {
+		return nil, err
+	}
+	query := `
+		SELECT t.id, t.name, t.color, t.created_at
+		FROM tags t
+		INNER JOIN ` + table + ` et ON et.tag_id = t.id
+		WHERE et.` + column + ` = $1
+		ORDER BY t.name
+	`
+	return r.queryTags(query, entityID)
+}
+
+func (r *PostgresTagRepository) GetTaggedIDs(tagID uuid.UUID, entity string) ([]uuid.UUID, error) {
+	table, column, err := tagTable(entity)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + column + ` FROM ` + table + ` WHERE tag_id = $1`
+	rows, err := r.db.Query(query, tagID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
@@ -5,45 +5,61 @@ import (
 	"fmt"
 
 	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/fieldcrypt"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type TeamRepository interface {
 	Create(team *domain.Team) error
 	GetByID(id uuid.UUID) (*domain.Team, error)
 	GetAll() ([]domain.Team, error)
+	// GetPage devuelve una página de GetAll junto con el total de equipos
+	GetPage(page domain.Page) (domain.Paged[domain.Team], error)
+	// FindByName busca equipos por nombre sin distinguir mayúsculas
+	FindByName(name string) ([]domain.Team, error)
+	// Search busca equipos por nombre (ver domain.SearchQuery)
+	Search(query domain.SearchQuery) ([]domain.Team, error)
 	Update(team *domain.Team) error
 	Delete(id uuid.UUID) error
-	AddPlayer(teamID, playerID uuid.UUID) error
+	// AddPlayer suma el jugador a la plantilla y registra el pase en la
+	// misma transacción
+	AddPlayer(teamID, playerID uuid.UUID, transfer *domain.Transfer) error
 	RemovePlayer(teamID, playerID uuid.UUID) error
 	GetTeamPlayers(teamID uuid.UUID) ([]domain.Player, error)
+	SetJerseyNumber(teamID, playerID uuid.UUID, number *int) error
+	// SetRoles reemplaza los roles del jugador en el equipo
+	SetRoles(teamID, playerID uuid.UUID, roles []string) error
 }
 
+// PostgresTeamRepository descifra con keyring los datos personales de los
+// jugadores del plantel
 type PostgresTeamRepository struct {
-	db *sql.DB
+	db      *sql.DB
+	keyring *fieldcrypt.Keyring
 }
 
-func NewPostgresTeamRepository(db *sql.DB) TeamRepository {
-	return &PostgresTeamRepository{db: db}
+func NewPostgresTeamRepository(db *sql.DB, keyring *fieldcrypt.Keyring) TeamRepository {
+	return &PostgresTeamRepository{db: db, keyring: keyring}
 }
 
 func (r *PostgresTeamRepository) Create(team *domain.Team) error {
 	query := `
-		INSERT INTO teams (id, name, created_at)
-		VALUES ($1, $2, $3)
+		INSERT INTO teams (id, name, created_at, is_test)
+		VALUES ($1, $2, $3, $4)
 	`
-	_, err := r.db.Exec(query, team.ID, team.Name, team.CreatedAt)
+	_, err := r.db.Exec(query, team.ID, team.Name, team.CreatedAt, team.IsTest)
 	return err
 }
 
 func (r *PostgresTeamRepository) GetByID(id uuid.UUID) (*domain.Team, error) {
 	query := `
-		SELECT id, name, created_at
+		SELECT id, name, created_at, is_test
 		FROM teams
 		WHERE id = $1
 	`
 	var team domain.Team
-	err := r.db.QueryRow(query, id).Scan(&team.ID, &team.Name, &team.CreatedAt)
+	err := r.db.QueryRow(query, id).Scan(&team.ID, &team.Name, &team.CreatedAt, &team.IsTest)
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("team not found")
 	}
@@ -54,14 +70,52 @@ func (r *PostgresTeamRepository) GetByID(id uuid.UUID) (*domain.Team, error) {
 }
 
 func (r *PostgresTeamRepository) GetAll() ([]domain.Team, error) {
-	query := `SELECT id, name, created_at FROM teams ORDER BY created_at DESC`
-	rows, err := r.db.Query(query)
+	return r.queryTeams(`SELECT id, name, created_at, is_test FROM teams ORDER BY created_at DESC`)
+}
+
+func (r *PostgresTeamRepository) GetPage(page domain.Page) (domain.Paged[domain.Team], error) {
+	result := domain.Paged[domain.Team]{Page: page}
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM teams`).Scan(&result.Total); err != nil {
+		return result, err
+	}
+
+	order, err := orderBy(domain.TeamSortFields.Of(page), teamSortColumns)
+	if err != nil {
+		return result, err
+	}
+	query := `SELECT id, name, created_at, is_test FROM teams ` + order + ` LIMIT $1 OFFSET $2`
+	result.Items, err = r.queryTeams(query, page.Limit, page.Offset)
+	return result, err
+}
+
+// queryTeams ejecuta una consulta que devuelve id, name, created_at e is_test
+func (r *PostgresTeamRepository) queryTeams(query string, args ...interface{}) ([]domain.Team, error) {
+	rows, err := r.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
 	var teams []domain.Team
+	for rows.Next() {
+		var team domain.Team
+		if err := rows.Scan(&team.ID, &team.Name, &team.CreatedAt, &team.IsTest); err != nil {
+			return nil, err
+		}
+		teams = append(teams, team)
+	}
+	return teams, rows.Err()
+}
+
+func (r *PostgresTeamRepository) FindByName(name string) ([]domain.Team, error) {
+	query := `SELECT id, name, created_at FROM teams WHERE LOWER(name) = LOWER($1)`
+	rows, err := r.db.Query(query, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	teams := []domain.Team{}
 	for rows.Next() {
 		var team domain.Team
 		if err := rows.Scan(&team.ID, &team.Name, &team.CreatedAt); err != nil {
@@ -72,6 +126,10 @@ func (r *PostgresTeamRepository) GetAll() ([]domain.Team, error) {
 	return teams, rows.Err()
 }
 
+func (r *PostgresTeamRepository) Search(search domain.SearchQuery) ([]domain.Team, error) {
+	return r.queryTeams(`SELECT id, name, created_at, is_test FROM teams WHERE `+searchWhere+` `+searchOrder, searchArgs(search)...)
+}
+
 func (r *PostgresTeamRepository) Update(team *domain.Team) error {
 	query := `UPDATE teams SET name = $2 WHERE id = $1`
 	result, err := r.db.Exec(query, team.ID, team.Name)
@@ -104,10 +162,33 @@ func (r *PostgresTeamRepository) Delete(id uuid.UUID) error {
 	return nil
 }
 
-func (r *PostgresTeamRepository) AddPlayer(teamID, playerID uuid.UUID) error {
-	query := `INSERT INTO team_players (team_id, player_id) VALUES ($1, $2)`
-	_, err := r.db.Exec(query, teamID, playerID)
-	return err
+func (r *PostgresTeamRepository) AddPlayer(teamID, playerID uuid.UUID, transfer *domain.Transfer) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO team_players (team_id, player_id, joined_at) VALUES ($1, $2, $3)`
+	if _, err := tx.Exec(query, teamID, playerID, transfer.TransferredAt); err != nil {
+		return err
+	}
+
+	transferQuery := `
+		INSERT INTO player_transfers (id, player_id, from_team_id, to_team_id, transferred_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	if _, err := tx.Exec(transferQuery,
+		transfer.ID,
+		transfer.PlayerID,
+		transfer.FromTeamID,
+		transfer.ToTeamID,
+		transfer.TransferredAt,
+	); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 func (r *PostgresTeamRepository) RemovePlayer(teamID, playerID uuid.UUID) error {
@@ -118,7 +199,7 @@ func (r *PostgresTeamRepository) RemovePlayer(teamID, playerID uuid.UUID) error
 
 func (r *PostgresTeamRepository) GetTeamPlayers(teamID uuid.UUID) ([]domain.Player, error) {
 	query := `
-		SELECT p.id, p.name, p.date_birth, p.created_at
+		SELECT p.id, p.name, p.date_birth, p.date_birth_encrypted, p.position, p.preferred_foot, p.nationality, p.created_at, tp.jersey_number, tp.roles
 		FROM players p
 		INNER JOIN team_players tp ON p.id = tp.player_id
 		WHERE tp.team_id = $1
@@ -133,10 +214,60 @@ func (r *PostgresTeamRepository) GetTeamPlayers(teamID uuid.UUID) ([]domain.Play
 	var players []domain.Player
 	for rows.Next() {
 		var player domain.Player
-		if err := rows.Scan(&player.ID, &player.Name, &player.DateBirth, &player.CreatedAt); err != nil {
+		var dateBirth sql.NullTime
+		var dateBirthEncrypted sql.NullString
+		if err := rows.Scan(
+			&player.ID,
+			&player.Name,
+			&dateBirth,
+			&dateBirthEncrypted,
+			&player.Position,
+			&player.PreferredFoot,
+			&player.Nationality,
+			&player.CreatedAt,
+			&player.JerseyNumber,
+			pq.Array(&player.Roles),
+		); err != nil {
+			return nil, err
+		}
+		if player.DateBirth, err = decodeDateBirth(r.keyring, dateBirth, dateBirthEncrypted); err != nil {
 			return nil, err
 		}
 		players = append(players, player)
 	}
 	return players, rows.Err()
 }
+
+// SetJerseyNumber asigna (o quita, con nil) el dorsal del jugador en el equipo
+func (r *PostgresTeamRepository) SetJerseyNumber(teamID, playerID uuid.UUID, number *int) error {
+	query := `UPDATE team_players SET jersey_number = $3 WHERE team_id = $1 AND player_id = $2`
+	result, err := r.db.Exec(query, teamID, playerID, number)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("player is not in the team")
+	}
+	return nil
+}
+
+// SetRoles reemplaza los roles del jugador en el equipo
+func (r *PostgresTeamRepository) SetRoles(teamID, playerID uuid.UUID, roles []string) error {
+	query := `UPDATE team_players SET roles = $3 WHERE team_id = $1 AND player_id = $2`
+	result, err := r.db.Exec(query, teamID, playerID, pq.Array(roles))
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("player is not in the team")
+	}
+	return nil
+}
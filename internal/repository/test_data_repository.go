@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+)
+
+// TestDataRepository cuenta y borra las entidades marcadas con is_test
+type TestDataRepository interface {
+	Count() (*domain.PurgeReport, error)
+	// Purge borra los datos de prueba en una transacción; lo que depende de
+	// ellos (partidos, eventos, plantillas...) se borra en cascada
+	Purge() (*domain.PurgeReport, error)
+}
+
+type PostgresTestDataRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresTestDataRepository(db *sql.DB) TestDataRepository {
+	return &PostgresTestDataRepository{db: db}
+}
+
+// countTestDataQuery cuenta los partidos antes de borrar nada, porque
+// después de borrar los torneos ya no se sabe cuáles les pertenecían
+const countTestDataQuery = `
+	SELECT
+		(SELECT COUNT(*) FROM tournaments WHERE is_test),
+		(SELECT COUNT(*) FROM teams WHERE is_test),
+		(SELECT COUNT(*) FROM players WHERE is_test),
+		(SELECT COUNT(*) FROM matches m
+		 WHERE m.tournament_id IN (SELECT id FROM tournaments WHERE is_test)
+		    OR m.team1_id IN (SELECT id FROM teams WHERE is_test)
+		    OR m.team2_id IN (SELECT id FROM teams WHERE is_test))
+`
+
+func (r *PostgresTestDataRepository) Count() (*domain.PurgeReport, error) {
+	return countTestData(r.db.QueryRow(countTestDataQuery))
+}
+
+func (r *PostgresTestDataRepository) Purge() (*domain.PurgeReport, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	report, err := countTestData(tx.QueryRow(countTestDataQuery))
+	if err != nil {
+		return nil, err
+	}
+
+	// Primero los torneos, que arrastran sus partidos, fases y sponsors;
+	// luego los equipos y por último los jugadores
+	for _, query := range []string{
+		`DELETE FROM tournaments WHERE is_test`,
+		`DELETE FROM teams WHERE is_test`,
+		`DELETE FROM players WHERE is_test`,
+	} {
+		if _, err := tx.Exec(query); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func countTestData(row *sql.Row) (*domain.PurgeReport, error) {
+	var report domain.PurgeReport
+	if err := row.Scan(&report.Tournaments, &report.Teams, &report.Players, &report.Matches); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
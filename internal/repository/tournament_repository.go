@@ -3,6 +3,7 @@ package repository
 import (
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
 	"github.com/google/uuid"
@@ -11,12 +12,30 @@ import (
 type TournamentRepository interface {
 	Create(tournament *domain.Tournament) error
 	GetByID(id uuid.UUID) (*domain.Tournament, error)
-	GetAll() ([]domain.Tournament, error)
+	// GetAll lista los torneos activos; con includeArchived también los archivados
+	GetAll(includeArchived bool) ([]domain.Tournament, error)
+	// GetPage devuelve una página de GetAll junto con el total de torneos
+	GetPage(includeArchived bool, page domain.Page) (domain.Paged[domain.Tournament], error)
+	GetBySeason(seasonID uuid.UUID) ([]domain.Tournament, error)
+	// GetChildren lista los torneos de una competición ordenados por el nivel
+	// de su división (los que no tienen división, al final)
+	GetChildren(parentID uuid.UUID) ([]domain.Tournament, error)
+	// FindByName busca torneos por nombre sin distinguir mayúsculas
+	FindByName(name string) ([]domain.Tournament, error)
+	// Search busca torneos por nombre, archivados incluidos (ver
+	// domain.SearchQuery)
+	Search(query domain.SearchQuery) ([]domain.Tournament, error)
 	Update(tournament *domain.Tournament) error
+	UpdateStatus(id uuid.UUID, status string) error
+	// SetArchived archiva (archivedAt no nil) o restaura el torneo junto con
+	// sus partidos
+	SetArchived(id uuid.UUID, archivedAt *time.Time) error
 	Delete(id uuid.UUID) error
 	AddTeam(tournamentID, teamID uuid.UUID) error
 	RemoveTeam(tournamentID, teamID uuid.UUID) error
 	GetTournamentTeams(tournamentID uuid.UUID) ([]domain.Team, error)
+	SetInitialPoints(tournamentID, teamID uuid.UUID, points int) error
+	GetStandings(tournamentID uuid.UUID, maxRound, embargoMinutes int) ([]domain.Standing, error)
 }
 
 type PostgresTournamentRepository struct {
@@ -27,16 +46,47 @@ func NewPostgresTournamentRepository(db *sql.DB) TournamentRepository {
 	return &PostgresTournamentRepository{db: db}
 }
 
+// tournamentColumns debe mantenerse en el mismo orden que scanTournament
+const tournamentColumns = `id, name, parent_tournament_id, season_id, division_id, status, results_delay_minutes, archived_at, created_at, is_test`
+
+func scanTournament(row rowScanner, tournament *domain.Tournament) error {
+	return row.Scan(
+		&tournament.ID,
+		&tournament.Name,
+		&tournament.ParentTournamentID,
+		&tournament.SeasonID,
+		&tournament.DivisionID,
+		&tournament.Status,
+		&tournament.ResultsDelayMinutes,
+		&tournament.ArchivedAt,
+		&tournament.CreatedAt,
+		&tournament.IsTest,
+	)
+}
+
 func (r *PostgresTournamentRepository) Create(tournament *domain.Tournament) error {
-	query := `INSERT INTO tournaments (id, name, created_at) VALUES ($1, $2, $3)`
-	_, err := r.db.Exec(query, tournament.ID, tournament.Name, tournament.CreatedAt)
+	query := `
+		INSERT INTO tournaments (id, name, parent_tournament_id, season_id, division_id, status, results_delay_minutes, created_at, is_test)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	_, err := r.db.Exec(query,
+		tournament.ID,
+		tournament.Name,
+		tournament.ParentTournamentID,
+		tournament.SeasonID,
+		tournament.DivisionID,
+		tournament.Status,
+		tournament.ResultsDelayMinutes,
+		tournament.CreatedAt,
+		tournament.IsTest,
+	)
 	return err
 }
 
 func (r *PostgresTournamentRepository) GetByID(id uuid.UUID) (*domain.Tournament, error) {
-	query := `SELECT id, name, created_at FROM tournaments WHERE id = $1`
+	query := `SELECT ` + tournamentColumns + ` FROM tournaments WHERE id = $1`
 	var tournament domain.Tournament
-	err := r.db.QueryRow(query, id).Scan(&tournament.ID, &tournament.Name, &tournament.CreatedAt)
+	err := scanTournament(r.db.QueryRow(query, id), &tournament)
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("tournament not found")
 	}
@@ -46,9 +96,51 @@ func (r *PostgresTournamentRepository) GetByID(id uuid.UUID) (*domain.Tournament
 	return &tournament, nil
 }
 
-func (r *PostgresTournamentRepository) GetAll() ([]domain.Tournament, error) {
-	query := `SELECT id, name, created_at FROM tournaments ORDER BY created_at DESC`
-	rows, err := r.db.Query(query)
+func (r *PostgresTournamentRepository) GetAll(includeArchived bool) ([]domain.Tournament, error) {
+	query := `SELECT ` + tournamentColumns + ` FROM tournaments WHERE $1 OR archived_at IS NULL ORDER BY created_at DESC`
+	return r.queryTournaments(query, includeArchived)
+}
+
+func (r *PostgresTournamentRepository) GetPage(includeArchived bool, page domain.Page) (domain.Paged[domain.Tournament], error) {
+	result := domain.Paged[domain.Tournament]{Page: page}
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM tournaments WHERE $1 OR archived_at IS NULL`, includeArchived).Scan(&result.Total); err != nil {
+		return result, err
+	}
+
+	order, err := orderBy(domain.TournamentSortFields.Of(page), tournamentSortColumns)
+	if err != nil {
+		return result, err
+	}
+	query := `SELECT ` + tournamentColumns + ` FROM tournaments WHERE $1 OR archived_at IS NULL
+		` + order + ` LIMIT $2 OFFSET $3`
+	result.Items, err = r.queryTournaments(query, includeArchived, page.Limit, page.Offset)
+	return result, err
+}
+
+func (r *PostgresTournamentRepository) GetBySeason(seasonID uuid.UUID) ([]domain.Tournament, error) {
+	query := `SELECT ` + tournamentColumns + ` FROM tournaments WHERE season_id = $1 ORDER BY created_at DESC`
+	return r.queryTournaments(query, seasonID)
+}
+
+func (r *PostgresTournamentRepository) GetChildren(parentID uuid.UUID) ([]domain.Tournament, error) {
+	query := `SELECT ` + tournamentColumns + ` FROM tournaments
+		WHERE parent_tournament_id = $1
+		ORDER BY (SELECT level FROM divisions WHERE divisions.id = tournaments.division_id) NULLS LAST, name`
+	return r.queryTournaments(query, parentID)
+}
+
+func (r *PostgresTournamentRepository) FindByName(name string) ([]domain.Tournament, error) {
+	query := `SELECT ` + tournamentColumns + ` FROM tournaments WHERE LOWER(name) = LOWER($1)`
+	return r.queryTournaments(query, name)
+}
+
+func (r *PostgresTournamentRepository) Search(search domain.SearchQuery) ([]domain.Tournament, error) {
+	return r.queryTournaments(`SELECT `+tournamentColumns+` FROM tournaments WHERE `+searchWhere+` `+searchOrder, searchArgs(search)...)
+}
+
+// queryTournaments ejecuta una consulta que devuelve tournamentColumns
+func (r *PostgresTournamentRepository) queryTournaments(query string, args ...interface{}) ([]domain.Tournament, error) {
+	rows, err := r.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +149,7 @@ func (r *PostgresTournamentRepository) GetAll() ([]domain.Tournament, error) {
 	var tournaments []domain.Tournament
 	for rows.Next() {
 		var t domain.Tournament
-		if err := rows.Scan(&t.ID, &t.Name, &t.CreatedAt); err != nil {
+		if err := scanTournament(rows, &t); err != nil {
 			return nil, err
 		}
 		tournaments = append(tournaments, t)
@@ -66,8 +158,51 @@ func (r *PostgresTournamentRepository) GetAll() ([]domain.Tournament, error) {
 }
 
 func (r *PostgresTournamentRepository) Update(tournament *domain.Tournament) error {
-	query := `UPDATE tournaments SET name = $2 WHERE id = $1`
-	result, err := r.db.Exec(query, tournament.ID, tournament.Name)
+	query := `UPDATE tournaments SET name = $2, results_delay_minutes = $3, season_id = $4, division_id = $5, parent_tournament_id = $6 WHERE id = $1`
+	result, err := r.db.Exec(query, tournament.ID, tournament.Name, tournament.ResultsDelayMinutes, tournament.SeasonID, tournament.DivisionID, tournament.ParentTournamentID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("tournament not found")
+	}
+	return nil
+}
+
+// SetArchived marca el torneo y sus partidos en una transacción. Los
+// partidos llevan su propia marca para que los listados usen los índices
+// parciales de partidos activos sin cruzar con tournaments.
+func (r *PostgresTournamentRepository) SetArchived(id uuid.UUID, archivedAt *time.Time) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`UPDATE tournaments SET archived_at = $2 WHERE id = $1`, id, archivedAt)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("tournament not found")
+	}
+
+	if _, err := tx.Exec(`UPDATE matches SET archived = $2 WHERE tournament_id = $1`, id, archivedAt != nil); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *PostgresTournamentRepository) UpdateStatus(id uuid.UUID, status string) error {
+	result, err := r.db.Exec(`UPDATE tournaments SET status = $2 WHERE id = $1`, id, status)
 	if err != nil {
 		return err
 	}
@@ -133,3 +268,89 @@ func (r *PostgresTournamentRepository) GetTournamentTeams(tournamentID uuid.UUID
 	}
 	return teams, rows.Err()
 }
+
+func (r *PostgresTournamentRepository) SetInitialPoints(tournamentID, teamID uuid.UUID, points int) error {
+	query := `UPDATE tournament_teams SET initial_points = $3 WHERE tournament_id = $1 AND team_id = $2`
+	result, err := r.db.Exec(query, tournamentID, teamID, points)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("team is not registered in the tournament")
+	}
+	return nil
+}
+
+// GetStandings agrega los partidos del torneo con resultado definitivo por
+// equipo (la regla de domain.Match.ResultCountsAt): confirmados y, con
+// embargoMinutes > 0, con el embargo vencido; un walkover cuenta desde que se
+// adjudica. Un partido que ya empezó pero no tiene el resultado confirmado no
+// suma. Con maxRound > 0 solo se consideran las jornadas hasta esa. Los
+// mini-juegos no cuentan porque su resultado ya está agregado en el partido
+// padre. Se restan las quitas de puntos vigentes (las de una jornada
+// posterior a maxRound no).
+func (r *PostgresTournamentRepository) GetStandings(tournamentID uuid.UUID, maxRound, embargoMinutes int) ([]domain.Standing, error) {
+	query := `
+		SELECT t.id, t.name, tt.initial_points,
+		       COALESCE((SELECT SUM(s.points) FROM sanctions s
+		                 WHERE s.tournament_id = tt.tournament_id AND s.team_id = t.id
+		                   AND s.type = $4 AND s.revoked_at IS NULL
+		                   AND ($2 = 0 OR s.round <= $2)), 0) AS deducted_points,
+		       COUNT(m.id) AS played,
+		       COUNT(m.id) FILTER (WHERE (m.team1_id = t.id AND m.goal_scored_team1 > m.goal_scored_team2)
+		                              OR (m.team2_id = t.id AND m.goal_scored_team2 > m.goal_scored_team1)) AS won,
+		       COUNT(m.id) FILTER (WHERE m.goal_scored_team1 = m.goal_scored_team2) AS drawn,
+		       COUNT(m.id) FILTER (WHERE (m.team1_id = t.id AND m.goal_scored_team1 < m.goal_scored_team2)
+		                              OR (m.team2_id = t.id AND m.goal_scored_team2 < m.goal_scored_team1)) AS lost,
+		       COALESCE(SUM(CASE WHEN m.team1_id = t.id THEN m.goal_scored_team1 ELSE m.goal_scored_team2 END), 0) AS goals_for,
+		       COALESCE(SUM(CASE WHEN m.team1_id = t.id THEN m.goal_scored_team2 ELSE m.goal_scored_team1 END), 0) AS goals_against
+		FROM tournament_teams tt
+		INNER JOIN teams t ON t.id = tt.team_id
+		LEFT JOIN matches m ON m.tournament_id = tt.tournament_id
+		                   AND m.parent_match_id IS NULL
+		                   AND NOT m.is_friendly
+		                   AND (m.team1_id = t.id OR m.team2_id = t.id)
+		                   AND (m.result_type = $3
+		                        OR (m.result_confirmed_at IS NOT NULL AND m.date + make_interval(mins => $5) <= NOW()))
+		                   AND ($2 = 0 OR m.round <= $2)
+		WHERE tt.tournament_id = $1
+		GROUP BY t.id, t.name, tt.initial_points
+	`
+	rows, err := r.db.Query(query, tournamentID, maxRound, domain.ResultForfeit, domain.SanctionPointDeduction, embargoMinutes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var standings []domain.Standing
+	for rows.Next() {
+		var s domain.Standing
+		if err := rows.Scan(
+			&s.TeamID,
+			&s.TeamName,
+			&s.CarriedPoints,
+			&s.DeductedPoints,
+			&s.Played,
+			&s.Won,
+			&s.Drawn,
+			&s.Lost,
+			&s.GoalsFor,
+			&s.GoalsAgainst,
+		); err != nil {
+			return nil, err
+		}
+		s.GoalDifference = s.GoalsFor - s.GoalsAgainst
+		s.Points = s.CarriedPoints + s.Won*domain.PointsWin + s.Drawn*domain.PointsDraw - s.DeductedPoints
+		standings = append(standings, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	domain.SortStandings(standings)
+	return standings, nil
+}
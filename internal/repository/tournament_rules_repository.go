@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+type TournamentRulesRepository interface {
+	// Get devuelve las reglas por defecto si el torneo no configuró ninguna
+	Get(tournamentID uuid.UUID) (*domain.TournamentRules, error)
+	// Save crea o reemplaza las reglas del torneo
+	Save(rules *domain.TournamentRules) error
+	// GetByTeam devuelve las reglas configuradas de los torneos en curso
+	// (no terminados, cancelados ni archivados) en los que juega el equipo
+	GetByTeam(teamID uuid.UUID) ([]domain.TournamentRules, error)
+}
+
+type PostgresTournamentRulesRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresTournamentRulesRepository(db *sql.DB) TournamentRulesRepository {
+	return &PostgresTournamentRulesRepository{db: db}
+}
+
+// tournamentRulesColumns debe mantenerse en el mismo orden que scanTournamentRules
+const tournamentRulesColumns = `tournament_id, min_squad_size, max_squad_size, max_foreign_players, country, match_duration_minutes, knockout_tiebreak, registration_fee_amount, registration_fee_currency,
+	attendance_max_spectators, attendance_closed_doors, attendance_reason, updated_at`
+
+func scanTournamentRules(row rowScanner, rules *domain.TournamentRules) error {
+	var fee nullMoney
+	err := row.Scan(
+		&rules.TournamentID,
+		&rules.MinSquadSize,
+		&rules.MaxSquadSize,
+		&rules.MaxForeignPlayers,
+		&rules.Country,
+		&rules.MatchDuration,
+		&rules.KnockoutTiebreak,
+		&fee.Amount,
+		&fee.Currency,
+		&rules.Attendance.MaxSpectators,
+		&rules.Attendance.ClosedDoors,
+		&rules.Attendance.Reason,
+		&rules.UpdatedAt,
+	)
+	rules.RegistrationFee = fee.Money()
+	return err
+}
+
+func (r *PostgresTournamentRulesRepository) Get(tournamentID uuid.UUID) (*domain.TournamentRules, error) {
+	query := `SELECT ` + tournamentRulesColumns + ` FROM tournament_rules WHERE tournament_id = $1`
+	var rules domain.TournamentRules
+	err := scanTournamentRules(r.db.QueryRow(query, tournamentID), &rules)
+	if err == sql.ErrNoRows {
+		return domain.NewTournamentRules(tournamentID), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+func (r *PostgresTournamentRulesRepository) Save(rules *domain.TournamentRules) error {
+	query := `
+		INSERT INTO tournament_rules (` + tournamentRulesColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (tournament_id) DO UPDATE
+		SET min_squad_size = EXCLUDED.min_squad_size,
+		    max_squad_size = EXCLUDED.max_squad_size,
+		    max_foreign_players = EXCLUDED.max_foreign_players,
+		    country = EXCLUDED.country,
+		    match_duration_minutes = EXCLUDED.match_duration_minutes,
+		    knockout_tiebreak = EXCLUDED.knockout_tiebreak,
+		    registration_fee_amount = EXCLUDED.registration_fee_amount,
+		    registration_fee_currency = EXCLUDED.registration_fee_currency,
+		    attendance_max_spectators = EXCLUDED.attendance_max_spectators,
+		    attendance_closed_doors = EXCLUDED.attendance_closed_doors,
+		    attendance_reason = EXCLUDED.attendance_reason,
+		    updated_at = EXCLUDED.updated_at
+	`
+	feeAmount, feeCurrency := moneyArgs(rules.RegistrationFee)
+	_, err := r.db.Exec(query,
+		rules.TournamentID,
+		rules.MinSquadSize,
+		rules.MaxSquadSize,
+		rules.MaxForeignPlayers,
+		rules.Country,
+		rules.MatchDuration,
+		rules.KnockoutTiebreak,
+		feeAmount,
+		feeCurrency,
+		rules.Attendance.MaxSpectators,
+		rules.Attendance.ClosedDoors,
+		rules.Attendance.Reason,
+		rules.UpdatedAt,
+	)
+	return err
+}
+
+func (r *PostgresTournamentRulesRepository) GetByTeam(teamID uuid.UUID) ([]domain.TournamentRules, error) {
+	query := `
+		SELECT r.tournament_id, r.min_squad_size, r.max_squad_size, r.max_foreign_players, r.country, r.match_duration_minutes, r.knockout_tiebreak,
+		       r.registration_fee_amount, r.registration_fee_currency,
+		       r.attendance_max_spectators, r.attendance_closed_doors, r.attendance_reason, r.updated_at
+		FROM tournament_rules r
+		INNER JOIN tournament_teams tt ON tt.tournament_id = r.tournament_id
+		INNER JOIN tournaments t ON t.id = r.tournament_id
+		WHERE tt.team_id = $1
+		  AND t.status NOT IN ($2, $3)
+		  AND t.archived_at IS NULL
+	`
+	rows, err := r.db.Query(query, teamID, domain.TournamentCompleted, domain.TournamentCancelled)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	all := []domain.TournamentRules{}
+	for rows.Next() {
+		var rules domain.TournamentRules
+		if err := scanTournamentRules(rows, &rules); err != nil {
+			return nil, err
+		}
+		all = append(all, rules)
+	}
+	return all, rows.Err()
+}
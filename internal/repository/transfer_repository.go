@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// TransferRepository lee el historial de pases. Los pases se crean junto con
+// el alta en la plantilla (TeamRepository.AddPlayer).
+type TransferRepository interface {
+	// GetByPlayer devuelve los pases del más antiguo al más reciente
+	GetByPlayer(playerID uuid.UUID) ([]domain.Transfer, error)
+}
+
+type PostgresTransferRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresTransferRepository(db *sql.DB) TransferRepository {
+	return &PostgresTransferRepository{db: db}
+}
+
+func (r *PostgresTransferRepository) GetByPlayer(playerID uuid.UUID) ([]domain.Transfer, error) {
+	query := `
+		SELECT pt.id, pt.player_id, pt.from_team_id, COALESCE(f.name, ''), pt.to_team_id, t.name, pt.transferred_at
+		FROM player_transfers pt
+		JOIN teams t ON t.id = pt.to_team_id
+		LEFT JOIN teams f ON f.id = pt.from_team_id
+		WHERE pt.player_id = $1
+		ORDER BY pt.transferred_at, pt.id
+	`
+	rows, err := r.db.Query(query, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	transfers := []domain.Transfer{}
+	for rows.Next() {
+		var transfer domain.Transfer
+		if err := rows.Scan(
+			&transfer.ID,
+			&transfer.PlayerID,
+			&transfer.FromTeamID,
+			&transfer.FromTeamName,
+			&transfer.ToTeamID,
+			&transfer.ToTeamName,
+			&transfer.TransferredAt,
+		); err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, transfer)
+	}
+	return transfers, rows.Err()
+}
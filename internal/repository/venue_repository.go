@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+type VenueRepository interface {
+	Create(venue *domain.Venue) error
+	GetByID(id uuid.UUID) (*domain.Venue, error)
+	GetAll() ([]domain.Venue, error)
+	Update(venue *domain.Venue) error
+	Delete(id uuid.UUID) error
+}
+
+type PostgresVenueRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresVenueRepository(db *sql.DB) VenueRepository {
+	return &PostgresVenueRepository{db: db}
+}
+
+// venueColumns debe mantenerse en el mismo orden que scanVenue
+const venueColumns = `id, name, address, capacity, attendance_max_spectators, attendance_closed_doors, attendance_reason, created_at`
+
+func scanVenue(row rowScanner, venue *domain.Venue) error {
+	return row.Scan(
+		&venue.ID,
+		&venue.Name,
+		&venue.Address,
+		&venue.Capacity,
+		&venue.Attendance.MaxSpectators,
+		&venue.Attendance.ClosedDoors,
+		&venue.Attendance.Reason,
+		&venue.CreatedAt,
+	)
+}
+
+func (r *PostgresVenueRepository) Create(venue *domain.Venue) error {
+	query := `
+		INSERT INTO venues (` + venueColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	_, err := r.db.Exec(query, venue.ID, venue.Name, venue.Address, venue.Capacity,
+		venue.Attendance.MaxSpectators, venue.Attendance.ClosedDoors, venue.Attendance.Reason, venue.CreatedAt)
+	return err
+}
+
+func (r *PostgresVenueRepository) GetByID(id uuid.UUID) (*domain.Venue, error) {
+	query := `SELECT ` + venueColumns + ` FROM venues WHERE id = $1`
+	var venue domain.Venue
+	err := scanVenue(r.db.QueryRow(query, id), &venue)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("venue not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &venue, nil
+}
+
+func (r *PostgresVenueRepository) GetAll() ([]domain.Venue, error) {
+	query := `SELECT ` + venueColumns + ` FROM venues ORDER BY name`
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	venues := []domain.Venue{}
+	for rows.Next() {
+		var venue domain.Venue
+		if err := scanVenue(rows, &venue); err != nil {
+			return nil, err
+		}
+		venues = append(venues, venue)
+	}
+	return venues, rows.Err()
+}
+
+func (r *PostgresVenueRepository) Update(venue *domain.Venue) error {
+	query := `
+		UPDATE venues
+		SET name = $2, address = $3, capacity = $4,
+		    attendance_max_spectators = $5, attendance_closed_doors = $6, attendance_reason = $7
+		WHERE id = $1
+	`
+	result, err := r.db.Exec(query, venue.ID, venue.Name, venue.Address, venue.Capacity,
+		venue.Attendance.MaxSpectators, venue.Attendance.ClosedDoors, venue.Attendance.Reason)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("venue not found")
+	}
+	return nil
+}
+
+// Delete borra la sede; los partidos que la usaban quedan sin sede
+func (r *PostgresVenueRepository) Delete(id uuid.UUID) error {
+	query := `DELETE FROM venues WHERE id = $1`
+	result, err := r.db.Exec(query, id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("venue not found")
+	}
+	return nil
+}
@@ -0,0 +1,354 @@
+// Package stress genera un set de datos grande (por defecto 50 torneos, 1000
+// equipos, 20.000 jugadores y 200.000 partidos con eventos) a través de los
+// repositorios, para probar paginación, índices y caches con volúmenes de
+// producción. Todo se crea con IsTest, así que la purga de datos de prueba
+// lo borra.
+package stress
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// Size es el volumen a generar
+type Size struct {
+	Tournaments int `json:"tournaments"`
+	Teams       int `json:"teams"`
+	Players     int `json:"players"`
+	Matches     int `json:"matches"`
+}
+
+// FullSize es el volumen completo del set de estrés
+var FullSize = Size{Tournaments: 50, Teams: 1000, Players: 20000, Matches: 200000}
+
+// Scale reduce (o agranda) el volumen manteniendo las proporciones; útil
+// para correr el mismo set en una notebook o en CI
+func (s Size) Scale(factor float64) Size {
+	scale := func(n, min int) int {
+		return max(int(float64(n)*factor), min)
+	}
+	scaled := Size{Tournaments: scale(s.Tournaments, 1)}
+	scaled.Teams = scale(s.Teams, 2*scaled.Tournaments)
+	scaled.Players = scale(s.Players, scaled.Teams)
+	scaled.Matches = scale(s.Matches, scaled.Tournaments)
+	return scaled
+}
+
+// Validate comprueba que cada torneo tenga al menos dos equipos y cada
+// equipo al menos un jugador
+func (s Size) Validate() error {
+	if s.Tournaments < 1 {
+		return fmt.Errorf("at least one tournament is required")
+	}
+	if s.Teams < 2*s.Tournaments {
+		return fmt.Errorf("each tournament needs at least two teams")
+	}
+	if s.Players < s.Teams {
+		return fmt.Errorf("each team needs at least one player")
+	}
+	if s.Matches < 0 {
+		return fmt.Errorf("matches cannot be negative")
+	}
+	return nil
+}
+
+// Repositories son los repositorios donde se escriben los datos
+type Repositories struct {
+	Players     repository.PlayerRepository
+	Teams       repository.TeamRepository
+	Tournaments repository.TournamentRepository
+	Matches     repository.MatchRepository
+	Events      repository.MatchEventRepository
+}
+
+// Summary cuenta lo generado
+type Summary struct {
+	Tag     string        `json:"tag"`
+	Size    Size          `json:"size"`
+	Events  int64         `json:"events"`
+	Elapsed time.Duration `json:"elapsed"`
+}
+
+// Etapas que informa Progress
+const (
+	StageTournaments = "tournaments"
+	StageTeams       = "teams"
+	StagePlayers     = "players"
+	StageMatches     = "matches"
+)
+
+// playedShare es la parte de las jornadas de cada torneo que ya se jugó; el
+// resto queda en el futuro para que haya partidos pendientes
+const playedShare = 0.9
+
+// Generator escribe el set de datos con varios workers en paralelo
+type Generator struct {
+	repos   Repositories
+	size    Size
+	workers int
+	seed    int64
+	now     time.Time
+	// tag distingue los nombres de cada corrida (los nombres de equipos y
+	// torneos son únicos)
+	tag string
+
+	// Progress, si no es nil, recibe el avance de cada etapa. Se llama desde
+	// los workers, así que debe ser seguro en concurrencia.
+	Progress func(stage string, done, total int)
+}
+
+// NewGenerator crea el generador; con la misma seed se repiten los planteles
+// y los resultados (las fechas dependen del día en que se corre)
+func NewGenerator(repos Repositories, size Size, workers int, seed int64) *Generator {
+	return &Generator{
+		repos:   repos,
+		size:    size,
+		workers: max(workers, 1),
+		seed:    seed,
+		now:     time.Now().UTC().Truncate(time.Hour),
+		tag:     uuid.NewString()[:8],
+	}
+}
+
+// stressTournament es un torneo generado con sus equipos y planteles
+type stressTournament struct {
+	tournament *domain.Tournament
+	teams      []stressTeam
+}
+
+type stressTeam struct {
+	team    *domain.Team
+	players []uuid.UUID
+}
+
+// Run genera el set completo. Si una escritura falla se detiene y devuelve
+// el error; lo ya escrito queda marcado como datos de prueba.
+func (g *Generator) Run() (*Summary, error) {
+	if err := g.size.Validate(); err != nil {
+		return nil, err
+	}
+	started := time.Now()
+
+	tournaments := make([]stressTournament, g.size.Tournaments)
+	err := g.parallel(StageTournaments, len(tournaments), func(i int, _ *rand.Rand) error {
+		tournament := domain.NewTournament(fmt.Sprintf("Stress %s Tournament %02d", g.tag, i+1))
+		tournament.Status = domain.TournamentInProgress
+		tournament.IsTest = true
+		if err := g.repos.Tournaments.Create(tournament); err != nil {
+			return fmt.Errorf("create tournament: %w", err)
+		}
+		tournaments[i].tournament = tournament
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Los equipos se reparten entre los torneos en orden: el equipo i juega
+	// en el torneo i % Tournaments
+	teams := make([]stressTeam, g.size.Teams)
+	err = g.parallel(StageTeams, len(teams), func(i int, _ *rand.Rand) error {
+		team := domain.NewTeam(fmt.Sprintf("Stress %s Team %04d", g.tag, i+1))
+		team.IsTest = true
+		if err := g.repos.Teams.Create(team); err != nil {
+			return fmt.Errorf("create team: %w", err)
+		}
+		if err := g.repos.Tournaments.AddTeam(tournaments[i%len(tournaments)].tournament.ID, team.ID); err != nil {
+			return fmt.Errorf("add team to tournament: %w", err)
+		}
+		teams[i].team = team
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Los jugadores se reparten entre los equipos de a bloques
+	var players atomic.Int64
+	err = g.parallel(StagePlayers, len(teams), func(i int, random *rand.Rand) error {
+		from, to := i*g.size.Players/len(teams), (i+1)*g.size.Players/len(teams)
+		for n := from; n < to; n++ {
+			player := domain.NewPlayer(fmt.Sprintf("Stress Player %05d", n+1), time.Date(1985+random.Intn(20), time.Month(1+random.Intn(12)), 1+random.Intn(28), 0, 0, 0, 0, time.UTC))
+			player.Position = []string{domain.PositionGoalkeeper, domain.PositionDefender, domain.PositionMidfielder, domain.PositionForward}[random.Intn(4)]
+			player.IsTest = true
+			if err := g.repos.Players.Create(player); err != nil {
+				return fmt.Errorf("create player: %w", err)
+			}
+			if err := g.repos.Teams.AddPlayer(teams[i].team.ID, player.ID, domain.NewTransfer(player.ID, nil, teams[i].team.ID)); err != nil {
+				return fmt.Errorf("add player to team: %w", err)
+			}
+			teams[i].players = append(teams[i].players, player.ID)
+		}
+		players.Add(int64(to - from))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, team := range teams {
+		tournaments[i%len(tournaments)].teams = append(tournaments[i%len(tournaments)].teams, team)
+	}
+
+	var matches, events atomic.Int64
+	err = g.parallel(StageMatches, len(tournaments), func(i int, random *rand.Rand) error {
+		count := g.size.Matches / len(tournaments)
+		if i < g.size.Matches%len(tournaments) {
+			count++
+		}
+		created, err := g.generateMatches(tournaments[i], count, random, &events)
+		matches.Add(int64(created))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Summary{
+		Tag: g.tag,
+		Size: Size{
+			Tournaments: len(tournaments),
+			Teams:       len(teams),
+			Players:     int(players.Load()),
+			Matches:     int(matches.Load()),
+		},
+		Events:  events.Load(),
+		Elapsed: time.Since(started),
+	}, nil
+}
+
+// generateMatches arma jornadas de todos contra todos (método del círculo)
+// hasta llegar a count partidos, una jornada por día. Las primeras
+// playedShare jornadas tienen resultado y un evento por gol más una
+// amonestación; las demás quedan programadas.
+func (g *Generator) generateMatches(t stressTournament, count int, random *rand.Rand, events *atomic.Int64) (int, error) {
+	order := make([]int, len(t.teams))
+	for i := range order {
+		order[i] = i
+	}
+	// Con cantidad impar un lugar queda libre en cada jornada
+	if len(order)%2 == 1 {
+		order = append(order, -1)
+	}
+
+	perRound := len(t.teams) / 2
+	rounds := (count + perRound - 1) / perRound
+	played := int(float64(rounds) * playedShare)
+	first := g.now.AddDate(0, 0, -played)
+
+	created := 0
+	for round := 1; created < count; round++ {
+		date := time.Date(first.Year(), first.Month(), first.Day(), 16, 0, 0, 0, time.UTC).AddDate(0, 0, round-1)
+		for i := 0; i < len(order)/2 && created < count; i++ {
+			home, away := order[i], order[len(order)-1-i]
+			if home < 0 || away < 0 {
+				continue
+			}
+			if round%2 == 0 {
+				home, away = away, home
+			}
+
+			goals1, goals2 := 0, 0
+			if round <= played {
+				goals1, goals2 = random.Intn(5), random.Intn(4)
+			}
+			created++
+			match := domain.NewMatch(created, date, t.teams[home].team.ID, t.teams[away].team.ID, goals1, goals2)
+			match.TournamentID = &t.tournament.ID
+			match.Round = round
+			if round <= played {
+				match.ConfirmResult(date)
+			}
+			if err := g.repos.Matches.Create(match); err != nil {
+				return created - 1, fmt.Errorf("create match: %w", err)
+			}
+			if round > played {
+				continue
+			}
+
+			n, err := g.generateEvents(match, t.teams[home], t.teams[away], random)
+			events.Add(int64(n))
+			if err != nil {
+				return created, err
+			}
+		}
+		// Rotar todos menos el primero
+		last := order[len(order)-1]
+		copy(order[2:], order[1:len(order)-1])
+		order[1] = last
+	}
+	return created, nil
+}
+
+func (g *Generator) generateEvents(match *domain.Match, home, away stressTeam, random *rand.Rand) (int, error) {
+	created := 0
+	create := func(eventType string, team stressTeam) error {
+		player := team.players[random.Intn(len(team.players))]
+		event := domain.NewMatchEvent(match.ID, eventType, 1+random.Intn(90), team.team.ID, &player)
+		if err := g.repos.Events.Create(event); err != nil {
+			return fmt.Errorf("create match event: %w", err)
+		}
+		created++
+		return nil
+	}
+
+	for i := 0; i < match.GoalScoredTeam1; i++ {
+		if err := create(domain.EventGoal, home); err != nil {
+			return created, err
+		}
+	}
+	for i := 0; i < match.GoalScoredTeam2; i++ {
+		if err := create(domain.EventGoal, away); err != nil {
+			return created, err
+		}
+	}
+	return created, create(domain.EventYellowCard, []stressTeam{home, away}[random.Intn(2)])
+}
+
+// parallel ejecuta fn para 0..n-1 repartido entre los workers. Cada índice
+// tiene su propio generador aleatorio, así el resultado no depende del orden
+// en que los workers toman el trabajo. Al primer error dejan de tomarlo.
+func (g *Generator) parallel(stage string, n int, fn func(i int, random *rand.Rand) error) error {
+	indexes := make(chan int)
+	var done atomic.Int64
+	var failed atomic.Bool
+	var once sync.Once
+	var firstErr error
+	var wg sync.WaitGroup
+
+	for w := 0; w < g.workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if failed.Load() {
+					continue
+				}
+				if err := fn(i, rand.New(rand.NewSource(g.seed+int64(i)))); err != nil {
+					failed.Store(true)
+					once.Do(func() { firstErr = err })
+					continue
+				}
+				if g.Progress != nil {
+					g.Progress(stage, int(done.Add(1)), n)
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		if failed.Load() {
+			break
+		}
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return firstErr
+}
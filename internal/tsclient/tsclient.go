@@ -0,0 +1,364 @@
+// Package tsclient genera el cliente de TypeScript de la API a partir del
+// documento OpenAPI: un tipo por cada esquema de components/schemas y un
+// método por operación, tipado con los DTOs que declara la tabla de rutas.
+// Lo ejecuta `api ts-client`; la salida vive en clients/typescript y se
+// publica como paquete npm. En C# sería lo que hace NSwag con un spec.
+package tsclient
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/handler"
+)
+
+// Rutas de los archivos generados dentro del paquete
+const (
+	TypesFile  = "src/types.ts"
+	ClientFile = "src/client.ts"
+)
+
+const header = "// Generado por `api ts-client` a partir de /openapi.json. No editar a mano:\n" +
+	"// los cambios se pierden al regenerar.\n\n"
+
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// reservedNames los declara client.ts o los usa de TypeScript; un esquema
+// con ese nombre los taparía
+var reservedNames = map[string]bool{
+	"ApiClient": true, "ApiError": true, "ClientOptions": true, "Query": true,
+	"BodyInit": true, "Promise": true, "Record": true, "Response": true,
+}
+
+// Generate devuelve el contenido de TypesFile y ClientFile
+func Generate(doc handler.OpenAPIDocument) (map[string][]byte, error) {
+	g := &generator{doc: doc, names: make(map[string]string)}
+	if err := g.nameSchemas(); err != nil {
+		return nil, err
+	}
+	client, err := g.client()
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{TypesFile: g.types(), ClientFile: client}, nil
+}
+
+type generator struct {
+	doc handler.OpenAPIDocument
+	// names es el nombre de TypeScript de cada esquema
+	names map[string]string
+	// used junta los tipos que nombra client.ts, para importarlos
+	used map[string]bool
+}
+
+// nameSchemas pasa los nombres de los esquemas a PascalCase (matchInput →
+// MatchInput, Paged_Team → PagedTeam) y falla si dos quedan iguales
+func (g *generator) nameSchemas() error {
+	taken := make(map[string]string)
+	for name := range g.doc.Components.Schemas {
+		tsName := pascalCase(name)
+		if reservedNames[tsName] {
+			return fmt.Errorf("schema %q maps to reserved TypeScript name %s", name, tsName)
+		}
+		if other, ok := taken[tsName]; ok {
+			return fmt.Errorf("schemas %q and %q both map to TypeScript type %s", other, name, tsName)
+		}
+		taken[tsName] = name
+		g.names[name] = tsName
+	}
+	return nil
+}
+
+func (g *generator) types() []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+
+	names := make([]string, 0, len(g.doc.Components.Schemas))
+	for name := range g.doc.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return g.names[names[i]] < g.names[names[j]] })
+
+	for i, name := range names {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		schema := g.doc.Components.Schemas[name]
+		if schema.Type == "object" && schema.Properties != nil && schema.AdditionalProperties == nil {
+			fmt.Fprintf(&b, "export interface %s {\n", g.names[name])
+			g.writeProperties(&b, schema, "  ")
+			b.WriteString("}\n")
+			continue
+		}
+		fmt.Fprintf(&b, "export type %s = %s;\n", g.names[name], g.tsType(schema))
+	}
+	return b.Bytes()
+}
+
+// writeProperties escribe los campos en orden alfabético; los que no son
+// requeridos quedan opcionales
+func (g *generator) writeProperties(b *bytes.Buffer, schema *handler.OpenAPISchema, indent string) {
+	required := make(map[string]bool, len(schema.Required))
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+	keys := make([]string, 0, len(schema.Properties))
+	for key := range schema.Properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		name := key
+		if !identifierPattern.MatchString(name) {
+			name = fmt.Sprintf("%q", name)
+		}
+		optional := "?"
+		if required[key] {
+			optional = ""
+		}
+		fmt.Fprintf(b, "%s%s%s: %s;\n", indent, name, optional, g.tsType(schema.Properties[key]))
+	}
+}
+
+// tsType es el tipo de TypeScript del esquema; los nullable suman | null
+func (g *generator) tsType(schema *handler.OpenAPISchema) string {
+	if schema == nil {
+		return "unknown"
+	}
+	if schema.Ref != "" {
+		name := g.names[strings.TrimPrefix(schema.Ref, "#/components/schemas/")]
+		if g.used != nil {
+			g.used[name] = true
+		}
+		return name
+	}
+
+	var t string
+	switch schema.Type {
+	case "string":
+		t = "string"
+	case "integer", "number":
+		t = "number"
+	case "boolean":
+		t = "boolean"
+	case "array":
+		item := g.tsType(schema.Items)
+		if schema.Items != nil && schema.Items.Ref == "" && schema.Items.Nullable {
+			item = "(" + item + ")"
+		}
+		t = item + "[]"
+	case "object":
+		switch {
+		case schema.AdditionalProperties != nil:
+			t = "Record<string, " + g.tsType(schema.AdditionalProperties) + ">"
+		case len(schema.Properties) > 0:
+			var b bytes.Buffer
+			b.WriteString("{\n")
+			g.writeProperties(&b, schema, "  ")
+			b.WriteString("}")
+			t = strings.ReplaceAll(b.String(), "\n  ", " ")
+			t = strings.ReplaceAll(t, "\n", " ")
+		default:
+			t = "Record<string, unknown>"
+		}
+	default:
+		return "unknown"
+	}
+	if schema.Nullable {
+		t += " | null"
+	}
+	return t
+}
+
+// operation es una ruta del documento con su método
+type operation struct {
+	method string
+	path   string
+	handler.OpenAPIOperation
+}
+
+func (g *generator) client() ([]byte, error) {
+	var operations []operation
+	for path, methods := range g.doc.Paths {
+		for method, op := range methods {
+			operations = append(operations, operation{method: strings.ToUpper(method), path: path, OpenAPIOperation: op})
+		}
+	}
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].OperationID < operations[j].OperationID
+	})
+
+	g.used = map[string]bool{"ErrorResponse": true}
+	defer func() { g.used = nil }()
+	var methods bytes.Buffer
+	seen := make(map[string]bool)
+	for _, op := range operations {
+		if op.OperationID == "" || seen[op.OperationID] {
+			return nil, fmt.Errorf("%s %s needs a unique operationId", op.method, op.path)
+		}
+		seen[op.OperationID] = true
+		methods.WriteString("\n")
+		g.writeMethod(&methods, op)
+	}
+
+	imports := make([]string, 0, len(g.used))
+	for name := range g.used {
+		imports = append(imports, name)
+	}
+	sort.Strings(imports)
+
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("import type {\n")
+	for _, name := range imports {
+		fmt.Fprintf(&b, "  %s,\n", name)
+	}
+	b.WriteString("} from \"./types\";\n\n")
+	b.WriteString(clientRuntime)
+	b.Write(methods.Bytes())
+	b.WriteString("}\n")
+	return b.Bytes(), nil
+}
+
+// writeMethod escribe el método de una operación. Los parámetros de ruta van
+// primero, después el cuerpo y al final la query y la Idempotency-Key. Sin
+// esquema de respuesta (streams, planillas) devuelve el Response de fetch.
+func (g *generator) writeMethod(b *bytes.Buffer, op operation) {
+	var params, pathArgs []string
+	idempotent := false
+	for _, param := range op.Parameters {
+		switch param.In {
+		case "path":
+			params = append(params, param.Name+": string")
+			pathArgs = append(pathArgs, param.Name)
+		case "header":
+			idempotent = idempotent || param.Name == "Idempotency-Key"
+		}
+	}
+
+	body := "undefined"
+	if op.RequestBody != nil {
+		params = append(params, "body: "+g.tsType(op.RequestBody.Content["application/json"].Schema))
+		body = "body"
+	} else if op.method != "GET" && op.method != "DELETE" {
+		params = append(params, "body?: BodyInit")
+		body = "body"
+	}
+	params = append(params, "query?: Query")
+	headers := ""
+	if idempotent {
+		params = append(params, "idempotencyKey?: string")
+		headers = ", idempotencyKey ? { \"Idempotency-Key\": idempotencyKey } : undefined"
+	}
+
+	path := op.path
+	for _, arg := range pathArgs {
+		path = strings.Replace(path, "{"+arg+"}", "${encodeURIComponent("+arg+")}", 1)
+	}
+
+	fmt.Fprintf(b, "  /** %s (%s %s) */\n", strings.ReplaceAll(op.Summary, "*/", "*\\/"), op.method, op.path)
+	response, ok := op.Responses["2XX"]
+	if media, isJSON := response.Content["application/json"]; ok && isJSON && media.Schema != nil {
+		result := g.tsType(media.Schema)
+		fmt.Fprintf(b, "  %s(%s): Promise<%s> {\n", op.OperationID, strings.Join(params, ", "), result)
+		fmt.Fprintf(b, "    return this.request<%s>(\"%s\", `%s`, query, %s%s);\n", result, op.method, path, body, headers)
+	} else {
+		fmt.Fprintf(b, "  %s(%s): Promise<Response> {\n", op.OperationID, strings.Join(params, ", "))
+		fmt.Fprintf(b, "    return this.send(\"%s\", `%s`, query, %s%s);\n", op.method, path, body, headers)
+	}
+	b.WriteString("  }\n")
+}
+
+// pascalCase une las palabras separadas por guiones, puntos o guiones bajos
+// con la primera letra en mayúscula
+func pascalCase(s string) string {
+	var b strings.Builder
+	for _, word := range strings.FieldsFunc(s, func(r rune) bool { return r == '-' || r == '.' || r == '_' }) {
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
+
+// clientRuntime es la parte fija de client.ts: las opciones, el error y el
+// envío de las peticiones. La clase se cierra después de los métodos.
+const clientRuntime = `/** Parámetros de query; los undefined no se envían */
+export type Query = Record<string, string | number | boolean | undefined>;
+
+export interface ClientOptions {
+  /** URL base de la API, p. ej. https://torneos.example.com */
+  baseUrl: string;
+  /** Token de organizador o de acceso de /oauth/token, sin "Bearer " */
+  token?: string;
+  /** fetch a usar; por defecto el global */
+  fetch?: typeof fetch;
+}
+
+/** Respuesta con un código de error; body trae {"error": "..."} si vino JSON */
+export class ApiError extends Error {
+  constructor(
+    readonly status: number,
+    readonly body: ErrorResponse | undefined,
+  ) {
+    super(body?.error ?? ` + "`HTTP ${status}`" + `);
+  }
+}
+
+export class ApiClient {
+  constructor(private readonly options: ClientOptions) {}
+
+  /** Envía la petición y lanza ApiError si la respuesta no es 2xx */
+  protected async send(
+    method: string,
+    path: string,
+    query: Query | undefined,
+    body: unknown,
+    extraHeaders?: Record<string, string>,
+  ): Promise<Response> {
+    const url = new URL(path, this.options.baseUrl);
+    for (const [key, value] of Object.entries(query ?? {})) {
+      if (value !== undefined) {
+        url.searchParams.set(key, String(value));
+      }
+    }
+
+    const headers: Record<string, string> = { ...extraHeaders };
+    if (this.options.token) {
+      headers["Authorization"] = ` + "`Bearer ${this.options.token}`" + `;
+    }
+    let payload: BodyInit | undefined;
+    if (body === undefined || typeof body === "string" || body instanceof URLSearchParams || body instanceof Blob || body instanceof FormData) {
+      payload = body;
+    } else {
+      payload = JSON.stringify(body);
+      headers["Content-Type"] = "application/json";
+    }
+
+    const response = await (this.options.fetch ?? fetch)(url, { method, headers, body: payload });
+    if (!response.ok) {
+      let error: ErrorResponse | undefined;
+      try {
+        error = (await response.json()) as ErrorResponse;
+      } catch {
+        error = undefined;
+      }
+      throw new ApiError(response.status, error);
+    }
+    return response;
+  }
+
+  /** Envía la petición y decodifica la respuesta JSON */
+  protected async request<T>(
+    method: string,
+    path: string,
+    query: Query | undefined,
+    body: unknown,
+    extraHeaders?: Record<string, string>,
+  ): Promise<T> {
+    const response = await this.send(method, path, query, body, extraHeaders);
+    return (await response.json()) as T;
+  }
+`
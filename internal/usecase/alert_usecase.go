@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// AlertCommands agrupa las operaciones que modifican las alertas
+type AlertCommands interface {
+	// RefreshAlerts vuelve a revisar las situaciones pendientes y devuelve
+	// cuántas alertas siguen vigentes
+	RefreshAlerts() (int, error)
+	DismissAlert(id uuid.UUID) error
+}
+
+// AlertQueries agrupa las lecturas de alertas
+type AlertQueries interface {
+	// GetAlerts devuelve las alertas vigentes; con includeDismissed también
+	// las descartadas
+	GetAlerts(includeDismissed bool) ([]domain.Alert, error)
+}
+
+var (
+	_ AlertCommands = (*AlertUseCase)(nil)
+	_ AlertQueries  = (*AlertUseCase)(nil)
+)
+
+// AlertUseCase arma la lista de tareas pendientes de los organizadores
+type AlertUseCase struct {
+	alertRepo repository.AlertRepository
+}
+
+func NewAlertUseCase(alertRepo repository.AlertRepository) *AlertUseCase {
+	return &AlertUseCase{alertRepo: alertRepo}
+}
+
+func (uc *AlertUseCase) RefreshAlerts() (int, error) {
+	missingSince := time.Now().UTC().Add(-domain.MissingResultGrace)
+	alerts, err := uc.alertRepo.Detect(missingSince, domain.MinSquadSize)
+	if err != nil {
+		return 0, err
+	}
+	if err := uc.alertRepo.Sync(alerts); err != nil {
+		return 0, err
+	}
+	return len(alerts), nil
+}
+
+func (uc *AlertUseCase) GetAlerts(includeDismissed bool) ([]domain.Alert, error) {
+	return uc.alertRepo.GetAll(includeDismissed)
+}
+
+func (uc *AlertUseCase) DismissAlert(id uuid.UUID) error {
+	return uc.alertRepo.Dismiss(id, time.Now().UTC())
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// AnalyticsCommands recalcula las estadísticas agregadas (lo usa el job)
+type AnalyticsCommands interface {
+	RefreshAnalytics(tournamentID uuid.UUID) (*domain.TournamentAnalytics, error)
+	// RefreshAllAnalytics recalcula los torneos que se están jugando o ya
+	// terminaron y devuelve cuántos actualizó
+	RefreshAllAnalytics() (int, error)
+}
+
+// AnalyticsQueries lee las estadísticas ya calculadas
+type AnalyticsQueries interface {
+	GetAnalytics(tournamentID uuid.UUID) (*domain.TournamentAnalytics, error)
+}
+
+var (
+	_ AnalyticsCommands = (*AnalyticsUseCase)(nil)
+	_ AnalyticsQueries  = (*AnalyticsUseCase)(nil)
+)
+
+// AnalyticsUseCase calcula tendencias de goles y rendimiento local/visitante.
+// Las consultas leen lo guardado por el último cálculo en lugar de recorrer
+// todos los partidos en cada petición.
+type AnalyticsUseCase struct {
+	analyticsRepo  repository.AnalyticsRepository
+	tournamentRepo repository.TournamentRepository
+	matchRepo      repository.MatchRepository
+}
+
+func NewAnalyticsUseCase(analyticsRepo repository.AnalyticsRepository, tournamentRepo repository.TournamentRepository, matchRepo repository.MatchRepository) *AnalyticsUseCase {
+	return &AnalyticsUseCase{
+		analyticsRepo:  analyticsRepo,
+		tournamentRepo: tournamentRepo,
+		matchRepo:      matchRepo,
+	}
+}
+
+// GetAnalytics devuelve las estadísticas guardadas. Si el job todavía no
+// las calculó, se calculan en el momento.
+func (uc *AnalyticsUseCase) GetAnalytics(tournamentID uuid.UUID) (*domain.TournamentAnalytics, error) {
+	if _, err := uc.tournamentRepo.GetByID(tournamentID); err != nil {
+		return nil, err
+	}
+
+	if analytics, err := uc.analyticsRepo.GetByTournament(tournamentID); err == nil {
+		return analytics, nil
+	}
+	return uc.RefreshAnalytics(tournamentID)
+}
+
+// RefreshAnalytics recalcula y guarda las estadísticas del torneo. Son
+// públicas, así que no cuentan los partidos con el resultado embargado.
+func (uc *AnalyticsUseCase) RefreshAnalytics(tournamentID uuid.UUID) (*domain.TournamentAnalytics, error) {
+	tournament, err := uc.tournamentRepo.GetByID(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := uc.matchRepo.GetByTournament(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	delay := time.Duration(tournament.ResultsDelayMinutes) * time.Minute
+	public := make([]domain.Match, 0, len(matches))
+	for _, match := range matches {
+		if !match.Date.Add(delay).After(now) {
+			public = append(public, match)
+		}
+	}
+
+	analytics := domain.NewTournamentAnalytics(tournamentID, public, now)
+	if err := uc.analyticsRepo.Save(analytics); err != nil {
+		return nil, err
+	}
+	return analytics, nil
+}
+
+func (uc *AnalyticsUseCase) RefreshAllAnalytics() (int, error) {
+	// Los torneos archivados ya no cambian: conservan su último cálculo
+	tournaments, err := uc.tournamentRepo.GetAll(false)
+	if err != nil {
+		return 0, err
+	}
+
+	refreshed := 0
+	for _, tournament := range tournaments {
+		if tournament.Status != domain.TournamentInProgress && tournament.Status != domain.TournamentCompleted {
+			continue
+		}
+		if _, err := uc.RefreshAnalytics(tournament.ID); err != nil {
+			return refreshed, fmt.Errorf("failed to refresh tournament %s: %w", tournament.ID, err)
+		}
+		refreshed++
+	}
+	return refreshed, nil
+}
@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// APIClientCommands agrupa el registro de aplicaciones de terceros y la
+// emisión de tokens (OAuth2 client credentials)
+type APIClientCommands interface {
+	// RegisterAPIClient da de alta un cliente y devuelve su secreto, que no
+	// se vuelve a mostrar
+	RegisterAPIClient(name string, scopes []string, rateLimit int) (*domain.APIClientCredentials, error)
+	// RevokeAPIClient da de baja el cliente; sus tokens dejan de valer
+	RevokeAPIClient(id uuid.UUID) error
+	// IssueToken valida las credenciales y emite un token con los alcances
+	// pedidos (separados por espacios; vacío = todos los del cliente)
+	IssueToken(clientID uuid.UUID, clientSecret, scope string) (*domain.TokenResponse, error)
+}
+
+// APIClientQueries agrupa las lecturas de clientes y la validación de tokens
+type APIClientQueries interface {
+	GetAllAPIClients() ([]domain.APIClient, error)
+	// AuthenticateToken devuelve el token vigente con el límite de su cliente
+	AuthenticateToken(accessToken string) (*domain.APIToken, error)
+}
+
+var (
+	_ APIClientCommands = (*APIClientUseCase)(nil)
+	_ APIClientQueries  = (*APIClientUseCase)(nil)
+)
+
+type APIClientUseCase struct {
+	clientRepo repository.APIClientRepository
+}
+
+func NewAPIClientUseCase(clientRepo repository.APIClientRepository) *APIClientUseCase {
+	return &APIClientUseCase{clientRepo: clientRepo}
+}
+
+func (uc *APIClientUseCase) RegisterAPIClient(name string, scopes []string, rateLimit int) (*domain.APIClientCredentials, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if len(scopes) == 0 {
+		return nil, fmt.Errorf("at least one scope is required")
+	}
+	for _, scope := range scopes {
+		if !domain.IsValidScope(scope) {
+			return nil, fmt.Errorf("invalid scope %q", scope)
+		}
+	}
+	if rateLimit < 0 {
+		return nil, fmt.Errorf("rate_limit_per_minute cannot be negative")
+	}
+
+	secret, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	client := domain.NewAPIClient(name, scopes, rateLimit)
+	client.SecretHash = hashToken(secret)
+	if err := uc.clientRepo.Create(client); err != nil {
+		return nil, err
+	}
+	return &domain.APIClientCredentials{APIClient: *client, ClientSecret: secret}, nil
+}
+
+func (uc *APIClientUseCase) GetAllAPIClients() ([]domain.APIClient, error) {
+	return uc.clientRepo.GetAll()
+}
+
+func (uc *APIClientUseCase) RevokeAPIClient(id uuid.UUID) error {
+	return uc.clientRepo.Revoke(id, time.Now().UTC())
+}
+
+func (uc *APIClientUseCase) IssueToken(clientID uuid.UUID, clientSecret, scope string) (*domain.TokenResponse, error) {
+	// Cliente inexistente, revocado o secreto incorrecto responden igual
+	client, err := uc.clientRepo.GetByID(clientID)
+	if err != nil || client.RevokedAt != nil ||
+		subtle.ConstantTimeCompare([]byte(hashToken(clientSecret)), []byte(client.SecretHash)) != 1 {
+		return nil, &domain.OAuthError{Code: domain.OAuthInvalidClient, Description: "client authentication failed"}
+	}
+
+	scopes := strings.Fields(scope)
+	if len(scopes) == 0 {
+		scopes = client.Scopes
+	}
+	for _, s := range scopes {
+		if !client.HasScope(s) {
+			return nil, &domain.OAuthError{Code: domain.OAuthInvalidScope, Description: fmt.Sprintf("scope %q is not granted to this client", s)}
+		}
+	}
+
+	accessToken, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	token := &domain.APIToken{
+		TokenHash: hashToken(accessToken),
+		ClientID:  client.ID,
+		Scopes:    scopes,
+		ExpiresAt: time.Now().UTC().Add(domain.APITokenLifetime),
+	}
+	if err := uc.clientRepo.CreateToken(token); err != nil {
+		return nil, err
+	}
+
+	return &domain.TokenResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(domain.APITokenLifetime.Seconds()),
+		Scope:       strings.Join(scopes, " "),
+	}, nil
+}
+
+func (uc *APIClientUseCase) AuthenticateToken(accessToken string) (*domain.APIToken, error) {
+	if accessToken == "" {
+		return nil, fmt.Errorf("access token required")
+	}
+	return uc.clientRepo.GetToken(hashToken(accessToken))
+}
+
+// randomToken genera 32 bytes aleatorios en hexadecimal, para secretos y tokens
+func randomToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// hashToken es el SHA-256 que se guarda en lugar del secreto o el token. Al
+// ser valores aleatorios largos no hace falta un hash lento.
+func hashToken(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// CompetitionQueries lee las competiciones con varias categorías: un torneo
+// padre cuyos torneos hijos (parent_tournament_id) son sus divisiones
+type CompetitionQueries interface {
+	// GetCompetitionDivisions lista las categorías ordenadas por nivel con su
+	// cantidad de equipos y el líder de cada tabla; includeEmbargoed se
+	// aplica a las tablas como en TournamentQueries.GetStandings
+	GetCompetitionDivisions(competitionID uuid.UUID, includeEmbargoed bool) ([]domain.CompetitionDivision, error)
+	// GetCompetitionStats agrega las estadísticas de todas las categorías;
+	// includeEmbargoed se aplica a la tabla de goleadores como en StatsQueries
+	GetCompetitionStats(competitionID uuid.UUID, includeEmbargoed bool) (*domain.CompetitionStats, error)
+}
+
+var _ CompetitionQueries = (*CompetitionUseCase)(nil)
+
+// CompetitionUseCase arma las vistas de una competición a partir de las de
+// cada torneo hijo, así que tablas y estadísticas siguen las mismas reglas
+// (snapshots, embargo) que las de un torneo suelto
+type CompetitionUseCase struct {
+	tournamentRepo repository.TournamentRepository
+	divisionRepo   repository.DivisionRepository
+	tournaments    TournamentQueries
+	analytics      AnalyticsQueries
+	stats          StatsQueries
+}
+
+func NewCompetitionUseCase(tournamentRepo repository.TournamentRepository, divisionRepo repository.DivisionRepository, tournaments TournamentQueries, analytics AnalyticsQueries, stats StatsQueries) *CompetitionUseCase {
+	return &CompetitionUseCase{
+		tournamentRepo: tournamentRepo,
+		divisionRepo:   divisionRepo,
+		tournaments:    tournaments,
+		analytics:      analytics,
+		stats:          stats,
+	}
+}
+
+func (uc *CompetitionUseCase) GetCompetitionDivisions(competitionID uuid.UUID, includeEmbargoed bool) ([]domain.CompetitionDivision, error) {
+	children, err := uc.children(competitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.CompetitionDivision, 0, len(children))
+	for _, child := range children {
+		division, err := uc.division(child)
+		if err != nil {
+			return nil, err
+		}
+		entry := domain.CompetitionDivision{Tournament: child, Division: division}
+
+		standings, err := uc.tournaments.GetStandings(child.ID, 0, includeEmbargoed)
+		if err != nil {
+			return nil, err
+		}
+		entry.Teams = len(standings)
+		if len(standings) > 0 {
+			entry.Leader = &standings[0]
+		}
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+func (uc *CompetitionUseCase) GetCompetitionStats(competitionID uuid.UUID, includeEmbargoed bool) (*domain.CompetitionStats, error) {
+	children, err := uc.children(competitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &domain.CompetitionStats{
+		CompetitionID: competitionID,
+		Divisions:     make([]domain.DivisionAnalytics, 0, len(children)),
+		TopScorers:    []domain.TopScorer{},
+	}
+	parts := make([]domain.TournamentAnalytics, 0, len(children))
+	for _, child := range children {
+		analytics, err := uc.analytics.GetAnalytics(child.ID)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, *analytics)
+
+		entry := domain.DivisionAnalytics{
+			TournamentID:   child.ID,
+			TournamentName: child.Name,
+			DivisionID:     child.DivisionID,
+			Analytics:      analytics,
+		}
+		division, err := uc.division(child)
+		if err != nil {
+			return nil, err
+		}
+		if division != nil {
+			entry.DivisionName = division.Name
+		}
+		stats.Divisions = append(stats.Divisions, entry)
+
+		// Cada equipo juega una sola categoría, así que las filas no se repiten
+		scorers, err := uc.stats.GetTopScorers(child.ID, includeEmbargoed)
+		if err != nil {
+			return nil, err
+		}
+		stats.TopScorers = append(stats.TopScorers, scorers...)
+	}
+
+	stats.Totals = domain.MergeAnalytics(competitionID, parts, time.Now().UTC())
+	domain.SortTopScorers(stats.TopScorers)
+	return stats, nil
+}
+
+// children valida la competición y devuelve sus torneos hijos
+func (uc *CompetitionUseCase) children(competitionID uuid.UUID) ([]domain.Tournament, error) {
+	competition, err := uc.tournamentRepo.GetByID(competitionID)
+	if err != nil {
+		return nil, err
+	}
+	children, err := uc.tournamentRepo.GetChildren(competitionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(children) == 0 {
+		return nil, fmt.Errorf("tournament %q has no divisions", competition.Name)
+	}
+	return children, nil
+}
+
+// division carga la división del torneo, si tiene
+func (uc *CompetitionUseCase) division(tournament domain.Tournament) (*domain.Division, error) {
+	if tournament.DivisionID == nil {
+		return nil, nil
+	}
+	return uc.divisionRepo.GetByID(*tournament.DivisionID)
+}
@@ -0,0 +1,222 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// DivisionCommands agrupa las operaciones que modifican divisiones y el
+// cierre de temporada con ascensos y descensos
+type DivisionCommands interface {
+	CreateDivision(division *domain.Division) error
+	UpdateDivision(division *domain.Division) error
+	DeleteDivision(id uuid.UUID) error
+	// ApplyPromotions inscribe a los equipos de los torneos por división de
+	// seasonID en los de nextSeasonID según su posición final
+	ApplyPromotions(seasonID, nextSeasonID uuid.UUID) (*domain.PromotionReport, error)
+}
+
+// DivisionQueries agrupa las lecturas de divisiones
+type DivisionQueries interface {
+	GetDivisionByID(id uuid.UUID) (*domain.Division, error)
+	GetAllDivisions() ([]domain.Division, error)
+}
+
+var (
+	_ DivisionCommands = (*DivisionUseCase)(nil)
+	_ DivisionQueries  = (*DivisionUseCase)(nil)
+)
+
+type DivisionUseCase struct {
+	divisionRepo   repository.DivisionRepository
+	tournamentRepo repository.TournamentRepository
+	seasonRepo     repository.SeasonRepository
+	// tournaments inscribe a los equipos con las mismas validaciones que una
+	// inscripción manual (estado del torneo y reglas del plantel)
+	tournaments TournamentCommands
+}
+
+func NewDivisionUseCase(divisionRepo repository.DivisionRepository, tournamentRepo repository.TournamentRepository, seasonRepo repository.SeasonRepository, tournaments TournamentCommands) *DivisionUseCase {
+	return &DivisionUseCase{
+		divisionRepo:   divisionRepo,
+		tournamentRepo: tournamentRepo,
+		seasonRepo:     seasonRepo,
+		tournaments:    tournaments,
+	}
+}
+
+func (uc *DivisionUseCase) CreateDivision(division *domain.Division) error {
+	if err := validateDivision(division); err != nil {
+		return err
+	}
+	return uc.divisionRepo.Create(division)
+}
+
+func (uc *DivisionUseCase) GetDivisionByID(id uuid.UUID) (*domain.Division, error) {
+	return uc.divisionRepo.GetByID(id)
+}
+
+func (uc *DivisionUseCase) GetAllDivisions() ([]domain.Division, error) {
+	return uc.divisionRepo.GetAll()
+}
+
+func (uc *DivisionUseCase) UpdateDivision(division *domain.Division) error {
+	if err := validateDivision(division); err != nil {
+		return err
+	}
+	return uc.divisionRepo.Update(division)
+}
+
+func (uc *DivisionUseCase) DeleteDivision(id uuid.UUID) error {
+	return uc.divisionRepo.Delete(id)
+}
+
+func validateDivision(division *domain.Division) error {
+	if strings.TrimSpace(division.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if division.Level < 1 {
+		return fmt.Errorf("level must be at least 1")
+	}
+	if division.Promoted < 0 || division.Relegated < 0 {
+		return fmt.Errorf("promoted and relegated cannot be negative")
+	}
+	return nil
+}
+
+// ApplyPromotions toma la tabla final de cada torneo por división de la
+// temporada terminada: los Promoted primeros pasan a la división de arriba,
+// los Relegated últimos a la de abajo y el resto se queda. La división más
+// alta no asciende a nadie y la más baja no desciende a nadie. Primero se
+// valida todo (torneos completos, destinos abiertos a inscripción) y recién
+// después se inscribe; los equipos que ya estaban inscriptos en destino se
+// saltean, así la operación se puede repetir.
+func (uc *DivisionUseCase) ApplyPromotions(seasonID, nextSeasonID uuid.UUID) (*domain.PromotionReport, error) {
+	if seasonID == nextSeasonID {
+		return nil, fmt.Errorf("next season must be different from the finished season")
+	}
+
+	divisions, err := uc.divisionRepo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(divisions) == 0 {
+		return nil, fmt.Errorf("no divisions configured")
+	}
+
+	current, err := uc.divisionTournaments(seasonID)
+	if err != nil {
+		return nil, err
+	}
+	next, err := uc.divisionTournaments(nextSeasonID)
+	if err != nil {
+		return nil, err
+	}
+	if len(current) == 0 {
+		return nil, fmt.Errorf("the finished season has no tournaments assigned to divisions")
+	}
+
+	report := &domain.PromotionReport{SeasonID: seasonID, NextSeasonID: nextSeasonID, Moves: []domain.DivisionMove{}}
+	for i, division := range divisions {
+		from, ok := current[division.ID]
+		if !ok {
+			continue
+		}
+		if from.Status != domain.TournamentCompleted {
+			return nil, fmt.Errorf("tournament %q has not been completed", from.Name)
+		}
+
+		standings, err := uc.tournamentRepo.GetStandings(from.ID, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		promoted, relegated := 0, 0
+		if i > 0 {
+			promoted = division.Promoted
+		}
+		if i < len(divisions)-1 {
+			relegated = division.Relegated
+		}
+		if promoted+relegated > len(standings) {
+			return nil, fmt.Errorf("division %q has %d teams but promotes %d and relegates %d", division.Name, len(standings), promoted, relegated)
+		}
+
+		for _, row := range standings {
+			target, movement := division, domain.MovementStayed
+			switch {
+			case row.Position <= promoted:
+				target, movement = divisions[i-1], domain.MovementPromoted
+			case row.Position > len(standings)-relegated:
+				target, movement = divisions[i+1], domain.MovementRelegated
+			}
+
+			to, ok := next[target.ID]
+			if !ok {
+				return nil, fmt.Errorf("next season has no tournament for division %q", target.Name)
+			}
+			if err := to.CheckRegistration(); err != nil {
+				return nil, fmt.Errorf("tournament %q: %w", to.Name, err)
+			}
+
+			report.Moves = append(report.Moves, domain.DivisionMove{
+				TeamID:           row.TeamID,
+				TeamName:         row.TeamName,
+				Position:         row.Position,
+				Movement:         movement,
+				FromDivisionID:   division.ID,
+				ToDivisionID:     target.ID,
+				FromTournamentID: from.ID,
+				ToTournamentID:   to.ID,
+			})
+		}
+	}
+
+	registered := make(map[uuid.UUID]map[uuid.UUID]bool)
+	for i := range report.Moves {
+		move := &report.Moves[i]
+		teams, ok := registered[move.ToTournamentID]
+		if !ok {
+			enrolled, err := uc.tournamentRepo.GetTournamentTeams(move.ToTournamentID)
+			if err != nil {
+				return nil, err
+			}
+			teams = make(map[uuid.UUID]bool, len(enrolled))
+			for _, team := range enrolled {
+				teams[team.ID] = true
+			}
+			registered[move.ToTournamentID] = teams
+		}
+
+		if teams[move.TeamID] {
+			move.AlreadyRegistered = true
+			continue
+		}
+		if err := uc.tournaments.AddTeamToTournament(move.ToTournamentID, move.TeamID); err != nil {
+			return nil, fmt.Errorf("team %q: %w", move.TeamName, err)
+		}
+	}
+	return report, nil
+}
+
+// divisionTournaments indexa por división los torneos de la temporada
+func (uc *DivisionUseCase) divisionTournaments(seasonID uuid.UUID) (map[uuid.UUID]*domain.Tournament, error) {
+	if _, err := uc.seasonRepo.GetByID(seasonID); err != nil {
+		return nil, err
+	}
+	tournaments, err := uc.tournamentRepo.GetBySeason(seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	byDivision := make(map[uuid.UUID]*domain.Tournament)
+	for i := range tournaments {
+		if tournaments[i].DivisionID != nil {
+			byDivision[*tournaments[i].DivisionID] = &tournaments[i]
+		}
+	}
+	return byDivision, nil
+}
@@ -0,0 +1,259 @@
+package usecase
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// DrawCommands agrupa las operaciones que modifican sorteos
+type DrawCommands interface {
+	RunDraw(tournamentID uuid.UUID, options domain.DrawOptions) (*domain.Draw, error)
+}
+
+// DrawQueries agrupa las lecturas de sorteos
+type DrawQueries interface {
+	GetDraw(tournamentID, drawID uuid.UUID) (*domain.Draw, error)
+	GetTournamentDraws(tournamentID uuid.UUID) ([]domain.Draw, error)
+	SuggestSeeding(tournamentID uuid.UUID, sources []uuid.UUID, potCount int) (*domain.SeedingSuggestion, error)
+}
+
+var (
+	_ DrawCommands = (*DrawUseCase)(nil)
+	_ DrawQueries  = (*DrawUseCase)(nil)
+)
+
+// DrawUseCase ejecuta y registra sorteos de grupos o llaves
+type DrawUseCase struct {
+	drawRepo       repository.DrawRepository
+	tournamentRepo repository.TournamentRepository
+}
+
+func NewDrawUseCase(drawRepo repository.DrawRepository, tournamentRepo repository.TournamentRepository) *DrawUseCase {
+	return &DrawUseCase{
+		drawRepo:       drawRepo,
+		tournamentRepo: tournamentRepo,
+	}
+}
+
+// RunDraw ejecuta el sorteo con una semilla (la indicada o una aleatoria) y lo
+// registra. En modo grupos cada bombo reparte un equipo por grupo; en modo
+// llave los bombos se sortean por separado y ocupan las posiciones en orden.
+func (uc *DrawUseCase) RunDraw(tournamentID uuid.UUID, options domain.DrawOptions) (*domain.Draw, error) {
+	tournament, err := uc.tournamentRepo.GetByID(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tournament.CheckSchedulable(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.validateOptions(tournamentID, options); err != nil {
+		return nil, err
+	}
+
+	seed := time.Now().UnixNano()
+	if options.Seed != nil {
+		seed = *options.Seed
+	}
+
+	draw := domain.NewDraw(tournamentID, options.Mode, options.GroupCount, seed)
+	draw.Picks = drawPicks(options, seed)
+
+	if err := uc.drawRepo.Create(draw); err != nil {
+		return nil, err
+	}
+	return draw, nil
+}
+
+func (uc *DrawUseCase) GetDraw(tournamentID, drawID uuid.UUID) (*domain.Draw, error) {
+	draw, err := uc.drawRepo.GetByID(drawID)
+	if err != nil {
+		return nil, err
+	}
+	if draw.TournamentID != tournamentID {
+		return nil, fmt.Errorf("draw not found")
+	}
+	return draw, nil
+}
+
+func (uc *DrawUseCase) GetTournamentDraws(tournamentID uuid.UUID) ([]domain.Draw, error) {
+	return uc.drawRepo.GetByTournament(tournamentID)
+}
+
+func (uc *DrawUseCase) validateOptions(tournamentID uuid.UUID, options domain.DrawOptions) error {
+	if len(options.Pots) == 0 {
+		return fmt.Errorf("at least one pot is required")
+	}
+
+	switch options.Mode {
+	case domain.DrawModeGroups:
+		if options.GroupCount < 2 {
+			return fmt.Errorf("group_count must be at least 2")
+		}
+		for i, pot := range options.Pots {
+			if len(pot) > options.GroupCount {
+				return fmt.Errorf("pot %d has more teams than groups", i+1)
+			}
+		}
+	case domain.DrawModeBracket:
+		if options.GroupCount != 0 {
+			return fmt.Errorf("group_count only applies to group draws")
+		}
+	default:
+		return fmt.Errorf("mode must be one of: groups, bracket")
+	}
+
+	teams, err := uc.tournamentRepo.GetTournamentTeams(tournamentID)
+	if err != nil {
+		return err
+	}
+	registered := make(map[uuid.UUID]bool, len(teams))
+	for _, team := range teams {
+		registered[team.ID] = true
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	for _, pot := range options.Pots {
+		if len(pot) == 0 {
+			return fmt.Errorf("pots cannot be empty")
+		}
+		for _, teamID := range pot {
+			if !registered[teamID] {
+				return fmt.Errorf("team %s is not registered in the tournament", teamID)
+			}
+			if seen[teamID] {
+				return fmt.Errorf("team %s appears in more than one pot", teamID)
+			}
+			seen[teamID] = true
+		}
+	}
+	return nil
+}
+
+// drawPicks es determinista para una misma semilla y los mismos bombos
+func drawPicks(options domain.DrawOptions, seed int64) []domain.DrawPick {
+	rng := rand.New(rand.NewSource(seed))
+
+	var picks []domain.DrawPick
+	position := 0
+	for potIndex, pot := range options.Pots {
+		teams := append([]uuid.UUID(nil), pot...)
+		rng.Shuffle(len(teams), func(i, j int) { teams[i], teams[j] = teams[j], teams[i] })
+
+		// En grupos, el orden de los grupos también se sortea para cada bombo
+		groups := rng.Perm(options.GroupCount)
+
+		for i, teamID := range teams {
+			var slot string
+			if options.Mode == domain.DrawModeGroups {
+				slot = groupLetter(groups[i])
+			} else {
+				position++
+				slot = strconv.Itoa(position)
+			}
+			picks = append(picks, domain.DrawPick{
+				Order:  len(picks) + 1,
+				TeamID: teamID,
+				Pot:    potIndex + 1,
+				Slot:   slot,
+			})
+		}
+	}
+	return picks
+}
+
+// groupLetter convierte 0, 1, 2... en "A", "B", "C"... (y "AA" tras la "Z")
+func groupLetter(index int) string {
+	letter := string(rune('A' + index%26))
+	if index >= 26 {
+		return groupLetter(index/26-1) + letter
+	}
+	return letter
+}
+
+// SuggestSeeding ordena los equipos del torneo según su rendimiento en torneos
+// anteriores (puntos por partido) y los reparte en potCount bombos. Las fuentes
+// se indican de la más reciente a la más antigua y pesan más cuanto más
+// recientes son. Los equipos sin historial quedan al final.
+func (uc *DrawUseCase) SuggestSeeding(tournamentID uuid.UUID, sources []uuid.UUID, potCount int) (*domain.SeedingSuggestion, error) {
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("at least one past tournament is required")
+	}
+	if potCount < 1 {
+		return nil, fmt.Errorf("pots must be at least 1")
+	}
+
+	teams, err := uc.tournamentRepo.GetTournamentTeams(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	if len(teams) == 0 {
+		return nil, fmt.Errorf("tournament has no teams")
+	}
+	if potCount > len(teams) {
+		return nil, fmt.Errorf("cannot make %d pots with %d teams", potCount, len(teams))
+	}
+
+	weighted := make(map[uuid.UUID]float64)
+	weights := make(map[uuid.UUID]float64)
+	for i, sourceID := range sources {
+		standings, err := uc.tournamentRepo.GetStandings(sourceID, 0, 0)
+		if err != nil {
+			return nil, fmt.Errorf("past tournament %s: %w", sourceID, err)
+		}
+
+		weight := float64(len(sources) - i)
+		for _, row := range standings {
+			if row.Played == 0 {
+				continue
+			}
+			weighted[row.TeamID] += weight * float64(row.Points) / float64(row.Played)
+			weights[row.TeamID] += weight
+		}
+	}
+
+	rankings := make([]domain.SeedRanking, 0, len(teams))
+	for _, team := range teams {
+		score := -1.0 // sin historial
+		if weights[team.ID] > 0 {
+			score = weighted[team.ID] / weights[team.ID]
+		}
+		rankings = append(rankings, domain.SeedRanking{TeamID: team.ID, TeamName: team.Name, Score: score})
+	}
+	sort.SliceStable(rankings, func(i, j int) bool {
+		if rankings[i].Score != rankings[j].Score {
+			return rankings[i].Score > rankings[j].Score
+		}
+		return rankings[i].TeamName < rankings[j].TeamName
+	})
+
+	// Bombos de tamaño parejo; los primeros reciben el resto si no es exacto
+	pots := make([][]uuid.UUID, potCount)
+	size, extra := len(rankings)/potCount, len(rankings)%potCount
+	index := 0
+	for p := 0; p < potCount; p++ {
+		n := size
+		if p < extra {
+			n++
+		}
+		for k := 0; k < n; k++ {
+			rankings[index].Rank = index + 1
+			pots[p] = append(pots[p], rankings[index].TeamID)
+			index++
+		}
+	}
+
+	return &domain.SeedingSuggestion{
+		TournamentID: tournamentID,
+		Sources:      sources,
+		Rankings:     rankings,
+		Pots:         pots,
+	}, nil
+}
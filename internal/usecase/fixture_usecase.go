@@ -0,0 +1,811 @@
+package usecase
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// FixtureCommands agrupa las operaciones que modifican fixtures
+type FixtureCommands interface {
+	ImportFixtures(tournamentID uuid.UUID, fixtures []domain.Fixture, dryRun bool) (*domain.FixtureImportReport, error)
+	SplitLeague(tournamentID uuid.UUID, options domain.SplitOptions) (*domain.SplitResult, error)
+}
+
+// FixtureQueries agrupa las lecturas de fixtures
+type FixtureQueries interface {
+	ExportFixtures(tournamentID uuid.UUID) ([]domain.Fixture, error)
+	PlanTimetable(tournamentID uuid.UUID, round int, options domain.TimetableOptions) (*domain.Timetable, error)
+	// GetRounds resume las jornadas del torneo en orden
+	GetRounds(tournamentID uuid.UUID) ([]domain.RoundSummary, error)
+	GetRoundMatches(tournamentID uuid.UUID, round int) ([]domain.Match, error)
+}
+
+var (
+	_ FixtureCommands = (*FixtureUseCase)(nil)
+	_ FixtureQueries  = (*FixtureUseCase)(nil)
+)
+
+// FixtureUseCase importa y exporta el calendario de un torneo en el formato
+// de intercambio de las federaciones (round, date, home, away, venue, pitch)
+type FixtureUseCase struct {
+	tournamentRepo repository.TournamentRepository
+	teamRepo       repository.TeamRepository
+	matchRepo      repository.MatchRepository
+	venueRepo      repository.VenueRepository
+	pitchRepo      repository.PitchRepository
+	rulesRepo      repository.TournamentRulesRepository
+}
+
+func NewFixtureUseCase(tournamentRepo repository.TournamentRepository, teamRepo repository.TeamRepository, matchRepo repository.MatchRepository, venueRepo repository.VenueRepository, pitchRepo repository.PitchRepository, rulesRepo repository.TournamentRulesRepository) *FixtureUseCase {
+	return &FixtureUseCase{
+		tournamentRepo: tournamentRepo,
+		teamRepo:       teamRepo,
+		matchRepo:      matchRepo,
+		venueRepo:      venueRepo,
+		pitchRepo:      pitchRepo,
+		rulesRepo:      rulesRepo,
+	}
+}
+
+// venuePitches devuelve las canchas de la sede y las guarda en cache para no
+// repetir la consulta en cada fila
+func (uc *FixtureUseCase) venuePitches(cache map[uuid.UUID][]domain.Pitch, venueID uuid.UUID) ([]domain.Pitch, error) {
+	if pitches, ok := cache[venueID]; ok {
+		return pitches, nil
+	}
+	pitches, err := uc.pitchRepo.GetByVenue(venueID)
+	if err != nil {
+		return nil, err
+	}
+	cache[venueID] = pitches
+	return pitches, nil
+}
+
+// GetRounds agrupa los partidos del torneo por jornada. Los partidos sin
+// jornada asignada no se incluyen.
+func (uc *FixtureUseCase) GetRounds(tournamentID uuid.UUID) ([]domain.RoundSummary, error) {
+	if _, err := uc.tournamentRepo.GetByID(tournamentID); err != nil {
+		return nil, err
+	}
+
+	matches, err := uc.matchRepo.GetByTournament(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// GetByTournament ordena por jornada, así cada una queda contigua
+	rounds := []domain.RoundSummary{}
+	for _, match := range matches {
+		if match.Round < 1 {
+			continue
+		}
+		last := len(rounds) - 1
+		if last < 0 || rounds[last].Round != match.Round {
+			rounds = append(rounds, domain.RoundSummary{Round: match.Round, FirstDate: match.Date, LastDate: match.Date})
+			last++
+		}
+		rounds[last].Matches++
+		if match.Date.Before(rounds[last].FirstDate) {
+			rounds[last].FirstDate = match.Date
+		}
+		if match.Date.After(rounds[last].LastDate) {
+			rounds[last].LastDate = match.Date
+		}
+	}
+	return rounds, nil
+}
+
+// GetRoundMatches devuelve los partidos de una jornada ordenados por fecha
+func (uc *FixtureUseCase) GetRoundMatches(tournamentID uuid.UUID, round int) ([]domain.Match, error) {
+	if round < 1 {
+		return nil, fmt.Errorf("round must be at least 1")
+	}
+	if _, err := uc.tournamentRepo.GetByID(tournamentID); err != nil {
+		return nil, err
+	}
+
+	matches, err := uc.matchRepo.GetByTournament(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	roundMatches := []domain.Match{}
+	for _, match := range matches {
+		if match.Round == round {
+			roundMatches = append(roundMatches, match)
+		}
+	}
+	decideWinners(roundMatches)
+	return roundMatches, nil
+}
+
+// ExportFixtures devuelve los partidos del torneo con equipos, sedes y
+// canchas por nombre
+func (uc *FixtureUseCase) ExportFixtures(tournamentID uuid.UUID) ([]domain.Fixture, error) {
+	if _, err := uc.tournamentRepo.GetByID(tournamentID); err != nil {
+		return nil, err
+	}
+
+	matches, err := uc.matchRepo.GetByTournament(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := uc.teamRepo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[uuid.UUID]string, len(teams))
+	for _, team := range teams {
+		names[team.ID] = team.Name
+	}
+
+	venues, err := uc.venueRepo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	venueNames := make(map[uuid.UUID]string, len(venues))
+	for _, venue := range venues {
+		venueNames[venue.ID] = venue.Name
+	}
+
+	pitchCache := make(map[uuid.UUID][]domain.Pitch)
+	fixtures := make([]domain.Fixture, 0, len(matches))
+	for _, match := range matches {
+		fixture := domain.Fixture{
+			Round: match.Round,
+			Date:  match.Date,
+			Home:  names[match.Team1ID],
+			Away:  names[match.Team2ID],
+		}
+		if match.VenueID != nil {
+			fixture.Venue = venueNames[*match.VenueID]
+		}
+		if match.VenueID != nil && match.PitchID != nil {
+			pitches, err := uc.venuePitches(pitchCache, *match.VenueID)
+			if err != nil {
+				return nil, err
+			}
+			for _, pitch := range pitches {
+				if pitch.ID == *match.PitchID {
+					fixture.Pitch = pitch.Name
+				}
+			}
+		}
+		fixtures = append(fixtures, fixture)
+	}
+	return fixtures, nil
+}
+
+// ImportFixtures crea los partidos del fixture en el torneo. Las filas que no
+// se pueden importar se devuelven en el reporte de conflictos; con dryRun solo
+// se valida sin guardar nada. La sede y la cancha se buscan por nombre y son
+// opcionales; una cancha ya ocupada en ese horario es un conflicto.
+func (uc *FixtureUseCase) ImportFixtures(tournamentID uuid.UUID, fixtures []domain.Fixture, dryRun bool) (*domain.FixtureImportReport, error) {
+	tournament, err := uc.tournamentRepo.GetByID(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tournament.CheckSchedulable(); err != nil {
+		return nil, err
+	}
+
+	// Mapear nombres de equipos del torneo a IDs (sin distinguir mayúsculas)
+	teams, err := uc.tournamentRepo.GetTournamentTeams(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	teamIDs := make(map[string]uuid.UUID, len(teams))
+	for _, team := range teams {
+		teamIDs[normalizeName(team.Name)] = team.ID
+	}
+
+	venues, err := uc.venueRepo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	venueIDs := make(map[string]uuid.UUID, len(venues))
+	for _, venue := range venues {
+		venueIDs[normalizeName(venue.Name)] = venue.ID
+	}
+
+	existing, err := uc.matchRepo.GetByTournament(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ocupación de equipos por jornada y por día, incluyendo partidos existentes
+	busyInRound := make(map[string]bool)
+	busyOnDay := make(map[string]bool)
+	nextNumber := 1
+	for _, match := range existing {
+		markBusy(busyInRound, busyOnDay, match.Round, match.Date.Format("2006-01-02"), match.Team1ID, match.Team2ID)
+		if match.MatchNumber >= nextNumber {
+			nextNumber = match.MatchNumber + 1
+		}
+	}
+
+	pitchCache := make(map[uuid.UUID][]domain.Pitch)
+
+	report := &domain.FixtureImportReport{
+		TournamentID: tournamentID,
+		DryRun:       dryRun,
+		Matches:      []domain.Match{},
+		Conflicts:    []domain.FixtureConflict{},
+	}
+
+	for i, fixture := range fixtures {
+		row := i + 1
+		conflict := func(reason string) {
+			report.Conflicts = append(report.Conflicts, domain.FixtureConflict{Row: row, Fixture: fixture, Reason: reason})
+		}
+
+		homeID, ok := teamIDs[normalizeName(fixture.Home)]
+		if !ok {
+			conflict(fmt.Sprintf("home team %q is not registered in the tournament", fixture.Home))
+			continue
+		}
+		awayID, ok := teamIDs[normalizeName(fixture.Away)]
+		if !ok {
+			conflict(fmt.Sprintf("away team %q is not registered in the tournament", fixture.Away))
+			continue
+		}
+		if homeID == awayID {
+			conflict("a team cannot play against itself")
+			continue
+		}
+		if fixture.Round < 0 {
+			conflict("round cannot be negative")
+			continue
+		}
+
+		var venueID *uuid.UUID
+		if strings.TrimSpace(fixture.Venue) != "" {
+			id, ok := venueIDs[normalizeName(fixture.Venue)]
+			if !ok {
+				conflict(fmt.Sprintf("venue %q is not registered", fixture.Venue))
+				continue
+			}
+			venueID = &id
+		}
+
+		var pitchID *uuid.UUID
+		if strings.TrimSpace(fixture.Pitch) != "" {
+			if venueID == nil {
+				conflict("pitch requires a venue")
+				continue
+			}
+			pitches, err := uc.venuePitches(pitchCache, *venueID)
+			if err != nil {
+				return nil, err
+			}
+			for _, pitch := range pitches {
+				if normalizeName(pitch.Name) == normalizeName(fixture.Pitch) {
+					id := pitch.ID
+					pitchID = &id
+				}
+			}
+			if pitchID == nil {
+				conflict(fmt.Sprintf("pitch %q is not registered in venue %q", fixture.Pitch, fixture.Venue))
+				continue
+			}
+		}
+
+		day := fixture.Date.Format("2006-01-02")
+		if fixture.Round > 0 && (busyInRound[busyKey(fixture.Round, homeID)] || busyInRound[busyKey(fixture.Round, awayID)]) {
+			conflict(fmt.Sprintf("a team already plays in round %d", fixture.Round))
+			continue
+		}
+		if busyOnDay[day+homeID.String()] || busyOnDay[day+awayID.String()] {
+			conflict(fmt.Sprintf("a team already plays on %s", day))
+			continue
+		}
+
+		match := domain.NewMatch(nextNumber, fixture.Date, homeID, awayID, 0, 0)
+		match.TournamentID = &tournamentID
+		match.VenueID = venueID
+		match.PitchID = pitchID
+		match.Round = fixture.Round
+
+		// La cancha no puede estar ocupada por otro partido (de cualquier
+		// torneo) ni por una fila anterior de esta importación
+		if pitchID != nil {
+			window := time.Duration(defaultMatchDuration) * time.Minute
+			booked, err := uc.matchRepo.GetByVenue(*venueID, fixture.Date.Add(-window), fixture.Date.Add(window))
+			if err != nil {
+				return nil, err
+			}
+			booked = append(booked, report.Matches...)
+			if other := pitchConflict(booked, match); other != nil {
+				conflict(fmt.Sprintf("pitch %q is already booked at %s", fixture.Pitch, other.Date.Format(time.RFC3339)))
+				continue
+			}
+		}
+
+		markBusy(busyInRound, busyOnDay, fixture.Round, day, homeID, awayID)
+		nextNumber++
+
+		if !dryRun {
+			if err := uc.matchRepo.Create(match); err != nil {
+				conflict(err.Error())
+				continue
+			}
+		}
+
+		report.Matches = append(report.Matches, *match)
+		report.Imported++
+	}
+
+	return report, nil
+}
+
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func busyKey(round int, teamID uuid.UUID) string {
+	return fmt.Sprintf("%d:%s", round, teamID)
+}
+
+func markBusy(busyInRound, busyOnDay map[string]bool, round int, day string, teams ...uuid.UUID) {
+	for _, teamID := range teams {
+		if round > 0 {
+			busyInRound[busyKey(round, teamID)] = true
+		}
+		busyOnDay[day+teamID.String()] = true
+	}
+}
+
+const defaultMatchDuration = domain.DefaultMatchDuration
+
+// PlanTimetable asigna los partidos de una jornada a canchas y horarios.
+// Es una heurística voraz: primero ubica los partidos con menos opciones y
+// siempre elige el horario libre más temprano, lo que compacta la jornada y
+// minimiza los huecos. El resultado es una propuesta; no se guarda nada.
+func (uc *FixtureUseCase) PlanTimetable(tournamentID uuid.UUID, round int, options domain.TimetableOptions) (*domain.Timetable, error) {
+	var venuePitches []domain.Pitch
+	if options.VenueID != nil {
+		if _, err := uc.venueRepo.GetByID(*options.VenueID); err != nil {
+			return nil, err
+		}
+		var err error
+		if venuePitches, err = uc.selectPitches(*options.VenueID, options.Pitches); err != nil {
+			return nil, err
+		}
+		options.Pitches = make([]string, len(venuePitches))
+		for i, pitch := range venuePitches {
+			options.Pitches[i] = pitch.Name
+		}
+	}
+	if len(options.Pitches) == 0 {
+		return nil, fmt.Errorf("at least one pitch is required")
+	}
+	if len(options.Slots) == 0 {
+		return nil, fmt.Errorf("at least one slot is required")
+	}
+	// Sin duración explícita se usa la de las reglas del torneo
+	if options.MatchDuration <= 0 {
+		rules, err := uc.rulesRepo.Get(tournamentID)
+		if err != nil {
+			return nil, err
+		}
+		options.MatchDuration = rules.MatchDuration
+	}
+	if options.MinRest < 0 {
+		return nil, fmt.Errorf("min_rest_minutes cannot be negative")
+	}
+
+	if _, err := uc.tournamentRepo.GetByID(tournamentID); err != nil {
+		return nil, err
+	}
+
+	all, err := uc.matchRepo.GetByTournament(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	var matches []domain.Match
+	for _, match := range all {
+		if match.Round == round {
+			matches = append(matches, match)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("round %d has no matches", round)
+	}
+
+	slots := append([]time.Time(nil), options.Slots...)
+	sort.Slice(slots, func(i, j int) bool { return slots[i].Before(slots[j]) })
+
+	constraints := make(map[uuid.UUID]domain.TeamConstraint, len(options.TeamConstraints))
+	for _, c := range options.TeamConstraints {
+		constraints[c.TeamID] = c
+	}
+
+	// Un equipo no puede volver a jugar hasta que termine el partido más el descanso
+	spacing := time.Duration(options.MatchDuration+options.MinRest) * time.Minute
+
+	teamAllowed := func(teamID uuid.UUID, kickoff time.Time) bool {
+		c, ok := constraints[teamID]
+		if !ok {
+			return true
+		}
+		if c.NotBefore != nil && kickoff.Before(*c.NotBefore) {
+			return false
+		}
+		if c.NotAfter != nil && kickoff.After(*c.NotAfter) {
+			return false
+		}
+		return true
+	}
+
+	// Ordenar por cantidad de horarios permitidos (los más restringidos primero)
+	feasible := func(match domain.Match) int {
+		count := 0
+		for _, slot := range slots {
+			if teamAllowed(match.Team1ID, slot) && teamAllowed(match.Team2ID, slot) {
+				count++
+			}
+		}
+		return count
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return feasible(matches[i]) < feasible(matches[j]) })
+
+	pitchBusy := make(map[string]bool)              // "slot|pitch" ocupados
+	teamKickoffs := make(map[uuid.UUID][]time.Time) // horarios ya asignados por equipo
+
+	// Partidos de otras jornadas o torneos que ya ocupan canchas de la sede
+	duration := time.Duration(options.MatchDuration) * time.Minute
+	pitchIDs := make(map[string]uuid.UUID, len(venuePitches))
+	booked := make(map[string][]time.Time)
+	if options.VenueID != nil {
+		for _, pitch := range venuePitches {
+			pitchIDs[pitch.Name] = pitch.ID
+		}
+		planned := make(map[uuid.UUID]bool, len(matches))
+		for _, match := range matches {
+			planned[match.ID] = true
+		}
+		existing, err := uc.matchRepo.GetByVenue(*options.VenueID, slots[0].Add(-duration), slots[len(slots)-1].Add(duration))
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range existing {
+			if planned[match.ID] || match.PitchID == nil {
+				continue
+			}
+			for name, id := range pitchIDs {
+				if id == *match.PitchID {
+					booked[name] = append(booked[name], match.Date)
+				}
+			}
+		}
+	}
+
+	pitchFree := func(pitch string, kickoff time.Time) bool {
+		if pitchBusy[kickoff.Format(time.RFC3339)+"|"+pitch] {
+			return false
+		}
+		for _, other := range booked[pitch] {
+			diff := kickoff.Sub(other)
+			if diff < 0 {
+				diff = -diff
+			}
+			if diff < duration {
+				return false
+			}
+		}
+		return true
+	}
+
+	teamRested := func(teamID uuid.UUID, kickoff time.Time) bool {
+		for _, other := range teamKickoffs[teamID] {
+			diff := kickoff.Sub(other)
+			if diff < 0 {
+				diff = -diff
+			}
+			if diff < spacing {
+				return false
+			}
+		}
+		return true
+	}
+
+	timetable := &domain.Timetable{
+		TournamentID: tournamentID,
+		Round:        round,
+		Entries:      []domain.TimetableEntry{},
+		Unassigned:   []domain.UnassignedMatch{},
+	}
+
+	for _, match := range matches {
+		assigned := false
+		for _, slot := range slots {
+			if !teamAllowed(match.Team1ID, slot) || !teamAllowed(match.Team2ID, slot) {
+				continue
+			}
+			if !teamRested(match.Team1ID, slot) || !teamRested(match.Team2ID, slot) {
+				continue
+			}
+			for _, pitch := range options.Pitches {
+				if !pitchFree(pitch, slot) {
+					continue
+				}
+				pitchBusy[slot.Format(time.RFC3339)+"|"+pitch] = true
+				teamKickoffs[match.Team1ID] = append(teamKickoffs[match.Team1ID], slot)
+				teamKickoffs[match.Team2ID] = append(teamKickoffs[match.Team2ID], slot)
+				entry := domain.TimetableEntry{
+					MatchID: match.ID,
+					Team1ID: match.Team1ID,
+					Team2ID: match.Team2ID,
+					Pitch:   pitch,
+					Kickoff: slot,
+				}
+				if id, ok := pitchIDs[pitch]; ok {
+					entry.PitchID = &id
+				}
+				timetable.Entries = append(timetable.Entries, entry)
+				assigned = true
+				break
+			}
+			if assigned {
+				break
+			}
+		}
+		if !assigned {
+			timetable.Unassigned = append(timetable.Unassigned, domain.UnassignedMatch{
+				MatchID: match.ID,
+				Reason:  "no free pitch/slot satisfies the team constraints",
+			})
+		}
+	}
+
+	sort.Slice(timetable.Entries, func(i, j int) bool {
+		if timetable.Entries[i].Kickoff.Equal(timetable.Entries[j].Kickoff) {
+			return timetable.Entries[i].Pitch < timetable.Entries[j].Pitch
+		}
+		return timetable.Entries[i].Kickoff.Before(timetable.Entries[j].Kickoff)
+	})
+	timetable.GapMinutes = timetableGaps(slots, timetable.Entries)
+
+	return timetable, nil
+}
+
+// selectPitches devuelve las canchas de la sede; con names solo las nombradas,
+// en el mismo orden (que es el orden de preferencia del planificador)
+func (uc *FixtureUseCase) selectPitches(venueID uuid.UUID, names []string) ([]domain.Pitch, error) {
+	pitches, err := uc.pitchRepo.GetByVenue(venueID)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return pitches, nil
+	}
+
+	byName := make(map[string]domain.Pitch, len(pitches))
+	for _, pitch := range pitches {
+		byName[normalizeName(pitch.Name)] = pitch
+	}
+	selected := make([]domain.Pitch, 0, len(names))
+	for _, name := range names {
+		pitch, ok := byName[normalizeName(name)]
+		if !ok {
+			return nil, fmt.Errorf("pitch %q is not registered in the venue", name)
+		}
+		selected = append(selected, pitch)
+	}
+	return selected, nil
+}
+
+// timetableGaps cuenta los minutos de horarios sin ningún partido entre el
+// primer y el último horario utilizado
+func timetableGaps(slots []time.Time, entries []domain.TimetableEntry) int {
+	if len(entries) == 0 {
+		return 0
+	}
+	first := entries[0].Kickoff
+	last := entries[len(entries)-1].Kickoff
+
+	used := make(map[int64]bool, len(entries))
+	for _, entry := range entries {
+		used[entry.Kickoff.Unix()] = true
+	}
+
+	gaps := 0
+	for i, slot := range slots {
+		if slot.Before(first) || slot.After(last) || used[slot.Unix()] {
+			continue
+		}
+		if i+1 < len(slots) {
+			gaps += int(slots[i+1].Sub(slot).Minutes())
+		}
+	}
+	return gaps
+}
+
+// SplitLeague divide la liga tras la jornada AfterRound en grupos según la tabla
+// (p. ej. top 6 / bottom 6). Cada grupo se crea como un torneo hijo con los puntos
+// arrastrados y su propio fixture todos contra todos.
+func (uc *FixtureUseCase) SplitLeague(tournamentID uuid.UUID, options domain.SplitOptions) (*domain.SplitResult, error) {
+	parent, err := uc.tournamentRepo.GetByID(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := parent.CheckSchedulable(); err != nil {
+		return nil, err
+	}
+
+	switch options.CarryOver {
+	case domain.CarryOverFull, domain.CarryOverHalf, domain.CarryOverNone:
+	case "":
+		options.CarryOver = domain.CarryOverFull
+	default:
+		return nil, fmt.Errorf("carry_over must be one of: full, half, none")
+	}
+	if options.AfterRound <= 0 {
+		return nil, fmt.Errorf("after_round must be greater than zero")
+	}
+	if len(options.Groups) < 2 {
+		return nil, fmt.Errorf("at least two split groups are required")
+	}
+	if options.StartDate.IsZero() {
+		return nil, fmt.Errorf("start_date is required")
+	}
+	if options.DaysBetweenRounds <= 0 {
+		options.DaysBetweenRounds = 7
+	}
+
+	standings, err := uc.tournamentRepo.GetStandings(tournamentID, options.AfterRound, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	total := 0
+	for _, group := range options.Groups {
+		if group.Name == "" {
+			return nil, fmt.Errorf("split group name is required")
+		}
+		if group.Size < 2 {
+			return nil, fmt.Errorf("split group %q needs at least two teams", group.Name)
+		}
+		total += group.Size
+	}
+	if total != len(standings) {
+		return nil, fmt.Errorf("split groups cover %d teams but the tournament has %d", total, len(standings))
+	}
+
+	result := &domain.SplitResult{
+		TournamentID: tournamentID,
+		CarryOver:    options.CarryOver,
+		Groups:       []domain.SplitGroupResult{},
+	}
+
+	offset := 0
+	for _, group := range options.Groups {
+		rows := standings[offset : offset+group.Size]
+		offset += group.Size
+
+		child := domain.NewTournament(fmt.Sprintf("%s - %s", parent.Name, group.Name))
+		child.ParentTournamentID = &parent.ID
+		child.SeasonID = parent.SeasonID
+		child.Status = parent.Status
+		child.ResultsDelayMinutes = parent.ResultsDelayMinutes
+		child.IsTest = parent.IsTest
+		if err := uc.tournamentRepo.Create(child); err != nil {
+			return nil, err
+		}
+
+		groupStandings := make([]domain.Standing, 0, len(rows))
+		teamIDs := make([]uuid.UUID, 0, len(rows))
+		for _, row := range rows {
+			points := carriedPoints(row.Points, options.CarryOver)
+			if err := uc.tournamentRepo.AddTeam(child.ID, row.TeamID); err != nil {
+				return nil, err
+			}
+			if err := uc.tournamentRepo.SetInitialPoints(child.ID, row.TeamID, points); err != nil {
+				return nil, err
+			}
+			teamIDs = append(teamIDs, row.TeamID)
+			groupStandings = append(groupStandings, domain.Standing{
+				TeamID:        row.TeamID,
+				TeamName:      row.TeamName,
+				CarriedPoints: points,
+				Points:        points,
+			})
+		}
+		domain.SortStandings(groupStandings)
+
+		created, err := uc.createRoundRobin(child.ID, teamIDs, options.StartDate, options.DaysBetweenRounds, options.DoubleRoundRobin)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Groups = append(result.Groups, domain.SplitGroupResult{
+			Tournament: *child,
+			Standings:  groupStandings,
+			Matches:    created,
+		})
+	}
+
+	return result, nil
+}
+
+// carriedPoints aplica el modo de arrastre; "half" redondea hacia arriba
+func carriedPoints(points int, mode string) int {
+	switch mode {
+	case domain.CarryOverHalf:
+		return (points + 1) / 2
+	case domain.CarryOverNone:
+		return 0
+	default:
+		return points
+	}
+}
+
+// createRoundRobin genera y guarda un fixture todos contra todos para el torneo
+func (uc *FixtureUseCase) createRoundRobin(tournamentID uuid.UUID, teamIDs []uuid.UUID, start time.Time, daysBetweenRounds int, double bool) (int, error) {
+	rounds := roundRobin(teamIDs)
+	if double {
+		// Segunda vuelta con localía invertida
+		for _, pairs := range roundRobin(teamIDs) {
+			mirrored := make([][2]uuid.UUID, 0, len(pairs))
+			for _, pair := range pairs {
+				mirrored = append(mirrored, [2]uuid.UUID{pair[1], pair[0]})
+			}
+			rounds = append(rounds, mirrored)
+		}
+	}
+
+	number := 1
+	for i, pairs := range rounds {
+		date := start.AddDate(0, 0, i*daysBetweenRounds)
+		for _, pair := range pairs {
+			match := domain.NewMatch(number, date, pair[0], pair[1], 0, 0)
+			match.TournamentID = &tournamentID
+			match.Round = i + 1
+			if err := uc.matchRepo.Create(match); err != nil {
+				return number - 1, err
+			}
+			number++
+		}
+	}
+	return number - 1, nil
+}
+
+// roundRobin usa el método del círculo: un equipo queda fijo y el resto rota.
+// Con un número impar de equipos se agrega un descanso (uuid.Nil).
+func roundRobin(teamIDs []uuid.UUID) [][][2]uuid.UUID {
+	list := append([]uuid.UUID(nil), teamIDs...)
+	if len(list)%2 == 1 {
+		list = append(list, uuid.Nil)
+	}
+	n := len(list)
+
+	var rounds [][][2]uuid.UUID
+	for round := 0; round < n-1; round++ {
+		var pairs [][2]uuid.UUID
+		for i := 0; i < n/2; i++ {
+			home, away := list[i], list[n-1-i]
+			if home == uuid.Nil || away == uuid.Nil {
+				continue
+			}
+			// Alternar localía para equilibrar partidos en casa
+			if round%2 == 1 {
+				home, away = away, home
+			}
+			pairs = append(pairs, [2]uuid.UUID{home, away})
+		}
+		rounds = append(rounds, pairs)
+
+		// Rotar todos menos el primero
+		last := list[n-1]
+		copy(list[2:], list[1:n-1])
+		list[1] = last
+	}
+	return rounds
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// GuestCommands agrupa las operaciones que modifican invitados
+type GuestCommands interface {
+	PromoteGuest(teamID uuid.UUID, name string, playerID uuid.UUID) (*domain.GuestPromotion, error)
+}
+
+// GuestQueries agrupa las lecturas de invitados
+type GuestQueries interface {
+	GetTeamGuests(teamID uuid.UUID) ([]domain.Guest, error)
+}
+
+var (
+	_ GuestCommands = (*GuestUseCase)(nil)
+	_ GuestQueries  = (*GuestUseCase)(nil)
+)
+
+// GuestUseCase administra los invitados sin ficha de cada equipo: quién jugó
+// y cuántos goles hizo, y el pase a un jugador inscripto cuando se completa
+// su ficha
+type GuestUseCase struct {
+	guestRepo repository.GuestRepository
+	teamRepo  repository.TeamRepository
+}
+
+func NewGuestUseCase(guestRepo repository.GuestRepository, teamRepo repository.TeamRepository) *GuestUseCase {
+	return &GuestUseCase{
+		guestRepo: guestRepo,
+		teamRepo:  teamRepo,
+	}
+}
+
+func (uc *GuestUseCase) GetTeamGuests(teamID uuid.UUID) ([]domain.Guest, error) {
+	if _, err := uc.teamRepo.GetByID(teamID); err != nil {
+		return nil, err
+	}
+	return uc.guestRepo.GetByTeam(teamID)
+}
+
+// PromoteGuest asocia el invitado a un jugador de la plantilla. El jugador
+// se crea y se agrega al equipo antes con los endpoints habituales.
+func (uc *GuestUseCase) PromoteGuest(teamID uuid.UUID, name string, playerID uuid.UUID) (*domain.GuestPromotion, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+
+	players, err := uc.teamRepo.GetTeamPlayers(teamID)
+	if err != nil {
+		return nil, err
+	}
+	inTeam := false
+	for _, player := range players {
+		if player.ID == playerID {
+			inTeam = true
+			break
+		}
+	}
+	if !inTeam {
+		return nil, fmt.Errorf("player does not belong to the team")
+	}
+
+	promotion, err := uc.guestRepo.Promote(teamID, name, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if promotion.Events == 0 && promotion.Lineups == 0 {
+		return nil, fmt.Errorf("guest not found")
+	}
+	return promotion, nil
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+)
+
+// IdempotencyCommands reserva las claves Idempotency-Key y guarda las
+// respuestas que se repiten en los reintentos. caller identifica a quien
+// envía la petición: cada uno tiene su propio espacio de claves.
+type IdempotencyCommands interface {
+	// BeginIdempotent reserva la clave para la petición y devuelve nil. Si
+	// la misma petición ya terminó devuelve su respuesta guardada; si sigue
+	// en curso, domain.ErrIdempotencyKeyInUse, y si la clave se usó con otra
+	// petición, domain.ErrIdempotencyKeyReused.
+	BeginIdempotent(caller, key, fingerprint string) (*domain.IdempotencyRecord, error)
+	// FinishIdempotent guarda la respuesta de la clave reservada
+	FinishIdempotent(caller, key string, statusCode int, contentType string, body []byte) error
+	// AbortIdempotent libera la clave sin guardar respuesta, para que un
+	// reintento vuelva a ejecutar la petición
+	AbortIdempotent(caller, key string) error
+}
+
+var _ IdempotencyCommands = (*IdempotencyUseCase)(nil)
+
+type IdempotencyUseCase struct {
+	repo repository.IdempotencyRepository
+}
+
+func NewIdempotencyUseCase(repo repository.IdempotencyRepository) *IdempotencyUseCase {
+	return &IdempotencyUseCase{repo: repo}
+}
+
+func (uc *IdempotencyUseCase) BeginIdempotent(caller, key, fingerprint string) (*domain.IdempotencyRecord, error) {
+	if err := domain.ValidateIdempotencyKey(key); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	existing, err := uc.repo.Reserve(&domain.IdempotencyRecord{
+		Caller:      caller,
+		Key:         key,
+		Fingerprint: fingerprint,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(domain.IdempotencyKeyTTL),
+	}, now)
+	if err != nil || existing == nil {
+		return nil, err
+	}
+	if existing.Fingerprint != fingerprint {
+		return nil, domain.ErrIdempotencyKeyReused
+	}
+	if !existing.Completed() {
+		return nil, domain.ErrIdempotencyKeyInUse
+	}
+	return existing, nil
+}
+
+func (uc *IdempotencyUseCase) FinishIdempotent(caller, key string, statusCode int, contentType string, body []byte) error {
+	return uc.repo.Complete(&domain.IdempotencyRecord{Caller: caller, Key: key, StatusCode: statusCode, ContentType: contentType, Body: body})
+}
+
+func (uc *IdempotencyUseCase) AbortIdempotent(caller, key string) error {
+	return uc.repo.Release(caller, key)
+}
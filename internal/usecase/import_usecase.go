@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// ImportCommands importa equipos y jugadores desde planillas CSV
+type ImportCommands interface {
+	// ImportTeams crea un equipo por fila (columna name)
+	ImportTeams(rows []domain.ImportRow, dryRun bool) (*domain.ImportReport, error)
+	// ImportPlayers crea un jugador por fila (name, date_birth, position,
+	// preferred_foot, nationality) y lo suma al equipo de la columna team
+	ImportPlayers(rows []domain.ImportRow, dryRun bool) (*domain.ImportReport, error)
+}
+
+var _ ImportCommands = (*ImportUseCase)(nil)
+
+// ImportUseCase carga las planillas que mandan los clubes. Cada fila pasa
+// por los mismos casos de uso que un alta desde la API, así se aplican las
+// mismas reglas (nombres únicos, topes de plantel); las filas que no las
+// cumplen se informan sin frenar el resto.
+type ImportUseCase struct {
+	teams       TeamCommands
+	teamQueries TeamQueries
+	players     PlayerCommands
+}
+
+func NewImportUseCase(teams TeamCommands, teamQueries TeamQueries, players PlayerCommands) *ImportUseCase {
+	return &ImportUseCase{teams: teams, teamQueries: teamQueries, players: players}
+}
+
+// ImportTeams crea los equipos; con dryRun solo valida. Un nombre repetido
+// en el archivo o ya usado por otro equipo rechaza la fila.
+func (uc *ImportUseCase) ImportTeams(rows []domain.ImportRow, dryRun bool) (*domain.ImportReport, error) {
+	if err := checkImportRows(rows); err != nil {
+		return nil, err
+	}
+
+	report := &domain.ImportReport{Entity: domain.ImportTeams, DryRun: dryRun, Teams: []domain.Team{}, Rejected: []domain.ImportRejection{}}
+	seen := make(map[string]bool)
+	for _, row := range rows {
+		if row.Error != "" {
+			report.Reject(row, row.Error)
+			continue
+		}
+		name := row.Fields["name"]
+		if name == "" {
+			report.Reject(row, "name is required")
+			continue
+		}
+		if seen[normalizeName(name)] {
+			report.Reject(row, fmt.Sprintf("team %q is repeated in the file", name))
+			continue
+		}
+		seen[normalizeName(name)] = true
+
+		team := domain.NewTeam(name)
+		if dryRun {
+			check, err := uc.teamQueries.CheckTeamName(name, nil)
+			if err != nil {
+				return nil, err
+			}
+			if !check.Available {
+				report.Reject(row, fmt.Sprintf("team name %q is already taken", name))
+				continue
+			}
+		} else if err := uc.teams.CreateTeam(team); err != nil {
+			report.Reject(row, err.Error())
+			continue
+		}
+
+		report.Teams = append(report.Teams, *team)
+		report.Imported++
+	}
+
+	return report, nil
+}
+
+// ImportPlayers crea los jugadores; con dryRun solo valida. La columna team
+// (opcional) es el nombre de un equipo existente: si el jugador no entra en
+// el plantel (tope global o de un torneo) la fila se rechaza y el jugador no
+// se crea. Con dryRun no se revisan los topes de plantel.
+func (uc *ImportUseCase) ImportPlayers(rows []domain.ImportRow, dryRun bool) (*domain.ImportReport, error) {
+	if err := checkImportRows(rows); err != nil {
+		return nil, err
+	}
+
+	report := &domain.ImportReport{Entity: domain.ImportPlayers, DryRun: dryRun, Players: []domain.Player{}, Rejected: []domain.ImportRejection{}}
+	teamIDs := make(map[string]*uuid.UUID)
+	for _, row := range rows {
+		if row.Error != "" {
+			report.Reject(row, row.Error)
+			continue
+		}
+		if row.Fields["name"] == "" {
+			report.Reject(row, "name is required")
+			continue
+		}
+		dateBirth, err := parseImportDate(row.Fields["date_birth"])
+		if err != nil {
+			report.Reject(row, "invalid date_birth, use YYYY-MM-DD or ISO 8601")
+			continue
+		}
+
+		player := domain.NewPlayer(row.Fields["name"], dateBirth)
+		// Las planillas suelen venir con mayúsculas ("Forward", "ar")
+		player.Position = strings.ToLower(row.Fields["position"])
+		player.PreferredFoot = strings.ToLower(row.Fields["preferred_foot"])
+		player.Nationality = strings.ToUpper(row.Fields["nationality"])
+		if err := validatePlayer(player); err != nil {
+			report.Reject(row, err.Error())
+			continue
+		}
+
+		var teamID *uuid.UUID
+		if teamName := row.Fields["team"]; teamName != "" {
+			id, ok := teamIDs[normalizeName(teamName)]
+			if !ok {
+				check, err := uc.teamQueries.CheckTeamName(teamName, nil)
+				if err != nil {
+					return nil, err
+				}
+				id = check.ConflictID
+				teamIDs[normalizeName(teamName)] = id
+			}
+			if id == nil {
+				report.Reject(row, fmt.Sprintf("team %q does not exist", teamName))
+				continue
+			}
+			teamID = id
+		}
+
+		if !dryRun {
+			if err := uc.players.CreatePlayer(player); err != nil {
+				report.Reject(row, err.Error())
+				continue
+			}
+			if teamID != nil {
+				if err := uc.teams.AddPlayerToTeam(*teamID, player.ID); err != nil {
+					// El jugador no queda creado sin equipo: la fila se
+					// rechaza completa
+					if err := uc.players.DeletePlayer(player.ID); err != nil {
+						return nil, err
+					}
+					report.Reject(row, err.Error())
+					continue
+				}
+			}
+		}
+
+		report.Players = append(report.Players, *player)
+		report.Imported++
+	}
+
+	return report, nil
+}
+
+func checkImportRows(rows []domain.ImportRow) error {
+	if len(rows) == 0 {
+		return fmt.Errorf("CSV file has no rows")
+	}
+	if len(rows) > domain.MaxImportRows {
+		return fmt.Errorf("CSV file cannot have more than %d rows", domain.MaxImportRows)
+	}
+	return nil
+}
+
+// parseImportDate acepta la fecha sola (2000-01-31), como la exportan las
+// planillas, o en ISO 8601 como el resto de la API
+func parseImportDate(value string) (time.Time, error) {
+	if date, err := time.Parse(time.DateOnly, value); err == nil {
+		return date, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
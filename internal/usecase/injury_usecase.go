@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// InjuryCommands agrupa las operaciones que modifican lesiones
+type InjuryCommands interface {
+	CreateInjury(injury *domain.Injury) error
+	UpdateInjury(injury *domain.Injury) error
+	DeleteInjury(playerID, id uuid.UUID) error
+}
+
+// InjuryQueries agrupa las lecturas de lesiones
+type InjuryQueries interface {
+	GetInjury(playerID, id uuid.UUID) (*domain.Injury, error)
+	// GetPlayerInjuries devuelve las lesiones de la más reciente a la más antigua
+	GetPlayerInjuries(playerID uuid.UUID) ([]domain.Injury, error)
+}
+
+var (
+	_ InjuryCommands = (*InjuryUseCase)(nil)
+	_ InjuryQueries  = (*InjuryUseCase)(nil)
+)
+
+// InjuryUseCase gestiona las lesiones de los jugadores
+type InjuryUseCase struct {
+	injuryRepo repository.InjuryRepository
+	playerRepo repository.PlayerRepository
+}
+
+func NewInjuryUseCase(injuryRepo repository.InjuryRepository, playerRepo repository.PlayerRepository) *InjuryUseCase {
+	return &InjuryUseCase{
+		injuryRepo: injuryRepo,
+		playerRepo: playerRepo,
+	}
+}
+
+func (uc *InjuryUseCase) CreateInjury(injury *domain.Injury) error {
+	if _, err := uc.playerRepo.GetByID(injury.PlayerID); err != nil {
+		return err
+	}
+	if err := validateInjury(injury); err != nil {
+		return err
+	}
+	return uc.injuryRepo.Create(injury)
+}
+
+func (uc *InjuryUseCase) GetInjury(playerID, id uuid.UUID) (*domain.Injury, error) {
+	injury, err := uc.injuryRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if injury.PlayerID != playerID {
+		return nil, fmt.Errorf("injury not found")
+	}
+	return injury, nil
+}
+
+func (uc *InjuryUseCase) GetPlayerInjuries(playerID uuid.UUID) ([]domain.Injury, error) {
+	if _, err := uc.playerRepo.GetByID(playerID); err != nil {
+		return nil, err
+	}
+	return uc.injuryRepo.GetByPlayer(playerID)
+}
+
+func (uc *InjuryUseCase) UpdateInjury(injury *domain.Injury) error {
+	if _, err := uc.GetInjury(injury.PlayerID, injury.ID); err != nil {
+		return err
+	}
+	if err := validateInjury(injury); err != nil {
+		return err
+	}
+	return uc.injuryRepo.Update(injury)
+}
+
+func (uc *InjuryUseCase) DeleteInjury(playerID, id uuid.UUID) error {
+	if _, err := uc.GetInjury(playerID, id); err != nil {
+		return err
+	}
+	return uc.injuryRepo.Delete(id)
+}
+
+func validateInjury(injury *domain.Injury) error {
+	if !domain.IsValidInjuryType(injury.Type) {
+		return fmt.Errorf("invalid injury type: %s", injury.Type)
+	}
+	if injury.ExpectedReturn != nil && !injury.ExpectedReturn.After(injury.StartDate) {
+		return fmt.Errorf("expected_return must be after start_date")
+	}
+	return nil
+}
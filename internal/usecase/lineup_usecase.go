@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// LineupCommands agrupa las operaciones que modifican alineaciones
+type LineupCommands interface {
+	SaveLineup(lineup *domain.Lineup) error
+}
+
+// LineupQueries agrupa las lecturas de alineaciones
+type LineupQueries interface {
+	GetMatchLineups(matchID uuid.UUID) ([]domain.Lineup, error)
+}
+
+var (
+	_ LineupCommands = (*LineupUseCase)(nil)
+	_ LineupQueries  = (*LineupUseCase)(nil)
+)
+
+// LineupUseCase gestiona el once inicial y los suplentes de cada partido
+type LineupUseCase struct {
+	lineupRepo repository.LineupRepository
+	matchRepo  repository.MatchRepository
+	teamRepo   repository.TeamRepository
+	injuryRepo repository.InjuryRepository
+	// registrationRepo valida que los jugadores estén fichados en el torneo
+	registrationRepo repository.RegistrationRepository
+}
+
+func NewLineupUseCase(lineupRepo repository.LineupRepository, matchRepo repository.MatchRepository, teamRepo repository.TeamRepository, injuryRepo repository.InjuryRepository, registrationRepo repository.RegistrationRepository) *LineupUseCase {
+	return &LineupUseCase{
+		lineupRepo:       lineupRepo,
+		matchRepo:        matchRepo,
+		teamRepo:         teamRepo,
+		injuryRepo:       injuryRepo,
+		registrationRepo: registrationRepo,
+	}
+}
+
+// SaveLineup valida la alineación contra la formación, la plantilla del
+// equipo, los fichajes del torneo y las lesiones vigentes el día del
+// partido y la guarda,
+// reemplazando la anterior del mismo equipo
+func (uc *LineupUseCase) SaveLineup(lineup *domain.Lineup) error {
+	match, err := uc.matchRepo.GetByID(lineup.MatchID)
+	if err != nil {
+		return err
+	}
+	if lineup.TeamID != match.Team1ID && lineup.TeamID != match.Team2ID {
+		return fmt.Errorf("team does not play in this match")
+	}
+
+	size, err := domain.FormationSize(lineup.Formation)
+	if err != nil {
+		return err
+	}
+	if len(lineup.Starting) != size {
+		return fmt.Errorf("formation %s requires %d starters, got %d", lineup.Formation, size, len(lineup.Starting))
+	}
+
+	players, err := uc.teamRepo.GetTeamPlayers(lineup.TeamID)
+	if err != nil {
+		return err
+	}
+	roster := make(map[uuid.UUID]bool, len(players))
+	for _, player := range players {
+		roster[player.ID] = true
+	}
+
+	selected := append(append([]uuid.UUID{}, lineup.Starting...), lineup.Bench...)
+	seen := make(map[uuid.UUID]bool)
+	for _, playerID := range selected {
+		if !roster[playerID] {
+			return fmt.Errorf("player %s does not belong to the team", playerID)
+		}
+		if seen[playerID] {
+			return fmt.Errorf("player %s is listed more than once", playerID)
+		}
+		seen[playerID] = true
+	}
+	if err := checkEligibility(uc.registrationRepo, match, lineup.TeamID, selected...); err != nil {
+		return err
+	}
+
+	guests := make(map[string]bool, len(lineup.Guests))
+	for _, name := range lineup.Guests {
+		key := strings.ToLower(strings.TrimSpace(name))
+		if key == "" {
+			return fmt.Errorf("guest names cannot be empty")
+		}
+		if guests[key] {
+			return fmt.Errorf("guest %s is listed more than once", name)
+		}
+		guests[key] = true
+	}
+
+	injuries, err := uc.injuryRepo.GetActive(selected, match.Date)
+	if err != nil {
+		return err
+	}
+	if len(injuries) > 0 {
+		return fmt.Errorf("player %s is injured (%s)", injuries[0].PlayerID, injuries[0].Type)
+	}
+
+	return uc.lineupRepo.Save(lineup)
+}
+
+func (uc *LineupUseCase) GetMatchLineups(matchID uuid.UUID) ([]domain.Lineup, error) {
+	if _, err := uc.matchRepo.GetByID(matchID); err != nil {
+		return nil, err
+	}
+	return uc.lineupRepo.GetByMatch(matchID)
+}
@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// MatchClockCommands agrupa las operaciones sobre el reloj de un partido
+type MatchClockCommands interface {
+	// ApplyClockAction inicia, pausa, reanuda o cierra un período, o anuncia
+	// el descuento (stoppageMinutes)
+	ApplyClockAction(matchID uuid.UUID, action string, stoppageMinutes int) (*domain.MatchClock, error)
+}
+
+// MatchClockQueries agrupa las lecturas del reloj de un partido
+type MatchClockQueries interface {
+	GetMatchClock(matchID uuid.UUID) (*domain.MatchClock, error)
+}
+
+var (
+	_ MatchClockCommands = (*MatchClockUseCase)(nil)
+	_ MatchClockQueries  = (*MatchClockUseCase)(nil)
+)
+
+// MatchClockUseCase lleva el reloj de cada partido y difunde sus cambios en vivo
+type MatchClockUseCase struct {
+	clockRepo      repository.MatchClockRepository
+	matchRepo      repository.MatchRepository
+	tournamentRepo repository.TournamentRepository
+	rulesRepo      repository.TournamentRulesRepository
+	publisher      MatchPublisher
+}
+
+func NewMatchClockUseCase(clockRepo repository.MatchClockRepository, matchRepo repository.MatchRepository, tournamentRepo repository.TournamentRepository, rulesRepo repository.TournamentRulesRepository, publisher MatchPublisher) *MatchClockUseCase {
+	return &MatchClockUseCase{
+		clockRepo:      clockRepo,
+		matchRepo:      matchRepo,
+		tournamentRepo: tournamentRepo,
+		rulesRepo:      rulesRepo,
+		publisher:      publisher,
+	}
+}
+
+// GetMatchClock devuelve el reloj con el minuto actual; un partido que no
+// empezó tiene el reloj detenido en cero
+func (uc *MatchClockUseCase) GetMatchClock(matchID uuid.UUID) (*domain.MatchClock, error) {
+	clock, err := uc.loadClock(matchID)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := clock.At(time.Now().UTC())
+	return &snapshot, nil
+}
+
+func (uc *MatchClockUseCase) ApplyClockAction(matchID uuid.UUID, action string, stoppageMinutes int) (*domain.MatchClock, error) {
+	clock, err := uc.loadClock(matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if action == domain.ClockActionStart && clock.Period >= domain.RegulationPeriods {
+		if err := uc.checkExtraTime(matchID); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now().UTC()
+	if err := clock.Apply(action, stoppageMinutes, now); err != nil {
+		return nil, err
+	}
+	if err := uc.clockRepo.Save(clock); err != nil {
+		return nil, err
+	}
+	if clock.Status == domain.ClockFinished {
+		if err := uc.confirmResult(matchID, now); err != nil {
+			return nil, err
+		}
+	}
+
+	snapshot := clock.At(now)
+	if uc.publisher != nil {
+		update := domain.NewMatchUpdate(domain.MatchUpdateClock, matchID)
+		update.Clock = &snapshot
+		uc.publisher.PublishMatchUpdate(update)
+	}
+	return &snapshot, nil
+}
+
+// confirmResult da por definitivo el marcador del partido cuando termina el
+// reloj, así entra en la tabla y en el ranking
+func (uc *MatchClockUseCase) confirmResult(matchID uuid.UUID, now time.Time) error {
+	match, err := uc.matchRepo.GetByID(matchID)
+	if err != nil {
+		return err
+	}
+	if match.ResultConfirmedAt != nil {
+		return nil
+	}
+	match.ConfirmResult(now)
+	if err := uc.matchRepo.Update(match); err != nil {
+		return err
+	}
+	decideWinner(match)
+	publishMatch(uc.publisher, uc.tournamentRepo, match)
+	return nil
+}
+
+// checkExtraTime rechaza iniciar la prórroga en torneos que desempatan
+// directo por penales
+func (uc *MatchClockUseCase) checkExtraTime(matchID uuid.UUID) error {
+	match, err := uc.matchRepo.GetByID(matchID)
+	if err != nil || match.TournamentID == nil {
+		return err
+	}
+	rules, err := uc.rulesRepo.Get(*match.TournamentID)
+	if err != nil {
+		return err
+	}
+	if !rules.AllowsExtraTime() {
+		return fmt.Errorf("extra time is not played in this tournament: ties go straight to penalties")
+	}
+	return nil
+}
+
+// loadClock lee el reloj guardado o crea uno nuevo con la duración del
+// torneo del partido
+func (uc *MatchClockUseCase) loadClock(matchID uuid.UUID) (*domain.MatchClock, error) {
+	match, err := uc.matchRepo.GetByID(matchID)
+	if err != nil {
+		return nil, err
+	}
+	if clock, err := uc.clockRepo.Get(matchID); err == nil {
+		return clock, nil
+	}
+
+	duration := domain.DefaultMatchDuration
+	if match.TournamentID != nil {
+		rules, err := uc.rulesRepo.Get(*match.TournamentID)
+		if err != nil {
+			return nil, err
+		}
+		duration = rules.MatchDuration
+	}
+	return domain.NewMatchClock(matchID, duration), nil
+}
+
+// checkClockMinute rechaza eventos con un minuto posterior al que marca el
+// reloj. Los partidos sin reloj (cargados después de jugarse) no se validan.
+func checkClockMinute(clockRepo repository.MatchClockRepository, matchID uuid.UUID, minute int) error {
+	clock, err := clockRepo.Get(matchID)
+	if err != nil || clock.Status == domain.ClockNotStarted {
+		return nil
+	}
+	if current := clock.TotalMinute(time.Now().UTC()); minute > current {
+		return fmt.Errorf("minute %d is ahead of the match clock (%d')", minute, current)
+	}
+	return nil
+}
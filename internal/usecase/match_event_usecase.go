@@ -0,0 +1,256 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// maxEventMinute cubre prórroga y descuentos
+const maxEventMinute = 150
+
+// maxGuestNameLength coincide con la columna match_events.guest_name
+const maxGuestNameLength = 255
+
+// MatchEventCommands agrupa las operaciones que modifican eventos de partido
+type MatchEventCommands interface {
+	CreateMatchEvent(event *domain.MatchEvent) error
+	DeleteMatchEvent(matchID, id uuid.UUID) error
+}
+
+// MatchEventQueries agrupa las lecturas de eventos de partido
+type MatchEventQueries interface {
+	GetMatchEvent(matchID, id uuid.UUID) (*domain.MatchEvent, error)
+	GetMatchEvents(matchID uuid.UUID) ([]domain.MatchEvent, error)
+	HideEmbargoedEvents(matchID uuid.UUID, events []domain.MatchEvent) ([]domain.MatchEvent, error)
+}
+
+var (
+	_ MatchEventCommands = (*MatchEventUseCase)(nil)
+	_ MatchEventQueries  = (*MatchEventUseCase)(nil)
+)
+
+// MatchEventUseCase registra goles, autogoles y tarjetas de cada partido.
+// El marcador del partido se sigue cargando aparte; los eventos aportan el
+// detalle (quién y en qué minuto).
+type MatchEventUseCase struct {
+	eventRepo      repository.MatchEventRepository
+	matchRepo      repository.MatchRepository
+	teamRepo       repository.TeamRepository
+	tournamentRepo repository.TournamentRepository
+	// registrationRepo valida que los jugadores estén fichados en el torneo
+	registrationRepo repository.RegistrationRepository
+	// clockRepo valida los minutos contra el reloj del partido
+	clockRepo repository.MatchClockRepository
+	// rulesRepo indica si la prórroga termina con gol de oro
+	rulesRepo repository.TournamentRulesRepository
+	publisher MatchPublisher
+}
+
+func NewMatchEventUseCase(eventRepo repository.MatchEventRepository, matchRepo repository.MatchRepository, teamRepo repository.TeamRepository, tournamentRepo repository.TournamentRepository, registrationRepo repository.RegistrationRepository, clockRepo repository.MatchClockRepository, rulesRepo repository.TournamentRulesRepository, publisher MatchPublisher) *MatchEventUseCase {
+	return &MatchEventUseCase{
+		eventRepo:        eventRepo,
+		matchRepo:        matchRepo,
+		teamRepo:         teamRepo,
+		tournamentRepo:   tournamentRepo,
+		registrationRepo: registrationRepo,
+		clockRepo:        clockRepo,
+		rulesRepo:        rulesRepo,
+		publisher:        publisher,
+	}
+}
+
+// CreateMatchEvent registra un evento. Un cliente que cargó eventos sin
+// conexión puede reenviarlos con su ID: si ya existe se devuelve el guardado.
+func (uc *MatchEventUseCase) CreateMatchEvent(event *domain.MatchEvent) error {
+	if existing, err := uc.eventRepo.GetByID(event.ID); err == nil {
+		if existing.MatchID != event.MatchID {
+			return fmt.Errorf("event id already used in another match")
+		}
+		*event = *existing
+		return nil
+	}
+
+	match, err := uc.matchRepo.GetByID(event.MatchID)
+	if err != nil {
+		return err
+	}
+	if err := uc.validateEvent(match, event); err != nil {
+		return err
+	}
+
+	if err := uc.eventRepo.Create(event); err != nil {
+		return err
+	}
+	publishEvent(uc.publisher, uc.tournamentRepo, match, domain.MatchUpdateEventCreated, event)
+	return uc.applyGoldenGoal(match, event)
+}
+
+// applyGoldenGoal termina el reloj del partido con el primer gol de la
+// prórroga si el torneo se desempata con gol de oro
+func (uc *MatchEventUseCase) applyGoldenGoal(match *domain.Match, event *domain.MatchEvent) error {
+	if !event.IsGoal() || match.TournamentID == nil {
+		return nil
+	}
+	clock, err := uc.clockRepo.Get(match.ID)
+	if err != nil || clock.Period <= domain.RegulationPeriods {
+		return nil
+	}
+	if clock.Status != domain.ClockRunning && clock.Status != domain.ClockPaused {
+		return nil
+	}
+
+	rules, err := uc.rulesRepo.Get(*match.TournamentID)
+	if err != nil || rules.KnockoutTiebreak != domain.TiebreakGoldenGoal {
+		return err
+	}
+
+	now := time.Now().UTC()
+	if err := clock.Apply(domain.ClockActionFinish, 0, now); err != nil {
+		return err
+	}
+	if err := uc.clockRepo.Save(clock); err != nil {
+		return err
+	}
+	if uc.publisher != nil {
+		snapshot := clock.At(now)
+		update := domain.NewMatchUpdate(domain.MatchUpdateClock, match.ID)
+		update.Clock = &snapshot
+		uc.publisher.PublishMatchUpdate(update)
+	}
+	return nil
+}
+
+// validateEvent aplica las reglas de un evento sobre el partido al que pertenece
+func (uc *MatchEventUseCase) validateEvent(match *domain.Match, event *domain.MatchEvent) error {
+	if !domain.IsValidEventType(event.Type) {
+		return fmt.Errorf("invalid event type: %s", event.Type)
+	}
+	if event.Minute < 0 || event.Minute > maxEventMinute {
+		return fmt.Errorf("minute must be between 0 and %d", maxEventMinute)
+	}
+	if err := checkClockMinute(uc.clockRepo, match.ID, event.Minute); err != nil {
+		return err
+	}
+	if event.TeamID != match.Team1ID && event.TeamID != match.Team2ID {
+		return fmt.Errorf("team does not play in this match")
+	}
+
+	// Un invitado sin ficha se identifica solo por nombre
+	event.GuestName = strings.TrimSpace(event.GuestName)
+	if event.GuestName != "" {
+		if event.PlayerID != nil {
+			return fmt.Errorf("player_id and guest_name are mutually exclusive")
+		}
+		if utf8.RuneCountInString(event.GuestName) > maxGuestNameLength {
+			return fmt.Errorf("guest_name must be at most %d characters", maxGuestNameLength)
+		}
+	}
+
+	// El goleador puede ser desconocido, pero una tarjeta siempre tiene jugador
+	if event.PlayerID == nil {
+		if !event.IsGoal() && event.GuestName == "" {
+			return fmt.Errorf("player_id or guest_name is required for %s events", event.Type)
+		}
+	} else if err := uc.validatePlayer(match, event.TeamID, *event.PlayerID); err != nil {
+		return err
+	}
+
+	if event.AssistPlayerID != nil {
+		if event.Type != domain.EventGoal {
+			return fmt.Errorf("assists can only be linked to goal events")
+		}
+		if event.PlayerID != nil && *event.AssistPlayerID == *event.PlayerID {
+			return fmt.Errorf("a player cannot assist their own goal")
+		}
+		if err := uc.validatePlayer(match, event.TeamID, *event.AssistPlayerID); err != nil {
+			return fmt.Errorf("assist: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (uc *MatchEventUseCase) GetMatchEvent(matchID, id uuid.UUID) (*domain.MatchEvent, error) {
+	event, err := uc.eventRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if event.MatchID != matchID {
+		return nil, fmt.Errorf("match event not found")
+	}
+	return event, nil
+}
+
+func (uc *MatchEventUseCase) GetMatchEvents(matchID uuid.UUID) ([]domain.MatchEvent, error) {
+	if _, err := uc.matchRepo.GetByID(matchID); err != nil {
+		return nil, err
+	}
+	return uc.eventRepo.GetByMatch(matchID)
+}
+
+func (uc *MatchEventUseCase) DeleteMatchEvent(matchID, id uuid.UUID) error {
+	event, err := uc.GetMatchEvent(matchID, id)
+	if err != nil {
+		return err
+	}
+	match, err := uc.matchRepo.GetByID(matchID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.eventRepo.Delete(id); err != nil {
+		return err
+	}
+	publishEvent(uc.publisher, uc.tournamentRepo, match, domain.MatchUpdateEventDeleted, event)
+	return nil
+}
+
+// HideEmbargoedEvents quita los goles mientras el resultado del partido está
+// embargado, para que los eventos no revelen el marcador (ver HideEmbargoedResults)
+func (uc *MatchEventUseCase) HideEmbargoedEvents(matchID uuid.UUID, events []domain.MatchEvent) ([]domain.MatchEvent, error) {
+	match, err := uc.matchRepo.GetByID(matchID)
+	if err != nil {
+		return nil, err
+	}
+	if match.TournamentID == nil {
+		return events, nil
+	}
+
+	tournament, err := uc.tournamentRepo.GetByID(*match.TournamentID)
+	if err != nil {
+		return nil, err
+	}
+	until := match.Date.Add(time.Duration(tournament.ResultsDelayMinutes) * time.Minute)
+	if tournament.ResultsDelayMinutes == 0 || !time.Now().UTC().Before(until) {
+		return events, nil
+	}
+
+	visible := make([]domain.MatchEvent, 0, len(events))
+	for _, event := range events {
+		if !event.IsGoal() {
+			visible = append(visible, event)
+		}
+	}
+	return visible, nil
+}
+
+// validatePlayer comprueba que el jugador pertenece al equipo del evento y
+// está fichado con él en el torneo del partido
+func (uc *MatchEventUseCase) validatePlayer(match *domain.Match, teamID, playerID uuid.UUID) error {
+	players, err := uc.teamRepo.GetTeamPlayers(teamID)
+	if err != nil {
+		return err
+	}
+	for _, player := range players {
+		if player.ID == playerID {
+			return checkEligibility(uc.registrationRepo, match, teamID, playerID)
+		}
+	}
+	return fmt.Errorf("player does not belong to the team")
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// MatchForfeitCommands adjudica partidos por walkover y revoca adjudicaciones
+type MatchForfeitCommands interface {
+	// AwardForfeit da el partido al rival de forfeitingTeamID por
+	// awardedGoals a 0 (0 = domain.DefaultForfeitGoals)
+	AwardForfeit(matchID, forfeitingTeamID uuid.UUID, awardedGoals int, reason string) (*domain.MatchForfeit, error)
+	// RevokeForfeit devuelve al partido el resultado que tenía antes
+	RevokeForfeit(matchID uuid.UUID) (*domain.MatchForfeit, error)
+}
+
+// MatchForfeitQueries lee el historial de adjudicaciones de un partido
+type MatchForfeitQueries interface {
+	GetForfeits(matchID uuid.UUID) ([]domain.MatchForfeit, error)
+}
+
+var (
+	_ MatchForfeitCommands = (*MatchForfeitUseCase)(nil)
+	_ MatchForfeitQueries  = (*MatchForfeitUseCase)(nil)
+)
+
+// MatchForfeitUseCase aplica los walkover. El resultado adjudicado queda en
+// el partido como cualquier otro, así que la tabla lo cuenta sin cambios;
+// mientras esté vigente el marcador no se edita por las vías habituales.
+type MatchForfeitUseCase struct {
+	forfeitRepo    repository.MatchForfeitRepository
+	matchRepo      repository.MatchRepository
+	tournamentRepo repository.TournamentRepository
+	publisher      MatchPublisher
+}
+
+func NewMatchForfeitUseCase(forfeitRepo repository.MatchForfeitRepository, matchRepo repository.MatchRepository, tournamentRepo repository.TournamentRepository, publisher MatchPublisher) *MatchForfeitUseCase {
+	return &MatchForfeitUseCase{
+		forfeitRepo:    forfeitRepo,
+		matchRepo:      matchRepo,
+		tournamentRepo: tournamentRepo,
+		publisher:      publisher,
+	}
+}
+
+func (uc *MatchForfeitUseCase) AwardForfeit(matchID, forfeitingTeamID uuid.UUID, awardedGoals int, reason string) (*domain.MatchForfeit, error) {
+	match, err := uc.matchRepo.GetByID(matchID)
+	if err != nil {
+		return nil, err
+	}
+	if match.IsForfeit() {
+		return nil, fmt.Errorf("match was already awarded by forfeit")
+	}
+	if match.ParentMatchID != nil {
+		return nil, fmt.Errorf("cannot award a sub-match by forfeit; award the parent match")
+	}
+	subMatches, err := uc.matchRepo.GetSubMatches(match.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(subMatches) > 0 {
+		return nil, fmt.Errorf("cannot award a match with sub-matches by forfeit")
+	}
+
+	forfeit, err := domain.NewMatchForfeit(match, forfeitingTeamID, awardedGoals, reason)
+	if err != nil {
+		return nil, err
+	}
+	forfeit.Apply(match)
+	if err := uc.forfeitRepo.Award(forfeit, match); err != nil {
+		return nil, err
+	}
+
+	decideWinner(match)
+	publishMatch(uc.publisher, uc.tournamentRepo, match)
+	return forfeit, nil
+}
+
+func (uc *MatchForfeitUseCase) RevokeForfeit(matchID uuid.UUID) (*domain.MatchForfeit, error) {
+	match, err := uc.matchRepo.GetByID(matchID)
+	if err != nil {
+		return nil, err
+	}
+	forfeit, err := uc.forfeitRepo.GetActive(matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	forfeit.RevokedAt = &now
+	forfeit.Restore(match)
+	if err := uc.forfeitRepo.Revoke(forfeit, match); err != nil {
+		return nil, err
+	}
+
+	decideWinner(match)
+	publishMatch(uc.publisher, uc.tournamentRepo, match)
+	return forfeit, nil
+}
+
+func (uc *MatchForfeitUseCase) GetForfeits(matchID uuid.UUID) ([]domain.MatchForfeit, error) {
+	if _, err := uc.matchRepo.GetByID(matchID); err != nil {
+		return nil, err
+	}
+	return uc.forfeitRepo.GetByMatch(matchID)
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"log"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+)
+
+// MatchPublisher difunde en vivo los cambios de los partidos (lo implementa
+// realtime.Hub). Es opcional: con nil no se publica nada.
+type MatchPublisher interface {
+	PublishMatchUpdate(update domain.MatchUpdate)
+}
+
+// resultEmbargo indica hasta cuándo está embargado el resultado del partido
+func resultEmbargo(tournamentRepo repository.TournamentRepository, match *domain.Match) (time.Time, bool, error) {
+	if match.TournamentID == nil {
+		return time.Time{}, false, nil
+	}
+	tournament, err := tournamentRepo.GetByID(*match.TournamentID)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if tournament.ResultsDelayMinutes == 0 {
+		return time.Time{}, false, nil
+	}
+	until := match.Date.Add(time.Duration(tournament.ResultsDelayMinutes) * time.Minute)
+	return until, time.Now().UTC().Before(until), nil
+}
+
+// publishMatch difunde el partido guardado. Las actualizaciones llegan al
+// público, así que un resultado o una transmisión embargados se publican ocultos.
+func publishMatch(publisher MatchPublisher, tournamentRepo repository.TournamentRepository, match *domain.Match) {
+	publishMatchUpdate(publisher, tournamentRepo, domain.MatchUpdateScore, match)
+}
+
+// publishMatchDeleted difunde el borrado del partido con sus últimos datos,
+// para que la tabla y los ratings se recalculen sin él
+func publishMatchDeleted(publisher MatchPublisher, tournamentRepo repository.TournamentRepository, match *domain.Match) {
+	publishMatchUpdate(publisher, tournamentRepo, domain.MatchUpdateDeleted, match)
+}
+
+func publishMatchUpdate(publisher MatchPublisher, tournamentRepo repository.TournamentRepository, updateType string, match *domain.Match) {
+	if publisher == nil {
+		return
+	}
+	until, embargoed, err := resultEmbargo(tournamentRepo, match)
+	if err != nil {
+		return
+	}
+
+	visible := *match
+	visible.HideStream(time.Now().UTC())
+	if embargoed {
+		visible.HideResult(until)
+	}
+	update := domain.NewMatchUpdate(updateType, match.ID)
+	update.Match = &visible
+	publisher.PublishMatchUpdate(update)
+}
+
+// publishEvent difunde un evento creado o borrado. Los goles de un partido
+// embargado no se publican para no revelar el marcador.
+func publishEvent(publisher MatchPublisher, tournamentRepo repository.TournamentRepository, match *domain.Match, updateType string, event *domain.MatchEvent) {
+	if publisher == nil {
+		return
+	}
+	if event.IsGoal() {
+		if _, embargoed, err := resultEmbargo(tournamentRepo, match); err != nil || embargoed {
+			return
+		}
+	}
+
+	update := domain.NewMatchUpdate(updateType, match.ID)
+	update.Event = event
+	publisher.PublishMatchUpdate(update)
+}
+
+// MatchPublishers reparte cada actualización entre varios publicadores
+// (el hub en vivo, el recálculo de ratings...)
+type MatchPublishers []MatchPublisher
+
+func (p MatchPublishers) PublishMatchUpdate(update domain.MatchUpdate) {
+	for _, publisher := range p {
+		publishIsolated(publisher, update)
+	}
+}
+
+// publishIsolated publica en un solo publicador. Se publica después de
+// guardar, así que un publicador que falla no puede cortar la respuesta de
+// un cambio que ya quedó guardado (el cliente lo reintentaría y lo
+// duplicaría) ni dejar sin aviso a los demás publicadores.
+func publishIsolated(publisher MatchPublisher, update domain.MatchUpdate) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("⚠️  Match update publisher %T failed: %v", publisher, r)
+		}
+	}()
+	publisher.PublishMatchUpdate(update)
+}
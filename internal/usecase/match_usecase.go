@@ -2,54 +2,519 @@ package usecase
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
 	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
 	"github.com/google/uuid"
 )
 
+// MatchCommands agrupa las operaciones que modifican partidos
+type MatchCommands interface {
+	CreateMatch(match *domain.Match) error
+	UpdateMatch(match *domain.Match) error
+	DeleteMatch(id uuid.UUID) error
+	CreateSubMatch(parentID uuid.UUID, subMatch *domain.Match) error
+}
+
+// MatchQueries agrupa las lecturas de partidos
+type MatchQueries interface {
+	GetMatchByID(id uuid.UUID) (*domain.Match, error)
+	// GetAllMatches lista los partidos; los de torneos archivados solo con includeArchived
+	GetAllMatches(includeArchived bool) ([]domain.Match, error)
+	// FindMatches lista los partidos que cumplen el filtro; sin
+	// filter.Now el estado se evalúa a la hora actual
+	FindMatches(filter domain.MatchFilter) ([]domain.Match, error)
+	// FindMatchesPage devuelve una página de FindMatches con el total
+	FindMatchesPage(filter domain.MatchFilter, page domain.Page) (domain.Paged[domain.Match], error)
+	// SeasonID resuelve una temporada por ID o nombre (p. ej. "2024/25")
+	// para MatchFilter.SeasonID
+	SeasonID(season string) (uuid.UUID, error)
+	GetSubMatches(parentID uuid.UUID) ([]domain.Match, error)
+	HideEmbargoedResults(matches []domain.Match) error
+	// AttachTeams completa Team1 y Team2 (sin planteles) para las
+	// exportaciones, que muestran los nombres
+	AttachTeams(matches []domain.Match) error
+	AttendanceQueries
+}
+
+// AttendanceQueries completa el público permitido de los partidos
+type AttendanceQueries interface {
+	// AttachAttendance completa Match.Attendance en los partidos en juego o
+	// por jugar que tienen restricciones de público
+	AttachAttendance(matches []domain.Match) error
+}
+
+var (
+	_ MatchCommands     = (*MatchUseCase)(nil)
+	_ MatchQueries      = (*MatchUseCase)(nil)
+	_ AttendanceQueries = (*MatchUseCase)(nil)
+)
+
 type MatchUseCase struct {
-	matchRepo repository.MatchRepository
-	teamRepo  repository.TeamRepository
+	matchRepo      repository.MatchRepository
+	teamRepo       repository.TeamRepository
+	tournamentRepo repository.TournamentRepository
+	conflictRepo   repository.SyncConflictRepository
+	refereeRepo    repository.RefereeRepository
+	venueRepo      repository.VenueRepository
+	pitchRepo      repository.PitchRepository
+	seasonRepo     repository.SeasonRepository
+	stageRepo      repository.StageRepository
+	// rulesRepo aplica el desempate de cada torneo a los resultados
+	rulesRepo repository.TournamentRulesRepository
+	publisher MatchPublisher
 }
 
-func NewMatchUseCase(matchRepo repository.MatchRepository, teamRepo repository.TeamRepository) *MatchUseCase {
+func NewMatchUseCase(matchRepo repository.MatchRepository, teamRepo repository.TeamRepository, tournamentRepo repository.TournamentRepository, conflictRepo repository.SyncConflictRepository, refereeRepo repository.RefereeRepository, venueRepo repository.VenueRepository, pitchRepo repository.PitchRepository, seasonRepo repository.SeasonRepository, stageRepo repository.StageRepository, rulesRepo repository.TournamentRulesRepository, publisher MatchPublisher) *MatchUseCase {
 	return &MatchUseCase{
-		matchRepo: matchRepo,
-		teamRepo:  teamRepo,
+		matchRepo:      matchRepo,
+		teamRepo:       teamRepo,
+		tournamentRepo: tournamentRepo,
+		conflictRepo:   conflictRepo,
+		refereeRepo:    refereeRepo,
+		venueRepo:      venueRepo,
+		pitchRepo:      pitchRepo,
+		seasonRepo:     seasonRepo,
+		stageRepo:      stageRepo,
+		rulesRepo:      rulesRepo,
+		publisher:      publisher,
 	}
 }
 
+// CreateMatch crea un partido. El ID puede venir generado por el cliente
+// (carga sin conexión): reenviar la misma creación es idempotente y un ID
+// existente con otros datos queda registrado como conflicto.
 func (uc *MatchUseCase) CreateMatch(match *domain.Match) error {
-	// Validar que ambos equipos existen
-	_, err := uc.teamRepo.GetByID(match.Team1ID)
+	if existing, err := uc.matchRepo.GetByID(match.ID); err == nil {
+		if existing.SameContent(match) {
+			*match = *existing
+			decideWinner(match)
+			return nil
+		}
+		return uc.recordConflict(domain.ConflictConcurrentEdit, existing.ID, match)
+	}
+
+	// Un partido nace jugado; los walkover se adjudican con AwardForfeit
+	match.ResultType = domain.ResultPlayed
+	if err := uc.validateMatch(match); err != nil {
+		return err
+	}
+	if err := uc.checkMatchNumber(match); err != nil {
+		return err
+	}
+
+	if err := uc.matchRepo.Create(match); err != nil {
+		return err
+	}
+	decideWinner(match)
+	publishMatch(uc.publisher, uc.tournamentRepo, match)
+	return nil
+}
+
+func (uc *MatchUseCase) GetMatchByID(id uuid.UUID) (*domain.Match, error) {
+	match, err := uc.matchRepo.GetByID(id)
 	if err != nil {
-		return fmt.Errorf("team1 not found: %w", err)
+		return nil, err
 	}
 
-	_, err = uc.teamRepo.GetByID(match.Team2ID)
+	referees, err := uc.refereeRepo.GetByMatch(id)
 	if err != nil {
-		return fmt.Errorf("team2 not found: %w", err)
+		return nil, err
 	}
+	match.Referees = referees
+	decideWinner(match)
+	return match, nil
+}
 
-	// Validar que no sea el mismo equipo
-	if match.Team1ID == match.Team2ID {
-		return fmt.Errorf("a team cannot play against itself")
+func (uc *MatchUseCase) GetAllMatches(includeArchived bool) ([]domain.Match, error) {
+	matches, err := uc.matchRepo.GetAll(includeArchived)
+	if err != nil {
+		return nil, err
 	}
+	return matches, uc.attachReferees(matches)
+}
 
-	return uc.matchRepo.Create(match)
+func (uc *MatchUseCase) FindMatches(filter domain.MatchFilter) ([]domain.Match, error) {
+	filter, err := matchFilterAtNow(filter)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := uc.matchRepo.Find(filter)
+	if err != nil {
+		return nil, err
+	}
+	return matches, uc.attachReferees(matches)
 }
 
-func (uc *MatchUseCase) GetMatchByID(id uuid.UUID) (*domain.Match, error) {
-	return uc.matchRepo.GetByID(id)
+func (uc *MatchUseCase) FindMatchesPage(filter domain.MatchFilter, page domain.Page) (domain.Paged[domain.Match], error) {
+	filter, err := matchFilterAtNow(filter)
+	if err != nil {
+		return domain.Paged[domain.Match]{}, err
+	}
+	paged, err := uc.matchRepo.FindPage(filter, page)
+	if err != nil {
+		return paged, err
+	}
+	return paged, uc.attachReferees(paged.Items)
 }
 
-func (uc *MatchUseCase) GetAllMatches() ([]domain.Match, error) {
-	return uc.matchRepo.GetAll()
+// matchFilterAtNow valida el filtro y fija la hora con que se evalúa el estado
+func matchFilterAtNow(filter domain.MatchFilter) (domain.MatchFilter, error) {
+	if err := filter.Validate(); err != nil {
+		return filter, err
+	}
+	if filter.Now.IsZero() {
+		filter.Now = time.Now().UTC()
+	}
+	return filter, nil
+}
+
+func (uc *MatchUseCase) SeasonID(season string) (uuid.UUID, error) {
+	found, err := findSeason(uc.seasonRepo, season)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return found.ID, nil
+}
+
+// attachReferees completa la terna arbitral y el ganador de cada partido
+func (uc *MatchUseCase) attachReferees(matches []domain.Match) error {
+	if len(matches) == 0 {
+		return nil
+	}
+	decideWinners(matches)
+
+	ids := make([]uuid.UUID, len(matches))
+	for i := range matches {
+		ids[i] = matches[i].ID
+	}
+	byMatch, err := uc.refereeRepo.GetByMatches(ids)
+	if err != nil {
+		return err
+	}
+	for i := range matches {
+		matches[i].Referees = byMatch[matches[i].ID]
+	}
+	return nil
 }
 
 func (uc *MatchUseCase) UpdateMatch(match *domain.Match) error {
-	// Validar equipos
+	existing, err := uc.matchRepo.GetByID(match.ID)
+	if err != nil {
+		return err
+	}
+	// El vínculo con el partido padre y el tipo de resultado no se modifican
+	// por esta vía. Un resultado confirmado sigue confirmado aunque se
+	// corrija el marcador.
+	match.ParentMatchID = existing.ParentMatchID
+	match.ResultType = existing.ResultType
+	if existing.ResultConfirmedAt != nil {
+		match.ResultConfirmedAt = existing.ResultConfirmedAt
+	}
+	if existing.IsForfeit() && !match.SameResult(existing) {
+		return fmt.Errorf("match was awarded by forfeit; revoke the forfeit to change the result")
+	}
+
+	// Si el cliente indica la versión sobre la que editó y el partido cambió
+	// desde entonces, la edición no se aplica y queda como conflicto
+	if !match.UpdatedAt.IsZero() && existing.UpdatedAt.After(match.UpdatedAt) {
+		return uc.recordConflict(domain.ConflictConcurrentEdit, existing.ID, match)
+	}
+
+	if match.ParentMatchID != nil {
+		return uc.updateSubMatch(match)
+	}
+
+	if err := uc.validateMatch(match); err != nil {
+		return err
+	}
+	if err := uc.checkMatchNumber(match); err != nil {
+		return err
+	}
+
+	// Si el partido tiene mini-juegos, su resultado es el agregado de ellos
+	subMatches, err := uc.matchRepo.GetSubMatches(match.ID)
+	if err != nil {
+		return err
+	}
+	if len(subMatches) > 0 {
+		if match.Team1ID != existing.Team1ID || match.Team2ID != existing.Team2ID {
+			return fmt.Errorf("cannot change the teams of a match with sub-matches")
+		}
+		goals1, goals2 := aggregateGoals(subMatches)
+		if match.GoalScoredTeam1 != goals1 || match.GoalScoredTeam2 != goals2 {
+			return fmt.Errorf("result must match the sub-matches aggregate (%d-%d)", goals1, goals2)
+		}
+	}
+
+	if err := uc.matchRepo.Update(match); err != nil {
+		return err
+	}
+	decideWinner(match)
+	publishMatch(uc.publisher, uc.tournamentRepo, match)
+	return nil
+}
+
+func (uc *MatchUseCase) DeleteMatch(id uuid.UUID) error {
+	existing, err := uc.matchRepo.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.matchRepo.Delete(id); err != nil {
+		return err
+	}
+	publishMatchDeleted(uc.publisher, uc.tournamentRepo, existing)
+
+	if existing.ParentMatchID != nil {
+		return uc.recalculateParent(*existing.ParentMatchID)
+	}
+	return nil
+}
+
+// CreateSubMatch agrega un mini-juego a un partido. Los equipos, el torneo y
+// la jornada se heredan del partido padre y su resultado se recalcula.
+func (uc *MatchUseCase) CreateSubMatch(parentID uuid.UUID, subMatch *domain.Match) error {
+	parent, err := uc.matchRepo.GetByID(parentID)
+	if err != nil {
+		return err
+	}
+	if parent.ParentMatchID != nil {
+		return fmt.Errorf("sub-matches cannot have their own sub-matches")
+	}
+	if parent.IsForfeit() {
+		return fmt.Errorf("cannot add sub-matches to a match awarded by forfeit")
+	}
+
+	existing, err := uc.matchRepo.GetSubMatches(parentID)
+	if err != nil {
+		return err
+	}
+
+	// Tras borrar un mini-juego intermedio len(existing)+1 repetiría un número
+	inheritFromParent(subMatch, parent)
+	subMatch.MatchNumber = 1
+	for _, other := range existing {
+		if other.MatchNumber >= subMatch.MatchNumber {
+			subMatch.MatchNumber = other.MatchNumber + 1
+		}
+	}
+
+	if subMatch.GoalScoredTeam1 < 0 || subMatch.GoalScoredTeam2 < 0 {
+		return fmt.Errorf("goals cannot be negative")
+	}
+
+	if err := uc.matchRepo.Create(subMatch); err != nil {
+		return err
+	}
+	decideWinner(subMatch)
+	publishMatch(uc.publisher, uc.tournamentRepo, subMatch)
+
+	return uc.recalculateParent(parentID)
+}
+
+func (uc *MatchUseCase) GetSubMatches(parentID uuid.UUID) ([]domain.Match, error) {
+	if _, err := uc.matchRepo.GetByID(parentID); err != nil {
+		return nil, err
+	}
+
+	subMatches, err := uc.matchRepo.GetSubMatches(parentID)
+	if err != nil {
+		return nil, err
+	}
+	return subMatches, uc.attachReferees(subMatches)
+}
+
+func (uc *MatchUseCase) updateSubMatch(subMatch *domain.Match) error {
+	parent, err := uc.matchRepo.GetByID(*subMatch.ParentMatchID)
+	if err != nil {
+		return err
+	}
+
+	if subMatch.Team1ID != parent.Team1ID || subMatch.Team2ID != parent.Team2ID {
+		return fmt.Errorf("sub-match teams must match the parent match")
+	}
+	if subMatch.GoalScoredTeam1 < 0 || subMatch.GoalScoredTeam2 < 0 {
+		return fmt.Errorf("goals cannot be negative")
+	}
+	if err := validateResult(subMatch); err != nil {
+		return err
+	}
+	inheritFromParent(subMatch, parent)
+	if err := validateTiebreak(uc.rulesRepo, subMatch); err != nil {
+		return err
+	}
+
+	if err := uc.matchRepo.Update(subMatch); err != nil {
+		return err
+	}
+	decideWinner(subMatch)
+	publishMatch(uc.publisher, uc.tournamentRepo, subMatch)
+
+	return uc.recalculateParent(parent.ID)
+}
+
+// recalculateParent actualiza el resultado del padre con el agregado de sus mini-juegos
+func (uc *MatchUseCase) recalculateParent(parentID uuid.UUID) error {
+	parent, err := uc.matchRepo.GetByID(parentID)
+	if err != nil {
+		return err
+	}
+
+	subMatches, err := uc.matchRepo.GetSubMatches(parentID)
+	if err != nil {
+		return err
+	}
+
+	parent.GoalScoredTeam1, parent.GoalScoredTeam2 = aggregateGoals(subMatches)
+	// Si el agregado deja de estar empatado, la prórroga y los penales
+	// registrados ya no aplican
+	if err := validateResult(parent); err != nil {
+		parent.ExtraTimeTeam1, parent.ExtraTimeTeam2 = nil, nil
+		parent.PenaltiesTeam1, parent.PenaltiesTeam2 = nil, nil
+	}
+	if err := uc.matchRepo.Update(parent); err != nil {
+		return err
+	}
+	decideWinner(parent)
+	publishMatch(uc.publisher, uc.tournamentRepo, parent)
+	return nil
+}
+
+func inheritFromParent(subMatch, parent *domain.Match) {
+	subMatch.ParentMatchID = &parent.ID
+	subMatch.TournamentID = parent.TournamentID
+	subMatch.VenueID = parent.VenueID
+	subMatch.PitchID = parent.PitchID
+	subMatch.StageID = parent.StageID
+	subMatch.Round = parent.Round
+	subMatch.IsFriendly = parent.IsFriendly
+	subMatch.Team1ID = parent.Team1ID
+	subMatch.Team2ID = parent.Team2ID
+}
+
+func aggregateGoals(matches []domain.Match) (int, int) {
+	goals1, goals2 := 0, 0
+	for _, match := range matches {
+		goals1 += match.GoalScoredTeam1
+		goals2 += match.GoalScoredTeam2
+	}
+	return goals1, goals2
+}
+
+// HideEmbargoedResults oculta los marcadores de partidos cuyo torneo tiene un
+// embargo de resultados vigente. El embargo se cuenta desde el inicio del
+// partido. También oculta los enlaces de transmisión embargados. Se usa en
+// las lecturas públicas; los organizadores ven todo.
+func (uc *MatchUseCase) HideEmbargoedResults(matches []domain.Match) error {
+	now := time.Now().UTC()
+	delays := make(map[uuid.UUID]int)
+
+	for i := range matches {
+		matches[i].HideStream(now)
+
+		tournamentID := matches[i].TournamentID
+		if tournamentID == nil {
+			continue
+		}
+
+		delay, ok := delays[*tournamentID]
+		if !ok {
+			tournament, err := uc.tournamentRepo.GetByID(*tournamentID)
+			if err != nil {
+				return err
+			}
+			delay = tournament.ResultsDelayMinutes
+			delays[*tournamentID] = delay
+		}
+
+		if delay == 0 {
+			continue
+		}
+		until := matches[i].Date.Add(time.Duration(delay) * time.Minute)
+		if now.Before(until) {
+			matches[i].HideResult(until)
+		}
+	}
+	return nil
+}
+
+func (uc *MatchUseCase) AttachTeams(matches []domain.Match) error {
+	teams := make(map[uuid.UUID]*domain.Team)
+	team := func(id uuid.UUID) (*domain.Team, error) {
+		if found, ok := teams[id]; ok {
+			return found, nil
+		}
+		found, err := uc.teamRepo.GetByID(id)
+		if err != nil {
+			return nil, err
+		}
+		found.Players = nil
+		teams[id] = found
+		return found, nil
+	}
+
+	for i := range matches {
+		var err error
+		if matches[i].Team1, err = team(matches[i].Team1ID); err != nil {
+			return err
+		}
+		if matches[i].Team2, err = team(matches[i].Team2ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *MatchUseCase) AttachAttendance(matches []domain.Match) error {
+	return attachAttendance(uc.venueRepo, uc.rulesRepo, matches, time.Now().UTC())
+}
+
+// attachAttendance combina la restricción de la sede y la del torneo de
+// cada partido; cada sede y cada torneo se leen una sola vez
+func attachAttendance(venueRepo repository.VenueRepository, rulesRepo repository.TournamentRulesRepository, matches []domain.Match, now time.Time) error {
+	venues := make(map[uuid.UUID]*domain.Venue)
+	rules := make(map[uuid.UUID]*domain.TournamentRules)
+
+	for i := range matches {
+		match := &matches[i]
+		if !match.HasAttendance(now) {
+			continue
+		}
+
+		var venue *domain.Venue
+		if match.VenueID != nil {
+			if _, ok := venues[*match.VenueID]; !ok {
+				found, err := venueRepo.GetByID(*match.VenueID)
+				if err != nil {
+					return err
+				}
+				venues[*match.VenueID] = found
+			}
+			venue = venues[*match.VenueID]
+		}
+
+		var tournamentRules *domain.TournamentRules
+		if match.TournamentID != nil {
+			if _, ok := rules[*match.TournamentID]; !ok {
+				found, err := rulesRepo.Get(*match.TournamentID)
+				if err != nil {
+					return err
+				}
+				rules[*match.TournamentID] = found
+			}
+			tournamentRules = rules[*match.TournamentID]
+		}
+
+		match.Attendance = domain.NewMatchAttendance(venue, tournamentRules)
+	}
+	return nil
+}
+
+// validateMatch aplica las reglas comunes a creación y actualización
+func (uc *MatchUseCase) validateMatch(match *domain.Match) error {
+	// Validar que ambos equipos existen
 	_, err := uc.teamRepo.GetByID(match.Team1ID)
 	if err != nil {
 		return fmt.Errorf("team1 not found: %w", err)
@@ -60,13 +525,202 @@ func (uc *MatchUseCase) UpdateMatch(match *domain.Match) error {
 		return fmt.Errorf("team2 not found: %w", err)
 	}
 
+	// Validar que no sea el mismo equipo
 	if match.Team1ID == match.Team2ID {
 		return fmt.Errorf("a team cannot play against itself")
 	}
 
-	return uc.matchRepo.Update(match)
+	// Validar el torneo si el partido pertenece a uno
+	if match.TournamentID != nil {
+		tournament, err := uc.tournamentRepo.GetByID(*match.TournamentID)
+		if err != nil {
+			return fmt.Errorf("tournament not found: %w", err)
+		}
+		if err := tournament.CheckEditable(); err != nil {
+			return err
+		}
+	}
+
+	if match.Round < 0 {
+		return fmt.Errorf("round cannot be negative")
+	}
+
+	if err := validateResult(match); err != nil {
+		return err
+	}
+	if err := validateTiebreak(uc.rulesRepo, match); err != nil {
+		return err
+	}
+
+	if match.VenueID != nil {
+		if _, err := uc.venueRepo.GetByID(*match.VenueID); err != nil {
+			return fmt.Errorf("venue not found: %w", err)
+		}
+	}
+
+	if err := uc.validatePitch(match); err != nil {
+		return err
+	}
+
+	if match.StreamURL != "" && !isHTTPURL(match.StreamURL) {
+		return fmt.Errorf("stream_url must be an absolute http(s) URL")
+	}
+	if match.StreamEmbargoUntil != nil && match.StreamURL == "" {
+		return fmt.Errorf("stream_embargo_until requires stream_url")
+	}
+
+	// La fase tiene que ser del mismo torneo que el partido
+	if match.StageID != nil {
+		stage, err := uc.stageRepo.GetByID(*match.StageID)
+		if err != nil {
+			return fmt.Errorf("stage not found: %w", err)
+		}
+		if match.TournamentID == nil || *match.TournamentID != stage.TournamentID {
+			return fmt.Errorf("stage does not belong to the match tournament")
+		}
+	}
+
+	return nil
 }
 
-func (uc *MatchUseCase) DeleteMatch(id uuid.UUID) error {
-	return uc.matchRepo.Delete(id)
+// validatePitch comprueba que la cancha sea de la sede del partido y que no
+// esté ocupada por otro partido. Los mini-juegos comparten la cancha del
+// partido padre, por eso no se controlan.
+func (uc *MatchUseCase) validatePitch(match *domain.Match) error {
+	if match.PitchID == nil {
+		return nil
+	}
+	if match.VenueID == nil {
+		return fmt.Errorf("pitch requires a venue")
+	}
+	pitch, err := uc.pitchRepo.GetByID(*match.PitchID)
+	if err != nil {
+		return err
+	}
+	if pitch.VenueID != *match.VenueID {
+		return fmt.Errorf("pitch does not belong to the match venue")
+	}
+	if match.ParentMatchID != nil {
+		return nil
+	}
+
+	window := time.Duration(defaultMatchDuration) * time.Minute
+	nearby, err := uc.matchRepo.GetByVenue(*match.VenueID, match.Date.Add(-window), match.Date.Add(window))
+	if err != nil {
+		return err
+	}
+	if other := pitchConflict(nearby, match); other != nil {
+		return fmt.Errorf("pitch %s is already booked by match %d at %s", pitch.Name, other.MatchNumber, other.Date.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// pitchConflict devuelve el partido de matches que ocupa la misma cancha que
+// match en un horario superpuesto, o nil si la cancha está libre
+func pitchConflict(matches []domain.Match, match *domain.Match) *domain.Match {
+	window := time.Duration(defaultMatchDuration) * time.Minute
+	for i := range matches {
+		other := &matches[i]
+		if other.ID == match.ID || other.PitchID == nil || *other.PitchID != *match.PitchID {
+			continue
+		}
+		diff := other.Date.Sub(match.Date)
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff < window {
+			return other
+		}
+	}
+	return nil
+}
+
+// validateResult comprueba la prórroga y los penales: la prórroga solo se
+// juega tras un empate en el tiempo reglamentario y los penales tras un
+// empate al final del partido (con o sin prórroga), sin poder empatar
+func validateResult(match *domain.Match) error {
+	if match.HasExtraTime() {
+		if match.ExtraTimeTeam1 == nil || match.ExtraTimeTeam2 == nil {
+			return fmt.Errorf("extra time requires the goals of both teams")
+		}
+		if *match.ExtraTimeTeam1 < 0 || *match.ExtraTimeTeam2 < 0 {
+			return fmt.Errorf("extra time goals cannot be negative")
+		}
+		if match.GoalScoredTeam1 != match.GoalScoredTeam2 {
+			return fmt.Errorf("extra time is only played after a draw in regular time")
+		}
+	}
+
+	if match.HasPenalties() {
+		if match.PenaltiesTeam1 == nil || match.PenaltiesTeam2 == nil {
+			return fmt.Errorf("penalty shootout requires the score of both teams")
+		}
+		if *match.PenaltiesTeam1 < 0 || *match.PenaltiesTeam2 < 0 {
+			return fmt.Errorf("penalty scores cannot be negative")
+		}
+		goals1, goals2 := match.TotalGoals()
+		if goals1 != goals2 {
+			return fmt.Errorf("penalty shootout is only played after a draw")
+		}
+		if *match.PenaltiesTeam1 == *match.PenaltiesTeam2 {
+			return fmt.Errorf("penalty shootout cannot end in a draw")
+		}
+	}
+	return nil
+}
+
+// validateTiebreak aplica a la prórroga cargada el desempate del torneo del
+// partido: sin prórroga si se va directo a penales y a lo sumo un gol con
+// gol de oro. Los amistosos admiten cualquier desempate.
+func validateTiebreak(rulesRepo repository.TournamentRulesRepository, match *domain.Match) error {
+	if match.TournamentID == nil || !match.HasExtraTime() {
+		return nil
+	}
+	rules, err := rulesRepo.Get(*match.TournamentID)
+	if err != nil {
+		return err
+	}
+	return rules.CheckTiebreak(match)
+}
+
+// decideWinner completa el ganador del partido: primero por goles (tiempo
+// reglamentario más prórroga) y, si siguen empatados, por penales. Sin
+// penales un empate queda sin ganador, como en una fase de liga.
+func decideWinner(match *domain.Match) {
+	match.WinnerID = nil
+	match.DecidedBy = ""
+
+	winner := match.Team1ID
+	goals1, goals2 := match.TotalGoals()
+	switch {
+	case goals1 > goals2:
+	case goals2 > goals1:
+		winner = match.Team2ID
+	case match.PenaltiesTeam1 != nil && match.PenaltiesTeam2 != nil && *match.PenaltiesTeam1 != *match.PenaltiesTeam2:
+		if *match.PenaltiesTeam2 > *match.PenaltiesTeam1 {
+			winner = match.Team2ID
+		}
+		match.WinnerID = &winner
+		match.DecidedBy = domain.DecidedOnPenalties
+		return
+	default:
+		return
+	}
+
+	match.WinnerID = &winner
+
+	switch {
+	case match.IsForfeit():
+		match.DecidedBy = domain.DecidedByForfeit
+	case match.HasExtraTime():
+		match.DecidedBy = domain.DecidedInExtraTime
+	default:
+		match.DecidedBy = domain.DecidedInRegularTime
+	}
+}
+
+func decideWinners(matches []domain.Match) {
+	for i := range matches {
+		decideWinner(&matches[i])
+	}
 }
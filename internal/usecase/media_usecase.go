@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// MediaCommands agrupa las operaciones que modifican la galería de un partido
+type MediaCommands interface {
+	CreateMedia(media *domain.MatchMedia) error
+	UpdateMedia(media *domain.MatchMedia) error
+	DeleteMedia(matchID, id uuid.UUID) error
+	ReorderMedia(matchID uuid.UUID, ids []uuid.UUID) ([]domain.MatchMedia, error)
+}
+
+// MediaQueries agrupa las lecturas de la galería de un partido
+type MediaQueries interface {
+	GetMedia(matchID, id uuid.UUID) (*domain.MatchMedia, error)
+	GetMatchMedia(matchID uuid.UUID) ([]domain.MatchMedia, error)
+}
+
+var (
+	_ MediaCommands = (*MediaUseCase)(nil)
+	_ MediaQueries  = (*MediaUseCase)(nil)
+)
+
+// MediaUseCase gestiona las fotos y videos de cada partido
+type MediaUseCase struct {
+	mediaRepo repository.MediaRepository
+	matchRepo repository.MatchRepository
+}
+
+func NewMediaUseCase(mediaRepo repository.MediaRepository, matchRepo repository.MatchRepository) *MediaUseCase {
+	return &MediaUseCase{
+		mediaRepo: mediaRepo,
+		matchRepo: matchRepo,
+	}
+}
+
+// CreateMedia agrega el elemento al final de la galería
+func (uc *MediaUseCase) CreateMedia(media *domain.MatchMedia) error {
+	gallery, err := uc.GetMatchMedia(media.MatchID)
+	if err != nil {
+		return err
+	}
+	if err := validateMedia(media); err != nil {
+		return err
+	}
+
+	media.Position = 1
+	if len(gallery) > 0 {
+		media.Position = gallery[len(gallery)-1].Position + 1
+	}
+	return uc.mediaRepo.Create(media)
+}
+
+func (uc *MediaUseCase) GetMedia(matchID, id uuid.UUID) (*domain.MatchMedia, error) {
+	media, err := uc.mediaRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if media.MatchID != matchID {
+		return nil, fmt.Errorf("media not found")
+	}
+	return media, nil
+}
+
+func (uc *MediaUseCase) GetMatchMedia(matchID uuid.UUID) ([]domain.MatchMedia, error) {
+	if _, err := uc.matchRepo.GetByID(matchID); err != nil {
+		return nil, err
+	}
+	return uc.mediaRepo.GetByMatch(matchID)
+}
+
+// UpdateMedia cambia tipo, enlace y epígrafe; la posición se cambia con ReorderMedia
+func (uc *MediaUseCase) UpdateMedia(media *domain.MatchMedia) error {
+	existing, err := uc.GetMedia(media.MatchID, media.ID)
+	if err != nil {
+		return err
+	}
+	if err := validateMedia(media); err != nil {
+		return err
+	}
+	media.Position = existing.Position
+	media.CreatedAt = existing.CreatedAt
+	return uc.mediaRepo.Update(media)
+}
+
+func (uc *MediaUseCase) DeleteMedia(matchID, id uuid.UUID) error {
+	if _, err := uc.GetMedia(matchID, id); err != nil {
+		return err
+	}
+	return uc.mediaRepo.Delete(id)
+}
+
+// ReorderMedia recibe todos los IDs de la galería en el orden deseado
+func (uc *MediaUseCase) ReorderMedia(matchID uuid.UUID, ids []uuid.UUID) ([]domain.MatchMedia, error) {
+	gallery, err := uc.GetMatchMedia(matchID)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) != len(gallery) {
+		return nil, fmt.Errorf("order must list all %d media items", len(gallery))
+	}
+
+	current := make(map[uuid.UUID]bool, len(gallery))
+	for _, media := range gallery {
+		current[media.ID] = true
+	}
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		if !current[id] {
+			return nil, fmt.Errorf("media %s does not belong to the match", id)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("media %s is listed more than once", id)
+		}
+		seen[id] = true
+	}
+
+	if err := uc.mediaRepo.Reorder(matchID, ids); err != nil {
+		return nil, err
+	}
+	return uc.mediaRepo.GetByMatch(matchID)
+}
+
+func validateMedia(media *domain.MatchMedia) error {
+	if !domain.IsValidMediaType(media.Type) {
+		return fmt.Errorf("invalid media type: %s", media.Type)
+	}
+	if !isHTTPURL(media.URL) {
+		return fmt.Errorf("url must be an absolute http(s) URL")
+	}
+	return nil
+}
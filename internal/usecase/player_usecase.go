@@ -1,25 +1,102 @@
 package usecase
 
 import (
+	"fmt"
+
 	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
 	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
 	"github.com/google/uuid"
 )
 
+// Cada caso de uso expone dos interfaces (CQRS-lite): Commands para escrituras
+// y Queries para lecturas. Los handlers dependen solo de lo que usan y las
+// políticas de cache, autorización o transacciones se aplican por lado.
+// En C# sería como separar IPlayerCommandService de IPlayerQueryService.
+
+// PlayerCommands agrupa las operaciones que modifican jugadores
+type PlayerCommands interface {
+	CreatePlayer(player *domain.Player) error
+	// CreatePlayers da de alta un lote de jugadores (ver domain.BulkPlayerReport)
+	CreatePlayers(players []*domain.Player, rejected map[int]error) (*domain.BulkPlayerReport, error)
+	UpdatePlayer(player *domain.Player) error
+	DeletePlayer(id uuid.UUID) error
+}
+
+// PlayerQueries agrupa las lecturas de jugadores
+type PlayerQueries interface {
+	GetPlayerByID(id uuid.UUID) (*domain.Player, error)
+	GetAllPlayers() ([]domain.Player, error)
+	// GetPlayersPage devuelve una página del listado con el total
+	GetPlayersPage(page domain.Page) (domain.Paged[domain.Player], error)
+	// GetPlayerTransfers devuelve el historial de pases del más antiguo al
+	// más reciente
+	GetPlayerTransfers(playerID uuid.UUID) ([]domain.Transfer, error)
+}
+
+var (
+	_ PlayerCommands = (*PlayerUseCase)(nil)
+	_ PlayerQueries  = (*PlayerUseCase)(nil)
+)
+
 // PlayerUseCase contiene la lógica de negocio para jugadores
 // Equivalente a un Service en C#
 type PlayerUseCase struct {
-	repo repository.PlayerRepository
+	repo         repository.PlayerRepository
+	transferRepo repository.TransferRepository
 }
 
-func NewPlayerUseCase(repo repository.PlayerRepository) *PlayerUseCase {
-	return &PlayerUseCase{repo: repo}
+func NewPlayerUseCase(repo repository.PlayerRepository, transferRepo repository.TransferRepository) *PlayerUseCase {
+	return &PlayerUseCase{repo: repo, transferRepo: transferRepo}
 }
 
 func (uc *PlayerUseCase) CreatePlayer(player *domain.Player) error {
+	if err := validatePlayer(player); err != nil {
+		return err
+	}
 	return uc.repo.Create(player)
 }
 
+// CreatePlayers guarda el lote en una sola transacción si todos los
+// jugadores son válidos; si no, no guarda ninguno y el reporte dice qué
+// falló en cada uno. rejected son los errores que ya encontró el handler al
+// leer el lote (una fecha mal escrita), por posición; en esas posiciones
+// players tiene nil.
+func (uc *PlayerUseCase) CreatePlayers(players []*domain.Player, rejected map[int]error) (*domain.BulkPlayerReport, error) {
+	if len(players) == 0 {
+		return nil, fmt.Errorf("players cannot be empty")
+	}
+	if len(players) > domain.MaxBulkPlayers {
+		return nil, fmt.Errorf("cannot create more than %d players at once", domain.MaxBulkPlayers)
+	}
+
+	report := &domain.BulkPlayerReport{Results: make([]domain.BulkPlayerResult, len(players))}
+	valid := true
+	for i, player := range players {
+		err := rejected[i]
+		if err == nil {
+			err = validatePlayer(player)
+		}
+		if err != nil {
+			report.Results[i] = domain.BulkPlayerResult{Index: i, Status: domain.BulkPlayerRejected, Error: err.Error()}
+			valid = false
+			continue
+		}
+		report.Results[i] = domain.BulkPlayerResult{Index: i, Status: domain.BulkPlayerSkipped}
+	}
+	if !valid {
+		return report, nil
+	}
+
+	if err := uc.repo.CreateMany(players); err != nil {
+		return nil, err
+	}
+	for i, player := range players {
+		report.Results[i] = domain.BulkPlayerResult{Index: i, Status: domain.BulkPlayerCreated, Player: player}
+	}
+	report.Created = len(players)
+	return report, nil
+}
+
 func (uc *PlayerUseCase) GetPlayerByID(id uuid.UUID) (*domain.Player, error) {
 	return uc.repo.GetByID(id)
 }
@@ -28,10 +105,37 @@ func (uc *PlayerUseCase) GetAllPlayers() ([]domain.Player, error) {
 	return uc.repo.GetAll()
 }
 
+func (uc *PlayerUseCase) GetPlayersPage(page domain.Page) (domain.Paged[domain.Player], error) {
+	return uc.repo.GetPage(page)
+}
+
+func (uc *PlayerUseCase) GetPlayerTransfers(playerID uuid.UUID) ([]domain.Transfer, error) {
+	if _, err := uc.repo.GetByID(playerID); err != nil {
+		return nil, err
+	}
+	return uc.transferRepo.GetByPlayer(playerID)
+}
+
 func (uc *PlayerUseCase) UpdatePlayer(player *domain.Player) error {
+	if err := validatePlayer(player); err != nil {
+		return err
+	}
 	return uc.repo.Update(player)
 }
 
 func (uc *PlayerUseCase) DeletePlayer(id uuid.UUID) error {
 	return uc.repo.Delete(id)
 }
+
+func validatePlayer(player *domain.Player) error {
+	if !domain.IsValidPosition(player.Position) {
+		return fmt.Errorf("invalid position: %s", player.Position)
+	}
+	if !domain.IsValidFoot(player.PreferredFoot) {
+		return fmt.Errorf("invalid preferred_foot: %s", player.PreferredFoot)
+	}
+	if !domain.IsValidCountryCode(player.Nationality) {
+		return fmt.Errorf("nationality must be an ISO 3166-1 alpha-2 code: %s", player.Nationality)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// PredictionQueries estima el resultado de los partidos por jugar
+type PredictionQueries interface {
+	PredictMatch(matchID uuid.UUID) (*domain.MatchPrediction, error)
+	// AttachOdds completa Match.Odds en los partidos por jugar; cada equipo
+	// se evalúa una sola vez aunque aparezca en varios partidos
+	AttachOdds(matches []domain.Match) error
+}
+
+var _ PredictionQueries = (*PredictionUseCase)(nil)
+
+// PredictionUseCase combina el rating Elo de cada equipo con su forma
+// reciente. Solo usa resultados públicos, así que no revela marcadores
+// embargados.
+type PredictionUseCase struct {
+	matchRepo  repository.MatchRepository
+	ratingRepo repository.RatingRepository
+}
+
+func NewPredictionUseCase(matchRepo repository.MatchRepository, ratingRepo repository.RatingRepository) *PredictionUseCase {
+	return &PredictionUseCase{matchRepo: matchRepo, ratingRepo: ratingRepo}
+}
+
+func (uc *PredictionUseCase) PredictMatch(matchID uuid.UUID) (*domain.MatchPrediction, error) {
+	match, err := uc.matchRepo.GetByID(matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if !match.Date.After(now) {
+		return nil, fmt.Errorf("match already played")
+	}
+
+	team1, err := uc.outlook(match.Team1ID, now)
+	if err != nil {
+		return nil, err
+	}
+	team2, err := uc.outlook(match.Team2ID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.PredictMatch(match.ID, team1, team2, now), nil
+}
+
+func (uc *PredictionUseCase) AttachOdds(matches []domain.Match) error {
+	now := time.Now().UTC()
+	outlooks := make(map[uuid.UUID]domain.TeamOutlook)
+	outlookOf := func(teamID uuid.UUID) (domain.TeamOutlook, error) {
+		if outlook, ok := outlooks[teamID]; ok {
+			return outlook, nil
+		}
+		outlook, err := uc.outlook(teamID, now)
+		if err != nil {
+			return outlook, err
+		}
+		outlooks[teamID] = outlook
+		return outlook, nil
+	}
+
+	for i := range matches {
+		match := &matches[i]
+		if !match.HasOdds(now) {
+			continue
+		}
+		team1, err := outlookOf(match.Team1ID)
+		if err != nil {
+			return err
+		}
+		team2, err := outlookOf(match.Team2ID)
+		if err != nil {
+			return err
+		}
+		match.Odds = domain.PredictMatch(match.ID, team1, team2, now).Odds()
+	}
+	return nil
+}
+
+// outlook usa el rating inicial si el equipo todavía no jugó
+func (uc *PredictionUseCase) outlook(teamID uuid.UUID, now time.Time) (domain.TeamOutlook, error) {
+	rating := domain.InitialRating
+	if current, err := uc.ratingRepo.GetByTeam(teamID); err == nil {
+		rating = current.Rating
+	}
+
+	recent, err := uc.matchRepo.GetPlayedByTeam(teamID, now, domain.RecentFormMatches)
+	if err != nil {
+		return domain.TeamOutlook{}, err
+	}
+	return domain.NewTeamOutlook(teamID, rating, recent), nil
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// ProvisionalResultCommands agrupa la carga de resultados por email y su revisión
+type ProvisionalResultCommands interface {
+	// IngestResultEmail lee un email de un árbitro y crea un resultado
+	// provisorio por cada línea "Match 14: 2-1"
+	IngestResultEmail(sender, body string) (*domain.ResultEmailReport, error)
+	ConfirmProvisionalResult(id uuid.UUID) (*domain.ProvisionalResult, error)
+	RejectProvisionalResult(id uuid.UUID) (*domain.ProvisionalResult, error)
+}
+
+// ProvisionalResultQueries agrupa las lecturas de resultados provisorios
+type ProvisionalResultQueries interface {
+	GetProvisionalResult(id uuid.UUID) (*domain.ProvisionalResult, error)
+	GetProvisionalResults(status string) ([]domain.ProvisionalResult, error)
+}
+
+var (
+	_ ProvisionalResultCommands = (*ProvisionalResultUseCase)(nil)
+	_ ProvisionalResultQueries  = (*ProvisionalResultUseCase)(nil)
+)
+
+type ProvisionalResultUseCase struct {
+	resultRepo  repository.ProvisionalResultRepository
+	refereeRepo repository.RefereeRepository
+	matchRepo   repository.MatchRepository
+	// matches aplica el resultado confirmado con las mismas reglas que la API
+	matches MatchCommands
+}
+
+func NewProvisionalResultUseCase(resultRepo repository.ProvisionalResultRepository, refereeRepo repository.RefereeRepository, matchRepo repository.MatchRepository, matches MatchCommands) *ProvisionalResultUseCase {
+	return &ProvisionalResultUseCase{
+		resultRepo:  resultRepo,
+		refereeRepo: refereeRepo,
+		matchRepo:   matchRepo,
+		matches:     matches,
+	}
+}
+
+// IngestResultEmail identifica al árbitro por el remitente y solo acepta
+// resultados de partidos donde está designado. Las líneas que no se pueden
+// asociar a un partido quedan en el reporte como rechazadas.
+func (uc *ProvisionalResultUseCase) IngestResultEmail(sender, body string) (*domain.ResultEmailReport, error) {
+	address, err := mail.ParseAddress(sender)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address")
+	}
+	referee, err := uc.refereeRepo.GetByEmail(address.Address)
+	if err != nil {
+		return nil, fmt.Errorf("sender %s is not a registered referee", address.Address)
+	}
+
+	reported := domain.ParseReportedResults(body)
+	if len(reported) == 0 {
+		return nil, fmt.Errorf("no result lines found (expected e.g. \"Match 14: 2-1\")")
+	}
+
+	// Partidos del árbitro por número; un número repetido en dos torneos es ambiguo
+	matchIDs, err := uc.refereeRepo.GetAssignedMatchIDs(referee.ID)
+	if err != nil {
+		return nil, err
+	}
+	byNumber := make(map[int][]uuid.UUID)
+	for _, matchID := range matchIDs {
+		match, err := uc.matchRepo.GetByID(matchID)
+		if err != nil {
+			return nil, err
+		}
+		byNumber[match.MatchNumber] = append(byNumber[match.MatchNumber], match.ID)
+	}
+
+	report := &domain.ResultEmailReport{
+		Sender:   address.Address,
+		Created:  []domain.ProvisionalResult{},
+		Rejected: []domain.RejectedLine{},
+	}
+	for _, line := range reported {
+		candidates := byNumber[line.MatchNumber]
+		if len(candidates) != 1 {
+			reason := fmt.Sprintf("match %d is not assigned to this referee", line.MatchNumber)
+			if len(candidates) > 1 {
+				reason = fmt.Sprintf("match number %d is ambiguous for this referee", line.MatchNumber)
+			}
+			report.Rejected = append(report.Rejected, domain.RejectedLine{Line: line.Line, Error: reason})
+			continue
+		}
+
+		result := domain.NewProvisionalResult(candidates[0], line.GoalScoredTeam1, line.GoalScoredTeam2, domain.ResultSourceEmail)
+		result.RefereeID = &referee.ID
+		result.Sender = address.Address
+		result.RawLine = line.Line
+		if err := uc.resultRepo.Create(result); err != nil {
+			return nil, err
+		}
+		report.Created = append(report.Created, *result)
+	}
+	return report, nil
+}
+
+func (uc *ProvisionalResultUseCase) GetProvisionalResult(id uuid.UUID) (*domain.ProvisionalResult, error) {
+	return uc.resultRepo.GetByID(id)
+}
+
+func (uc *ProvisionalResultUseCase) GetProvisionalResults(status string) ([]domain.ProvisionalResult, error) {
+	switch status {
+	case "", domain.ProvisionalPending, domain.ProvisionalConfirmed, domain.ProvisionalRejected:
+	default:
+		return nil, fmt.Errorf("status must be one of: pending, confirmed, rejected")
+	}
+	return uc.resultRepo.GetAll(status)
+}
+
+// ConfirmProvisionalResult carga el marcador en el partido y cierra el
+// resultado provisorio
+func (uc *ProvisionalResultUseCase) ConfirmProvisionalResult(id uuid.UUID) (*domain.ProvisionalResult, error) {
+	result, err := uc.pending(id)
+	if err != nil {
+		return nil, err
+	}
+
+	match, err := uc.matchRepo.GetByID(result.MatchID)
+	if err != nil {
+		return nil, err
+	}
+	match.GoalScoredTeam1 = result.GoalScoredTeam1
+	match.GoalScoredTeam2 = result.GoalScoredTeam2
+	match.ConfirmResult(time.Now().UTC())
+	// Sin versión, UpdateMatch no comprueba ediciones concurrentes
+	match.UpdatedAt = time.Time{}
+	if err := uc.matches.UpdateMatch(match); err != nil {
+		return nil, err
+	}
+
+	return result, uc.review(result, domain.ProvisionalConfirmed)
+}
+
+// RejectProvisionalResult descarta el resultado sin tocar el partido
+func (uc *ProvisionalResultUseCase) RejectProvisionalResult(id uuid.UUID) (*domain.ProvisionalResult, error) {
+	result, err := uc.pending(id)
+	if err != nil {
+		return nil, err
+	}
+	return result, uc.review(result, domain.ProvisionalRejected)
+}
+
+func (uc *ProvisionalResultUseCase) pending(id uuid.UUID) (*domain.ProvisionalResult, error) {
+	result, err := uc.resultRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if result.Status != domain.ProvisionalPending {
+		return nil, fmt.Errorf("provisional result is already %s", result.Status)
+	}
+	return result, nil
+}
+
+func (uc *ProvisionalResultUseCase) review(result *domain.ProvisionalResult, status string) error {
+	reviewedAt := time.Now().UTC()
+	result.Status = status
+	result.ReviewedAt = &reviewedAt
+	return uc.resultRepo.Review(result)
+}
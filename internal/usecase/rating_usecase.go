@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// RatingCommands recalcula los ratings Elo
+type RatingCommands interface {
+	// RecalculateRatings rehace los ratings desde el primer partido, así un
+	// resultado corregido se refleja en todos los partidos posteriores
+	RecalculateRatings() error
+}
+
+// RatingQueries lee los ratings Elo
+type RatingQueries interface {
+	// GetTeamRating devuelve el rating del equipo con su historial
+	GetTeamRating(teamID uuid.UUID) (*domain.TeamRating, error)
+	GetRanking() ([]domain.TeamRating, error)
+}
+
+var (
+	_ RatingCommands = (*RatingUseCase)(nil)
+	_ RatingQueries  = (*RatingUseCase)(nil)
+)
+
+// RatingUseCase mantiene el ranking Elo de los equipos a partir de los resultados
+type RatingUseCase struct {
+	ratingRepo     repository.RatingRepository
+	matchRepo      repository.MatchRepository
+	teamRepo       repository.TeamRepository
+	tournamentRepo repository.TournamentRepository
+}
+
+func NewRatingUseCase(ratingRepo repository.RatingRepository, matchRepo repository.MatchRepository, teamRepo repository.TeamRepository, tournamentRepo repository.TournamentRepository) *RatingUseCase {
+	return &RatingUseCase{
+		ratingRepo:     ratingRepo,
+		matchRepo:      matchRepo,
+		teamRepo:       teamRepo,
+		tournamentRepo: tournamentRepo,
+	}
+}
+
+// RecalculateRatings cuenta los partidos con resultado definitivo, con la
+// misma regla que la tabla de posiciones (domain.Match.ResultCountsAt). El
+// ranking es público, así que un resultado embargado entra recién cuando se
+// levanta el embargo.
+func (uc *RatingUseCase) RecalculateRatings() error {
+	matches, err := uc.matchRepo.GetAll(true)
+	if err != nil {
+		return err
+	}
+	tournaments, err := uc.tournamentRepo.GetAll(true)
+	if err != nil {
+		return err
+	}
+
+	delays := make(map[uuid.UUID]time.Duration, len(tournaments))
+	for _, tournament := range tournaments {
+		delays[tournament.ID] = time.Duration(tournament.ResultsDelayMinutes) * time.Minute
+	}
+
+	now := time.Now().UTC()
+	played := make([]domain.Match, 0, len(matches))
+	for _, match := range matches {
+		var delay time.Duration
+		if match.TournamentID != nil {
+			delay = delays[*match.TournamentID]
+		}
+		if match.ResultCountsAt(now, delay) {
+			played = append(played, match)
+		}
+	}
+
+	ratings, changes := domain.ReplayElo(played)
+	return uc.ratingRepo.Replace(ratings, changes)
+}
+
+// GetTeamRating devuelve el rating inicial si el equipo todavía no jugó
+func (uc *RatingUseCase) GetTeamRating(teamID uuid.UUID) (*domain.TeamRating, error) {
+	team, err := uc.teamRepo.GetByID(teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	rating, err := uc.ratingRepo.GetByTeam(teamID)
+	if err != nil {
+		rating = &domain.TeamRating{TeamID: team.ID, TeamName: team.Name, Rating: domain.InitialRating}
+	}
+
+	history, err := uc.ratingRepo.GetHistory(teamID)
+	if err != nil {
+		return nil, err
+	}
+	rating.History = history
+	return rating, nil
+}
+
+func (uc *RatingUseCase) GetRanking() ([]domain.TeamRating, error) {
+	return uc.ratingRepo.GetRanking()
+}
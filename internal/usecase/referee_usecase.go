@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// RefereeCommands agrupa las operaciones que modifican árbitros y designaciones
+type RefereeCommands interface {
+	CreateReferee(referee *domain.Referee) error
+	UpdateReferee(referee *domain.Referee) error
+	DeleteReferee(id uuid.UUID) error
+	AssignMatchReferees(matchID uuid.UUID, mainID *uuid.UUID, assistantIDs []uuid.UUID) ([]domain.MatchReferee, error)
+}
+
+// RefereeQueries agrupa las lecturas de árbitros
+type RefereeQueries interface {
+	GetRefereeByID(id uuid.UUID) (*domain.Referee, error)
+	GetAllReferees() ([]domain.Referee, error)
+	GetMatchReferees(matchID uuid.UUID) ([]domain.MatchReferee, error)
+}
+
+var (
+	_ RefereeCommands = (*RefereeUseCase)(nil)
+	_ RefereeQueries  = (*RefereeUseCase)(nil)
+)
+
+type RefereeUseCase struct {
+	refereeRepo repository.RefereeRepository
+	matchRepo   repository.MatchRepository
+}
+
+func NewRefereeUseCase(refereeRepo repository.RefereeRepository, matchRepo repository.MatchRepository) *RefereeUseCase {
+	return &RefereeUseCase{
+		refereeRepo: refereeRepo,
+		matchRepo:   matchRepo,
+	}
+}
+
+func (uc *RefereeUseCase) CreateReferee(referee *domain.Referee) error {
+	if err := validateReferee(referee); err != nil {
+		return err
+	}
+	return uc.refereeRepo.Create(referee)
+}
+
+func (uc *RefereeUseCase) GetRefereeByID(id uuid.UUID) (*domain.Referee, error) {
+	return uc.refereeRepo.GetByID(id)
+}
+
+func (uc *RefereeUseCase) GetAllReferees() ([]domain.Referee, error) {
+	return uc.refereeRepo.GetAll()
+}
+
+func (uc *RefereeUseCase) UpdateReferee(referee *domain.Referee) error {
+	if err := validateReferee(referee); err != nil {
+		return err
+	}
+	return uc.refereeRepo.Update(referee)
+}
+
+func validateReferee(referee *domain.Referee) error {
+	if strings.TrimSpace(referee.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if referee.Email != "" {
+		address, err := mail.ParseAddress(referee.Email)
+		if err != nil || address.Address != referee.Email {
+			return fmt.Errorf("invalid email address")
+		}
+	}
+	return nil
+}
+
+func (uc *RefereeUseCase) DeleteReferee(id uuid.UUID) error {
+	return uc.refereeRepo.Delete(id)
+}
+
+// AssignMatchReferees reemplaza la terna arbitral del partido. Sin principal
+// ni asistentes se quita la designación.
+func (uc *RefereeUseCase) AssignMatchReferees(matchID uuid.UUID, mainID *uuid.UUID, assistantIDs []uuid.UUID) ([]domain.MatchReferee, error) {
+	if _, err := uc.matchRepo.GetByID(matchID); err != nil {
+		return nil, err
+	}
+	if len(assistantIDs) > domain.MaxAssistantReferees {
+		return nil, fmt.Errorf("a match can have at most %d assistant referees", domain.MaxAssistantReferees)
+	}
+
+	assigned := make(map[uuid.UUID]bool)
+	var referees []domain.MatchReferee
+	add := func(id uuid.UUID, role string) error {
+		if assigned[id] {
+			return fmt.Errorf("referee %s is assigned more than once", id)
+		}
+		assigned[id] = true
+
+		referee, err := uc.refereeRepo.GetByID(id)
+		if err != nil {
+			return fmt.Errorf("%w: %s", err, id)
+		}
+		referees = append(referees, domain.MatchReferee{RefereeID: referee.ID, Name: referee.Name, Role: role})
+		return nil
+	}
+
+	if mainID != nil {
+		if err := add(*mainID, domain.RefereeRoleMain); err != nil {
+			return nil, err
+		}
+	}
+	for _, id := range assistantIDs {
+		if err := add(id, domain.RefereeRoleAssistant); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.refereeRepo.SetMatchReferees(matchID, referees); err != nil {
+		return nil, err
+	}
+	if referees == nil {
+		referees = []domain.MatchReferee{}
+	}
+	return referees, nil
+}
+
+func (uc *RefereeUseCase) GetMatchReferees(matchID uuid.UUID) ([]domain.MatchReferee, error) {
+	if _, err := uc.matchRepo.GetByID(matchID); err != nil {
+		return nil, err
+	}
+	return uc.refereeRepo.GetByMatch(matchID)
+}
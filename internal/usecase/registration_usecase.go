@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// RegistrationCommands agrupa las operaciones que modifican los fichajes
+type RegistrationCommands interface {
+	RegisterPlayer(registration *domain.Registration) error
+	UnregisterPlayer(tournamentID, playerID uuid.UUID) error
+}
+
+// RegistrationQueries agrupa las lecturas de fichajes
+type RegistrationQueries interface {
+	// GetRegistrations lista los fichajes del torneo; teamID filtra por equipo
+	GetRegistrations(tournamentID uuid.UUID, teamID *uuid.UUID) ([]domain.Registration, error)
+}
+
+var (
+	_ RegistrationCommands = (*RegistrationUseCase)(nil)
+	_ RegistrationQueries  = (*RegistrationUseCase)(nil)
+)
+
+// RegistrationUseCase gestiona con qué equipo juega cada jugador cada torneo
+type RegistrationUseCase struct {
+	registrationRepo repository.RegistrationRepository
+	tournamentRepo   repository.TournamentRepository
+	teamRepo         repository.TeamRepository
+}
+
+func NewRegistrationUseCase(registrationRepo repository.RegistrationRepository, tournamentRepo repository.TournamentRepository, teamRepo repository.TeamRepository) *RegistrationUseCase {
+	return &RegistrationUseCase{
+		registrationRepo: registrationRepo,
+		tournamentRepo:   tournamentRepo,
+		teamRepo:         teamRepo,
+	}
+}
+
+// RegisterPlayer ficha a un jugador del plantel de un equipo inscripto en
+// el torneo. Un jugador fichado con otro equipo primero debe darse de baja.
+func (uc *RegistrationUseCase) RegisterPlayer(registration *domain.Registration) error {
+	if _, err := uc.tournamentRepo.GetByID(registration.TournamentID); err != nil {
+		return err
+	}
+
+	teams, err := uc.tournamentRepo.GetTournamentTeams(registration.TournamentID)
+	if err != nil {
+		return err
+	}
+	enrolled := false
+	for _, team := range teams {
+		if team.ID == registration.TeamID {
+			enrolled = true
+			break
+		}
+	}
+	if !enrolled {
+		return fmt.Errorf("team is not registered in this tournament")
+	}
+
+	players, err := uc.teamRepo.GetTeamPlayers(registration.TeamID)
+	if err != nil {
+		return err
+	}
+	inSquad := false
+	for _, player := range players {
+		if player.ID == registration.PlayerID {
+			inSquad = true
+			break
+		}
+	}
+	if !inSquad {
+		return fmt.Errorf("player does not belong to the team")
+	}
+
+	if existing, err := uc.registrationRepo.GetByPlayer(registration.TournamentID, registration.PlayerID); err == nil {
+		if existing.TeamID == registration.TeamID {
+			*registration = *existing
+			return nil
+		}
+		return fmt.Errorf("player is already registered with team %s in this tournament", existing.TeamID)
+	}
+
+	return uc.registrationRepo.Create(registration)
+}
+
+func (uc *RegistrationUseCase) UnregisterPlayer(tournamentID, playerID uuid.UUID) error {
+	return uc.registrationRepo.Delete(tournamentID, playerID)
+}
+
+func (uc *RegistrationUseCase) GetRegistrations(tournamentID uuid.UUID, teamID *uuid.UUID) ([]domain.Registration, error) {
+	if _, err := uc.tournamentRepo.GetByID(tournamentID); err != nil {
+		return nil, err
+	}
+	return uc.registrationRepo.GetByTournament(tournamentID, teamID)
+}
+
+// checkEligibility comprueba que los jugadores estén fichados con el equipo
+// en el torneo del partido. Los amistosos (sin torneo) no se validan.
+func checkEligibility(registrationRepo repository.RegistrationRepository, match *domain.Match, teamID uuid.UUID, playerIDs ...uuid.UUID) error {
+	if match.TournamentID == nil || len(playerIDs) == 0 {
+		return nil
+	}
+
+	registrations, err := registrationRepo.GetByTournament(*match.TournamentID, &teamID)
+	if err != nil {
+		return err
+	}
+	registered := make(map[uuid.UUID]bool, len(registrations))
+	for _, registration := range registrations {
+		registered[registration.PlayerID] = true
+	}
+
+	for _, playerID := range playerIDs {
+		if !registered[playerID] {
+			return fmt.Errorf("player %s is not registered with the team for this tournament", playerID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// SanctionCommands registra y anula decisiones disciplinarias
+type SanctionCommands interface {
+	CreateSanction(sanction *domain.Sanction) error
+	// RevokeSanction anula una sanción vigente (por ejemplo tras una apelación)
+	RevokeSanction(tournamentID, id uuid.UUID) (*domain.Sanction, error)
+}
+
+// SanctionQueries lee el historial disciplinario de un torneo
+type SanctionQueries interface {
+	GetSanction(tournamentID, id uuid.UUID) (*domain.Sanction, error)
+	// GetTournamentSanctions devuelve las sanciones del torneo; teamID y
+	// playerID, si no son nil, filtran por sancionado
+	GetTournamentSanctions(tournamentID uuid.UUID, teamID, playerID *uuid.UUID) ([]domain.Sanction, error)
+}
+
+var (
+	_ SanctionCommands = (*SanctionUseCase)(nil)
+	_ SanctionQueries  = (*SanctionUseCase)(nil)
+)
+
+// SanctionUseCase gestiona el tribunal de disciplina de cada torneo. Las
+// quitas de puntos no tocan los partidos: la tabla las resta al calcularse.
+type SanctionUseCase struct {
+	sanctionRepo   repository.SanctionRepository
+	tournamentRepo repository.TournamentRepository
+	playerRepo     repository.PlayerRepository
+}
+
+func NewSanctionUseCase(sanctionRepo repository.SanctionRepository, tournamentRepo repository.TournamentRepository, playerRepo repository.PlayerRepository) *SanctionUseCase {
+	return &SanctionUseCase{
+		sanctionRepo:   sanctionRepo,
+		tournamentRepo: tournamentRepo,
+		playerRepo:     playerRepo,
+	}
+}
+
+func (uc *SanctionUseCase) CreateSanction(sanction *domain.Sanction) error {
+	if _, err := uc.tournamentRepo.GetByID(sanction.TournamentID); err != nil {
+		return fmt.Errorf("tournament not found: %w", err)
+	}
+	if err := sanction.Validate(); err != nil {
+		return err
+	}
+
+	if sanction.TeamID != nil {
+		teams, err := uc.tournamentRepo.GetTournamentTeams(sanction.TournamentID)
+		if err != nil {
+			return err
+		}
+		registered := false
+		for _, team := range teams {
+			if team.ID == *sanction.TeamID {
+				registered = true
+				break
+			}
+		}
+		if !registered {
+			return fmt.Errorf("team is not registered in the tournament")
+		}
+	}
+	if sanction.PlayerID != nil {
+		if _, err := uc.playerRepo.GetByID(*sanction.PlayerID); err != nil {
+			return fmt.Errorf("player not found: %w", err)
+		}
+	}
+
+	return uc.sanctionRepo.Create(sanction)
+}
+
+func (uc *SanctionUseCase) RevokeSanction(tournamentID, id uuid.UUID) (*domain.Sanction, error) {
+	sanction, err := uc.GetSanction(tournamentID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !sanction.IsActive() {
+		return nil, fmt.Errorf("sanction was already revoked")
+	}
+
+	now := time.Now().UTC()
+	if err := uc.sanctionRepo.Revoke(id, now); err != nil {
+		return nil, err
+	}
+	sanction.RevokedAt = &now
+	return sanction, nil
+}
+
+func (uc *SanctionUseCase) GetSanction(tournamentID, id uuid.UUID) (*domain.Sanction, error) {
+	sanction, err := uc.sanctionRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if sanction.TournamentID != tournamentID {
+		return nil, fmt.Errorf("sanction not found")
+	}
+	return sanction, nil
+}
+
+func (uc *SanctionUseCase) GetTournamentSanctions(tournamentID uuid.UUID, teamID, playerID *uuid.UUID) ([]domain.Sanction, error) {
+	if _, err := uc.tournamentRepo.GetByID(tournamentID); err != nil {
+		return nil, err
+	}
+	sanctions, err := uc.sanctionRepo.GetByTournament(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := []domain.Sanction{}
+	for _, sanction := range sanctions {
+		if teamID != nil && (sanction.TeamID == nil || *sanction.TeamID != *teamID) {
+			continue
+		}
+		if playerID != nil && (sanction.PlayerID == nil || *sanction.PlayerID != *playerID) {
+			continue
+		}
+		filtered = append(filtered, sanction)
+	}
+	return filtered, nil
+}
@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// ScoreboardQueries arma el marcador que muestran las pantallas de una sede
+type ScoreboardQueries interface {
+	GetScoreboard(venueID uuid.UUID) (*domain.Scoreboard, error)
+}
+
+var (
+	_ ScoreboardQueries = (*ScoreboardUseCase)(nil)
+	_ ScoreboardQueries = (*CachedScoreboard)(nil)
+	_ MatchPublisher    = (*CachedScoreboard)(nil)
+)
+
+// ScoreboardUseCase junta los partidos en juego y los siguientes de una sede.
+// Las pantallas son públicas, así que los marcadores embargados se ocultan.
+type ScoreboardUseCase struct {
+	venueRepo      repository.VenueRepository
+	matchRepo      repository.MatchRepository
+	teamRepo       repository.TeamRepository
+	tournamentRepo repository.TournamentRepository
+	// rulesRepo aporta la restricción de público de cada torneo
+	rulesRepo repository.TournamentRulesRepository
+}
+
+func NewScoreboardUseCase(venueRepo repository.VenueRepository, matchRepo repository.MatchRepository, teamRepo repository.TeamRepository, tournamentRepo repository.TournamentRepository, rulesRepo repository.TournamentRulesRepository) *ScoreboardUseCase {
+	return &ScoreboardUseCase{
+		venueRepo:      venueRepo,
+		matchRepo:      matchRepo,
+		teamRepo:       teamRepo,
+		tournamentRepo: tournamentRepo,
+		rulesRepo:      rulesRepo,
+	}
+}
+
+func (uc *ScoreboardUseCase) GetScoreboard(venueID uuid.UUID) (*domain.Scoreboard, error) {
+	venue, err := uc.venueRepo.GetByID(venueID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	matches, err := uc.matchRepo.GetByVenue(venueID, now.Add(-domain.ScoreboardLiveWindow), now.Add(domain.ScoreboardUpcomingWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	teamNames := make(map[uuid.UUID]string)
+	for i := range matches {
+		until, embargoed, err := resultEmbargo(uc.tournamentRepo, &matches[i])
+		if err != nil {
+			return nil, err
+		}
+		if embargoed {
+			matches[i].HideResult(until)
+		}
+
+		for _, teamID := range []uuid.UUID{matches[i].Team1ID, matches[i].Team2ID} {
+			if _, ok := teamNames[teamID]; ok {
+				continue
+			}
+			team, err := uc.teamRepo.GetByID(teamID)
+			if err != nil {
+				return nil, err
+			}
+			teamNames[teamID] = team.Name
+		}
+	}
+	if err := attachAttendance(uc.venueRepo, uc.rulesRepo, matches, now); err != nil {
+		return nil, err
+	}
+
+	return domain.NewScoreboard(venue, matches, teamNames, now), nil
+}
+
+// CachedScoreboard guarda cada marcador durante ttl: en un complejo con
+// varias canchas muchas pantallas consultan la misma sede a la vez. Como
+// MatchPublisher descarta todo lo guardado ante cualquier cambio de un
+// partido, así un gol se ve sin esperar a que venza el ttl.
+type CachedScoreboard struct {
+	queries ScoreboardQueries
+	ttl     time.Duration
+
+	mu      sync.Mutex
+	entries map[uuid.UUID]*domain.Scoreboard
+	// generation cambia al invalidar, para no guardar un marcador que se
+	// calculó antes del cambio
+	generation int
+}
+
+// NewCachedScoreboard envuelve queries con una cache en memoria
+func NewCachedScoreboard(queries ScoreboardQueries, ttl time.Duration) *CachedScoreboard {
+	return &CachedScoreboard{
+		queries: queries,
+		ttl:     ttl,
+		entries: make(map[uuid.UUID]*domain.Scoreboard),
+	}
+}
+
+// TTL es cuánto puede servirse un marcador guardado
+func (c *CachedScoreboard) TTL() time.Duration {
+	return c.ttl
+}
+
+func (c *CachedScoreboard) GetScoreboard(venueID uuid.UUID) (*domain.Scoreboard, error) {
+	c.mu.Lock()
+	cached, ok := c.entries[venueID]
+	generation := c.generation
+	c.mu.Unlock()
+	if ok && time.Since(cached.GeneratedAt) < c.ttl {
+		return cached, nil
+	}
+
+	scoreboard, err := c.queries.GetScoreboard(venueID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if c.generation == generation {
+		c.entries[venueID] = scoreboard
+	}
+	c.mu.Unlock()
+	return scoreboard, nil
+}
+
+// PublishMatchUpdate invalida la cache. Un evento no indica la sede del
+// partido, así que se descartan todas.
+func (c *CachedScoreboard) PublishMatchUpdate(update domain.MatchUpdate) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[uuid.UUID]*domain.Scoreboard)
+	c.generation++
+}
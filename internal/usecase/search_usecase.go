@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+)
+
+// SearchQueries es la búsqueda global por nombre
+type SearchQueries interface {
+	// Search busca en los tipos pedidos y devuelve los resultados de todos
+	// juntos, los más parecidos al texto primero
+	Search(query domain.SearchQuery) ([]domain.SearchResult, error)
+}
+
+var _ SearchQueries = (*SearchUseCase)(nil)
+
+// SearchUseCase busca jugadores, equipos y torneos para el buscador único de
+// las pantallas de administración. Cada repositorio resuelve su parte; acá
+// solo se juntan y se ordenan.
+type SearchUseCase struct {
+	playerRepo     repository.PlayerRepository
+	teamRepo       repository.TeamRepository
+	tournamentRepo repository.TournamentRepository
+}
+
+func NewSearchUseCase(playerRepo repository.PlayerRepository, teamRepo repository.TeamRepository, tournamentRepo repository.TournamentRepository) *SearchUseCase {
+	return &SearchUseCase{playerRepo: playerRepo, teamRepo: teamRepo, tournamentRepo: tournamentRepo}
+}
+
+func (uc *SearchUseCase) Search(query domain.SearchQuery) ([]domain.SearchResult, error) {
+	results := []domain.SearchResult{}
+
+	if query.Includes(domain.SearchTeam) {
+		teams, err := uc.teamRepo.Search(query)
+		if err != nil {
+			return nil, err
+		}
+		for _, team := range teams {
+			results = append(results, domain.SearchResult{Type: domain.SearchTeam, ID: team.ID, Name: team.Name})
+		}
+	}
+
+	if query.Includes(domain.SearchTournament) {
+		tournaments, err := uc.tournamentRepo.Search(query)
+		if err != nil {
+			return nil, err
+		}
+		for _, tournament := range tournaments {
+			detail := tournament.Status
+			if tournament.ArchivedAt != nil {
+				detail = "archived"
+			}
+			results = append(results, domain.SearchResult{Type: domain.SearchTournament, ID: tournament.ID, Name: tournament.Name, Detail: detail})
+		}
+	}
+
+	if query.Includes(domain.SearchPlayer) {
+		players, err := uc.playerRepo.Search(query)
+		if err != nil {
+			return nil, err
+		}
+		for _, player := range players {
+			results = append(results, domain.SearchResult{Type: domain.SearchPlayer, ID: player.ID, Name: player.Name, Detail: player.Position})
+		}
+	}
+
+	domain.SortSearchResults(results, query)
+	return results, nil
+}
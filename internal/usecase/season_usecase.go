@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// SeasonCommands agrupa las operaciones que modifican temporadas
+type SeasonCommands interface {
+	CreateSeason(season *domain.Season) error
+	UpdateSeason(season *domain.Season) error
+	DeleteSeason(id uuid.UUID) error
+}
+
+// SeasonQueries agrupa las lecturas de temporadas
+type SeasonQueries interface {
+	GetSeasonByID(id uuid.UUID) (*domain.Season, error)
+	GetAllSeasons() ([]domain.Season, error)
+}
+
+var (
+	_ SeasonCommands = (*SeasonUseCase)(nil)
+	_ SeasonQueries  = (*SeasonUseCase)(nil)
+)
+
+type SeasonUseCase struct {
+	seasonRepo repository.SeasonRepository
+}
+
+func NewSeasonUseCase(seasonRepo repository.SeasonRepository) *SeasonUseCase {
+	return &SeasonUseCase{seasonRepo: seasonRepo}
+}
+
+func (uc *SeasonUseCase) CreateSeason(season *domain.Season) error {
+	if err := validateSeason(season); err != nil {
+		return err
+	}
+	return uc.seasonRepo.Create(season)
+}
+
+func (uc *SeasonUseCase) GetSeasonByID(id uuid.UUID) (*domain.Season, error) {
+	return uc.seasonRepo.GetByID(id)
+}
+
+func (uc *SeasonUseCase) GetAllSeasons() ([]domain.Season, error) {
+	return uc.seasonRepo.GetAll()
+}
+
+func (uc *SeasonUseCase) UpdateSeason(season *domain.Season) error {
+	if err := validateSeason(season); err != nil {
+		return err
+	}
+	return uc.seasonRepo.Update(season)
+}
+
+func (uc *SeasonUseCase) DeleteSeason(id uuid.UUID) error {
+	return uc.seasonRepo.Delete(id)
+}
+
+func validateSeason(season *domain.Season) error {
+	if strings.TrimSpace(season.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if season.EndsOn.Before(season.StartsOn) {
+		return fmt.Errorf("ends_on must not be before starts_on")
+	}
+	return nil
+}
+
+// findSeason busca una temporada por ID o por nombre ("2024/25"), como la
+// acepta el filtro ?season= de los listados
+func findSeason(seasonRepo repository.SeasonRepository, ref string) (*domain.Season, error) {
+	if id, err := uuid.Parse(ref); err == nil {
+		return seasonRepo.GetByID(id)
+	}
+	return seasonRepo.GetByName(strings.TrimSpace(ref))
+}
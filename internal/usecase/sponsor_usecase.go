@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// SponsorCommands agrupa las operaciones que modifican patrocinadores
+type SponsorCommands interface {
+	CreateSponsor(sponsor *domain.Sponsor) error
+	UpdateSponsor(sponsor *domain.Sponsor) error
+	DeleteSponsor(tournamentID, id uuid.UUID) error
+}
+
+// SponsorQueries agrupa las lecturas de patrocinadores
+type SponsorQueries interface {
+	GetSponsor(tournamentID, id uuid.UUID) (*domain.Sponsor, error)
+	GetTournamentSponsors(tournamentID uuid.UUID) ([]domain.Sponsor, error)
+	GetActiveSponsors(tournamentID uuid.UUID, placement string) ([]domain.Sponsor, error)
+}
+
+var (
+	_ SponsorCommands = (*SponsorUseCase)(nil)
+	_ SponsorQueries  = (*SponsorUseCase)(nil)
+)
+
+// SponsorUseCase gestiona los patrocinadores de cada torneo
+type SponsorUseCase struct {
+	sponsorRepo    repository.SponsorRepository
+	tournamentRepo repository.TournamentRepository
+}
+
+func NewSponsorUseCase(sponsorRepo repository.SponsorRepository, tournamentRepo repository.TournamentRepository) *SponsorUseCase {
+	return &SponsorUseCase{
+		sponsorRepo:    sponsorRepo,
+		tournamentRepo: tournamentRepo,
+	}
+}
+
+func (uc *SponsorUseCase) CreateSponsor(sponsor *domain.Sponsor) error {
+	if _, err := uc.tournamentRepo.GetByID(sponsor.TournamentID); err != nil {
+		return fmt.Errorf("tournament not found: %w", err)
+	}
+	if err := validateSponsor(sponsor); err != nil {
+		return err
+	}
+	return uc.sponsorRepo.Create(sponsor)
+}
+
+func (uc *SponsorUseCase) GetSponsor(tournamentID, id uuid.UUID) (*domain.Sponsor, error) {
+	sponsor, err := uc.sponsorRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if sponsor.TournamentID != tournamentID {
+		return nil, fmt.Errorf("sponsor not found")
+	}
+	return sponsor, nil
+}
+
+func (uc *SponsorUseCase) GetTournamentSponsors(tournamentID uuid.UUID) ([]domain.Sponsor, error) {
+	return uc.sponsorRepo.GetByTournament(tournamentID)
+}
+
+// GetActiveSponsors devuelve los banners vigentes ahora para una ubicación
+func (uc *SponsorUseCase) GetActiveSponsors(tournamentID uuid.UUID, placement string) ([]domain.Sponsor, error) {
+	return uc.sponsorRepo.GetActive(tournamentID, placement, time.Now().UTC())
+}
+
+func (uc *SponsorUseCase) UpdateSponsor(sponsor *domain.Sponsor) error {
+	if _, err := uc.GetSponsor(sponsor.TournamentID, sponsor.ID); err != nil {
+		return err
+	}
+	if err := validateSponsor(sponsor); err != nil {
+		return err
+	}
+	return uc.sponsorRepo.Update(sponsor)
+}
+
+func (uc *SponsorUseCase) DeleteSponsor(tournamentID, id uuid.UUID) error {
+	if _, err := uc.GetSponsor(tournamentID, id); err != nil {
+		return err
+	}
+	return uc.sponsorRepo.Delete(id)
+}
+
+func validateSponsor(sponsor *domain.Sponsor) error {
+	if strings.TrimSpace(sponsor.Name) == "" {
+		return fmt.Errorf("sponsor name is required")
+	}
+	if strings.TrimSpace(sponsor.Placement) == "" {
+		return fmt.Errorf("placement is required")
+	}
+	if !isHTTPURL(sponsor.LogoURL) {
+		return fmt.Errorf("logo_url must be an absolute http(s) URL")
+	}
+	if sponsor.LinkURL != "" && !isHTTPURL(sponsor.LinkURL) {
+		return fmt.Errorf("link_url must be an absolute http(s) URL")
+	}
+	if sponsor.EndsAt.Before(sponsor.StartsAt) {
+		return fmt.Errorf("ends_at must be after starts_at")
+	}
+	return nil
+}
+
+func isHTTPURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// StaffCommands agrupa las operaciones que modifican el cuerpo técnico
+type StaffCommands interface {
+	CreateStaff(staff *domain.Staff) error
+	UpdateStaff(staff *domain.Staff) error
+	DeleteStaff(teamID, id uuid.UUID) error
+}
+
+// StaffQueries agrupa las lecturas del cuerpo técnico
+type StaffQueries interface {
+	GetStaff(teamID, id uuid.UUID) (*domain.Staff, error)
+	GetTeamStaff(teamID uuid.UUID) ([]domain.Staff, error)
+}
+
+var (
+	_ StaffCommands = (*StaffUseCase)(nil)
+	_ StaffQueries  = (*StaffUseCase)(nil)
+)
+
+// StaffUseCase gestiona el cuerpo técnico de los equipos
+type StaffUseCase struct {
+	staffRepo repository.StaffRepository
+	teamRepo  repository.TeamRepository
+}
+
+func NewStaffUseCase(staffRepo repository.StaffRepository, teamRepo repository.TeamRepository) *StaffUseCase {
+	return &StaffUseCase{
+		staffRepo: staffRepo,
+		teamRepo:  teamRepo,
+	}
+}
+
+func (uc *StaffUseCase) CreateStaff(staff *domain.Staff) error {
+	if _, err := uc.teamRepo.GetByID(staff.TeamID); err != nil {
+		return err
+	}
+	if err := validateStaff(staff); err != nil {
+		return err
+	}
+	return uc.staffRepo.Create(staff)
+}
+
+func (uc *StaffUseCase) GetStaff(teamID, id uuid.UUID) (*domain.Staff, error) {
+	staff, err := uc.staffRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if staff.TeamID != teamID {
+		return nil, fmt.Errorf("staff member not found")
+	}
+	return staff, nil
+}
+
+func (uc *StaffUseCase) GetTeamStaff(teamID uuid.UUID) ([]domain.Staff, error) {
+	if _, err := uc.teamRepo.GetByID(teamID); err != nil {
+		return nil, err
+	}
+	return uc.staffRepo.GetByTeam(teamID)
+}
+
+func (uc *StaffUseCase) UpdateStaff(staff *domain.Staff) error {
+	existing, err := uc.GetStaff(staff.TeamID, staff.ID)
+	if err != nil {
+		return err
+	}
+	if err := validateStaff(staff); err != nil {
+		return err
+	}
+	staff.CreatedAt = existing.CreatedAt
+	return uc.staffRepo.Update(staff)
+}
+
+func (uc *StaffUseCase) DeleteStaff(teamID, id uuid.UUID) error {
+	if _, err := uc.GetStaff(teamID, id); err != nil {
+		return err
+	}
+	return uc.staffRepo.Delete(id)
+}
+
+func validateStaff(staff *domain.Staff) error {
+	if strings.TrimSpace(staff.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if !domain.IsValidStaffRole(staff.Role) {
+		return fmt.Errorf("invalid staff role: %s", staff.Role)
+	}
+	return nil
+}
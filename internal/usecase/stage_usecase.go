@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// StageCommands agrupa las operaciones que modifican las fases de un torneo
+type StageCommands interface {
+	CreateStage(stage *domain.Stage) error
+	DeleteStage(tournamentID, id uuid.UUID) error
+	AdvanceStage(tournamentID uuid.UUID) ([]domain.Stage, error)
+}
+
+// StageQueries agrupa las lecturas de fases
+type StageQueries interface {
+	GetStage(tournamentID, id uuid.UUID) (*domain.Stage, error)
+	GetTournamentStages(tournamentID uuid.UUID) ([]domain.Stage, error)
+	GetStageMatches(tournamentID, id uuid.UUID) ([]domain.Match, error)
+}
+
+var (
+	_ StageCommands = (*StageUseCase)(nil)
+	_ StageQueries  = (*StageUseCase)(nil)
+)
+
+type StageUseCase struct {
+	stageRepo      repository.StageRepository
+	tournamentRepo repository.TournamentRepository
+	matchRepo      repository.MatchRepository
+}
+
+func NewStageUseCase(stageRepo repository.StageRepository, tournamentRepo repository.TournamentRepository, matchRepo repository.MatchRepository) *StageUseCase {
+	return &StageUseCase{
+		stageRepo:      stageRepo,
+		tournamentRepo: tournamentRepo,
+		matchRepo:      matchRepo,
+	}
+}
+
+// CreateStage agrega una fase al torneo. Sin posición se agrega al final.
+func (uc *StageUseCase) CreateStage(stage *domain.Stage) error {
+	if _, err := uc.tournamentRepo.GetByID(stage.TournamentID); err != nil {
+		return err
+	}
+	if strings.TrimSpace(stage.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	existing, err := uc.stageRepo.GetByTournament(stage.TournamentID)
+	if err != nil {
+		return err
+	}
+	if stage.Position == 0 {
+		stage.Position = len(existing) + 1
+		for _, other := range existing {
+			if other.Position >= stage.Position {
+				stage.Position = other.Position + 1
+			}
+		}
+	}
+	if stage.Position < 0 {
+		return fmt.Errorf("position must be positive")
+	}
+	for _, other := range existing {
+		if other.Position == stage.Position {
+			return fmt.Errorf("position %d is already used by stage %q", stage.Position, other.Name)
+		}
+	}
+
+	return uc.stageRepo.Create(stage)
+}
+
+func (uc *StageUseCase) GetStage(tournamentID, id uuid.UUID) (*domain.Stage, error) {
+	stage, err := uc.stageRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if stage.TournamentID != tournamentID {
+		return nil, fmt.Errorf("stage not found")
+	}
+	return stage, nil
+}
+
+func (uc *StageUseCase) GetTournamentStages(tournamentID uuid.UUID) ([]domain.Stage, error) {
+	if _, err := uc.tournamentRepo.GetByID(tournamentID); err != nil {
+		return nil, err
+	}
+	return uc.stageRepo.GetByTournament(tournamentID)
+}
+
+// GetStageMatches devuelve los partidos de la fase
+func (uc *StageUseCase) GetStageMatches(tournamentID, id uuid.UUID) ([]domain.Match, error) {
+	if _, err := uc.GetStage(tournamentID, id); err != nil {
+		return nil, err
+	}
+
+	matches, err := uc.matchRepo.GetByTournament(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	stageMatches := []domain.Match{}
+	for _, match := range matches {
+		if match.StageID != nil && *match.StageID == id {
+			stageMatches = append(stageMatches, match)
+		}
+	}
+	decideWinners(stageMatches)
+	return stageMatches, nil
+}
+
+// DeleteStage borra la fase; sus partidos quedan sin fase
+func (uc *StageUseCase) DeleteStage(tournamentID, id uuid.UUID) error {
+	if _, err := uc.GetStage(tournamentID, id); err != nil {
+		return err
+	}
+	return uc.stageRepo.Delete(id)
+}
+
+// AdvanceStage cierra la fase activa y activa la siguiente. Sin fase activa
+// activa la primera pendiente. Una fase solo se cierra cuando todos sus
+// partidos ya se jugaron.
+func (uc *StageUseCase) AdvanceStage(tournamentID uuid.UUID) ([]domain.Stage, error) {
+	stages, err := uc.GetTournamentStages(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []domain.Stage
+	next := 0
+	for i := range stages {
+		if stages[i].Status != domain.StageActive {
+			continue
+		}
+
+		matches, err := uc.GetStageMatches(tournamentID, stages[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		now := time.Now().UTC()
+		for _, match := range matches {
+			if match.Date.After(now) {
+				return nil, fmt.Errorf("stage %q has matches that have not been played yet", stages[i].Name)
+			}
+		}
+
+		stages[i].Status = domain.StageCompleted
+		changed = append(changed, stages[i])
+		next = i + 1
+		break
+	}
+
+	// La siguiente fase es la primera pendiente desde la que se cerró
+	for i := next; i < len(stages); i++ {
+		if stages[i].Status == domain.StagePending {
+			stages[i].Status = domain.StageActive
+			changed = append(changed, stages[i])
+			break
+		}
+	}
+
+	if len(changed) == 0 {
+		return nil, fmt.Errorf("there are no stages left to advance")
+	}
+	if err := uc.stageRepo.UpdateStatuses(changed); err != nil {
+		return nil, err
+	}
+	return stages, nil
+}
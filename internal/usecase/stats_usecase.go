@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// StatsQueries agrupa las estadísticas de jugadores por torneo (solo lectura)
+type StatsQueries interface {
+	GetTopScorers(tournamentID uuid.UUID, includeEmbargoed bool) ([]domain.TopScorer, error)
+	GetTopAssists(tournamentID uuid.UUID, includeEmbargoed bool) ([]domain.TopAssister, error)
+	GetCleanSheets(tournamentID uuid.UUID, includeEmbargoed bool) (*domain.CleanSheets, error)
+}
+
+var _ StatsQueries = (*StatsUseCase)(nil)
+
+// StatsUseCase calcula las tablas de jugadores a partir de los eventos de partido
+type StatsUseCase struct {
+	statsRepo      repository.StatsRepository
+	tournamentRepo repository.TournamentRepository
+}
+
+func NewStatsUseCase(statsRepo repository.StatsRepository, tournamentRepo repository.TournamentRepository) *StatsUseCase {
+	return &StatsUseCase{
+		statsRepo:      statsRepo,
+		tournamentRepo: tournamentRepo,
+	}
+}
+
+// GetTopScorers devuelve la tabla de goleadores. Salvo includeEmbargoed
+// (organizadores), no cuenta los goles de partidos con resultado embargado.
+func (uc *StatsUseCase) GetTopScorers(tournamentID uuid.UUID, includeEmbargoed bool) ([]domain.TopScorer, error) {
+	embargo, err := uc.embargoMinutes(tournamentID, includeEmbargoed)
+	if err != nil {
+		return nil, err
+	}
+	return uc.statsRepo.GetTopScorers(tournamentID, embargo)
+}
+
+// GetTopAssists devuelve la tabla de asistidores con el mismo criterio de embargo
+func (uc *StatsUseCase) GetTopAssists(tournamentID uuid.UUID, includeEmbargoed bool) ([]domain.TopAssister, error) {
+	embargo, err := uc.embargoMinutes(tournamentID, includeEmbargoed)
+	if err != nil {
+		return nil, err
+	}
+	return uc.statsRepo.GetTopAssists(tournamentID, embargo)
+}
+
+// GetCleanSheets devuelve vallas invictas y goles recibidos por equipo y por arquero
+func (uc *StatsUseCase) GetCleanSheets(tournamentID uuid.UUID, includeEmbargoed bool) (*domain.CleanSheets, error) {
+	embargo, err := uc.embargoMinutes(tournamentID, includeEmbargoed)
+	if err != nil {
+		return nil, err
+	}
+	return uc.statsRepo.GetCleanSheets(tournamentID, embargo)
+}
+
+// embargoMinutes valida el torneo y devuelve el embargo a aplicar
+func (uc *StatsUseCase) embargoMinutes(tournamentID uuid.UUID, includeEmbargoed bool) (int, error) {
+	tournament, err := uc.tournamentRepo.GetByID(tournamentID)
+	if err != nil {
+		return 0, err
+	}
+	if includeEmbargoed {
+		return 0, nil
+	}
+	return tournament.ResultsDelayMinutes, nil
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// statusCheckTimeout acota cada chequeo para que la página de estado
+// responda aunque una dependencia no conteste
+const statusCheckTimeout = 2 * time.Second
+
+// DependencyCheck es un chequeo de salud de una dependencia (base de datos,
+// esquema, etc.). Los arma la capa de composición, que conoce la infraestructura.
+type DependencyCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// IncidentCommands agrupa las operaciones de los administradores sobre los incidentes
+type IncidentCommands interface {
+	CreateIncident(incident *domain.Incident) error
+	ResolveIncident(id uuid.UUID) (*domain.Incident, error)
+}
+
+// StatusQueries agrupa las lecturas de la página de estado
+type StatusQueries interface {
+	GetStatus(ctx context.Context) (*domain.ServiceStatus, error)
+}
+
+var (
+	_ IncidentCommands = (*StatusUseCase)(nil)
+	_ StatusQueries    = (*StatusUseCase)(nil)
+)
+
+// StatusUseCase arma el estado del servicio para una página de estado:
+// uptime, salud de las dependencias e incidentes abiertos
+type StatusUseCase struct {
+	incidentRepo repository.IncidentRepository
+	startedAt    time.Time
+	checks       []DependencyCheck
+}
+
+func NewStatusUseCase(incidentRepo repository.IncidentRepository, startedAt time.Time, checks ...DependencyCheck) *StatusUseCase {
+	return &StatusUseCase{
+		incidentRepo: incidentRepo,
+		startedAt:    startedAt,
+		checks:       checks,
+	}
+}
+
+// GetStatus nunca falla por una dependencia caída: la informa en el resumen.
+// Si no se pueden leer los incidentes, el servicio figura degradado.
+func (uc *StatusUseCase) GetStatus(ctx context.Context) (*domain.ServiceStatus, error) {
+	now := time.Now().UTC()
+	status := &domain.ServiceStatus{
+		Status:        domain.StatusOperational,
+		StartedAt:     uc.startedAt,
+		UptimeSeconds: int64(now.Sub(uc.startedAt).Seconds()),
+		Dependencies:  make([]domain.DependencyHealth, 0, len(uc.checks)),
+		Incidents:     []domain.Incident{},
+		CheckedAt:     now,
+	}
+
+	for _, check := range uc.checks {
+		health := runDependencyCheck(ctx, check)
+		if health.Status != domain.StatusOperational {
+			status.Status = domain.StatusDegraded
+		}
+		status.Dependencies = append(status.Dependencies, health)
+	}
+
+	incidents, err := uc.incidentRepo.GetActive()
+	if err != nil {
+		status.Status = domain.StatusDegraded
+		return status, nil
+	}
+	status.Incidents = incidents
+
+	for _, incident := range incidents {
+		switch {
+		case incident.Severity == domain.IncidentMajor:
+			status.Status = domain.StatusDegraded
+		case incident.Severity == domain.IncidentMaintenance && status.Status == domain.StatusOperational:
+			status.Status = domain.StatusMaintenance
+		}
+	}
+	return status, nil
+}
+
+func runDependencyCheck(ctx context.Context, check DependencyCheck) domain.DependencyHealth {
+	ctx, cancel := context.WithTimeout(ctx, statusCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check.Check(ctx)
+	health := domain.DependencyHealth{
+		Name:      check.Name,
+		Status:    domain.StatusOperational,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		health.Status = domain.StatusDown
+		health.Error = err.Error()
+	}
+	return health
+}
+
+func (uc *StatusUseCase) CreateIncident(incident *domain.Incident) error {
+	if strings.TrimSpace(incident.Title) == "" {
+		return fmt.Errorf("title is required")
+	}
+	if !domain.IsValidIncidentSeverity(incident.Severity) {
+		return fmt.Errorf("invalid incident severity: %s", incident.Severity)
+	}
+	return uc.incidentRepo.Create(incident)
+}
+
+func (uc *StatusUseCase) ResolveIncident(id uuid.UUID) (*domain.Incident, error) {
+	if err := uc.incidentRepo.Resolve(id, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	return uc.incidentRepo.GetByID(id)
+}
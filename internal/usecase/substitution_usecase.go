@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// SubstitutionCommands agrupa las operaciones que modifican cambios
+type SubstitutionCommands interface {
+	CreateSubstitution(substitution *domain.Substitution) error
+	DeleteSubstitution(matchID, id uuid.UUID) error
+}
+
+// SubstitutionQueries agrupa las lecturas de cambios
+type SubstitutionQueries interface {
+	GetSubstitution(matchID, id uuid.UUID) (*domain.Substitution, error)
+	GetMatchSubstitutions(matchID uuid.UUID) ([]domain.Substitution, error)
+}
+
+var (
+	_ SubstitutionCommands = (*SubstitutionUseCase)(nil)
+	_ SubstitutionQueries  = (*SubstitutionUseCase)(nil)
+)
+
+// SubstitutionUseCase registra los cambios de jugadores de cada partido
+type SubstitutionUseCase struct {
+	substitutionRepo repository.SubstitutionRepository
+	matchRepo        repository.MatchRepository
+	teamRepo         repository.TeamRepository
+	lineupRepo       repository.LineupRepository
+}
+
+func NewSubstitutionUseCase(substitutionRepo repository.SubstitutionRepository, matchRepo repository.MatchRepository, teamRepo repository.TeamRepository, lineupRepo repository.LineupRepository) *SubstitutionUseCase {
+	return &SubstitutionUseCase{
+		substitutionRepo: substitutionRepo,
+		matchRepo:        matchRepo,
+		teamRepo:         teamRepo,
+		lineupRepo:       lineupRepo,
+	}
+}
+
+func (uc *SubstitutionUseCase) CreateSubstitution(substitution *domain.Substitution) error {
+	match, err := uc.matchRepo.GetByID(substitution.MatchID)
+	if err != nil {
+		return err
+	}
+
+	if substitution.TeamID != match.Team1ID && substitution.TeamID != match.Team2ID {
+		return fmt.Errorf("team does not play in this match")
+	}
+	if substitution.Minute < 0 || substitution.Minute > maxEventMinute {
+		return fmt.Errorf("minute must be between 0 and %d", maxEventMinute)
+	}
+	if substitution.PlayerOutID == substitution.PlayerInID {
+		return fmt.Errorf("a player cannot replace themselves")
+	}
+
+	// Ambos jugadores deben pertenecer al equipo que hace el cambio
+	players, err := uc.teamRepo.GetTeamPlayers(substitution.TeamID)
+	if err != nil {
+		return err
+	}
+	roster := make(map[uuid.UUID]bool, len(players))
+	for _, player := range players {
+		roster[player.ID] = true
+	}
+	if !roster[substitution.PlayerOutID] {
+		return fmt.Errorf("player_out does not belong to the team")
+	}
+	if !roster[substitution.PlayerInID] {
+		return fmt.Errorf("player_in does not belong to the team")
+	}
+
+	// Quien sale no puede volver a entrar ni salir dos veces
+	existing, err := uc.substitutionRepo.GetByMatch(substitution.MatchID)
+	if err != nil {
+		return err
+	}
+	for _, previous := range existing {
+		if previous.PlayerOutID == substitution.PlayerOutID {
+			return fmt.Errorf("player_out was already substituted")
+		}
+		if previous.PlayerOutID == substitution.PlayerInID {
+			return fmt.Errorf("player_in was substituted earlier and cannot return")
+		}
+		if previous.PlayerInID == substitution.PlayerInID {
+			return fmt.Errorf("player_in already came on")
+		}
+	}
+
+	if err := uc.validateAgainstLineup(substitution, existing); err != nil {
+		return err
+	}
+
+	return uc.substitutionRepo.Create(substitution)
+}
+
+// validateAgainstLineup exige, si el equipo cargó alineación, que salga un
+// jugador en cancha (titular o que ya entró) y entre uno del banco
+func (uc *SubstitutionUseCase) validateAgainstLineup(substitution *domain.Substitution, previous []domain.Substitution) error {
+	lineups, err := uc.lineupRepo.GetByMatch(substitution.MatchID)
+	if err != nil {
+		return err
+	}
+
+	for _, lineup := range lineups {
+		if lineup.TeamID != substitution.TeamID {
+			continue
+		}
+
+		_, starter := lineup.Has(substitution.PlayerOutID)
+		if !starter {
+			cameOn := false
+			for _, p := range previous {
+				if p.PlayerInID == substitution.PlayerOutID {
+					cameOn = true
+					break
+				}
+			}
+			if !cameOn {
+				return fmt.Errorf("player_out is not on the pitch")
+			}
+		}
+
+		if inLineup, starter := lineup.Has(substitution.PlayerInID); !inLineup || starter {
+			return fmt.Errorf("player_in is not on the bench")
+		}
+	}
+	return nil
+}
+
+func (uc *SubstitutionUseCase) GetSubstitution(matchID, id uuid.UUID) (*domain.Substitution, error) {
+	substitution, err := uc.substitutionRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if substitution.MatchID != matchID {
+		return nil, fmt.Errorf("substitution not found")
+	}
+	return substitution, nil
+}
+
+func (uc *SubstitutionUseCase) GetMatchSubstitutions(matchID uuid.UUID) ([]domain.Substitution, error) {
+	if _, err := uc.matchRepo.GetByID(matchID); err != nil {
+		return nil, err
+	}
+	return uc.substitutionRepo.GetByMatch(matchID)
+}
+
+func (uc *SubstitutionUseCase) DeleteSubstitution(matchID, id uuid.UUID) error {
+	if _, err := uc.GetSubstitution(matchID, id); err != nil {
+		return err
+	}
+	return uc.substitutionRepo.Delete(id)
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// Los conflictos de sincronización los genera MatchUseCase al crear o editar
+// partidos, así que la resolución vive en el mismo caso de uso.
+
+// SyncConflictCommands agrupa las operaciones que resuelven conflictos
+type SyncConflictCommands interface {
+	ResolveConflict(id uuid.UUID, resolution string) (*domain.SyncConflict, error)
+}
+
+// SyncConflictQueries agrupa las lecturas de conflictos
+type SyncConflictQueries interface {
+	GetConflicts(status string) ([]domain.SyncConflict, error)
+	GetConflictByID(id uuid.UUID) (*domain.SyncConflict, error)
+}
+
+var (
+	_ SyncConflictCommands = (*MatchUseCase)(nil)
+	_ SyncConflictQueries  = (*MatchUseCase)(nil)
+)
+
+func (uc *MatchUseCase) GetConflicts(status string) ([]domain.SyncConflict, error) {
+	if status != "" && status != domain.ConflictOpen && status != domain.ConflictResolved {
+		return nil, fmt.Errorf("invalid status: %s", status)
+	}
+	return uc.conflictRepo.GetAll(status)
+}
+
+func (uc *MatchUseCase) GetConflictByID(id uuid.UUID) (*domain.SyncConflict, error) {
+	return uc.conflictRepo.GetByID(id)
+}
+
+// ResolveConflict aplica la resolución elegida y cierra el conflicto.
+// Con "client" la versión del cliente pisa la del servidor; con "renumber"
+// el partido del cliente se crea con el siguiente número libre del torneo.
+func (uc *MatchUseCase) ResolveConflict(id uuid.UUID, resolution string) (*domain.SyncConflict, error) {
+	conflict, err := uc.conflictRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if conflict.Status != domain.ConflictOpen {
+		return nil, fmt.Errorf("sync conflict is already resolved")
+	}
+	if !conflict.IsValidResolution(resolution) {
+		return nil, fmt.Errorf("invalid resolution %q for %s conflicts", resolution, conflict.Kind)
+	}
+
+	client := conflict.ClientMatch
+	switch resolution {
+	case domain.ResolutionKeepClient:
+		// Sin versión, UpdateMatch no vuelve a comprobar la concurrencia
+		client.ID = conflict.MatchID
+		client.UpdatedAt = time.Time{}
+		if err := uc.UpdateMatch(&client); err != nil {
+			return nil, err
+		}
+	case domain.ResolutionRenumber:
+		number, err := uc.nextMatchNumber(*client.TournamentID)
+		if err != nil {
+			return nil, err
+		}
+		client.MatchNumber = number
+		if err := uc.CreateMatch(&client); err != nil {
+			return nil, err
+		}
+	}
+
+	resolvedAt := time.Now().UTC()
+	conflict.Status = domain.ConflictResolved
+	conflict.Resolution = resolution
+	conflict.ResolvedAt = &resolvedAt
+	if err := uc.conflictRepo.Resolve(conflict); err != nil {
+		return nil, err
+	}
+	return conflict, nil
+}
+
+// checkMatchNumber detecta otro partido del torneo con el mismo número.
+// Los mini-juegos se numeran dentro de su partido padre y no se comprueban.
+func (uc *MatchUseCase) checkMatchNumber(match *domain.Match) error {
+	if match.TournamentID == nil || match.ParentMatchID != nil {
+		return nil
+	}
+
+	matches, err := uc.matchRepo.GetByTournament(*match.TournamentID)
+	if err != nil {
+		return err
+	}
+	for _, other := range matches {
+		if other.ID != match.ID && other.MatchNumber == match.MatchNumber {
+			return uc.recordConflict(domain.ConflictDuplicateMatchNumber, other.ID, match)
+		}
+	}
+	return nil
+}
+
+func (uc *MatchUseCase) nextMatchNumber(tournamentID uuid.UUID) (int, error) {
+	matches, err := uc.matchRepo.GetByTournament(tournamentID)
+	if err != nil {
+		return 0, err
+	}
+
+	next := 1
+	for _, match := range matches {
+		if match.MatchNumber >= next {
+			next = match.MatchNumber + 1
+		}
+	}
+	return next, nil
+}
+
+// recordConflict guarda la operación rechazada para resolverla más tarde
+func (uc *MatchUseCase) recordConflict(kind string, matchID uuid.UUID, clientMatch *domain.Match) error {
+	conflict := domain.NewSyncConflict(kind, matchID, *clientMatch)
+	if err := uc.conflictRepo.Create(conflict); err != nil {
+		return err
+	}
+	return &domain.SyncConflictError{Conflict: conflict}
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// SyncCommands agrupa la sincronización por lotes de las tablets de planilleros
+type SyncCommands interface {
+	ApplyBatch(ops []domain.SyncOperation) ([]domain.SyncOpResult, error)
+}
+
+var _ SyncCommands = (*SyncUseCase)(nil)
+
+type SyncUseCase struct {
+	syncRepo  repository.SyncRepository
+	matchRepo repository.MatchRepository
+	eventRepo repository.MatchEventRepository
+	rulesRepo repository.TournamentRulesRepository
+	publisher MatchPublisher
+	// events reutiliza las validaciones de los eventos cargados de a uno
+	events *MatchEventUseCase
+}
+
+func NewSyncUseCase(syncRepo repository.SyncRepository, matchRepo repository.MatchRepository, eventRepo repository.MatchEventRepository, teamRepo repository.TeamRepository, tournamentRepo repository.TournamentRepository, registrationRepo repository.RegistrationRepository, clockRepo repository.MatchClockRepository, rulesRepo repository.TournamentRulesRepository, publisher MatchPublisher) *SyncUseCase {
+	return &SyncUseCase{
+		syncRepo:  syncRepo,
+		matchRepo: matchRepo,
+		eventRepo: eventRepo,
+		rulesRepo: rulesRepo,
+		publisher: publisher,
+		events:    NewMatchEventUseCase(eventRepo, matchRepo, teamRepo, tournamentRepo, registrationRepo, clockRepo, rulesRepo, publisher),
+	}
+}
+
+// ApplyBatch agrupa las operaciones por partido y aplica cada grupo de forma
+// atómica: si una operación es inválida no se aplica ninguna de su partido.
+// Los partidos son independientes entre sí. Devuelve un resultado por
+// operación, en el mismo orden del lote.
+func (uc *SyncUseCase) ApplyBatch(ops []domain.SyncOperation) ([]domain.SyncOpResult, error) {
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("batch is empty")
+	}
+	if len(ops) > domain.MaxSyncBatchSize {
+		return nil, fmt.Errorf("batch cannot have more than %d operations", domain.MaxSyncBatchSize)
+	}
+
+	results := make([]domain.SyncOpResult, len(ops))
+	var matchOrder []uuid.UUID
+	byMatch := make(map[uuid.UUID][]int)
+	for i, op := range ops {
+		results[i] = domain.SyncOpResult{OpID: op.OpID, Type: op.Type, MatchID: op.MatchID}
+		if _, ok := byMatch[op.MatchID]; !ok {
+			matchOrder = append(matchOrder, op.MatchID)
+		}
+		byMatch[op.MatchID] = append(byMatch[op.MatchID], i)
+	}
+
+	for _, matchID := range matchOrder {
+		uc.applyMatch(matchID, ops, byMatch[matchID], results)
+	}
+	return results, nil
+}
+
+// applyMatch valida y aplica las operaciones de un partido (indices apunta a ops)
+func (uc *SyncUseCase) applyMatch(matchID uuid.UUID, ops []domain.SyncOperation, indices []int, results []domain.SyncOpResult) {
+	match, err := uc.matchRepo.GetByID(matchID)
+	if err != nil {
+		for _, i := range indices {
+			results[i].Status = domain.SyncOpRejected
+			results[i].Error = err.Error()
+		}
+		return
+	}
+
+	rejected := false
+	group := make([]domain.SyncOperation, 0, len(indices))
+	for _, i := range indices {
+		if err := uc.validateOperation(match, &ops[i]); err != nil {
+			results[i].Status = domain.SyncOpRejected
+			results[i].Error = err.Error()
+			rejected = true
+			continue
+		}
+		group = append(group, ops[i])
+	}
+
+	status, message := domain.SyncOpApplied, ""
+	if rejected {
+		status, message = domain.SyncOpAborted, "another operation for this match was rejected"
+	} else if err := uc.syncRepo.ApplyMatchOperations(matchID, group); err != nil {
+		status, message = domain.SyncOpAborted, err.Error()
+	} else {
+		uc.publishApplied(match, group)
+	}
+
+	for _, i := range indices {
+		if results[i].Status == "" {
+			results[i].Status = status
+			results[i].Error = message
+		}
+	}
+}
+
+// validateOperation comprueba la operación y completa los datos que el
+// cliente puede omitir (IDs, fechas)
+func (uc *SyncUseCase) validateOperation(match *domain.Match, op *domain.SyncOperation) error {
+	now := time.Now().UTC()
+
+	switch op.Type {
+	case domain.SyncOpCreateEvent:
+		event := op.Event
+		if event == nil {
+			return fmt.Errorf("event is required for %s operations", op.Type)
+		}
+		event.MatchID = match.ID
+		if event.ID == uuid.Nil {
+			event.ID = uuid.New()
+		}
+		if event.CreatedAt.IsZero() {
+			event.CreatedAt = now
+		}
+		if existing, err := uc.eventRepo.GetByID(event.ID); err == nil && existing.MatchID != match.ID {
+			return fmt.Errorf("event id already used in another match")
+		}
+		return uc.events.validateEvent(match, event)
+
+	case domain.SyncOpUpdateScore:
+		score := op.Score
+		if score == nil {
+			return fmt.Errorf("score is required for %s operations", op.Type)
+		}
+		if score.GoalScoredTeam1 < 0 || score.GoalScoredTeam2 < 0 {
+			return fmt.Errorf("goals cannot be negative")
+		}
+		if match.ParentMatchID != nil {
+			return fmt.Errorf("cannot update the score of a sub-match in a batch")
+		}
+		if match.IsForfeit() {
+			return fmt.Errorf("match was awarded by forfeit; revoke the forfeit to change the result")
+		}
+		subMatches, err := uc.matchRepo.GetSubMatches(match.ID)
+		if err != nil {
+			return err
+		}
+		if len(subMatches) > 0 {
+			return fmt.Errorf("the result of a match with sub-matches is their aggregate")
+		}
+		// El marcador nuevo tiene que seguir siendo coherente con la
+		// prórroga y los penales ya registrados
+		scored := *match
+		scored.GoalScoredTeam1, scored.GoalScoredTeam2 = score.GoalScoredTeam1, score.GoalScoredTeam2
+		if err := validateResult(&scored); err != nil {
+			return err
+		}
+		return validateTiebreak(uc.rulesRepo, &scored)
+
+	case domain.SyncOpCheckIn:
+		checkIn := op.CheckIn
+		if checkIn == nil {
+			return fmt.Errorf("check_in is required for %s operations", op.Type)
+		}
+		checkIn.MatchID = match.ID
+		if checkIn.CheckedInAt.IsZero() {
+			checkIn.CheckedInAt = now
+		}
+		if checkIn.TeamID != match.Team1ID && checkIn.TeamID != match.Team2ID {
+			return fmt.Errorf("team does not play in this match")
+		}
+		return uc.events.validatePlayer(match, checkIn.TeamID, checkIn.PlayerID)
+	}
+
+	return fmt.Errorf("invalid operation type: %s", op.Type)
+}
+
+// publishApplied difunde los cambios de un grupo ya aplicado. Los eventos
+// reenviados que ya existían también se publican: el cliente los ignora.
+func (uc *SyncUseCase) publishApplied(match *domain.Match, group []domain.SyncOperation) {
+	if uc.publisher == nil {
+		return
+	}
+	scoreChanged := false
+	for _, op := range group {
+		switch op.Type {
+		case domain.SyncOpCreateEvent:
+			publishEvent(uc.publisher, uc.events.tournamentRepo, match, domain.MatchUpdateEventCreated, op.Event)
+		case domain.SyncOpUpdateScore:
+			scoreChanged = true
+		}
+	}
+	if !scoreChanged {
+		return
+	}
+	updated, err := uc.matchRepo.GetByID(match.ID)
+	if err != nil {
+		return
+	}
+	publishMatch(uc.publisher, uc.events.tournamentRepo, updated)
+}
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// TagCommands agrupa las operaciones que modifican etiquetas
+type TagCommands interface {
+	CreateTag(tag *domain.Tag) error
+	UpdateTag(tag *domain.Tag) error
+	DeleteTag(id uuid.UUID) error
+	// AttachTag etiqueta un equipo, jugador o partido (ver domain.TagEntity*)
+	AttachTag(entity string, entityID, tagID uuid.UUID) error
+	DetachTag(entity string, entityID, tagID uuid.UUID) error
+}
+
+// TagQueries agrupa las lecturas de etiquetas
+type TagQueries interface {
+	GetTagByID(id uuid.UUID) (*domain.Tag, error)
+	GetAllTags() ([]domain.Tag, error)
+	GetEntityTags(entity string, entityID uuid.UUID) ([]domain.Tag, error)
+	// GetTaggedIDs devuelve las entidades con la etiqueta (ID o nombre)
+	GetTaggedIDs(entity, tag string) ([]uuid.UUID, error)
+}
+
+var (
+	_ TagCommands = (*TagUseCase)(nil)
+	_ TagQueries  = (*TagUseCase)(nil)
+)
+
+// TagUseCase gestiona las etiquetas y su asignación
+type TagUseCase struct {
+	tagRepo    repository.TagRepository
+	teamRepo   repository.TeamRepository
+	playerRepo repository.PlayerRepository
+	matchRepo  repository.MatchRepository
+}
+
+func NewTagUseCase(tagRepo repository.TagRepository, teamRepo repository.TeamRepository, playerRepo repository.PlayerRepository, matchRepo repository.MatchRepository) *TagUseCase {
+	return &TagUseCase{
+		tagRepo:    tagRepo,
+		teamRepo:   teamRepo,
+		playerRepo: playerRepo,
+		matchRepo:  matchRepo,
+	}
+}
+
+func (uc *TagUseCase) CreateTag(tag *domain.Tag) error {
+	if err := uc.validateTag(tag); err != nil {
+		return err
+	}
+	return uc.tagRepo.Create(tag)
+}
+
+func (uc *TagUseCase) GetTagByID(id uuid.UUID) (*domain.Tag, error) {
+	return uc.tagRepo.GetByID(id)
+}
+
+func (uc *TagUseCase) GetAllTags() ([]domain.Tag, error) {
+	return uc.tagRepo.GetAll()
+}
+
+func (uc *TagUseCase) UpdateTag(tag *domain.Tag) error {
+	existing, err := uc.tagRepo.GetByID(tag.ID)
+	if err != nil {
+		return err
+	}
+	if err := uc.validateTag(tag); err != nil {
+		return err
+	}
+	tag.CreatedAt = existing.CreatedAt
+	return uc.tagRepo.Update(tag)
+}
+
+func (uc *TagUseCase) DeleteTag(id uuid.UUID) error {
+	return uc.tagRepo.Delete(id)
+}
+
+func (uc *TagUseCase) AttachTag(entity string, entityID, tagID uuid.UUID) error {
+	if err := uc.checkEntity(entity, entityID); err != nil {
+		return err
+	}
+	if _, err := uc.tagRepo.GetByID(tagID); err != nil {
+		return err
+	}
+	return uc.tagRepo.Attach(tagID, entity, entityID)
+}
+
+func (uc *TagUseCase) DetachTag(entity string, entityID, tagID uuid.UUID) error {
+	if !domain.IsValidTagEntity(entity) {
+		return fmt.Errorf("entity %q cannot be tagged", entity)
+	}
+	return uc.tagRepo.Detach(tagID, entity, entityID)
+}
+
+func (uc *TagUseCase) GetEntityTags(entity string, entityID uuid.UUID) ([]domain.Tag, error) {
+	if err := uc.checkEntity(entity, entityID); err != nil {
+		return nil, err
+	}
+	return uc.tagRepo.GetByEntity(entity, entityID)
+}
+
+func (uc *TagUseCase) GetTaggedIDs(entity, tag string) ([]uuid.UUID, error) {
+	if !domain.IsValidTagEntity(entity) {
+		return nil, fmt.Errorf("entity %q cannot be tagged", entity)
+	}
+	found, err := uc.findTag(tag)
+	if err != nil {
+		return nil, err
+	}
+	return uc.tagRepo.GetTaggedIDs(found.ID, entity)
+}
+
+// findTag acepta el ID o el nombre de la etiqueta, como las temporadas
+func (uc *TagUseCase) findTag(ref string) (*domain.Tag, error) {
+	if id, err := uuid.Parse(ref); err == nil {
+		return uc.tagRepo.GetByID(id)
+	}
+	return uc.tagRepo.GetByName(ref)
+}
+
+// checkEntity comprueba que la entidad a etiquetar exista
+func (uc *TagUseCase) checkEntity(entity string, entityID uuid.UUID) error {
+	var err error
+	switch entity {
+	case domain.TagEntityTeam:
+		_, err = uc.teamRepo.GetByID(entityID)
+	case domain.TagEntityPlayer:
+		_, err = uc.playerRepo.GetByID(entityID)
+	case domain.TagEntityMatch:
+		_, err = uc.matchRepo.GetByID(entityID)
+	default:
+		err = fmt.Errorf("entity %q cannot be tagged", entity)
+	}
+	return err
+}
+
+// validateTag exige un nombre único sin distinguir mayúsculas
+func (uc *TagUseCase) validateTag(tag *domain.Tag) error {
+	if strings.TrimSpace(tag.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if !domain.IsValidTagColor(tag.Color) {
+		return fmt.Errorf("color must have the form #rrggbb")
+	}
+	if existing, err := uc.tagRepo.GetByName(tag.Name); err == nil && existing.ID != tag.ID {
+		return fmt.Errorf("tag %q already exists", tag.Name)
+	}
+	return nil
+}
@@ -8,19 +8,59 @@ import (
 	"github.com/google/uuid"
 )
 
+// TeamCommands agrupa las operaciones que modifican equipos
+type TeamCommands interface {
+	CreateTeam(team *domain.Team) error
+	UpdateTeam(team *domain.Team) error
+	DeleteTeam(id uuid.UUID) error
+	AddPlayerToTeam(teamID, playerID uuid.UUID) error
+	RemovePlayerFromTeam(teamID, playerID uuid.UUID) error
+	SetJerseyNumber(teamID, playerID uuid.UUID, number *int) error
+	// SetPlayerRoles reemplaza los roles del jugador en el equipo
+	SetPlayerRoles(teamID, playerID uuid.UUID, roles []string) error
+}
+
+// TeamQueries agrupa las lecturas de equipos
+type TeamQueries interface {
+	GetTeamByID(id uuid.UUID) (*domain.Team, error)
+	GetAllTeams() ([]domain.Team, error)
+	// GetTeamsPage devuelve una página del listado con el total
+	GetTeamsPage(page domain.Page) (domain.Paged[domain.Team], error)
+	// CheckTeamName indica si el nombre está libre; excludeID es el equipo
+	// que se está editando
+	CheckTeamName(name string, excludeID *uuid.UUID) (*domain.NameCheck, error)
+	GetTeamPlayers(teamID uuid.UUID) ([]domain.Player, error)
+}
+
+var (
+	_ TeamCommands = (*TeamUseCase)(nil)
+	_ TeamQueries  = (*TeamUseCase)(nil)
+)
+
 type TeamUseCase struct {
-	teamRepo   repository.TeamRepository
-	playerRepo repository.PlayerRepository
+	teamRepo     repository.TeamRepository
+	playerRepo   repository.PlayerRepository
+	transferRepo repository.TransferRepository
+	rulesRepo    repository.TournamentRulesRepository
+	// maxSquadSize es el tope de jugadores por plantel para todos los
+	// equipos (0 = sin tope); las reglas de cada torneo pueden bajarlo
+	maxSquadSize int
 }
 
-func NewTeamUseCase(teamRepo repository.TeamRepository, playerRepo repository.PlayerRepository) *TeamUseCase {
+func NewTeamUseCase(teamRepo repository.TeamRepository, playerRepo repository.PlayerRepository, transferRepo repository.TransferRepository, rulesRepo repository.TournamentRulesRepository, maxSquadSize int) *TeamUseCase {
 	return &TeamUseCase{
-		teamRepo:   teamRepo,
-		playerRepo: playerRepo,
+		teamRepo:     teamRepo,
+		playerRepo:   playerRepo,
+		transferRepo: transferRepo,
+		rulesRepo:    rulesRepo,
+		maxSquadSize: maxSquadSize,
 	}
 }
 
 func (uc *TeamUseCase) CreateTeam(team *domain.Team) error {
+	if err := uc.ensureTeamName(team); err != nil {
+		return err
+	}
 	return uc.teamRepo.Create(team)
 }
 
@@ -32,10 +72,53 @@ func (uc *TeamUseCase) GetAllTeams() ([]domain.Team, error) {
 	return uc.teamRepo.GetAll()
 }
 
+func (uc *TeamUseCase) GetTeamsPage(page domain.Page) (domain.Paged[domain.Team], error) {
+	return uc.teamRepo.GetPage(page)
+}
+
 func (uc *TeamUseCase) UpdateTeam(team *domain.Team) error {
+	if err := uc.ensureTeamName(team); err != nil {
+		return err
+	}
 	return uc.teamRepo.Update(team)
 }
 
+// CheckTeamName aplica la misma regla que la creación: los nombres de equipo
+// son únicos sin distinguir mayúsculas
+func (uc *TeamUseCase) CheckTeamName(name string, excludeID *uuid.UUID) (*domain.NameCheck, error) {
+	if name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+
+	teams, err := uc.teamRepo.FindByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	check := &domain.NameCheck{Name: name, Available: true}
+	for _, team := range teams {
+		if excludeID != nil && team.ID == *excludeID {
+			continue
+		}
+		id := team.ID
+		check.Available = false
+		check.ConflictID = &id
+		break
+	}
+	return check, nil
+}
+
+func (uc *TeamUseCase) ensureTeamName(team *domain.Team) error {
+	check, err := uc.CheckTeamName(team.Name, &team.ID)
+	if err != nil {
+		return err
+	}
+	if !check.Available {
+		return fmt.Errorf("team name %q is already taken", team.Name)
+	}
+	return nil
+}
+
 func (uc *TeamUseCase) DeleteTeam(id uuid.UUID) error {
 	return uc.teamRepo.Delete(id)
 }
@@ -48,12 +131,64 @@ func (uc *TeamUseCase) AddPlayerToTeam(teamID, playerID uuid.UUID) error {
 	}
 
 	// Validar que el jugador existe
-	_, err = uc.playerRepo.GetByID(playerID)
+	player, err := uc.playerRepo.GetByID(playerID)
 	if err != nil {
 		return fmt.Errorf("player not found: %w", err)
 	}
 
-	return uc.teamRepo.AddPlayer(teamID, playerID)
+	if err := uc.checkSquad(teamID, player); err != nil {
+		return err
+	}
+
+	// El pase viene del último equipo al que se incorporó el jugador
+	transfers, err := uc.transferRepo.GetByPlayer(playerID)
+	if err != nil {
+		return err
+	}
+	var fromTeamID *uuid.UUID
+	if len(transfers) > 0 {
+		last := transfers[len(transfers)-1].ToTeamID
+		fromTeamID = &last
+	}
+
+	return uc.teamRepo.AddPlayer(teamID, playerID, domain.NewTransfer(playerID, fromTeamID, teamID))
+}
+
+// checkSquad valida que el plantel con el nuevo jugador respete el tope
+// global y las reglas de los torneos en curso del equipo. Un plantel lleno
+// devuelve *domain.RosterFullError.
+func (uc *TeamUseCase) checkSquad(teamID uuid.UUID, player *domain.Player) error {
+	players, err := uc.teamRepo.GetTeamPlayers(teamID)
+	if err != nil {
+		return err
+	}
+	for _, p := range players {
+		if p.ID == player.ID {
+			return nil
+		}
+	}
+
+	if uc.maxSquadSize > 0 && len(players) >= uc.maxSquadSize {
+		return &domain.RosterFullError{MaxSquadSize: uc.maxSquadSize}
+	}
+
+	rules, err := uc.rulesRepo.GetByTeam(teamID)
+	if err != nil {
+		return err
+	}
+	for i := range rules {
+		if rules[i].MaxSquadSize > 0 && len(players) >= rules[i].MaxSquadSize {
+			return &domain.RosterFullError{MaxSquadSize: rules[i].MaxSquadSize, TournamentID: &rules[i].TournamentID}
+		}
+	}
+
+	squad := append(players, *player)
+	for i := range rules {
+		if err := rules[i].CheckSquadLimits(squad); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (uc *TeamUseCase) RemovePlayerFromTeam(teamID, playerID uuid.UUID) error {
@@ -63,3 +198,75 @@ func (uc *TeamUseCase) RemovePlayerFromTeam(teamID, playerID uuid.UUID) error {
 func (uc *TeamUseCase) GetTeamPlayers(teamID uuid.UUID) ([]domain.Player, error) {
 	return uc.teamRepo.GetTeamPlayers(teamID)
 }
+
+// SetJerseyNumber asigna el dorsal del jugador en el equipo; nil lo quita.
+// El dorsal va de 1 a 99 y no se puede repetir dentro del equipo.
+func (uc *TeamUseCase) SetJerseyNumber(teamID, playerID uuid.UUID, number *int) error {
+	if number != nil && (*number < 1 || *number > 99) {
+		return fmt.Errorf("jersey_number must be between 1 and 99")
+	}
+
+	players, err := uc.teamRepo.GetTeamPlayers(teamID)
+	if err != nil {
+		return err
+	}
+
+	inTeam := false
+	for _, player := range players {
+		if player.ID == playerID {
+			inTeam = true
+			continue
+		}
+		if number != nil && player.JerseyNumber != nil && *player.JerseyNumber == *number {
+			return fmt.Errorf("jersey number %d is already used by %s", *number, player.Name)
+		}
+	}
+	if !inTeam {
+		return fmt.Errorf("player is not in the team")
+	}
+
+	return uc.teamRepo.SetJerseyNumber(teamID, playerID, number)
+}
+
+// SetPlayerRoles reemplaza los roles del jugador en el equipo; una lista vacía
+// los quita. El capitán y el subcapitán son únicos en el equipo y no pueden
+// ser el mismo jugador.
+func (uc *TeamUseCase) SetPlayerRoles(teamID, playerID uuid.UUID, roles []string) error {
+	unique := make([]string, 0, len(roles))
+	seen := make(map[string]bool, len(roles))
+	for _, role := range roles {
+		if !domain.IsValidRole(role) {
+			return fmt.Errorf("invalid role %q", role)
+		}
+		if !seen[role] {
+			seen[role] = true
+			unique = append(unique, role)
+		}
+	}
+	if seen[domain.RoleCaptain] && seen[domain.RoleViceCaptain] {
+		return fmt.Errorf("a player cannot be captain and vice-captain")
+	}
+
+	players, err := uc.teamRepo.GetTeamPlayers(teamID)
+	if err != nil {
+		return err
+	}
+
+	inTeam := false
+	for _, player := range players {
+		if player.ID == playerID {
+			inTeam = true
+			continue
+		}
+		for _, role := range unique {
+			if domain.IsUniqueRole(role) && player.HasRole(role) {
+				return fmt.Errorf("role %s is already assigned to %s", role, player.Name)
+			}
+		}
+	}
+	if !inTeam {
+		return fmt.Errorf("player is not in the team")
+	}
+
+	return uc.teamRepo.SetRoles(teamID, playerID, unique)
+}
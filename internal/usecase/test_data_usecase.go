@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+)
+
+// TestDataCommands limpia los datos de prueba de un entorno de staging
+type TestDataCommands interface {
+	// PurgeTestData borra los torneos, equipos y jugadores marcados como
+	// prueba; con dryRun solo cuenta lo que se borraría
+	PurgeTestData(dryRun bool) (*domain.PurgeReport, error)
+}
+
+var _ TestDataCommands = (*TestDataUseCase)(nil)
+
+type TestDataUseCase struct {
+	testDataRepo repository.TestDataRepository
+	ratings      RatingCommands
+}
+
+func NewTestDataUseCase(testDataRepo repository.TestDataRepository, ratings RatingCommands) *TestDataUseCase {
+	return &TestDataUseCase{testDataRepo: testDataRepo, ratings: ratings}
+}
+
+func (uc *TestDataUseCase) PurgeTestData(dryRun bool) (*domain.PurgeReport, error) {
+	if dryRun {
+		report, err := uc.testDataRepo.Count()
+		if err != nil {
+			return nil, err
+		}
+		report.DryRun = true
+		return report, nil
+	}
+
+	report, err := uc.testDataRepo.Purge()
+	if err != nil {
+		return nil, err
+	}
+
+	// Los ratings de los equipos reales incluían los partidos borrados
+	if report.Matches > 0 {
+		if err := uc.ratings.RecalculateRatings(); err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
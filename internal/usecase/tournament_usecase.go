@@ -2,25 +2,96 @@ package usecase
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
 	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
 	"github.com/google/uuid"
 )
 
+// maxMatchDuration es la duración máxima configurable de un partido, en minutos
+const maxMatchDuration = 180
+
+// TournamentCommands agrupa las operaciones que modifican torneos
+type TournamentCommands interface {
+	CreateTournament(tournament *domain.Tournament) error
+	UpdateTournament(tournament *domain.Tournament) error
+	DeleteTournament(id uuid.UUID) error
+	AddTeamToTournament(tournamentID, teamID uuid.UUID) error
+	RemoveTeamFromTournament(tournamentID, teamID uuid.UUID) error
+	// SetTournamentRules reemplaza las reglas del torneo
+	SetTournamentRules(rules *domain.TournamentRules) error
+	// SetTournamentAttendance cambia solo la restricción de público del
+	// reglamento, para editarla durante la temporada sin reenviar el resto
+	SetTournamentAttendance(tournamentID uuid.UUID, restriction domain.AttendanceRestriction) (*domain.TournamentRules, error)
+	// ChangeTournamentStatus aplica una transición del ciclo de vida
+	ChangeTournamentStatus(id uuid.UUID, status string) (*domain.Tournament, error)
+	ArchiveTournament(id uuid.UUID) (*domain.Tournament, error)
+	// RestoreTournament devuelve un torneo archivado a las tablas activas
+	RestoreTournament(id uuid.UUID) (*domain.Tournament, error)
+	// ArchiveOldSeasons archiva los torneos de las temporadas anteriores a
+	// las keepSeasons más recientes y devuelve cuántos archivó
+	ArchiveOldSeasons(keepSeasons int) (int, error)
+	// SnapshotStandings guarda la tabla de las jornadas completas que todavía
+	// no tienen foto y vuelve a tomar las de fromRound en adelante (0 = ninguna);
+	// devuelve cuántas guardó
+	SnapshotStandings(tournamentID uuid.UUID, fromRound int) (int, error)
+	// SnapshotAllStandings hace lo mismo para todos los torneos activos
+	SnapshotAllStandings() (int, error)
+}
+
+// TournamentQueries agrupa las lecturas de torneos
+type TournamentQueries interface {
+	GetTournamentByID(id uuid.UUID) (*domain.Tournament, error)
+	// GetAllTournaments lista los torneos; los archivados solo con includeArchived
+	GetAllTournaments(includeArchived bool) ([]domain.Tournament, error)
+	// GetTournamentsPage devuelve una página de GetAllTournaments con el total
+	GetTournamentsPage(includeArchived bool, page domain.Page) (domain.Paged[domain.Tournament], error)
+	// GetTournamentsBySeason filtra por temporada (ID o nombre, p. ej. "2024/25")
+	GetTournamentsBySeason(season string) ([]domain.Tournament, error)
+	GetTournamentTeams(tournamentID uuid.UUID) ([]domain.Team, error)
+	// CheckTournamentName indica si el nombre está libre en la temporada;
+	// excludeID es el torneo que se está editando
+	CheckTournamentName(name string, seasonID, excludeID *uuid.UUID) (*domain.NameCheck, error)
+	// GetStandings devuelve la tabla actual o, con maxRound > 0, la de esa
+	// jornada, con la variación de puestos respecto de la anterior. Salvo
+	// includeEmbargoed (organizadores), no cuenta los resultados embargados.
+	GetStandings(tournamentID uuid.UUID, maxRound int, includeEmbargoed bool) ([]domain.Standing, error)
+	// GetTournamentRules devuelve las reglas del torneo (las por defecto si no configuró ninguna)
+	GetTournamentRules(tournamentID uuid.UUID) (*domain.TournamentRules, error)
+}
+
+var (
+	_ TournamentCommands = (*TournamentUseCase)(nil)
+	_ TournamentQueries  = (*TournamentUseCase)(nil)
+)
+
 type TournamentUseCase struct {
 	tournamentRepo repository.TournamentRepository
 	teamRepo       repository.TeamRepository
+	seasonRepo     repository.SeasonRepository
+	rulesRepo      repository.TournamentRulesRepository
+	// registrationRepo ficha el plantel de cada equipo al inscribirlo
+	registrationRepo repository.RegistrationRepository
+	// snapshotRepo guarda la tabla de cada jornada completa
+	snapshotRepo repository.StandingsSnapshotRepository
 }
 
-func NewTournamentUseCase(tournamentRepo repository.TournamentRepository, teamRepo repository.TeamRepository) *TournamentUseCase {
+func NewTournamentUseCase(tournamentRepo repository.TournamentRepository, teamRepo repository.TeamRepository, seasonRepo repository.SeasonRepository, rulesRepo repository.TournamentRulesRepository, registrationRepo repository.RegistrationRepository, snapshotRepo repository.StandingsSnapshotRepository) *TournamentUseCase {
 	return &TournamentUseCase{
-		tournamentRepo: tournamentRepo,
-		teamRepo:       teamRepo,
+		tournamentRepo:   tournamentRepo,
+		teamRepo:         teamRepo,
+		seasonRepo:       seasonRepo,
+		rulesRepo:        rulesRepo,
+		registrationRepo: registrationRepo,
+		snapshotRepo:     snapshotRepo,
 	}
 }
 
 func (uc *TournamentUseCase) CreateTournament(tournament *domain.Tournament) error {
+	if err := uc.validateTournament(tournament); err != nil {
+		return err
+	}
 	return uc.tournamentRepo.Create(tournament)
 }
 
@@ -28,12 +99,207 @@ func (uc *TournamentUseCase) GetTournamentByID(id uuid.UUID) (*domain.Tournament
 	return uc.tournamentRepo.GetByID(id)
 }
 
-func (uc *TournamentUseCase) GetAllTournaments() ([]domain.Tournament, error) {
-	return uc.tournamentRepo.GetAll()
+func (uc *TournamentUseCase) GetAllTournaments(includeArchived bool) ([]domain.Tournament, error) {
+	return uc.tournamentRepo.GetAll(includeArchived)
+}
+
+func (uc *TournamentUseCase) GetTournamentsPage(includeArchived bool, page domain.Page) (domain.Paged[domain.Tournament], error) {
+	return uc.tournamentRepo.GetPage(includeArchived, page)
+}
+
+func (uc *TournamentUseCase) GetTournamentsBySeason(season string) ([]domain.Tournament, error) {
+	found, err := findSeason(uc.seasonRepo, season)
+	if err != nil {
+		return nil, err
+	}
+	return uc.tournamentRepo.GetBySeason(found.ID)
 }
 
 func (uc *TournamentUseCase) UpdateTournament(tournament *domain.Tournament) error {
-	return uc.tournamentRepo.Update(tournament)
+	if err := uc.validateTournament(tournament); err != nil {
+		return err
+	}
+	if err := uc.tournamentRepo.Update(tournament); err != nil {
+		return err
+	}
+
+	// El estado no se edita aquí: se devuelve el guardado
+	stored, err := uc.tournamentRepo.GetByID(tournament.ID)
+	if err != nil {
+		return err
+	}
+	tournament.Status = stored.Status
+	tournament.ArchivedAt = stored.ArchivedAt
+	return nil
+}
+
+func (uc *TournamentUseCase) ChangeTournamentStatus(id uuid.UUID, status string) (*domain.Tournament, error) {
+	tournament, err := uc.tournamentRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tournament.ChangeStatus(status); err != nil {
+		return nil, err
+	}
+	if err := uc.tournamentRepo.UpdateStatus(id, tournament.Status); err != nil {
+		return nil, err
+	}
+	return tournament, nil
+}
+
+func (uc *TournamentUseCase) ArchiveTournament(id uuid.UUID) (*domain.Tournament, error) {
+	tournament, err := uc.tournamentRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tournament.Archive(time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := uc.tournamentRepo.SetArchived(id, tournament.ArchivedAt); err != nil {
+		return nil, err
+	}
+	return tournament, nil
+}
+
+func (uc *TournamentUseCase) RestoreTournament(id uuid.UUID) (*domain.Tournament, error) {
+	tournament, err := uc.tournamentRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tournament.Restore(); err != nil {
+		return nil, err
+	}
+	if err := uc.tournamentRepo.SetArchived(id, nil); err != nil {
+		return nil, err
+	}
+	return tournament, nil
+}
+
+// ArchiveOldSeasons recorre las temporadas de la más reciente a la más
+// antigua (por fecha de inicio). Los torneos que todavía se están jugando
+// se dejan activos; los que no tienen temporada no se archivan nunca.
+func (uc *TournamentUseCase) ArchiveOldSeasons(keepSeasons int) (int, error) {
+	if keepSeasons < 1 {
+		return 0, fmt.Errorf("at least one season must be kept")
+	}
+
+	seasons, err := uc.seasonRepo.GetAll()
+	if err != nil {
+		return 0, err
+	}
+	if len(seasons) <= keepSeasons {
+		return 0, nil
+	}
+
+	archived := 0
+	now := time.Now().UTC()
+	for _, season := range seasons[keepSeasons:] {
+		tournaments, err := uc.tournamentRepo.GetBySeason(season.ID)
+		if err != nil {
+			return archived, err
+		}
+		for _, tournament := range tournaments {
+			if tournament.Archive(now) != nil {
+				continue
+			}
+			if err := uc.tournamentRepo.SetArchived(tournament.ID, tournament.ArchivedAt); err != nil {
+				return archived, err
+			}
+			archived++
+		}
+	}
+	return archived, nil
+}
+
+// validateTournament aplica las reglas comunes a creación y actualización
+func (uc *TournamentUseCase) validateTournament(tournament *domain.Tournament) error {
+	if tournament.ResultsDelayMinutes < 0 {
+		return fmt.Errorf("results_delay_minutes cannot be negative")
+	}
+	if tournament.SeasonID != nil {
+		if _, err := uc.seasonRepo.GetByID(*tournament.SeasonID); err != nil {
+			return err
+		}
+	}
+	if err := uc.validateParent(tournament); err != nil {
+		return err
+	}
+
+	check, err := uc.CheckTournamentName(tournament.Name, tournament.SeasonID, &tournament.ID)
+	if err != nil {
+		return err
+	}
+	if !check.Available {
+		return fmt.Errorf("tournament name %q is already taken in this season", tournament.Name)
+	}
+	return nil
+}
+
+// validateParent comprueba la competición a la que pertenece el torneo: debe
+// existir, jugarse en la misma temporada y la jerarquía tiene un solo nivel
+func (uc *TournamentUseCase) validateParent(tournament *domain.Tournament) error {
+	if tournament.ParentTournamentID == nil {
+		return nil
+	}
+	if *tournament.ParentTournamentID == tournament.ID {
+		return fmt.Errorf("a tournament cannot be its own parent competition")
+	}
+
+	parent, err := uc.tournamentRepo.GetByID(*tournament.ParentTournamentID)
+	if err != nil {
+		return fmt.Errorf("parent competition not found")
+	}
+	if parent.ParentTournamentID != nil {
+		return fmt.Errorf("parent competition %q already belongs to another competition", parent.Name)
+	}
+	if parent.SeasonID != nil && tournament.SeasonID != nil && *parent.SeasonID != *tournament.SeasonID {
+		return fmt.Errorf("tournament season must match its parent competition")
+	}
+
+	children, err := uc.tournamentRepo.GetChildren(tournament.ID)
+	if err != nil {
+		return err
+	}
+	if len(children) > 0 {
+		return fmt.Errorf("a competition with its own divisions cannot belong to another competition")
+	}
+	return nil
+}
+
+// CheckTournamentName aplica la misma regla que la creación: dentro de una
+// temporada (o entre los torneos sin temporada) los nombres son únicos sin
+// distinguir mayúsculas
+func (uc *TournamentUseCase) CheckTournamentName(name string, seasonID, excludeID *uuid.UUID) (*domain.NameCheck, error) {
+	if name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+
+	tournaments, err := uc.tournamentRepo.FindByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	check := &domain.NameCheck{Name: name, Available: true}
+	for _, tournament := range tournaments {
+		if excludeID != nil && tournament.ID == *excludeID {
+			continue
+		}
+		if !sameSeason(tournament.SeasonID, seasonID) {
+			continue
+		}
+		id := tournament.ID
+		check.Available = false
+		check.ConflictID = &id
+		break
+	}
+	return check, nil
+}
+
+func sameSeason(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
 }
 
 func (uc *TournamentUseCase) DeleteTournament(id uuid.UUID) error {
@@ -41,11 +307,14 @@ func (uc *TournamentUseCase) DeleteTournament(id uuid.UUID) error {
 }
 
 func (uc *TournamentUseCase) AddTeamToTournament(tournamentID, teamID uuid.UUID) error {
-	// Validar que el torneo existe
-	_, err := uc.tournamentRepo.GetByID(tournamentID)
+	// Validar que el torneo existe y admite inscripciones
+	tournament, err := uc.tournamentRepo.GetByID(tournamentID)
 	if err != nil {
 		return fmt.Errorf("tournament not found: %w", err)
 	}
+	if err := tournament.CheckRegistration(); err != nil {
+		return err
+	}
 
 	// Validar que el equipo existe
 	_, err = uc.teamRepo.GetByID(teamID)
@@ -53,13 +322,221 @@ func (uc *TournamentUseCase) AddTeamToTournament(tournamentID, teamID uuid.UUID)
 		return fmt.Errorf("team not found: %w", err)
 	}
 
-	return uc.tournamentRepo.AddTeam(tournamentID, teamID)
+	// El plantel tiene que cumplir las reglas del torneo al inscribirse
+	rules, err := uc.rulesRepo.Get(tournamentID)
+	if err != nil {
+		return err
+	}
+	players, err := uc.teamRepo.GetTeamPlayers(teamID)
+	if err != nil {
+		return err
+	}
+	if err := rules.CheckSquad(players); err != nil {
+		return err
+	}
+
+	if err := uc.tournamentRepo.AddTeam(tournamentID, teamID); err != nil {
+		return err
+	}
+	// El plantel actual queda fichado; los jugadores que se sumen después
+	// se fichan aparte
+	return uc.registrationRepo.RegisterSquad(tournamentID, teamID)
 }
 
 func (uc *TournamentUseCase) RemoveTeamFromTournament(tournamentID, teamID uuid.UUID) error {
+	tournament, err := uc.tournamentRepo.GetByID(tournamentID)
+	if err != nil {
+		return err
+	}
+	if err := tournament.CheckRegistration(); err != nil {
+		return err
+	}
 	return uc.tournamentRepo.RemoveTeam(tournamentID, teamID)
 }
 
+func (uc *TournamentUseCase) GetTournamentRules(tournamentID uuid.UUID) (*domain.TournamentRules, error) {
+	if _, err := uc.tournamentRepo.GetByID(tournamentID); err != nil {
+		return nil, err
+	}
+	return uc.rulesRepo.Get(tournamentID)
+}
+
+// SetTournamentRules guarda las reglas. No revisa los planteles ya
+// inscriptos: las reglas se aplican en las próximas altas.
+func (uc *TournamentUseCase) SetTournamentRules(rules *domain.TournamentRules) error {
+	if _, err := uc.tournamentRepo.GetByID(rules.TournamentID); err != nil {
+		return err
+	}
+
+	if rules.MinSquadSize < 0 || rules.MaxSquadSize < 0 {
+		return fmt.Errorf("squad sizes cannot be negative")
+	}
+	if rules.MaxSquadSize > 0 && rules.MaxSquadSize < rules.MinSquadSize {
+		return fmt.Errorf("max_squad_size cannot be lower than min_squad_size")
+	}
+	if !domain.IsValidCountryCode(rules.Country) {
+		return fmt.Errorf("country must be an ISO 3166-1 alpha-2 code: %s", rules.Country)
+	}
+	if rules.MaxForeignPlayers != nil {
+		if *rules.MaxForeignPlayers < 0 {
+			return fmt.Errorf("max_foreign_players cannot be negative")
+		}
+		if rules.Country == "" {
+			return fmt.Errorf("max_foreign_players requires country")
+		}
+	}
+	if rules.MatchDuration == 0 {
+		rules.MatchDuration = domain.DefaultMatchDuration
+	}
+	if rules.MatchDuration < 1 || rules.MatchDuration > maxMatchDuration {
+		return fmt.Errorf("match_duration_minutes must be between 1 and %d", maxMatchDuration)
+	}
+	if rules.KnockoutTiebreak == "" {
+		rules.KnockoutTiebreak = domain.TiebreakExtraTime
+	}
+	if !domain.IsValidTiebreak(rules.KnockoutTiebreak) {
+		return fmt.Errorf("knockout_tiebreak must be one of: extra_time, penalties, golden_goal")
+	}
+	if rules.RegistrationFee != (domain.Money{}) {
+		if err := rules.RegistrationFee.Validate(); err != nil {
+			return fmt.Errorf("invalid registration_fee: %w", err)
+		}
+	}
+	if err := rules.Attendance.Validate(); err != nil {
+		return fmt.Errorf("invalid attendance: %w", err)
+	}
+
+	rules.UpdatedAt = time.Now().UTC()
+	return uc.rulesRepo.Save(rules)
+}
+
+func (uc *TournamentUseCase) SetTournamentAttendance(tournamentID uuid.UUID, restriction domain.AttendanceRestriction) (*domain.TournamentRules, error) {
+	if _, err := uc.tournamentRepo.GetByID(tournamentID); err != nil {
+		return nil, err
+	}
+	rules, err := uc.rulesRepo.Get(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	rules.Attendance = restriction
+	if err := uc.SetTournamentRules(rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
 func (uc *TournamentUseCase) GetTournamentTeams(tournamentID uuid.UUID) ([]domain.Team, error) {
 	return uc.tournamentRepo.GetTournamentTeams(tournamentID)
 }
+
+// GetStandings calcula la tabla de posiciones del torneo a partir de sus
+// partidos jugados. Con maxRound > 0 devuelve la foto guardada de esa jornada
+// o, si todavía no se completó, la tabla cortada en ella. La variación de
+// puestos se mide contra la jornada anterior a la pedida (o a la última
+// jugada para la tabla actual). Mientras el torneo embargue resultados, el
+// público recibe la tabla calculada sin ellos en lugar de las fotos, que
+// se toman con todos los partidos.
+func (uc *TournamentUseCase) GetStandings(tournamentID uuid.UUID, maxRound int, includeEmbargoed bool) ([]domain.Standing, error) {
+	tournament, err := uc.tournamentRepo.GetByID(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	embargo := 0
+	if !includeEmbargoed {
+		embargo = tournament.ResultsDelayMinutes
+	}
+
+	round := maxRound
+	var standings []domain.Standing
+	if maxRound > 0 {
+		standings, err = uc.standingsAt(tournamentID, maxRound, embargo)
+	} else {
+		standings, err = uc.tournamentRepo.GetStandings(tournamentID, 0, embargo)
+		if err == nil {
+			round, err = uc.snapshotRepo.LastPlayedRound(tournamentID)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if standings == nil {
+		return []domain.Standing{}, nil
+	}
+
+	if round > 1 {
+		previous, err := uc.standingsAt(tournamentID, round-1, embargo)
+		if err != nil {
+			return nil, err
+		}
+		domain.ApplyPositionChanges(standings, previous)
+	}
+	return standings, nil
+}
+
+// standingsAt devuelve la tabla tras la jornada round: la foto guardada si
+// existe y no hay embargo que aplicar, o la calculada con los partidos hasta
+// esa jornada
+func (uc *TournamentUseCase) standingsAt(tournamentID uuid.UUID, round, embargoMinutes int) ([]domain.Standing, error) {
+	if embargoMinutes == 0 {
+		if snapshot, err := uc.snapshotRepo.Get(tournamentID, round); err == nil {
+			return snapshot.Standings, nil
+		}
+	}
+	return uc.tournamentRepo.GetStandings(tournamentID, round, embargoMinutes)
+}
+
+// SnapshotStandings fotografía las jornadas completas en orden. Una jornada
+// ya guardada no se vuelve a tomar salvo que un resultado corregido la
+// afecte (fromRound), así la foto conserva cómo quedó la tabla al cerrarse.
+func (uc *TournamentUseCase) SnapshotStandings(tournamentID uuid.UUID, fromRound int) (int, error) {
+	completed, err := uc.snapshotRepo.CompletedRounds(tournamentID)
+	if err != nil {
+		return 0, err
+	}
+	saved, err := uc.snapshotRepo.GetRounds(tournamentID)
+	if err != nil {
+		return 0, err
+	}
+	taken := make(map[int]bool, len(saved))
+	for _, round := range saved {
+		taken[round] = true
+	}
+
+	count := 0
+	for _, round := range completed {
+		if taken[round] && (fromRound < 1 || round < fromRound) {
+			continue
+		}
+		standings, err := uc.tournamentRepo.GetStandings(tournamentID, round, 0)
+		if err != nil {
+			return count, err
+		}
+		if standings == nil {
+			standings = []domain.Standing{}
+		}
+		if err := uc.snapshotRepo.Save(domain.NewStandingsSnapshot(tournamentID, round, standings)); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// SnapshotAllStandings recorre los torneos activos; los archivados ya no
+// cambian
+func (uc *TournamentUseCase) SnapshotAllStandings() (int, error) {
+	tournaments, err := uc.tournamentRepo.GetAll(false)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, tournament := range tournaments {
+		taken, err := uc.SnapshotStandings(tournament.ID, 0)
+		count += taken
+		if err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/google/uuid"
+)
+
+// VenueCommands agrupa las operaciones que modifican sedes
+type VenueCommands interface {
+	CreateVenue(venue *domain.Venue) error
+	UpdateVenue(venue *domain.Venue) error
+	DeleteVenue(id uuid.UUID) error
+	// SetVenueAttendance cambia la restricción de público de la sede; vale
+	// para los partidos que todavía no se jugaron
+	SetVenueAttendance(id uuid.UUID, restriction domain.AttendanceRestriction) (*domain.Venue, error)
+	CreatePitch(pitch *domain.Pitch) error
+	UpdatePitch(pitch *domain.Pitch) error
+	DeletePitch(venueID, id uuid.UUID) error
+}
+
+// VenueQueries agrupa las lecturas de sedes
+type VenueQueries interface {
+	GetVenueByID(id uuid.UUID) (*domain.Venue, error)
+	GetAllVenues() ([]domain.Venue, error)
+	GetPitch(venueID, id uuid.UUID) (*domain.Pitch, error)
+	GetVenuePitches(venueID uuid.UUID) ([]domain.Pitch, error)
+}
+
+var (
+	_ VenueCommands = (*VenueUseCase)(nil)
+	_ VenueQueries  = (*VenueUseCase)(nil)
+)
+
+// VenueUseCase gestiona las sedes y sus canchas
+type VenueUseCase struct {
+	venueRepo repository.VenueRepository
+	pitchRepo repository.PitchRepository
+}
+
+func NewVenueUseCase(venueRepo repository.VenueRepository, pitchRepo repository.PitchRepository) *VenueUseCase {
+	return &VenueUseCase{venueRepo: venueRepo, pitchRepo: pitchRepo}
+}
+
+func (uc *VenueUseCase) CreateVenue(venue *domain.Venue) error {
+	if err := validateVenue(venue); err != nil {
+		return err
+	}
+	return uc.venueRepo.Create(venue)
+}
+
+func (uc *VenueUseCase) GetVenueByID(id uuid.UUID) (*domain.Venue, error) {
+	return uc.venueRepo.GetByID(id)
+}
+
+func (uc *VenueUseCase) GetAllVenues() ([]domain.Venue, error) {
+	return uc.venueRepo.GetAll()
+}
+
+func (uc *VenueUseCase) UpdateVenue(venue *domain.Venue) error {
+	if err := validateVenue(venue); err != nil {
+		return err
+	}
+	return uc.venueRepo.Update(venue)
+}
+
+func (uc *VenueUseCase) SetVenueAttendance(id uuid.UUID, restriction domain.AttendanceRestriction) (*domain.Venue, error) {
+	venue, err := uc.venueRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	venue.Attendance = restriction
+	if err := uc.UpdateVenue(venue); err != nil {
+		return nil, err
+	}
+	return venue, nil
+}
+
+func (uc *VenueUseCase) DeleteVenue(id uuid.UUID) error {
+	return uc.venueRepo.Delete(id)
+}
+
+func (uc *VenueUseCase) CreatePitch(pitch *domain.Pitch) error {
+	if _, err := uc.venueRepo.GetByID(pitch.VenueID); err != nil {
+		return err
+	}
+	if err := uc.validatePitchName(pitch); err != nil {
+		return err
+	}
+	return uc.pitchRepo.Create(pitch)
+}
+
+func (uc *VenueUseCase) GetPitch(venueID, id uuid.UUID) (*domain.Pitch, error) {
+	pitch, err := uc.pitchRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if pitch.VenueID != venueID {
+		return nil, fmt.Errorf("pitch not found")
+	}
+	return pitch, nil
+}
+
+func (uc *VenueUseCase) GetVenuePitches(venueID uuid.UUID) ([]domain.Pitch, error) {
+	if _, err := uc.venueRepo.GetByID(venueID); err != nil {
+		return nil, err
+	}
+	return uc.pitchRepo.GetByVenue(venueID)
+}
+
+func (uc *VenueUseCase) UpdatePitch(pitch *domain.Pitch) error {
+	existing, err := uc.GetPitch(pitch.VenueID, pitch.ID)
+	if err != nil {
+		return err
+	}
+	if err := uc.validatePitchName(pitch); err != nil {
+		return err
+	}
+	pitch.CreatedAt = existing.CreatedAt
+	return uc.pitchRepo.Update(pitch)
+}
+
+func (uc *VenueUseCase) DeletePitch(venueID, id uuid.UUID) error {
+	if _, err := uc.GetPitch(venueID, id); err != nil {
+		return err
+	}
+	return uc.pitchRepo.Delete(id)
+}
+
+// validatePitchName exige un nombre único (sin distinguir mayúsculas) dentro
+// de la sede, porque el fixture identifica las canchas por nombre
+func (uc *VenueUseCase) validatePitchName(pitch *domain.Pitch) error {
+	if strings.TrimSpace(pitch.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	pitches, err := uc.pitchRepo.GetByVenue(pitch.VenueID)
+	if err != nil {
+		return err
+	}
+	for _, other := range pitches {
+		if other.ID != pitch.ID && normalizeName(other.Name) == normalizeName(pitch.Name) {
+			return fmt.Errorf("pitch %q already exists in the venue", pitch.Name)
+		}
+	}
+	return nil
+}
+
+func validateVenue(venue *domain.Venue) error {
+	if strings.TrimSpace(venue.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if venue.Capacity < 0 {
+		return fmt.Errorf("capacity cannot be negative")
+	}
+	if err := venue.Attendance.Validate(); err != nil {
+		return fmt.Errorf("invalid attendance: %w", err)
+	}
+	return nil
+}
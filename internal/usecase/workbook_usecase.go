@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/google/uuid"
+)
+
+// WorkbookQueries arma la planilla imprimible de un torneo
+type WorkbookQueries interface {
+	// GetTournamentWorkbook junta tabla, fixture y goleadores; sin
+	// includeEmbargoed los resultados embargados quedan ocultos
+	GetTournamentWorkbook(tournamentID uuid.UUID, includeEmbargoed bool) (*domain.TournamentWorkbook, error)
+}
+
+var _ WorkbookQueries = (*WorkbookUseCase)(nil)
+
+// WorkbookUseCase no lee repositorios: reúne lo que ya devuelven los
+// endpoints de tabla, partidos y goleadores, así la planilla muestra lo
+// mismo que la API
+type WorkbookUseCase struct {
+	tournaments TournamentQueries
+	matches     MatchQueries
+	stats       StatsQueries
+	venues      VenueQueries
+}
+
+func NewWorkbookUseCase(tournaments TournamentQueries, matches MatchQueries, stats StatsQueries, venues VenueQueries) *WorkbookUseCase {
+	return &WorkbookUseCase{tournaments: tournaments, matches: matches, stats: stats, venues: venues}
+}
+
+func (uc *WorkbookUseCase) GetTournamentWorkbook(tournamentID uuid.UUID, includeEmbargoed bool) (*domain.TournamentWorkbook, error) {
+	tournament, err := uc.tournaments.GetTournamentByID(tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	standings, err := uc.tournaments.GetStandings(tournamentID, 0, includeEmbargoed)
+	if err != nil {
+		return nil, err
+	}
+	scorers, err := uc.stats.GetTopScorers(tournamentID, includeEmbargoed)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	// el torneo se pide por ID, así que se incluye aunque esté archivado
+	matches, err := uc.matches.FindMatches(domain.MatchFilter{TournamentID: &tournamentID, Now: now, IncludeArchived: true})
+	if err != nil {
+		return nil, err
+	}
+	domain.SortMatches(matches, domain.Sort{Field: "date"})
+	if !includeEmbargoed {
+		if err := uc.matches.HideEmbargoedResults(matches); err != nil {
+			return nil, err
+		}
+	}
+	if err := uc.matches.AttachTeams(matches); err != nil {
+		return nil, err
+	}
+	fixtures, err := uc.workbookFixtures(matches, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TournamentWorkbook{
+		Tournament:  *tournament,
+		Standings:   standings,
+		Fixtures:    fixtures,
+		TopScorers:  scorers,
+		GeneratedAt: now,
+	}, nil
+}
+
+// workbookFixtures resuelve los nombres de equipos y sedes; el marcador solo
+// se muestra en los partidos que ya empezaron y no están embargados
+func (uc *WorkbookUseCase) workbookFixtures(matches []domain.Match, now time.Time) ([]domain.WorkbookFixture, error) {
+	venues := make(map[uuid.UUID]string)
+	fixtures := make([]domain.WorkbookFixture, 0, len(matches))
+	for i := range matches {
+		match := &matches[i]
+		fixture := domain.WorkbookFixture{
+			Match:  *match,
+			Home:   match.Team1.Name,
+			Away:   match.Team2.Name,
+			Status: domain.MatchStatusAt(match.Date, now),
+		}
+		if fixture.Status != domain.MatchScheduled && match.ResultEmbargoedUntil == nil {
+			fixture.Score = match.ScoreText()
+		}
+
+		if match.VenueID != nil {
+			name, ok := venues[*match.VenueID]
+			if !ok {
+				venue, err := uc.venues.GetVenueByID(*match.VenueID)
+				if err != nil {
+					return nil, err
+				}
+				name = venue.Name
+				venues[*match.VenueID] = name
+			}
+			fixture.Venue = name
+		}
+		fixtures = append(fixtures, fixture)
+	}
+	return fixtures, nil
+}
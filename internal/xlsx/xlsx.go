@@ -0,0 +1,304 @@
+// Package xlsx escribe libros de Excel (.xlsx) con la biblioteca estándar.
+// Un .xlsx es un zip de documentos XML (Office Open XML); acá se generan
+// solo las partes mínimas para tablas con título, encabezado en negrita,
+// anchos de columna y números. No lee archivos ni soporta fórmulas.
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ContentType es el tipo MIME de los .xlsx
+const ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+// maxSheetName es el largo máximo que Excel acepta para el nombre de una hoja
+const maxSheetName = 31
+
+// Estilos de celda: el índice en cellXfs de styles.xml
+const (
+	styleNormal = iota
+	styleHeader
+	styleTitle
+)
+
+// Cell es una celda de texto o numérica
+type Cell struct {
+	text     string
+	number   float64
+	isNumber bool
+}
+
+// Text es una celda de texto
+func Text(s string) Cell {
+	return Cell{text: s}
+}
+
+// Int es una celda numérica entera
+func Int(n int) Cell {
+	return Cell{number: float64(n), isNumber: true}
+}
+
+// Column es una columna de la tabla: su encabezado y su ancho en caracteres
+type Column struct {
+	Header string
+	Width  float64
+}
+
+// Sheet es una hoja con un título opcional en la primera fila, el
+// encabezado de las columnas (que queda fijo al desplazarse) y las filas
+type Sheet struct {
+	Name    string
+	Title   string
+	Columns []Column
+	Rows    [][]Cell
+}
+
+// Workbook es el libro; las hojas se escriben en orden
+type Workbook struct {
+	Sheets []Sheet
+}
+
+// Write escribe el libro como .xlsx
+func (wb *Workbook) Write(w io.Writer) error {
+	if len(wb.Sheets) == 0 {
+		return fmt.Errorf("workbook has no sheets")
+	}
+	seen := make(map[string]bool, len(wb.Sheets))
+	for _, sheet := range wb.Sheets {
+		if err := validateSheetName(sheet.Name); err != nil {
+			return err
+		}
+		// Excel compara los nombres sin distinguir mayúsculas
+		key := strings.ToLower(sheet.Name)
+		if seen[key] {
+			return fmt.Errorf("duplicate sheet name %q", sheet.Name)
+		}
+		seen[key] = true
+	}
+
+	zw := zip.NewWriter(w)
+	parts := []struct {
+		name  string
+		write func(io.Writer) error
+	}{
+		{"[Content_Types].xml", wb.writeContentTypes},
+		{"_rels/.rels", writeString(rootRels)},
+		{"xl/workbook.xml", wb.writeWorkbook},
+		{"xl/_rels/workbook.xml.rels", wb.writeWorkbookRels},
+		{"xl/styles.xml", writeString(styles)},
+	}
+	for _, part := range parts {
+		if err := writePart(zw, part.name, part.write); err != nil {
+			return err
+		}
+	}
+	for i := range wb.Sheets {
+		sheet := &wb.Sheets[i]
+		if err := writePart(zw, fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), sheet.write); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func validateSheetName(name string) error {
+	if name == "" {
+		return fmt.Errorf("sheet name is required")
+	}
+	if len([]rune(name)) > maxSheetName {
+		return fmt.Errorf("sheet name %q is longer than %d characters", name, maxSheetName)
+	}
+	if strings.ContainsAny(name, `[]:*?/\`) {
+		return fmt.Errorf("sheet name %q contains a character Excel does not allow", name)
+	}
+	return nil
+}
+
+// partTime es la fecha de las entradas del zip; zip.Writer.Create deja el
+// mes en 0, que los lectores estrictos rechazan
+var partTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func writePart(zw *zip.Writer, name string, write func(io.Writer) error) error {
+	part, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: partTime})
+	if err != nil {
+		return fmt.Errorf("error creating %s: %w", name, err)
+	}
+	buf := bufio.NewWriter(part)
+	if err := write(buf); err != nil {
+		return fmt.Errorf("error writing %s: %w", name, err)
+	}
+	return buf.Flush()
+}
+
+func writeString(s string) func(io.Writer) error {
+	return func(w io.Writer) error {
+		_, err := io.WriteString(w, s)
+		return err
+	}
+}
+
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+const rootRels = xmlHeader + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+// styles define tres formatos: normal, encabezado (negrita sobre gris) y
+// título (negrita más grande), en el orden de styleNormal, styleHeader y
+// styleTitle
+const styles = xmlHeader + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<fonts count="3">` +
+	`<font><sz val="11"/><name val="Calibri"/></font>` +
+	`<font><b/><sz val="11"/><name val="Calibri"/></font>` +
+	`<font><b/><sz val="14"/><name val="Calibri"/></font>` +
+	`</fonts>` +
+	`<fills count="3">` +
+	`<fill><patternFill patternType="none"/></fill>` +
+	`<fill><patternFill patternType="gray125"/></fill>` +
+	`<fill><patternFill patternType="solid"><fgColor rgb="FFD9D9D9"/><bgColor indexed="64"/></patternFill></fill>` +
+	`</fills>` +
+	`<borders count="2">` +
+	`<border><left/><right/><top/><bottom/><diagonal/></border>` +
+	`<border><left/><right/><top/><bottom style="thin"><color auto="1"/></bottom><diagonal/></border>` +
+	`</borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="3">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="2" borderId="1" xfId="0" applyFont="1" applyFill="1" applyBorder="1"/>` +
+	`<xf numFmtId="0" fontId="2" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`</cellXfs>` +
+	`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+	`</styleSheet>`
+
+func (wb *Workbook) writeContentTypes(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	b.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	b.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	b.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	b.WriteString(`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i := range wb.Sheets {
+		fmt.Fprintf(&b, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i+1)
+	}
+	b.WriteString(`</Types>`)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (wb *Workbook) writeWorkbook(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+	for i, sheet := range wb.Sheets {
+		fmt.Fprintf(&b, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, escape(sheet.Name), i+1, i+1)
+	}
+	b.WriteString(`</sheets></workbook>`)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (wb *Workbook) writeWorkbookRels(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for i := range wb.Sheets {
+		fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i+1, i+1)
+	}
+	fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, len(wb.Sheets)+1)
+	b.WriteString(`</Relationships>`)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// write escribe la hoja con el encabezado fijo y ajustada al ancho de la
+// página para imprimir
+func (s *Sheet) write(w io.Writer) error {
+	headerRow := 1
+	if s.Title != "" {
+		// el título y una fila en blanco antes del encabezado
+		headerRow = 3
+	}
+
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">`)
+	b.WriteString(`<sheetPr><pageSetUpPr fitToPage="1"/></sheetPr>`)
+	fmt.Fprintf(&b, `<sheetViews><sheetView workbookViewId="0"><pane ySplit="%d" topLeftCell="A%d" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>`, headerRow, headerRow+1)
+	if len(s.Columns) > 0 {
+		b.WriteString(`<cols>`)
+		for i, column := range s.Columns {
+			if column.Width > 0 {
+				fmt.Fprintf(&b, `<col min="%d" max="%d" width="%s" customWidth="1"/>`, i+1, i+1, strconv.FormatFloat(column.Width, 'f', -1, 64))
+			}
+		}
+		b.WriteString(`</cols>`)
+	}
+
+	b.WriteString(`<sheetData>`)
+	if s.Title != "" {
+		writeRow(&b, 1, []Cell{Text(s.Title)}, styleTitle)
+	}
+	header := make([]Cell, len(s.Columns))
+	for i, column := range s.Columns {
+		header[i] = Text(column.Header)
+	}
+	writeRow(&b, headerRow, header, styleHeader)
+	for i, row := range s.Rows {
+		writeRow(&b, headerRow+1+i, row, styleNormal)
+	}
+	b.WriteString(`</sheetData>`)
+
+	b.WriteString(`<pageMargins left="0.5" right="0.5" top="0.75" bottom="0.75" header="0.3" footer="0.3"/>`)
+	b.WriteString(`<pageSetup orientation="landscape" fitToWidth="1" fitToHeight="0"/>`)
+	b.WriteString(`</worksheet>`)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeRow escribe una fila; los textos van como inlineStr para no tener
+// que armar la tabla de textos compartidos (sharedStrings.xml)
+func writeRow(b *strings.Builder, number int, cells []Cell, style int) {
+	fmt.Fprintf(b, `<row r="%d">`, number)
+	for i, cell := range cells {
+		ref := columnName(i) + strconv.Itoa(number)
+		styleAttr := ""
+		if style != styleNormal {
+			styleAttr = fmt.Sprintf(` s="%d"`, style)
+		}
+		if cell.isNumber {
+			fmt.Fprintf(b, `<c r="%s"%s><v>%s</v></c>`, ref, styleAttr, strconv.FormatFloat(cell.number, 'f', -1, 64))
+			continue
+		}
+		if cell.text == "" && style == styleNormal {
+			continue
+		}
+		fmt.Fprintf(b, `<c r="%s"%s t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, styleAttr, escape(cell.text))
+	}
+	b.WriteString(`</row>`)
+}
+
+// columnName traduce el índice (desde 0) a la letra de columna: A, B, ..., Z, AA
+func columnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
+
+// escape escapa el texto para XML; los caracteres de control que XML no
+// admite quedan reemplazados por U+FFFD
+func escape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
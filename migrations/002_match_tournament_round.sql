@@ -0,0 +1,11 @@
+-- Vincula los partidos con su torneo y su jornada (round)
+-- Necesario para exportar/importar el fixture de un torneo
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS tournament_id UUID REFERENCES tournaments(id) ON DELETE CASCADE;
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS round INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_matches_tournament ON matches(tournament_id);
+CREATE INDEX IF NOT EXISTS idx_matches_tournament_round ON matches(tournament_id, round);
+
+COMMENT ON COLUMN matches.tournament_id IS 'Torneo al que pertenece el partido (opcional)';
+COMMENT ON COLUMN matches.round IS 'Jornada del partido dentro del torneo (0 = sin jornada)';
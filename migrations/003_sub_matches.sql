@@ -0,0 +1,8 @@
+-- Sub-partidos (mini-juegos) vinculados a un partido padre
+-- El resultado del padre es el agregado de sus sub-partidos
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS parent_match_id UUID REFERENCES matches(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_matches_parent ON matches(parent_match_id);
+
+COMMENT ON COLUMN matches.parent_match_id IS 'Partido padre cuando el partido es un mini-juego';
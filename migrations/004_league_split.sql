@@ -0,0 +1,13 @@
+-- Formato "split": tras la fase regular la liga se divide en grupos que
+-- arrastran (total o parcialmente) los puntos obtenidos
+
+-- Torneo del que proviene un grupo de split
+ALTER TABLE tournaments ADD COLUMN IF NOT EXISTS parent_tournament_id UUID REFERENCES tournaments(id) ON DELETE SET NULL;
+
+-- Puntos con los que un equipo inicia el torneo (arrastrados de la fase regular)
+ALTER TABLE tournament_teams ADD COLUMN IF NOT EXISTS initial_points INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_tournaments_parent ON tournaments(parent_tournament_id);
+
+COMMENT ON COLUMN tournaments.parent_tournament_id IS 'Liga original cuando el torneo es un grupo de split';
+COMMENT ON COLUMN tournament_teams.initial_points IS 'Puntos arrastrados al iniciar el torneo';
@@ -0,0 +1,41 @@
+-- Sorteos (draw) de grupos o llaves con bombos y semilla reproducible
+-- Los sorteos son inmutables: una vez registrados no se pueden modificar
+
+CREATE TABLE IF NOT EXISTS draws (
+    id UUID PRIMARY KEY,
+    tournament_id UUID NOT NULL REFERENCES tournaments(id) ON DELETE CASCADE,
+    mode VARCHAR(20) NOT NULL CHECK (mode IN ('groups', 'bracket')),
+    group_count INTEGER NOT NULL DEFAULT 0,
+    seed BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS draw_picks (
+    draw_id UUID NOT NULL REFERENCES draws(id) ON DELETE CASCADE,
+    pick_order INTEGER NOT NULL,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    pot INTEGER NOT NULL,
+    slot VARCHAR(20) NOT NULL,
+    PRIMARY KEY (draw_id, pick_order),
+    UNIQUE (draw_id, team_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_draws_tournament ON draws(tournament_id);
+
+-- Impedir modificaciones de sorteos ya registrados
+CREATE OR REPLACE FUNCTION prevent_draw_update() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'draws are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS draws_immutable ON draws;
+CREATE TRIGGER draws_immutable BEFORE UPDATE ON draws
+    FOR EACH ROW EXECUTE FUNCTION prevent_draw_update();
+
+DROP TRIGGER IF EXISTS draw_picks_immutable ON draw_picks;
+CREATE TRIGGER draw_picks_immutable BEFORE UPDATE ON draw_picks
+    FOR EACH ROW EXECUTE FUNCTION prevent_draw_update();
+
+COMMENT ON TABLE draws IS 'Sorteos de grupos o llaves de un torneo (inmutables)';
+COMMENT ON TABLE draw_picks IS 'Resultado de cada extracción de un sorteo, en orden';
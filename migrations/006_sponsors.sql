@@ -0,0 +1,19 @@
+-- Patrocinadores por torneo para los banners del frontend
+
+CREATE TABLE IF NOT EXISTS sponsors (
+    id UUID PRIMARY KEY,
+    tournament_id UUID NOT NULL REFERENCES tournaments(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    logo_url TEXT NOT NULL,
+    link_url TEXT NOT NULL DEFAULT '',
+    placement VARCHAR(50) NOT NULL,
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ends_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT sponsor_dates CHECK (ends_at >= starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_sponsors_tournament ON sponsors(tournament_id);
+CREATE INDEX IF NOT EXISTS idx_sponsors_active ON sponsors(tournament_id, placement, starts_at, ends_at);
+
+COMMENT ON TABLE sponsors IS 'Patrocinadores de un torneo y su ubicación en el frontend';
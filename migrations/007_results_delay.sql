@@ -0,0 +1,7 @@
+-- Embargo de resultados por derechos de transmisión
+-- Los endpoints públicos ocultan el marcador hasta kickoff + results_delay_minutes
+
+ALTER TABLE tournaments ADD COLUMN IF NOT EXISTS results_delay_minutes INTEGER NOT NULL DEFAULT 0
+    CHECK (results_delay_minutes >= 0);
+
+COMMENT ON COLUMN tournaments.results_delay_minutes IS 'Minutos desde el inicio del partido durante los que el marcador no es público';
@@ -0,0 +1,18 @@
+-- Eventos de partido: goles con goleador y minuto, tarjetas
+
+CREATE TABLE IF NOT EXISTS match_events (
+    id UUID PRIMARY KEY,
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL,
+    minute INTEGER NOT NULL,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    player_id UUID REFERENCES players(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT match_event_minute CHECK (minute >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_match_events_match ON match_events(match_id, minute);
+CREATE INDEX IF NOT EXISTS idx_match_events_player ON match_events(player_id);
+
+COMMENT ON TABLE match_events IS 'Eventos de un partido (goles, autogoles, tarjetas)';
+COMMENT ON COLUMN match_events.team_id IS 'Equipo del jugador que protagoniza el evento';
@@ -0,0 +1,17 @@
+-- Cambios de jugadores por partido
+
+CREATE TABLE IF NOT EXISTS substitutions (
+    id UUID PRIMARY KEY,
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    player_out_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    player_in_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    minute INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT substitution_minute CHECK (minute >= 0),
+    CONSTRAINT substitution_players CHECK (player_out_id <> player_in_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_substitutions_match ON substitutions(match_id, minute);
+
+COMMENT ON TABLE substitutions IS 'Cambios de un partido: jugador que sale, jugador que entra y minuto';
@@ -0,0 +1,25 @@
+-- Alineaciones por partido: once inicial y suplentes de cada equipo
+
+CREATE TABLE IF NOT EXISTS lineups (
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    formation VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (match_id, team_id)
+);
+
+CREATE TABLE IF NOT EXISTS lineup_players (
+    match_id UUID NOT NULL,
+    team_id UUID NOT NULL,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    role VARCHAR(10) NOT NULL,
+    slot INTEGER NOT NULL,
+    PRIMARY KEY (match_id, player_id),
+    FOREIGN KEY (match_id, team_id) REFERENCES lineups(match_id, team_id) ON DELETE CASCADE,
+    CONSTRAINT lineup_role CHECK (role IN ('starter', 'bench'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_lineup_players_team ON lineup_players(match_id, team_id);
+
+COMMENT ON TABLE lineups IS 'Alineación de un equipo en un partido';
+COMMENT ON COLUMN lineup_players.slot IS 'Orden del jugador dentro de su rol (titulares según la formación)';
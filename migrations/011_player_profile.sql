@@ -0,0 +1,15 @@
+-- Posición y pie hábil del jugador, y dorsal por equipo
+
+ALTER TABLE players ADD COLUMN IF NOT EXISTS position VARCHAR(20) NOT NULL DEFAULT '';
+ALTER TABLE players ADD COLUMN IF NOT EXISTS preferred_foot VARCHAR(10) NOT NULL DEFAULT '';
+
+-- El dorsal depende del equipo: un jugador puede estar en varios equipos
+ALTER TABLE team_players ADD COLUMN IF NOT EXISTS jersey_number INTEGER;
+ALTER TABLE team_players DROP CONSTRAINT IF EXISTS team_players_jersey_range;
+ALTER TABLE team_players ADD CONSTRAINT team_players_jersey_range CHECK (jersey_number BETWEEN 1 AND 99);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_team_players_jersey
+    ON team_players(team_id, jersey_number)
+    WHERE jersey_number IS NOT NULL;
+
+COMMENT ON COLUMN team_players.jersey_number IS 'Dorsal del jugador en el equipo, único por equipo';
@@ -0,0 +1,24 @@
+-- Registro de migraciones aplicadas, usado por `api doctor` para verificar
+-- la versión del esquema. Cada migración nueva debe registrar su versión al final.
+
+CREATE TABLE IF NOT EXISTS schema_migrations (
+    version INTEGER PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    applied_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Las migraciones anteriores a esta tabla se registran aquí
+INSERT INTO schema_migrations (version, name) VALUES
+    (1, 'initial_schema'),
+    (2, 'match_tournament_round'),
+    (3, 'sub_matches'),
+    (4, 'league_split'),
+    (5, 'draws'),
+    (6, 'sponsors'),
+    (7, 'results_delay'),
+    (8, 'match_events'),
+    (9, 'substitutions'),
+    (10, 'lineups'),
+    (11, 'player_profile'),
+    (12, 'schema_migrations')
+ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,9 @@
+-- Asistencias: jugador que dio el pase de gol
+
+ALTER TABLE match_events ADD COLUMN IF NOT EXISTS assist_player_id UUID REFERENCES players(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_match_events_assist ON match_events(assist_player_id);
+
+COMMENT ON COLUMN match_events.assist_player_id IS 'Jugador que asistió el gol (solo eventos de tipo goal)';
+
+INSERT INTO schema_migrations (version, name) VALUES (13, 'event_assists') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,22 @@
+-- Sincronización offline: versión de cada partido y conflictos pendientes
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
+
+COMMENT ON COLUMN matches.updated_at IS 'Versión del partido para detectar ediciones concurrentes';
+
+CREATE TABLE IF NOT EXISTS sync_conflicts (
+    id UUID PRIMARY KEY,
+    kind VARCHAR(40) NOT NULL,
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    client_match JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    resolution VARCHAR(20),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_sync_conflicts_status ON sync_conflicts(status);
+
+COMMENT ON TABLE sync_conflicts IS 'Operaciones de clientes offline que chocan con el estado del servidor';
+
+INSERT INTO schema_migrations (version, name) VALUES (14, 'offline_sync') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,25 @@
+-- Árbitros y su designación en partidos
+
+CREATE TABLE IF NOT EXISTS referees (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    license_number VARCHAR(50) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS match_referees (
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    referee_id UUID NOT NULL REFERENCES referees(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL,
+    slot INTEGER NOT NULL,
+    PRIMARY KEY (match_id, referee_id)
+);
+
+-- Un solo árbitro principal por partido
+CREATE UNIQUE INDEX IF NOT EXISTS idx_match_referees_main ON match_referees(match_id) WHERE role = 'main';
+CREATE INDEX IF NOT EXISTS idx_match_referees_referee ON match_referees(referee_id);
+
+COMMENT ON COLUMN match_referees.role IS 'main o assistant';
+COMMENT ON COLUMN match_referees.slot IS 'Orden de los asistentes (el principal usa 0)';
+
+INSERT INTO schema_migrations (version, name) VALUES (15, 'referees') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,13 @@
+-- Presentismo de jugadores cargado desde las tablets de los planilleros
+
+CREATE TABLE IF NOT EXISTS match_checkins (
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    checked_in_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (match_id, player_id)
+);
+
+COMMENT ON TABLE match_checkins IS 'Jugadores presentes en cada partido';
+
+INSERT INTO schema_migrations (version, name) VALUES (16, 'match_checkins') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,17 @@
+-- Sedes (estadios/canchas) y sede de cada partido
+
+CREATE TABLE IF NOT EXISTS venues (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    address VARCHAR(500) NOT NULL DEFAULT '',
+    capacity INTEGER NOT NULL DEFAULT 0 CHECK (capacity >= 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS venue_id UUID REFERENCES venues(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_matches_venue ON matches(venue_id);
+
+COMMENT ON COLUMN matches.venue_id IS 'Sede donde se juega el partido (opcional)';
+
+INSERT INTO schema_migrations (version, name) VALUES (17, 'venues') ON CONFLICT (version) DO NOTHING;
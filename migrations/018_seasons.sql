@@ -0,0 +1,18 @@
+-- Temporadas para agrupar torneos (y sus partidos y estadísticas)
+
+CREATE TABLE IF NOT EXISTS seasons (
+    id UUID PRIMARY KEY,
+    name VARCHAR(50) NOT NULL UNIQUE,
+    starts_on DATE NOT NULL,
+    ends_on DATE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT season_dates CHECK (ends_on >= starts_on)
+);
+
+ALTER TABLE tournaments ADD COLUMN IF NOT EXISTS season_id UUID REFERENCES seasons(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tournaments_season ON tournaments(season_id);
+
+COMMENT ON COLUMN tournaments.season_id IS 'Temporada a la que pertenece el torneo (opcional)';
+
+INSERT INTO schema_migrations (version, name) VALUES (18, 'seasons') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,19 @@
+-- Fases de un torneo (fase de grupos, cuartos, final...) y fase de cada partido
+
+CREATE TABLE IF NOT EXISTS stages (
+    id UUID PRIMARY KEY,
+    tournament_id UUID NOT NULL REFERENCES tournaments(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    position INTEGER NOT NULL CHECK (position > 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (tournament_id, position)
+);
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS stage_id UUID REFERENCES stages(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_matches_stage ON matches(stage_id);
+
+COMMENT ON COLUMN stages.status IS 'pending, active o completed';
+
+INSERT INTO schema_migrations (version, name) VALUES (19, 'stages') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,7 @@
+-- Ciclo de vida de los torneos
+
+ALTER TABLE tournaments ADD COLUMN IF NOT EXISTS status VARCHAR(30) NOT NULL DEFAULT 'draft';
+
+COMMENT ON COLUMN tournaments.status IS 'draft, registration_open, in_progress, completed o cancelled';
+
+INSERT INTO schema_migrations (version, name) VALUES (20, 'tournament_status') ON CONFLICT (version) DO NOTHING;
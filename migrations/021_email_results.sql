@@ -0,0 +1,26 @@
+-- Resultados enviados por email por los árbitros: quedan provisorios hasta
+-- que un organizador los confirma
+
+ALTER TABLE referees ADD COLUMN IF NOT EXISTS email VARCHAR(255) NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_referees_email ON referees (LOWER(email)) WHERE email <> '';
+
+CREATE TABLE IF NOT EXISTS provisional_results (
+    id UUID PRIMARY KEY,
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    referee_id UUID REFERENCES referees(id) ON DELETE SET NULL,
+    goal_scored_team1 INTEGER NOT NULL CHECK (goal_scored_team1 >= 0),
+    goal_scored_team2 INTEGER NOT NULL CHECK (goal_scored_team2 >= 0),
+    source VARCHAR(20) NOT NULL,
+    sender VARCHAR(255) NOT NULL DEFAULT '',
+    raw_line TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    reviewed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_provisional_results_status ON provisional_results(status, created_at);
+
+COMMENT ON COLUMN provisional_results.status IS 'pending, confirmed o rejected';
+
+INSERT INTO schema_migrations (version, name) VALUES (21, 'email_results') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,11 @@
+-- Prórroga y tanda de penales de los partidos de eliminación directa.
+-- NULL indica que el partido no tuvo prórroga o penales.
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS extra_time_team1 INTEGER CHECK (extra_time_team1 >= 0);
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS extra_time_team2 INTEGER CHECK (extra_time_team2 >= 0);
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS penalties_team1 INTEGER CHECK (penalties_team1 >= 0);
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS penalties_team2 INTEGER CHECK (penalties_team2 >= 0);
+
+COMMENT ON COLUMN matches.extra_time_team1 IS 'Goles marcados solo en la prórroga; goal_scored_team1 es el tiempo reglamentario';
+
+INSERT INTO schema_migrations (version, name) VALUES (22, 'extra_time_penalties') ON CONFLICT (version) DO NOTHING;
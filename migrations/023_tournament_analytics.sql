@@ -0,0 +1,14 @@
+-- Estadísticas agregadas de cada torneo, precalculadas por un job
+
+CREATE TABLE IF NOT EXISTS tournament_analytics (
+    tournament_id UUID PRIMARY KEY REFERENCES tournaments(id) ON DELETE CASCADE,
+    matches INTEGER NOT NULL,
+    goals INTEGER NOT NULL,
+    home_wins INTEGER NOT NULL,
+    away_wins INTEGER NOT NULL,
+    draws INTEGER NOT NULL,
+    goals_per_matchday JSONB NOT NULL DEFAULT '[]',
+    computed_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+INSERT INTO schema_migrations (version, name) VALUES (23, 'tournament_analytics') ON CONFLICT (version) DO NOTHING;
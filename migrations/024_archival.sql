@@ -0,0 +1,13 @@
+-- Archivo de torneos de temporadas antiguas. Los torneos archivados y sus
+-- partidos quedan fuera de los listados y de los índices parciales que usan
+-- las consultas frecuentes; se siguen pudiendo leer por ID.
+
+ALTER TABLE tournaments ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS archived BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_tournaments_active ON tournaments(created_at DESC) WHERE archived_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_matches_active_date ON matches(date DESC) WHERE NOT archived;
+
+COMMENT ON COLUMN matches.archived IS 'Copia de tournaments.archived_at IS NOT NULL para filtrar sin join';
+
+INSERT INTO schema_migrations (version, name) VALUES (24, 'archival') ON CONFLICT (version) DO NOTHING;
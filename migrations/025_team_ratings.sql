@@ -0,0 +1,24 @@
+-- Rating Elo de los equipos y su historial por partido. Se recalculan
+-- completos a partir de los resultados, así que se pueden regenerar.
+
+CREATE TABLE IF NOT EXISTS team_ratings (
+    team_id UUID PRIMARY KEY REFERENCES teams(id) ON DELETE CASCADE,
+    rating DOUBLE PRECISION NOT NULL,
+    matches INTEGER NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS rating_history (
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    opponent_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    rating_before DOUBLE PRECISION NOT NULL,
+    rating_after DOUBLE PRECISION NOT NULL,
+    delta DOUBLE PRECISION NOT NULL,
+    played_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (team_id, match_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_rating_history_team ON rating_history(team_id, played_at);
+
+INSERT INTO schema_migrations (version, name) VALUES (25, 'team_ratings') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,12 @@
+-- Marca de datos de prueba: las entidades creadas con is_test se pueden
+-- purgar en staging sin borrar el esquema completo.
+
+ALTER TABLE players ADD COLUMN IF NOT EXISTS is_test BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS is_test BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE tournaments ADD COLUMN IF NOT EXISTS is_test BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_players_test ON players(id) WHERE is_test;
+CREATE INDEX IF NOT EXISTS idx_teams_test ON teams(id) WHERE is_test;
+CREATE INDEX IF NOT EXISTS idx_tournaments_test ON tournaments(id) WHERE is_test;
+
+INSERT INTO schema_migrations (version, name) VALUES (26, 'test_data') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,24 @@
+-- Historial de pases: cada alta de un jugador en un equipo registra desde
+-- qué equipo llega (NULL si es su primer equipo).
+
+CREATE TABLE IF NOT EXISTS player_transfers (
+    id UUID PRIMARY KEY,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    from_team_id UUID REFERENCES teams(id) ON DELETE SET NULL,
+    to_team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    transferred_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_transfers_player ON player_transfers(player_id, transferred_at);
+
+-- Las plantillas anteriores pasan al historial en el orden en que se armaron
+INSERT INTO player_transfers (id, player_id, from_team_id, to_team_id, transferred_at)
+SELECT uuid_generate_v4(), player_id,
+       LAG(team_id) OVER (PARTITION BY player_id ORDER BY joined_at),
+       team_id, joined_at
+FROM team_players tp
+WHERE NOT EXISTS (
+    SELECT 1 FROM player_transfers pt WHERE pt.player_id = tp.player_id AND pt.to_team_id = tp.team_id
+);
+
+INSERT INTO schema_migrations (version, name) VALUES (27, 'player_transfers') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,15 @@
+-- Lesiones de los jugadores. Un jugador lesionado no se puede alinear en
+-- los partidos entre start_date y expected_return.
+
+CREATE TABLE IF NOT EXISTS injuries (
+    id UUID PRIMARY KEY,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    type VARCHAR(30) NOT NULL,
+    start_date TIMESTAMP WITH TIME ZONE NOT NULL,
+    expected_return TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_injuries_player ON injuries(player_id, start_date);
+
+INSERT INTO schema_migrations (version, name) VALUES (28, 'injuries') ON CONFLICT (version) DO NOTHING;
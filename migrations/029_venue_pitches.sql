@@ -0,0 +1,19 @@
+-- Canchas de una sede. Una sede puede tener varias canchas con nombre y cada
+-- partido puede asignarse a una cancha concreta de su sede.
+
+CREATE TABLE IF NOT EXISTS pitches (
+    id UUID PRIMARY KEY,
+    venue_id UUID NOT NULL REFERENCES venues(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_pitches_venue_name ON pitches(venue_id, LOWER(name));
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS pitch_id UUID REFERENCES pitches(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_matches_pitch ON matches(pitch_id, date);
+
+COMMENT ON COLUMN matches.pitch_id IS 'Cancha de la sede donde se juega el partido (opcional)';
+
+INSERT INTO schema_migrations (version, name) VALUES (29, 'venue_pitches') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,20 @@
+-- Alertas para los organizadores. Un job las recalcula periódicamente: agrega
+-- las nuevas y borra las que dejaron de aplicar. Una alerta descartada no
+-- vuelve a mostrarse mientras siga vigente.
+
+CREATE TABLE IF NOT EXISTS admin_alerts (
+    id UUID PRIMARY KEY,
+    type VARCHAR(30) NOT NULL,
+    entity_id UUID NOT NULL,
+    tournament_id UUID REFERENCES tournaments(id) ON DELETE CASCADE,
+    message TEXT NOT NULL,
+    detected_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    dismissed_at TIMESTAMP WITH TIME ZONE,
+    UNIQUE (type, entity_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_admin_alerts_detected ON admin_alerts(detected_at);
+
+COMMENT ON COLUMN admin_alerts.entity_id IS 'Partido, equipo o resultado provisorio al que se refiere la alerta';
+
+INSERT INTO schema_migrations (version, name) VALUES (30, 'admin_alerts') ON CONFLICT (version) DO NOTHING;
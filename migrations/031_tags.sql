@@ -0,0 +1,36 @@
+-- Etiquetas libres de los organizadores ("needs-review", "veteranos") que se
+-- asignan a equipos, jugadores y partidos. Una tabla de relación por entidad
+-- para que se borren en cascada junto con ella.
+
+CREATE TABLE IF NOT EXISTS tags (
+    id UUID PRIMARY KEY,
+    name VARCHAR(50) NOT NULL,
+    color VARCHAR(7) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tags_name ON tags(LOWER(name));
+
+CREATE TABLE IF NOT EXISTS team_tags (
+    tag_id UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    PRIMARY KEY (tag_id, team_id)
+);
+
+CREATE TABLE IF NOT EXISTS player_tags (
+    tag_id UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    PRIMARY KEY (tag_id, player_id)
+);
+
+CREATE TABLE IF NOT EXISTS match_tags (
+    tag_id UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    PRIMARY KEY (tag_id, match_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_tags_team ON team_tags(team_id);
+CREATE INDEX IF NOT EXISTS idx_player_tags_player ON player_tags(player_id);
+CREATE INDEX IF NOT EXISTS idx_match_tags_match ON match_tags(match_id);
+
+INSERT INTO schema_migrations (version, name) VALUES (31, 'tags') ON CONFLICT (version) DO NOTHING;
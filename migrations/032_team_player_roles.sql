@@ -0,0 +1,17 @@
+-- Roles del jugador en cada equipo (captain, vice_captain, goalkeeper). Como
+-- el dorsal, dependen del equipo. Cada equipo tiene a lo sumo un capitán y un
+-- subcapitán.
+
+ALTER TABLE team_players ADD COLUMN IF NOT EXISTS roles TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_team_players_captain
+    ON team_players(team_id)
+    WHERE 'captain' = ANY(roles);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_team_players_vice_captain
+    ON team_players(team_id)
+    WHERE 'vice_captain' = ANY(roles);
+
+COMMENT ON COLUMN team_players.roles IS 'Roles del jugador en el equipo: captain, vice_captain, goalkeeper';
+
+INSERT INTO schema_migrations (version, name) VALUES (32, 'team_player_roles') ON CONFLICT (version) DO NOTHING;
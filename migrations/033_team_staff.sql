@@ -0,0 +1,16 @@
+-- Cuerpo técnico de los equipos: entrenadores, ayudantes y kinesiólogos. No
+-- son jugadores: no se alinean ni cuentan para el plantel mínimo.
+
+CREATE TABLE IF NOT EXISTS team_staff (
+    id UUID PRIMARY KEY,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    role VARCHAR(20) NOT NULL,
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    phone VARCHAR(50) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_staff_team ON team_staff(team_id);
+
+INSERT INTO schema_migrations (version, name) VALUES (33, 'team_staff') ON CONFLICT (version) DO NOTHING;
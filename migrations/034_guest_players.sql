@@ -0,0 +1,13 @@
+-- Jugadores invitados: en ligas chicas suele jugar alguien que no está
+-- inscripto. Se registra solo su nombre en los eventos y en la alineación,
+-- y más adelante se lo asocia a un jugador con ficha completa.
+
+ALTER TABLE match_events ADD COLUMN IF NOT EXISTS guest_name VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE lineups ADD COLUMN IF NOT EXISTS guests TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_match_events_guest ON match_events(team_id, LOWER(guest_name)) WHERE guest_name <> '';
+
+COMMENT ON COLUMN match_events.guest_name IS 'Nombre del invitado sin ficha; excluyente con player_id';
+COMMENT ON COLUMN lineups.guests IS 'Nombres de invitados sin ficha que jugaron el partido';
+
+INSERT INTO schema_migrations (version, name) VALUES (34, 'guest_players') ON CONFLICT (version) DO NOTHING;
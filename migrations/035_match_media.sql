@@ -0,0 +1,16 @@
+-- Galería de un partido: enlaces a fotos y videos con epígrafe y orden.
+
+CREATE TABLE IF NOT EXISTS match_media (
+    id UUID PRIMARY KEY,
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    type VARCHAR(10) NOT NULL,
+    url TEXT NOT NULL,
+    caption VARCHAR(500) NOT NULL DEFAULT '',
+    position INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT match_media_type CHECK (type IN ('photo', 'video'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_match_media_match ON match_media(match_id, position);
+
+INSERT INTO schema_migrations (version, name) VALUES (35, 'match_media') ON CONFLICT (version) DO NOTHING;
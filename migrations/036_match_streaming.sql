@@ -0,0 +1,10 @@
+-- Transmisión de un partido: dónde verlo (YouTube, Twitch, etc.), quién lo
+-- transmite y hasta cuándo el enlace queda oculto al público.
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS stream_url TEXT NOT NULL DEFAULT '';
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS broadcaster VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS stream_embargo_until TIMESTAMP WITH TIME ZONE;
+
+COMMENT ON COLUMN matches.stream_embargo_until IS 'Hasta esta fecha el público no ve stream_url';
+
+INSERT INTO schema_migrations (version, name) VALUES (36, 'match_streaming') ON CONFLICT (version) DO NOTHING;
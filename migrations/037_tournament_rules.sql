@@ -0,0 +1,19 @@
+-- Reglas de cada torneo: tamaño del plantel, cupo de extranjeros y duración
+-- de los partidos. Sin fila se aplican las reglas por defecto (sin límites,
+-- 90 minutos). La nacionalidad del jugador define quién es extranjero.
+
+ALTER TABLE players ADD COLUMN IF NOT EXISTS nationality VARCHAR(2) NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS tournament_rules (
+    tournament_id UUID PRIMARY KEY REFERENCES tournaments(id) ON DELETE CASCADE,
+    min_squad_size INTEGER NOT NULL DEFAULT 0,
+    max_squad_size INTEGER NOT NULL DEFAULT 0,
+    max_foreign_players INTEGER,
+    country VARCHAR(2) NOT NULL DEFAULT '',
+    match_duration_minutes INTEGER NOT NULL DEFAULT 90,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON COLUMN tournament_rules.max_foreign_players IS 'NULL = sin cupo; extranjero es quien tiene nacionalidad distinta de country';
+
+INSERT INTO schema_migrations (version, name) VALUES (37, 'tournament_rules') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,15 @@
+-- Incidentes de la página de estado: notas que cargan los administradores
+-- mientras hay un problema o un mantenimiento.
+
+CREATE TABLE IF NOT EXISTS status_incidents (
+    id UUID PRIMARY KEY,
+    title VARCHAR(255) NOT NULL,
+    message TEXT NOT NULL DEFAULT '',
+    severity VARCHAR(20) NOT NULL,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    resolved_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_status_incidents_active ON status_incidents(started_at DESC) WHERE resolved_at IS NULL;
+
+INSERT INTO schema_migrations (version, name) VALUES (38, 'status_incidents') ON CONFLICT (version) DO NOTHING;
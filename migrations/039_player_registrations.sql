@@ -0,0 +1,24 @@
+-- Fichajes por torneo: un jugador solo puede jugar un torneo con el equipo
+-- con el que está inscripto en él
+
+CREATE TABLE IF NOT EXISTS player_registrations (
+    tournament_id UUID NOT NULL,
+    team_id UUID NOT NULL,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    registered_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tournament_id, player_id),
+    FOREIGN KEY (tournament_id, team_id) REFERENCES tournament_teams(tournament_id, team_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_registrations_team ON player_registrations(tournament_id, team_id);
+
+-- Los planteles actuales de los equipos inscriptos quedan fichados. Solo la
+-- primera vez: al reaplicar la migración volverían los fichajes dados de baja
+INSERT INTO player_registrations (tournament_id, team_id, player_id, registered_at)
+SELECT tt.tournament_id, tt.team_id, tp.player_id, NOW()
+FROM tournament_teams tt
+INNER JOIN team_players tp ON tp.team_id = tt.team_id
+WHERE NOT EXISTS (SELECT 1 FROM schema_migrations WHERE version = 39)
+ON CONFLICT (tournament_id, player_id) DO NOTHING;
+
+INSERT INTO schema_migrations (version, name) VALUES (39, 'player_registrations') ON CONFLICT (version) DO NOTHING;
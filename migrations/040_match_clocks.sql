@@ -0,0 +1,15 @@
+-- Reloj de los partidos: el tiempo jugado se guarda al pausar y mientras
+-- corre se calcula desde running_since
+
+CREATE TABLE IF NOT EXISTS match_clocks (
+    match_id UUID PRIMARY KEY REFERENCES matches(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL,
+    period INT NOT NULL DEFAULT 0,
+    duration_minutes INT NOT NULL,
+    elapsed_ms BIGINT NOT NULL DEFAULT 0,
+    running_since TIMESTAMP WITH TIME ZONE,
+    stoppage_minutes INT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO schema_migrations (version, name) VALUES (40, 'match_clocks') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,6 @@
+-- Desempate de los partidos de eliminación por torneo: prórroga y penales,
+-- directo a penales o prórroga con gol de oro
+
+ALTER TABLE tournament_rules ADD COLUMN IF NOT EXISTS knockout_tiebreak VARCHAR(20) NOT NULL DEFAULT 'extra_time';
+
+INSERT INTO schema_migrations (version, name) VALUES (41, 'knockout_tiebreak') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,11 @@
+-- Tabla de posiciones de cada jornada completa, tal como quedó al cerrarse
+
+CREATE TABLE IF NOT EXISTS standings_snapshots (
+    tournament_id UUID NOT NULL REFERENCES tournaments(id) ON DELETE CASCADE,
+    round INTEGER NOT NULL CHECK (round >= 1),
+    standings JSONB NOT NULL DEFAULT '[]',
+    taken_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (tournament_id, round)
+);
+
+INSERT INTO schema_migrations (version, name) VALUES (42, 'standings_snapshots') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,21 @@
+-- Divisiones encadenadas (Primera, Segunda, ...) para los ascensos y
+-- descensos entre temporadas. Cada torneo puede jugar una división.
+
+CREATE TABLE IF NOT EXISTS divisions (
+    id UUID PRIMARY KEY,
+    name VARCHAR(50) NOT NULL UNIQUE,
+    level INTEGER NOT NULL UNIQUE CHECK (level >= 1),
+    promoted INTEGER NOT NULL DEFAULT 0 CHECK (promoted >= 0),
+    relegated INTEGER NOT NULL DEFAULT 0 CHECK (relegated >= 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE tournaments ADD COLUMN IF NOT EXISTS division_id UUID REFERENCES divisions(id) ON DELETE SET NULL;
+
+-- Un solo torneo por división en cada temporada
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tournaments_season_division ON tournaments(season_id, division_id)
+    WHERE season_id IS NOT NULL AND division_id IS NOT NULL;
+
+COMMENT ON COLUMN tournaments.division_id IS 'División que juega el torneo (opcional)';
+
+INSERT INTO schema_migrations (version, name) VALUES (43, 'divisions') ON CONFLICT (version) DO NOTHING;
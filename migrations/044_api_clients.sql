@@ -0,0 +1,24 @@
+-- Clientes OAuth (client credentials) de las aplicaciones de terceros que
+-- consumen la API pública, y los tokens emitidos. Se guardan solo los hashes
+-- del secreto y de los tokens.
+
+CREATE TABLE IF NOT EXISTS api_clients (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    secret_hash VARCHAR(64) NOT NULL,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    rate_limit_per_minute INTEGER NOT NULL CHECK (rate_limit_per_minute > 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE TABLE IF NOT EXISTS api_tokens (
+    token_hash VARCHAR(64) PRIMARY KEY,
+    client_id UUID NOT NULL REFERENCES api_clients(id) ON DELETE CASCADE,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_tokens_expires ON api_tokens(expires_at);
+
+INSERT INTO schema_migrations (version, name) VALUES (44, 'api_clients') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,12 @@
+-- Cifrado a nivel de aplicación de los datos personales de los jugadores.
+-- Con claves configuradas (PII_ENCRYPTION_KEYS) la fecha de nacimiento se
+-- guarda cifrada en date_birth_encrypted y date_birth queda en NULL; sin
+-- claves se sigue usando date_birth. `api rotate-pii` cifra las filas viejas
+-- y vuelve a cifrar las de claves anteriores.
+
+ALTER TABLE players ALTER COLUMN date_birth DROP NOT NULL;
+ALTER TABLE players ADD COLUMN IF NOT EXISTS date_birth_encrypted TEXT;
+
+COMMENT ON COLUMN players.date_birth_encrypted IS 'Fecha de nacimiento cifrada (enc:v1:<key-id>:...)';
+
+INSERT INTO schema_migrations (version, name) VALUES (45, 'player_pii_encryption') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,9 @@
+-- Partidos amistosos: se registran con sus eventos pero no cuentan para la
+-- tabla de posiciones ni para las tablas de jugadores (goleadores,
+-- asistidores y vallas invictas).
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS is_friendly BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN matches.is_friendly IS 'Amistoso: excluido de posiciones y tablas de jugadores';
+
+INSERT INTO schema_migrations (version, name) VALUES (46, 'friendly_matches') ON CONFLICT (version) DO NOTHING;
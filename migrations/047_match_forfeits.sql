@@ -0,0 +1,31 @@
+-- Partidos adjudicados por walkover. matches.result_type distingue los
+-- resultados administrativos de los jugados; match_forfeits es el historial
+-- de adjudicaciones con el resultado previo de cada una, para auditarlas y
+-- poder revocarlas.
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS result_type VARCHAR(20) NOT NULL DEFAULT 'played';
+
+CREATE TABLE IF NOT EXISTS match_forfeits (
+    id UUID PRIMARY KEY,
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    forfeiting_team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    awarded_team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    awarded_goals INTEGER NOT NULL,
+    reason TEXT NOT NULL,
+    previous_goals_team1 INTEGER NOT NULL,
+    previous_goals_team2 INTEGER NOT NULL,
+    previous_extra_time_team1 INTEGER,
+    previous_extra_time_team2 INTEGER,
+    previous_penalties_team1 INTEGER,
+    previous_penalties_team2 INTEGER,
+    awarded_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Como mucho una adjudicación vigente por partido
+CREATE UNIQUE INDEX IF NOT EXISTS idx_match_forfeits_active ON match_forfeits(match_id) WHERE revoked_at IS NULL;
+
+COMMENT ON COLUMN matches.result_type IS 'played o forfeit (adjudicado por walkover)';
+COMMENT ON TABLE match_forfeits IS 'Historial de partidos adjudicados por walkover';
+
+INSERT INTO schema_migrations (version, name) VALUES (47, 'match_forfeits') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,28 @@
+-- Sanciones disciplinarias de cada torneo: multas, quitas de puntos y
+-- suspensiones contra un equipo o un jugador. Las quitas vigentes se restan
+-- en la tabla de posiciones; las anuladas quedan con revoked_at.
+
+CREATE TABLE IF NOT EXISTS sanctions (
+    id UUID PRIMARY KEY,
+    tournament_id UUID NOT NULL REFERENCES tournaments(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL,
+    team_id UUID REFERENCES teams(id) ON DELETE CASCADE,
+    player_id UUID REFERENCES players(id) ON DELETE CASCADE,
+    amount INTEGER NOT NULL DEFAULT 0,
+    points INTEGER NOT NULL DEFAULT 0,
+    matches INTEGER NOT NULL DEFAULT 0,
+    round INTEGER NOT NULL DEFAULT 0,
+    reason TEXT NOT NULL,
+    decided_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    CHECK ((team_id IS NULL) <> (player_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_sanctions_tournament ON sanctions(tournament_id);
+-- La tabla de posiciones suma las quitas vigentes de cada equipo
+CREATE INDEX IF NOT EXISTS idx_sanctions_deductions ON sanctions(tournament_id, team_id)
+    WHERE type = 'point_deduction' AND revoked_at IS NULL;
+
+COMMENT ON TABLE sanctions IS 'Decisiones disciplinarias: multas, quitas de puntos y suspensiones';
+
+INSERT INTO schema_migrations (version, name) VALUES (48, 'sanctions') ON CONFLICT (version) DO NOTHING;
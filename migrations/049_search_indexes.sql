@@ -0,0 +1,10 @@
+-- Búsqueda global por nombre (GET /api/search): índices de trigramas para
+-- que name ILIKE '%texto%' no recorra las tablas completas.
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_players_name_trgm ON players USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_teams_name_trgm ON teams USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_tournaments_name_trgm ON tournaments USING gin (name gin_trgm_ops);
+
+INSERT INTO schema_migrations (version, name) VALUES (49, 'search_indexes') ON CONFLICT (version) DO NOTHING;
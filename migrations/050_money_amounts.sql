@@ -0,0 +1,33 @@
+-- Importes en unidades menores con su moneda (ISO 4217). Las multas se
+-- guardaban en euros enteros: pasan a céntimos de EUR. Las reglas del
+-- torneo suman la cuota de inscripción por equipo.
+
+ALTER TABLE sanctions ADD COLUMN IF NOT EXISTS fine_amount BIGINT;
+ALTER TABLE sanctions ADD COLUMN IF NOT EXISTS fine_currency VARCHAR(3);
+
+-- sanctions.amount queda mientras pueda correr la versión anterior (que la
+-- sigue escribiendo); una migración posterior la elimina. Por eso el
+-- relleno se repite en cada aplicación para las multas que falten.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns
+               WHERE table_name = 'sanctions' AND column_name = 'amount') THEN
+        UPDATE sanctions SET fine_amount = amount * 100, fine_currency = 'EUR'
+        WHERE type = 'fine' AND fine_amount IS NULL;
+    END IF;
+END $$;
+
+ALTER TABLE sanctions DROP CONSTRAINT IF EXISTS sanctions_fine_currency;
+ALTER TABLE sanctions ADD CONSTRAINT sanctions_fine_currency
+    CHECK ((fine_amount IS NULL) = (fine_currency IS NULL));
+
+ALTER TABLE tournament_rules ADD COLUMN IF NOT EXISTS registration_fee_amount BIGINT;
+ALTER TABLE tournament_rules ADD COLUMN IF NOT EXISTS registration_fee_currency VARCHAR(3);
+ALTER TABLE tournament_rules DROP CONSTRAINT IF EXISTS tournament_rules_registration_fee_currency;
+ALTER TABLE tournament_rules ADD CONSTRAINT tournament_rules_registration_fee_currency
+    CHECK ((registration_fee_amount IS NULL) = (registration_fee_currency IS NULL));
+
+COMMENT ON COLUMN sanctions.fine_amount IS 'Importe de la multa en unidades menores de fine_currency';
+COMMENT ON COLUMN tournament_rules.registration_fee_amount IS 'Cuota de inscripción en unidades menores de registration_fee_currency';
+
+INSERT INTO schema_migrations (version, name) VALUES (50, 'money_amounts') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,16 @@
+-- Restricciones de público por sede y por torneo: tope de espectadores y
+-- partidos a puertas cerradas. Se editan durante la temporada y se muestran
+-- en los partidos en juego o por jugar.
+
+ALTER TABLE venues ADD COLUMN IF NOT EXISTS attendance_max_spectators INTEGER NOT NULL DEFAULT 0 CHECK (attendance_max_spectators >= 0);
+ALTER TABLE venues ADD COLUMN IF NOT EXISTS attendance_closed_doors BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE venues ADD COLUMN IF NOT EXISTS attendance_reason TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE tournament_rules ADD COLUMN IF NOT EXISTS attendance_max_spectators INTEGER NOT NULL DEFAULT 0 CHECK (attendance_max_spectators >= 0);
+ALTER TABLE tournament_rules ADD COLUMN IF NOT EXISTS attendance_closed_doors BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE tournament_rules ADD COLUMN IF NOT EXISTS attendance_reason TEXT NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN venues.attendance_max_spectators IS 'Tope de espectadores vigente (0 = sin tope)';
+COMMENT ON COLUMN tournament_rules.attendance_max_spectators IS 'Tope de espectadores de todos los partidos del torneo (0 = sin tope)';
+
+INSERT INTO schema_migrations (version, name) VALUES (51, 'attendance_restrictions') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,19 @@
+-- Claves Idempotency-Key de los POST de alta: guardan la respuesta original
+-- para repetirla cuando el cliente reintenta. status_code = 0 mientras la
+-- petición original sigue en curso.
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    key VARCHAR(255) PRIMARY KEY,
+    fingerprint VARCHAR(64) NOT NULL,
+    status_code INTEGER NOT NULL DEFAULT 0,
+    content_type TEXT NOT NULL DEFAULT '',
+    body BYTEA,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires ON idempotency_keys(expires_at);
+
+COMMENT ON COLUMN idempotency_keys.fingerprint IS 'SHA-256 del método, la ruta, las credenciales y el cuerpo';
+
+INSERT INTO schema_migrations (version, name) VALUES (52, 'idempotency_keys') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,22 @@
+-- Resultado definitivo de cada partido: la tabla de posiciones y el ranking
+-- Elo cuentan solo los partidos con el resultado confirmado (o adjudicados
+-- por walkover), no todos los que ya empezaron.
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS result_confirmed_at TIMESTAMP WITH TIME ZONE;
+
+-- Hasta ahora no se registraba la confirmación. Solo la primera vez, los
+-- partidos ya empezados que muestran haberse jugado (reloj cerrado,
+-- resultado por email confirmado, goles, eventos o un marcador editado
+-- después del alta) quedan confirmados; un 0-0 sin datos queda pendiente.
+UPDATE matches m
+SET result_confirmed_at = m.updated_at
+WHERE m.result_confirmed_at IS NULL
+  AND m.date <= NOW()
+  AND NOT EXISTS (SELECT 1 FROM schema_migrations WHERE version = 53)
+  AND (m.goal_scored_team1 <> 0 OR m.goal_scored_team2 <> 0
+       OR m.updated_at > m.created_at
+       OR EXISTS (SELECT 1 FROM match_clocks c WHERE c.match_id = m.id AND c.status = 'finished')
+       OR EXISTS (SELECT 1 FROM provisional_results pr WHERE pr.match_id = m.id AND pr.status = 'confirmed')
+       OR EXISTS (SELECT 1 FROM match_events e WHERE e.match_id = m.id));
+
+INSERT INTO schema_migrations (version, name) VALUES (53, 'match_result_confirmed') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,21 @@
+-- Las claves Idempotency-Key eran globales: dos clientes que generaban la
+-- misma clave se pisaban (el segundo recibía 422). Ahora cada clave es del
+-- que la envió: caller es el SHA-256 del cliente de la API o de la cabecera
+-- Authorization, y la clave primaria pasa a ser (caller, key).
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns
+                   WHERE table_name = 'idempotency_keys' AND column_name = 'caller') THEN
+        -- Las claves guardadas no saben de quién son y vencen en 24 horas:
+        -- se descartan en lugar de asignarlas a un caller que no coincidiría
+        DELETE FROM idempotency_keys;
+        ALTER TABLE idempotency_keys ADD COLUMN caller VARCHAR(64) NOT NULL;
+        ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+        ALTER TABLE idempotency_keys ADD PRIMARY KEY (caller, key);
+    END IF;
+END $$;
+
+COMMENT ON COLUMN idempotency_keys.caller IS 'SHA-256 del cliente de la API o de la cabecera Authorization';
+
+INSERT INTO schema_migrations (version, name) VALUES (54, 'idempotency_key_caller') ON CONFLICT (version) DO NOTHING;
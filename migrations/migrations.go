@@ -0,0 +1,101 @@
+// Package migrations embebe los scripts SQL para que el binario conozca la
+// versión de esquema que espera (ver `api doctor`).
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+// Migration es un script de migración identificado por su número
+type Migration struct {
+	Version int
+	Name    string
+}
+
+// List devuelve las migraciones embebidas ordenadas por versión.
+// Los archivos siguen el formato NNN_nombre.sql.
+func List() ([]Migration, error) {
+	entries, err := fs.ReadDir(files, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	var list []Migration
+	for _, entry := range entries {
+		name := strings.TrimSuffix(entry.Name(), ".sql")
+		prefix, rest, ok := strings.Cut(name, "_")
+		if !ok {
+			continue
+		}
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			continue
+		}
+		list = append(list, Migration{Version: version, Name: rest})
+	}
+
+	sort.Slice(list, func(i, j int) bool { return list[i].Version < list[j].Version })
+	return list, nil
+}
+
+// Latest devuelve la versión más alta disponible
+func Latest() (int, error) {
+	list, err := List()
+	if err != nil {
+		return 0, err
+	}
+	if len(list) == 0 {
+		return 0, nil
+	}
+	return list[len(list)-1].Version, nil
+}
+
+// Pending devuelve las migraciones embebidas que la base todavía no
+// registró en schema_migrations. Falla si la tabla no existe.
+func Pending(ctx context.Context, db *sql.DB) ([]Migration, error) {
+	expected, err := List()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, `SELECT version FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("schema_migrations table not found, apply migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int]bool)
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			return nil, err
+		}
+		applied[version] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, migration := range expected {
+		if !applied[migration.Version] {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// String devuelve el nombre del archivo sin extensión (p. ej. "012_schema_migrations")
+func (m Migration) String() string {
+	return fmt.Sprintf("%03d_%s", m.Version, m.Name)
+}
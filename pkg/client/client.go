@@ -0,0 +1,207 @@
+// Package client es un cliente HTTP tipado para la API de torneos.
+// En C# sería el equivalente a un cliente generado con NSwag o Refit.
+//
+//	c := client.New("http://localhost:8080", client.WithToken(token))
+//	team, err := c.CreateTeam(ctx, "Argentina")
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Client llama a la API de torneos
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	token      string
+	maxRetries int
+	backoff    time.Duration
+}
+
+// Option configura el cliente
+type Option func(*Client)
+
+// WithHTTPClient usa un *http.Client propio (timeouts, transport, etc.)
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithToken envía el token de organizador en cada petición
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithRetries define cuántas veces reintentar una petición idempotente (o un
+// alta con Idempotency-Key) que falla por red o con 5xx/429, y la espera
+// inicial (se duplica en cada intento; un Retry-After más largo la reemplaza)
+func WithRetries(maxRetries int, backoff time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.backoff = backoff
+	}
+}
+
+// New crea un cliente para la API en baseURL (p. ej. "http://localhost:8080")
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		maxRetries: 2,
+		backoff:    200 * time.Millisecond,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// do envía la petición, decodifica la respuesta en out (si no es nil) y
+// convierte las respuestas de error en *APIError
+func (c *Client) do(ctx context.Context, method, path string, body, out interface{}) error {
+	return c.doWithKey(ctx, method, path, "", body, out)
+}
+
+// create envía un alta por POST con una Idempotency-Key nueva. La clave es
+// la misma en todos los reintentos, así un reintento tras un corte recibe la
+// respuesta original en lugar de crear un duplicado.
+func (c *Client) create(ctx context.Context, path string, body, out interface{}) error {
+	return c.doWithKey(ctx, http.MethodPost, path, uuid.NewString(), body, out)
+}
+
+func (c *Client) doWithKey(ctx context.Context, method, path, idempotencyKey string, body, out interface{}) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		payload, err = json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+	}
+
+	// POST no es idempotente: reintentarlo podría crear duplicados, salvo que
+	// lleve una Idempotency-Key
+	attempts := 1
+	if method != http.MethodPost || idempotencyKey != "" {
+		attempts += c.maxRetries
+	}
+
+	var lastErr error
+	var retryAfter time.Duration
+	wait := c.backoff
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			delay := wait
+			if retryAfter > delay {
+				delay = retryAfter
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+			wait *= 2
+		}
+
+		var retry bool
+		var err error
+		retry, retryAfter, err = c.send(ctx, method, path, idempotencyKey, payload, out)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+		if !retry {
+			break
+		}
+	}
+	return lastErr
+}
+
+// send hace un único intento e indica si vale la pena reintentar y cuánto
+// esperar según Retry-After (0 si la respuesta no lo trae)
+func (c *Client) send(ctx context.Context, method, path, idempotencyKey string, payload []byte, out interface{}) (bool, time.Duration, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return false, 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		// Errores de red: se reintenta salvo que el contexto se haya cancelado
+		return ctx.Err() == nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		apiErr := newAPIError(resp)
+		retry := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+		// 409 con la misma clave: la petición original sigue en curso y el
+		// reintento recibirá su respuesta
+		if idempotencyKey != "" && resp.StatusCode == http.StatusConflict && resp.Header.Get("Retry-After") != "" {
+			retry = true
+		}
+		return retry, parseRetryAfter(resp.Header.Get("Retry-After")), apiErr
+	}
+
+	if out == nil {
+		return false, 0, nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return false, 0, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return false, 0, nil
+}
+
+// newAPIError lee el {"error": "..."} de una respuesta de error
+func newAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{StatusCode: resp.StatusCode, Message: http.StatusText(resp.StatusCode)}
+	var errBody struct {
+		Error string `json:"error"`
+	}
+	if json.NewDecoder(resp.Body).Decode(&errBody) == nil && errBody.Error != "" {
+		apiErr.Message = errBody.Error
+	}
+	return apiErr
+}
+
+// parseRetryAfter lee Retry-After en segundos o como fecha HTTP
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return time.Until(at)
+	}
+	return 0
+}
@@ -0,0 +1,276 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/pkg/tournament"
+	"github.com/google/uuid"
+)
+
+// Los tipos de respuesta son los del SDK (pkg/tournament), así el cliente y
+// el servidor comparten las mismas definiciones.
+
+// ---- Jugadores ----
+
+func (c *Client) CreatePlayer(ctx context.Context, name string, dateBirth time.Time) (*tournament.Player, error) {
+	input := map[string]string{"name": name, "date_birth": dateBirth.Format(time.RFC3339)}
+	var player tournament.Player
+	if err := c.create(ctx, "/api/players", input, &player); err != nil {
+		return nil, err
+	}
+	return &player, nil
+}
+
+func (c *Client) GetPlayer(ctx context.Context, id uuid.UUID) (*tournament.Player, error) {
+	var player tournament.Player
+	if err := c.do(ctx, http.MethodGet, "/api/players/"+id.String(), nil, &player); err != nil {
+		return nil, err
+	}
+	return &player, nil
+}
+
+func (c *Client) ListPlayers(ctx context.Context) ([]tournament.Player, error) {
+	return listAll[tournament.Player](ctx, c, "/api/players", nil)
+}
+
+// GetPlayerTransfers devuelve el historial de pases del jugador
+func (c *Client) GetPlayerTransfers(ctx context.Context, id uuid.UUID) ([]tournament.Transfer, error) {
+	var transfers []tournament.Transfer
+	if err := c.do(ctx, http.MethodGet, "/api/players/"+id.String()+"/transfers", nil, &transfers); err != nil {
+		return nil, err
+	}
+	return transfers, nil
+}
+
+// ---- Equipos ----
+
+func (c *Client) CreateTeam(ctx context.Context, name string) (*tournament.Team, error) {
+	var team tournament.Team
+	if err := c.create(ctx, "/api/teams", map[string]string{"name": name}, &team); err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+func (c *Client) GetTeam(ctx context.Context, id uuid.UUID) (*tournament.Team, error) {
+	var team tournament.Team
+	if err := c.do(ctx, http.MethodGet, "/api/teams/"+id.String(), nil, &team); err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+func (c *Client) ListTeams(ctx context.Context) ([]tournament.Team, error) {
+	return listAll[tournament.Team](ctx, c, "/api/teams", nil)
+}
+
+func (c *Client) AddPlayerToTeam(ctx context.Context, teamID, playerID uuid.UUID) error {
+	return c.do(ctx, http.MethodPost, "/api/teams/"+teamID.String()+"/players/"+playerID.String(), nil, nil)
+}
+
+// ---- Torneos ----
+
+func (c *Client) CreateTournament(ctx context.Context, name string) (*tournament.Tournament, error) {
+	var t tournament.Tournament
+	if err := c.create(ctx, "/api/tournaments", map[string]string{"name": name}, &t); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (c *Client) GetTournament(ctx context.Context, id uuid.UUID) (*tournament.Tournament, error) {
+	var t tournament.Tournament
+	if err := c.do(ctx, http.MethodGet, "/api/tournaments/"+id.String(), nil, &t); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (c *Client) AddTeamToTournament(ctx context.Context, tournamentID, teamID uuid.UUID) error {
+	return c.do(ctx, http.MethodPost, "/api/tournaments/"+tournamentID.String()+"/teams/"+teamID.String(), nil, nil)
+}
+
+// GetStandings devuelve la tabla de posiciones; con round > 0 se corta en esa jornada
+func (c *Client) GetStandings(ctx context.Context, tournamentID uuid.UUID, round int) ([]tournament.Standing, error) {
+	path := "/api/tournaments/" + tournamentID.String() + "/standings"
+	if round > 0 {
+		path += "?" + url.Values{"round": {strconv.Itoa(round)}}.Encode()
+	}
+
+	var standings []tournament.Standing
+	if err := c.do(ctx, http.MethodGet, path, nil, &standings); err != nil {
+		return nil, err
+	}
+	return standings, nil
+}
+
+// ---- Partidos ----
+
+// MatchInput son los datos para crear o actualizar un partido
+type MatchInput struct {
+	// ID y CreatedAt permiten crear partidos generados sin conexión
+	ID              *uuid.UUID `json:"id,omitempty"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	TournamentID    *uuid.UUID `json:"tournament_id,omitempty"`
+	VenueID         *uuid.UUID `json:"venue_id,omitempty"`
+	PitchID         *uuid.UUID `json:"pitch_id,omitempty"`
+	StageID         *uuid.UUID `json:"stage_id,omitempty"`
+	Round           int        `json:"round,omitempty"`
+	MatchNumber     int        `json:"match_number"`
+	Date            time.Time  `json:"date"`
+	Team1ID         uuid.UUID  `json:"team1_id"`
+	Team2ID         uuid.UUID  `json:"team2_id"`
+	GoalScoredTeam1 int        `json:"goal_scored_team1"`
+	GoalScoredTeam2 int        `json:"goal_scored_team2"`
+	// ResultConfirmed marca el marcador como definitivo para la tabla
+	ResultConfirmed bool `json:"result_confirmed,omitempty"`
+	// Prórroga y penales de un partido de eliminación directa (opcionales)
+	ExtraTimeTeam1 *int `json:"extra_time_team1,omitempty"`
+	ExtraTimeTeam2 *int `json:"extra_time_team2,omitempty"`
+	PenaltiesTeam1 *int `json:"penalties_team1,omitempty"`
+	PenaltiesTeam2 *int `json:"penalties_team2,omitempty"`
+	// Transmisión: hasta StreamEmbargoUntil el público no ve StreamURL
+	StreamURL          string     `json:"stream_url,omitempty"`
+	Broadcaster        string     `json:"broadcaster,omitempty"`
+	StreamEmbargoUntil *time.Time `json:"stream_embargo_until,omitempty"`
+	// UpdatedAt es la versión leída; al actualizar, si el partido cambió
+	// desde entonces la API responde ErrConflict
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+func (c *Client) CreateMatch(ctx context.Context, input MatchInput) (*tournament.Match, error) {
+	var match tournament.Match
+	if err := c.create(ctx, "/api/matches", input, &match); err != nil {
+		return nil, err
+	}
+	return &match, nil
+}
+
+func (c *Client) UpdateMatch(ctx context.Context, id uuid.UUID, input MatchInput) (*tournament.Match, error) {
+	var match tournament.Match
+	if err := c.do(ctx, http.MethodPut, "/api/matches/"+id.String(), input, &match); err != nil {
+		return nil, err
+	}
+	return &match, nil
+}
+
+func (c *Client) GetMatch(ctx context.Context, id uuid.UUID) (*tournament.Match, error) {
+	var match tournament.Match
+	if err := c.do(ctx, http.MethodGet, "/api/matches/"+id.String(), nil, &match); err != nil {
+		return nil, err
+	}
+	return &match, nil
+}
+
+func (c *Client) ListMatches(ctx context.Context) ([]tournament.Match, error) {
+	return listAll[tournament.Match](ctx, c, "/api/matches", nil)
+}
+
+// ListMatchesBySeason lista los partidos de una temporada (ID o nombre, p. ej. "2024/25")
+func (c *Client) ListMatchesBySeason(ctx context.Context, season string) ([]tournament.Match, error) {
+	return listAll[tournament.Match](ctx, c, "/api/matches", url.Values{"season": {season}})
+}
+
+// FindMatches lista los partidos que cumplen el filtro; el servidor filtra,
+// así no se descarga el listado completo. filter.Now se ignora: el estado se
+// evalúa a la hora del servidor.
+func (c *Client) FindMatches(ctx context.Context, filter tournament.MatchFilter) ([]tournament.Match, error) {
+	query := url.Values{}
+	if filter.TeamID != nil {
+		query.Set("team_id", filter.TeamID.String())
+	}
+	if filter.TournamentID != nil {
+		query.Set("tournament_id", filter.TournamentID.String())
+	}
+	if filter.SeasonID != nil {
+		query.Set("season", filter.SeasonID.String())
+	}
+	if filter.From != nil {
+		query.Set("from", filter.From.Format(time.RFC3339Nano))
+	}
+	if filter.To != nil {
+		query.Set("to", filter.To.Format(time.RFC3339Nano))
+	}
+	if filter.Status != "" {
+		query.Set("status", filter.Status)
+	}
+	if filter.IncludeArchived {
+		query.Set("archived", "true")
+	}
+	return listAll[tournament.Match](ctx, c, "/api/matches", query)
+}
+
+// listAll recorre las páginas de un listado paginado (ver
+// tournament.MaxPageLimit) y devuelve todos los elementos
+func listAll[T any](ctx context.Context, c *Client, path string, query url.Values) ([]T, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	var all []T
+	for offset := 0; ; offset += tournament.MaxPageLimit {
+		query.Set("limit", strconv.Itoa(tournament.MaxPageLimit))
+		query.Set("offset", strconv.Itoa(offset))
+
+		var page []T
+		if err := c.do(ctx, http.MethodGet, path+"?"+query.Encode(), nil, &page); err != nil {
+			return nil, err
+		}
+		all = append(all, page...)
+		if len(page) < tournament.MaxPageLimit {
+			return all, nil
+		}
+	}
+}
+
+// ListRoundMatches lista los partidos de una jornada del torneo
+func (c *Client) ListRoundMatches(ctx context.Context, tournamentID uuid.UUID, round int) ([]tournament.Match, error) {
+	var matches []tournament.Match
+	path := "/api/tournaments/" + tournamentID.String() + "/rounds/" + strconv.Itoa(round) + "/matches"
+	if err := c.do(ctx, http.MethodGet, path, nil, &matches); err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+// MatchEventInput son los datos para registrar un evento de partido
+type MatchEventInput struct {
+	ID             *uuid.UUID `json:"id,omitempty"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	Type           string     `json:"type"`
+	Minute         int        `json:"minute"`
+	TeamID         uuid.UUID  `json:"team_id"`
+	PlayerID       *uuid.UUID `json:"player_id,omitempty"`
+	GuestName      string     `json:"guest_name,omitempty"`
+	AssistPlayerID *uuid.UUID `json:"assist_player_id,omitempty"`
+}
+
+func (c *Client) CreateMatchEvent(ctx context.Context, matchID uuid.UUID, input MatchEventInput) (*tournament.MatchEvent, error) {
+	var event tournament.MatchEvent
+	if err := c.create(ctx, "/api/matches/"+matchID.String()+"/events", input, &event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (c *Client) ListMatchEvents(ctx context.Context, matchID uuid.UUID) ([]tournament.MatchEvent, error) {
+	var events []tournament.MatchEvent
+	if err := c.do(ctx, http.MethodGet, "/api/matches/"+matchID.String()+"/events", nil, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// ResolveConflict resuelve un conflicto de sincronización con "server",
+// "client" o "renumber"
+func (c *Client) ResolveConflict(ctx context.Context, id uuid.UUID, resolution string) (*tournament.SyncConflict, error) {
+	var conflict tournament.SyncConflict
+	input := map[string]string{"resolution": resolution}
+	if err := c.do(ctx, http.MethodPost, "/api/conflicts/"+id.String()+"/resolve", input, &conflict); err != nil {
+		return nil, err
+	}
+	return &conflict, nil
+}
@@ -0,0 +1,197 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/pkg/tournament"
+	"github.com/google/uuid"
+)
+
+// maxSSELine es el largo máximo de una línea del stream (un partido con
+// todos sus datos entra de sobra)
+const maxSSELine = 1 << 20
+
+// errStreamClosed indica que el servidor cerró el stream
+var errStreamClosed = errors.New("match stream closed")
+
+// StreamMatch sigue un partido y llama a onUpdate cada vez que cambia, empezando
+// por su estado actual. Bloquea hasta que se cancela ctx, onUpdate devuelve
+// un error o el partido se borra (ErrNotFound). Escucha el stream SSE
+// /api/matches/{id}/stream y se reconecta si se corta; si el stream no se
+// puede abrir (un proxy que no deja pasar SSE, un servidor anterior) consulta
+// el partido cada interval.
+func (c *Client) StreamMatch(ctx context.Context, id uuid.UUID, interval time.Duration, onUpdate func(*tournament.Match) error) error {
+	if interval <= 0 {
+		return fmt.Errorf("interval must be positive")
+	}
+
+	// last evita repetir el mismo estado al reconectar o al pasar a consultar
+	var last []byte
+	var stopErr error
+	emit := func(match *tournament.Match) error {
+		current, err := json.Marshal(match)
+		if err != nil {
+			stopErr = err
+			return err
+		}
+		if bytes.Equal(current, last) {
+			return nil
+		}
+		last = current
+		if err := onUpdate(match); err != nil {
+			stopErr = err
+			return err
+		}
+		return nil
+	}
+
+	for {
+		received, err := c.followMatch(ctx, id, emit)
+		switch {
+		case stopErr != nil:
+			return stopErr
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case !received:
+			return c.pollMatch(ctx, id, interval, emit)
+		}
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode < 500 && apiErr.StatusCode != http.StatusTooManyRequests {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.backoff):
+		}
+	}
+}
+
+// followMatch lee el stream SSE del partido hasta que se corta e indica si
+// llegó a recibir algún evento. Las actualizaciones del partido pasan a emit;
+// un gol o una tarjeta relee el partido, porque el evento no trae el marcador.
+func (c *Client) followMatch(ctx context.Context, id uuid.UUID, emit func(*tournament.Match) error) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/api/matches/"+id.String()+"/stream", nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	// El Timeout del cliente cortaría el stream: se usa el mismo transport sin él
+	streamClient := *c.httpClient
+	streamClient.Timeout = 0
+	resp, err := streamClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return false, newAPIError(resp)
+	}
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		return false, fmt.Errorf("match stream returned %q instead of text/event-stream", resp.Header.Get("Content-Type"))
+	}
+
+	received := false
+	err = readSSE(resp.Body, func(event, data string) error {
+		received = true
+		var update struct {
+			Match *tournament.Match `json:"match"`
+		}
+		if err := json.Unmarshal([]byte(data), &update); err != nil {
+			return fmt.Errorf("failed to decode %s event: %w", event, err)
+		}
+
+		switch event {
+		case "match_updated":
+			if update.Match != nil {
+				return emit(update.Match)
+			}
+		case "match_deleted":
+			return &APIError{StatusCode: http.StatusNotFound, Message: "match was deleted"}
+		case "event_created", "event_deleted":
+			match, err := c.GetMatch(ctx, id)
+			if err != nil {
+				return err
+			}
+			return emit(match)
+		}
+		return nil
+	})
+	return received, err
+}
+
+// pollMatch es la alternativa sin stream: consulta el partido cada interval
+func (c *Client) pollMatch(ctx context.Context, id uuid.UUID, interval time.Duration, emit func(*tournament.Match) error) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		match, err := c.GetMatch(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := emit(match); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// readSSE separa el stream en eventos (nombre y datos) y los pasa a onEvent.
+// Ignora los comentarios como ": ping"; sin "event:" el nombre es "message".
+func readSSE(r io.Reader, onEvent func(event, data string) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxSSELine)
+
+	event, data := "", []string(nil)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			if len(data) > 0 {
+				if event == "" {
+					event = "message"
+				}
+				if err := onEvent(event, strings.Join(data, "\n")); err != nil {
+					return err
+				}
+			}
+			event, data = "", nil
+			continue
+		}
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			event = value
+		case "data":
+			data = append(data, value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return errStreamClosed
+}
@@ -0,0 +1,176 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"math/rand/v2"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// ErrInjectedFault es el error transitorio que devuelve el modo caos.
+// Las pruebas pueden detectarlo con errors.Is.
+var ErrInjectedFault = errors.New("chaos: injected transient database error")
+
+// ChaosConfig configura la inyección de fallas en la capa de base de datos.
+// Se aplica a nivel de driver, así que afecta a todos los repositorios sin
+// tocarlos (un decorador de todos a la vez). Pensado para pruebas y staging,
+// nunca para producción.
+type ChaosConfig struct {
+	// Latency es la demora máxima agregada a cada operación (aleatoria entre 0 y Latency)
+	Latency time.Duration
+	// ErrorRate es la probabilidad (0 a 1) de devolver ErrInjectedFault
+	ErrorRate float64
+	// DropRate es la probabilidad (0 a 1) de cortar la conexión (driver.ErrBadConn)
+	DropRate float64
+}
+
+// Enabled indica si hay alguna falla configurada
+func (c ChaosConfig) Enabled() bool {
+	return c.Latency > 0 || c.ErrorRate > 0 || c.DropRate > 0
+}
+
+// newChaosConfigFromEnv lee DB_CHAOS_LATENCY_MS, DB_CHAOS_ERROR_RATE y DB_CHAOS_DROP_RATE
+func newChaosConfigFromEnv() ChaosConfig {
+	latencyMS, _ := strconv.Atoi(getEnv("DB_CHAOS_LATENCY_MS", "0"))
+	errorRate, _ := strconv.ParseFloat(getEnv("DB_CHAOS_ERROR_RATE", "0"), 64)
+	dropRate, _ := strconv.ParseFloat(getEnv("DB_CHAOS_DROP_RATE", "0"), 64)
+	return ChaosConfig{
+		Latency:   time.Duration(latencyMS) * time.Millisecond,
+		ErrorRate: errorRate,
+		DropRate:  dropRate,
+	}
+}
+
+// WrapConnector envuelve un connector de cualquier driver con inyección de
+// fallas. Uso en pruebas: sql.OpenDB(database.WrapConnector(base, cfg)).
+func WrapConnector(base driver.Connector, config ChaosConfig) driver.Connector {
+	return &chaosConnector{base: base, config: config}
+}
+
+type chaosConnector struct {
+	base   driver.Connector
+	config ChaosConfig
+}
+
+func (c *chaosConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.base.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &chaosConn{Conn: conn, config: c.config}, nil
+}
+
+func (c *chaosConnector) Driver() driver.Driver {
+	return c.base.Driver()
+}
+
+// chaosConn delega en la conexión real e inyecta fallas antes de cada operación.
+// Una conexión "cortada" queda inválida y el pool de database/sql la descarta
+// y reintenta con otra, igual que ante una caída real.
+type chaosConn struct {
+	driver.Conn
+	config ChaosConfig
+	broken atomic.Bool
+}
+
+// inject aplica latencia y decide si la operación falla
+func (c *chaosConn) inject(ctx context.Context) error {
+	if c.broken.Load() {
+		return driver.ErrBadConn
+	}
+
+	if c.config.Latency > 0 {
+		delay := rand.N(c.config.Latency)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+
+	roll := rand.Float64()
+	if roll < c.config.DropRate {
+		c.broken.Store(true)
+		return driver.ErrBadConn
+	}
+	if roll < c.config.DropRate+c.config.ErrorRate {
+		return ErrInjectedFault
+	}
+	return nil
+}
+
+func (c *chaosConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	if err := c.inject(ctx); err != nil {
+		return nil, err
+	}
+	return queryer.QueryContext(ctx, query, args)
+}
+
+func (c *chaosConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	if err := c.inject(ctx); err != nil {
+		return nil, err
+	}
+	return execer.ExecContext(ctx, query, args)
+}
+
+func (c *chaosConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if err := c.inject(ctx); err != nil {
+		return nil, err
+	}
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		return preparer.PrepareContext(ctx, query)
+	}
+	return c.Conn.Prepare(query)
+}
+
+func (c *chaosConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if err := c.inject(ctx); err != nil {
+		return nil, err
+	}
+	if beginner, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+	return c.Conn.Begin() // drivers sin BeginTx
+}
+
+// Ping no inyecta fallas para no impedir el arranque
+func (c *chaosConn) Ping(ctx context.Context) error {
+	if c.broken.Load() {
+		return driver.ErrBadConn
+	}
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *chaosConn) ResetSession(ctx context.Context) error {
+	if c.broken.Load() {
+		return driver.ErrBadConn
+	}
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *chaosConn) IsValid() bool {
+	if c.broken.Load() {
+		return false
+	}
+	if validator, ok := c.Conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+	return true
+}
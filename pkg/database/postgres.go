@@ -8,7 +8,7 @@ import (
 	"os"
 	"time"
 
-	_ "github.com/lib/pq" // Driver de PostgreSQL
+	"github.com/lib/pq" // Driver de PostgreSQL
 )
 
 // Config contiene la configuración de conexión a la base de datos
@@ -19,6 +19,8 @@ type Config struct {
 	User     string
 	Password string
 	DBName   string
+	// Chaos inyecta fallas en la conexión (solo pruebas/staging)
+	Chaos ChaosConfig
 }
 
 // NewConfigFromEnv crea una configuración desde variables de entorno
@@ -29,12 +31,30 @@ func NewConfigFromEnv() *Config {
 		User:     getEnv("DB_USER", "postgres"),
 		Password: getEnv("DB_PASSWORD", "postgres"),
 		DBName:   getEnv("DB_NAME", "tournament_db"),
+		Chaos:    newChaosConfigFromEnv(),
 	}
 }
 
 // NewConnection crea una nueva conexión a PostgreSQL
 // Equivalente a DbContext en Entity Framework
 func NewConnection(config *Config) (*sql.DB, error) {
+	db, err := Open(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verificar conexión con timeout
+	if err := pingWithRetry(db, 5); err != nil {
+		return nil, fmt.Errorf("error connecting to database: %w", err)
+	}
+
+	log.Println("✅ Connected to PostgreSQL database")
+	return db, nil
+}
+
+// Open configura el pool de conexiones sin verificar que la base responda.
+// Útil para diagnósticos que quieren controlar el timeout del primer ping.
+func Open(config *Config) (*sql.DB, error) {
 	// String de conexión de PostgreSQL
 	connStr := fmt.Sprintf(
 		"host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
@@ -46,22 +66,25 @@ func NewConnection(config *Config) (*sql.DB, error) {
 	)
 
 	// Abrir conexión
-	db, err := sql.Open("postgres", connStr)
+	connector, err := pq.NewConnector(connStr)
 	if err != nil {
 		return nil, fmt.Errorf("error opening database: %w", err)
 	}
 
+	var db *sql.DB
+	if config.Chaos.Enabled() {
+		log.Printf("⚠️  Database chaos mode enabled (latency<=%s, error_rate=%.2f, drop_rate=%.2f)",
+			config.Chaos.Latency, config.Chaos.ErrorRate, config.Chaos.DropRate)
+		db = sql.OpenDB(WrapConnector(connector, config.Chaos))
+	} else {
+		db = sql.OpenDB(connector)
+	}
+
 	// Configurar pool de conexiones
 	db.SetMaxOpenConns(25)                 // Máximo de conexiones abiertas
 	db.SetMaxIdleConns(5)                  // Conexiones en idle
 	db.SetConnMaxLifetime(5 * time.Minute) // Tiempo de vida de conexión
 
-	// Verificar conexión con timeout
-	if err := pingWithRetry(db, 5); err != nil {
-		return nil, fmt.Errorf("error connecting to database: %w", err)
-	}
-
-	log.Println("✅ Connected to PostgreSQL database")
 	return db, nil
 }
 
@@ -0,0 +1,323 @@
+// Package tournament expone el motor de torneos como SDK de Go para
+// embeberlo en otros servicios sin pasar por HTTP.
+//
+// Garantía de compatibilidad: este paquete sigue versionado semántico
+// (ver Version). Dentro de una misma versión mayor no se eliminan ni cambian
+// de firma los tipos, funciones y métodos exportados aquí; solo se agregan.
+// Lo que está bajo internal/ puede cambiar en cualquier momento.
+//
+// Uso típico (equivalente a registrar los servicios en el contenedor de DI en C#):
+//
+//	engine, err := tournament.NewEngine(tournament.NewPostgresStorage(db))
+//	if err != nil { ... }
+//	standings, err := engine.Tournaments.GetStandings(id, 0, true)
+package tournament
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/fieldcrypt"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+)
+
+// Version es la versión de la API pública de este paquete
+const Version = "1.0.0"
+
+// Storage agrupa los repositorios que usa el motor. Cada servicio puede
+// cablear su propio almacenamiento implementando las interfaces.
+type Storage struct {
+	Players       PlayerRepository
+	Teams         TeamRepository
+	Tournaments   TournamentRepository
+	Matches       MatchRepository
+	Draws         DrawRepository
+	Sponsors      SponsorRepository
+	MatchEvents   MatchEventRepository
+	Substitutions SubstitutionRepository
+	Lineups       LineupRepository
+	Stats         StatsRepository
+	SyncConflicts SyncConflictRepository
+	Referees      RefereeRepository
+	Sync          SyncRepository
+	Venues        VenueRepository
+	Seasons       SeasonRepository
+	Stages        StageRepository
+	// ProvisionalResults guarda los resultados recibidos por email
+	ProvisionalResults ProvisionalResultRepository
+	// Analytics guarda las estadísticas agregadas precalculadas
+	Analytics AnalyticsRepository
+	Ratings   RatingRepository
+	// TestData purga las entidades marcadas como prueba
+	TestData TestDataRepository
+	// Transfers lee el historial de pases de los jugadores
+	Transfers TransferRepository
+	Injuries  InjuryRepository
+	// Pitches guarda las canchas de cada sede
+	Pitches PitchRepository
+	// Alerts guarda las tareas pendientes de los organizadores
+	Alerts AlertRepository
+	Tags   TagRepository
+	// Staff guarda el cuerpo técnico de los equipos
+	Staff StaffRepository
+	// Guests reasigna los invitados sin ficha a jugadores inscriptos
+	Guests GuestRepository
+	// Media guarda la galería de fotos y videos de cada partido
+	Media MediaRepository
+	// TournamentRules guarda las reglas de plantel y duración de cada torneo
+	TournamentRules TournamentRulesRepository
+	// Registrations guarda con qué equipo está fichado cada jugador en cada torneo
+	Registrations RegistrationRepository
+	// MatchClocks guarda el reloj de cada partido
+	MatchClocks MatchClockRepository
+	// Incidents guarda las notas de la página de estado
+	Incidents IncidentRepository
+	// StandingsSnapshots guarda la tabla de cada jornada completa
+	StandingsSnapshots StandingsSnapshotRepository
+	// Divisions guarda la pirámide de ligas para ascensos y descensos
+	Divisions DivisionRepository
+	// APIClients guarda las aplicaciones de terceros y sus tokens
+	APIClients APIClientRepository
+	// MatchForfeits guarda el historial de partidos adjudicados por walkover
+	MatchForfeits MatchForfeitRepository
+	// Sanctions guarda las decisiones disciplinarias de cada torneo
+	Sanctions SanctionRepository
+}
+
+// NewEncryptedPostgresStorage es NewPostgresStorage con los datos personales
+// de los jugadores cifrados, igual que la API con PII_ENCRYPTION_KEYS
+// ("id:base64,id:base64"; la primera clave es la vigente). Las claves quedan
+// en los repositorios de este Storage, así varios motores no se pisan.
+func NewEncryptedPostgresStorage(db *sql.DB, keys string) (Storage, error) {
+	keyring, err := fieldcrypt.ParseKeyring(keys)
+	if err != nil {
+		return Storage{}, err
+	}
+	return newPostgresStorage(db, keyring), nil
+}
+
+// NewPostgresStorage crea el almacenamiento PostgreSQL que usa la API, con
+// los datos personales en claro. El esquema debe estar creado con las
+// migraciones del repositorio.
+func NewPostgresStorage(db *sql.DB) Storage {
+	return newPostgresStorage(db, nil)
+}
+
+func newPostgresStorage(db *sql.DB, keyring *fieldcrypt.Keyring) Storage {
+	return Storage{
+		Players:            repository.NewPostgresPlayerRepository(db, keyring),
+		Teams:              repository.NewPostgresTeamRepository(db, keyring),
+		Tournaments:        repository.NewPostgresTournamentRepository(db),
+		Matches:            repository.NewPostgresMatchRepository(db),
+		Draws:              repository.NewPostgresDrawRepository(db),
+		Sponsors:           repository.NewPostgresSponsorRepository(db),
+		MatchEvents:        repository.NewPostgresMatchEventRepository(db),
+		Substitutions:      repository.NewPostgresSubstitutionRepository(db),
+		Lineups:            repository.NewPostgresLineupRepository(db),
+		Stats:              repository.NewPostgresStatsRepository(db),
+		SyncConflicts:      repository.NewPostgresSyncConflictRepository(db),
+		Referees:           repository.NewPostgresRefereeRepository(db),
+		Sync:               repository.NewPostgresSyncRepository(db),
+		Venues:             repository.NewPostgresVenueRepository(db),
+		Seasons:            repository.NewPostgresSeasonRepository(db),
+		Stages:             repository.NewPostgresStageRepository(db),
+		ProvisionalResults: repository.NewPostgresProvisionalResultRepository(db),
+		Analytics:          repository.NewPostgresAnalyticsRepository(db),
+		Ratings:            repository.NewPostgresRatingRepository(db),
+		TestData:           repository.NewPostgresTestDataRepository(db),
+		Transfers:          repository.NewPostgresTransferRepository(db),
+		Injuries:           repository.NewPostgresInjuryRepository(db),
+		Pitches:            repository.NewPostgresPitchRepository(db),
+		Alerts:             repository.NewPostgresAlertRepository(db),
+		Tags:               repository.NewPostgresTagRepository(db),
+		Staff:              repository.NewPostgresStaffRepository(db),
+		Guests:             repository.NewPostgresGuestRepository(db),
+		Media:              repository.NewPostgresMediaRepository(db),
+		TournamentRules:    repository.NewPostgresTournamentRulesRepository(db),
+		Registrations:      repository.NewPostgresRegistrationRepository(db),
+		MatchClocks:        repository.NewPostgresMatchClockRepository(db),
+		Incidents:          repository.NewPostgresIncidentRepository(db),
+		StandingsSnapshots: repository.NewPostgresStandingsSnapshotRepository(db),
+		Divisions:          repository.NewPostgresDivisionRepository(db),
+		APIClients:         repository.NewPostgresAPIClientRepository(db),
+		MatchForfeits:      repository.NewPostgresMatchForfeitRepository(db),
+		Sanctions:          repository.NewPostgresSanctionRepository(db),
+	}
+}
+
+// Engine es el motor de torneos con las mismas reglas de negocio que la API HTTP
+type Engine struct {
+	Players       PlayerService
+	Teams         TeamService
+	Tournaments   TournamentService
+	Matches       MatchService
+	Fixtures      FixtureService
+	Draws         DrawService
+	Sponsors      SponsorService
+	MatchEvents   MatchEventService
+	Substitutions SubstitutionService
+	Lineups       LineupService
+	Stats         StatsService
+	SyncConflicts SyncConflictService
+	Referees      RefereeService
+	Sync          SyncService
+	Venues        VenueService
+	Seasons       SeasonService
+	Stages        StageService
+	// ProvisionalResults carga y revisa los resultados recibidos por email
+	ProvisionalResults ProvisionalResultService
+	// Analytics calcula y lee las estadísticas agregadas de los torneos
+	Analytics AnalyticsService
+	// Ratings es el ranking Elo. Sin el servidor HTTP no se recalcula solo:
+	// llamar a RecalculateRatings después de cargar resultados.
+	Ratings RatingService
+	// Injuries son las lesiones; impiden alinear al jugador mientras duran
+	Injuries InjuryService
+	// Scoreboards arma el marcador de las pantallas de cada sede
+	Scoreboards ScoreboardService
+	// Predictions estima el resultado de los partidos por jugar a partir de
+	// los ratings guardados y la forma reciente
+	Predictions PredictionService
+	// TestData purga los datos de prueba de un entorno de staging
+	TestData TestDataService
+	// Alerts son las tareas pendientes de los organizadores. Sin el servidor
+	// HTTP no se recalculan solas: llamar a RefreshAlerts periódicamente.
+	Alerts AlertService
+	// Tags son las etiquetas libres de equipos, jugadores y partidos
+	Tags TagService
+	// Staff es el cuerpo técnico (entrenador, ayudantes, kinesiólogo) de cada equipo
+	Staff StaffService
+	// Guests son los invitados sin ficha que jugaron para cada equipo
+	Guests GuestService
+	// Media es la galería de fotos y videos (enlaces externos) de cada partido
+	Media MediaService
+	// Registrations son los fichajes de los jugadores en cada torneo; las
+	// alineaciones y los eventos solo aceptan jugadores fichados
+	Registrations RegistrationService
+	// MatchClocks es el reloj de cada partido; los eventos no pueden tener
+	// un minuto posterior al que marca
+	MatchClocks MatchClockService
+	// Status informa el uptime y los incidentes abiertos. Sin el servidor HTTP
+	// no chequea dependencias: el uptime cuenta desde NewEngine.
+	Status StatusService
+	// Divisions es la pirámide de ligas; ApplyPromotions inscribe a los
+	// equipos en los torneos de la temporada siguiente
+	Divisions DivisionService
+	// Competitions agrupa las categorías de una competición (torneos hijos)
+	// y suma sus estadísticas
+	Competitions CompetitionService
+	// APIClients registra aplicaciones de terceros y emite sus tokens OAuth2
+	APIClients APIClientService
+	// MatchForfeits adjudica partidos por walkover y guarda el historial
+	MatchForfeits MatchForfeitService
+	// Sanctions registra multas, quitas de puntos y suspensiones; las quitas
+	// vigentes se restan en la tabla de posiciones
+	Sanctions SanctionService
+}
+
+// NewEngine construye el motor sobre el almacenamiento indicado
+func NewEngine(storage Storage) (*Engine, error) {
+	if err := storage.validate(); err != nil {
+		return nil, err
+	}
+
+	ratings := usecase.NewRatingUseCase(storage.Ratings, storage.Matches, storage.Teams, storage.Tournaments)
+	tournaments := usecase.NewTournamentUseCase(storage.Tournaments, storage.Teams, storage.Seasons, storage.TournamentRules, storage.Registrations, storage.StandingsSnapshots)
+	analytics := usecase.NewAnalyticsUseCase(storage.Analytics, storage.Tournaments, storage.Matches)
+	stats := usecase.NewStatsUseCase(storage.Stats, storage.Tournaments)
+	matches := usecase.NewMatchUseCase(storage.Matches, storage.Teams, storage.Tournaments, storage.SyncConflicts, storage.Referees, storage.Venues, storage.Pitches, storage.Seasons, storage.Stages, storage.TournamentRules, nil)
+
+	return &Engine{
+		Players:            usecase.NewPlayerUseCase(storage.Players, storage.Transfers),
+		Teams:              usecase.NewTeamUseCase(storage.Teams, storage.Players, storage.Transfers, storage.TournamentRules, 0),
+		Tournaments:        tournaments,
+		Matches:            matches,
+		Fixtures:           usecase.NewFixtureUseCase(storage.Tournaments, storage.Teams, storage.Matches, storage.Venues, storage.Pitches, storage.TournamentRules),
+		Draws:              usecase.NewDrawUseCase(storage.Draws, storage.Tournaments),
+		Sponsors:           usecase.NewSponsorUseCase(storage.Sponsors, storage.Tournaments),
+		MatchEvents:        usecase.NewMatchEventUseCase(storage.MatchEvents, storage.Matches, storage.Teams, storage.Tournaments, storage.Registrations, storage.MatchClocks, storage.TournamentRules, nil),
+		Substitutions:      usecase.NewSubstitutionUseCase(storage.Substitutions, storage.Matches, storage.Teams, storage.Lineups),
+		Lineups:            usecase.NewLineupUseCase(storage.Lineups, storage.Matches, storage.Teams, storage.Injuries, storage.Registrations),
+		Stats:              stats,
+		SyncConflicts:      matches,
+		Referees:           usecase.NewRefereeUseCase(storage.Referees, storage.Matches),
+		Venues:             usecase.NewVenueUseCase(storage.Venues, storage.Pitches),
+		Seasons:            usecase.NewSeasonUseCase(storage.Seasons),
+		Stages:             usecase.NewStageUseCase(storage.Stages, storage.Tournaments, storage.Matches),
+		ProvisionalResults: usecase.NewProvisionalResultUseCase(storage.ProvisionalResults, storage.Referees, storage.Matches, matches),
+		Sync:               usecase.NewSyncUseCase(storage.Sync, storage.Matches, storage.MatchEvents, storage.Teams, storage.Tournaments, storage.Registrations, storage.MatchClocks, storage.TournamentRules, nil),
+		Analytics:          analytics,
+		Ratings:            ratings,
+		Predictions:        usecase.NewPredictionUseCase(storage.Matches, storage.Ratings),
+		Injuries:           usecase.NewInjuryUseCase(storage.Injuries, storage.Players),
+		Scoreboards:        usecase.NewScoreboardUseCase(storage.Venues, storage.Matches, storage.Teams, storage.Tournaments, storage.TournamentRules),
+		TestData:           usecase.NewTestDataUseCase(storage.TestData, ratings),
+		Alerts:             usecase.NewAlertUseCase(storage.Alerts),
+		Tags:               usecase.NewTagUseCase(storage.Tags, storage.Teams, storage.Players, storage.Matches),
+		Staff:              usecase.NewStaffUseCase(storage.Staff, storage.Teams),
+		Guests:             usecase.NewGuestUseCase(storage.Guests, storage.Teams),
+		Media:              usecase.NewMediaUseCase(storage.Media, storage.Matches),
+		Registrations:      usecase.NewRegistrationUseCase(storage.Registrations, storage.Tournaments, storage.Teams),
+		MatchClocks:        usecase.NewMatchClockUseCase(storage.MatchClocks, storage.Matches, storage.Tournaments, storage.TournamentRules, nil),
+		Status:             usecase.NewStatusUseCase(storage.Incidents, time.Now().UTC()),
+		Divisions:          usecase.NewDivisionUseCase(storage.Divisions, storage.Tournaments, storage.Seasons, tournaments),
+		Competitions:       usecase.NewCompetitionUseCase(storage.Tournaments, storage.Divisions, tournaments, analytics, stats),
+		APIClients:         usecase.NewAPIClientUseCase(storage.APIClients),
+		MatchForfeits:      usecase.NewMatchForfeitUseCase(storage.MatchForfeits, storage.Matches, storage.Tournaments, nil),
+		Sanctions:          usecase.NewSanctionUseCase(storage.Sanctions, storage.Tournaments, storage.Players),
+	}, nil
+}
+
+// validate comprueba que no falte ningún repositorio
+func (s Storage) validate() error {
+	checks := []struct {
+		name    string
+		missing bool
+	}{
+		{"players", s.Players == nil},
+		{"teams", s.Teams == nil},
+		{"tournaments", s.Tournaments == nil},
+		{"matches", s.Matches == nil},
+		{"draws", s.Draws == nil},
+		{"sponsors", s.Sponsors == nil},
+		{"match events", s.MatchEvents == nil},
+		{"substitutions", s.Substitutions == nil},
+		{"lineups", s.Lineups == nil},
+		{"stats", s.Stats == nil},
+		{"sync conflicts", s.SyncConflicts == nil},
+		{"referees", s.Referees == nil},
+		{"sync", s.Sync == nil},
+		{"venues", s.Venues == nil},
+		{"seasons", s.Seasons == nil},
+		{"stages", s.Stages == nil},
+		{"provisional results", s.ProvisionalResults == nil},
+		{"analytics", s.Analytics == nil},
+		{"ratings", s.Ratings == nil},
+		{"test data", s.TestData == nil},
+		{"transfers", s.Transfers == nil},
+		{"injuries", s.Injuries == nil},
+		{"pitches", s.Pitches == nil},
+		{"alerts", s.Alerts == nil},
+		{"tags", s.Tags == nil},
+		{"staff", s.Staff == nil},
+		{"guests", s.Guests == nil},
+		{"media", s.Media == nil},
+		{"tournament rules", s.TournamentRules == nil},
+		{"registrations", s.Registrations == nil},
+		{"match clocks", s.MatchClocks == nil},
+		{"incidents", s.Incidents == nil},
+		{"standings snapshots", s.StandingsSnapshots == nil},
+		{"divisions", s.Divisions == nil},
+		{"api clients", s.APIClients == nil},
+		{"match forfeits", s.MatchForfeits == nil},
+		{"sanctions", s.Sanctions == nil},
+	}
+	for _, check := range checks {
+		if check.missing {
+			return fmt.Errorf("storage is missing the %s repository", check.name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,480 @@
+package tournament
+
+import (
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/domain"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/repository"
+	"github.com/cgonzalezvera/football-tournament-api-native/internal/usecase"
+)
+
+// Los paquetes internal/ no se pueden importar desde otros módulos, así que el
+// SDK los re-exporta con alias de tipo. Un alias es el mismo tipo (no una copia),
+// parecido a un "using Team = Domain.Team;" en C#.
+
+// Entidades de dominio
+type (
+	Player            = domain.Player
+	Transfer          = domain.Transfer
+	Injury            = domain.Injury
+	Team              = domain.Team
+	Tournament        = domain.Tournament
+	Match             = domain.Match
+	Standing          = domain.Standing
+	StandingsSnapshot = domain.StandingsSnapshot
+	Sponsor           = domain.Sponsor
+	MatchEvent        = domain.MatchEvent
+	Substitution      = domain.Substitution
+	Lineup            = domain.Lineup
+	TopScorer         = domain.TopScorer
+	TopAssister       = domain.TopAssister
+
+	CleanSheets          = domain.CleanSheets
+	TeamCleanSheet       = domain.TeamCleanSheet
+	GoalkeeperCleanSheet = domain.GoalkeeperCleanSheet
+
+	SyncConflict      = domain.SyncConflict
+	SyncConflictError = domain.SyncConflictError
+
+	Referee      = domain.Referee
+	MatchReferee = domain.MatchReferee
+
+	SyncOperation = domain.SyncOperation
+	SyncScore     = domain.SyncScore
+	SyncOpResult  = domain.SyncOpResult
+	CheckIn       = domain.CheckIn
+
+	Venue     = domain.Venue
+	Pitch     = domain.Pitch
+	Season    = domain.Season
+	NameCheck = domain.NameCheck
+	Stage     = domain.Stage
+
+	Division        = domain.Division
+	DivisionMove    = domain.DivisionMove
+	PromotionReport = domain.PromotionReport
+
+	CompetitionDivision = domain.CompetitionDivision
+	CompetitionStats    = domain.CompetitionStats
+	DivisionAnalytics   = domain.DivisionAnalytics
+
+	APIClient            = domain.APIClient
+	APIClientCredentials = domain.APIClientCredentials
+	APIToken             = domain.APIToken
+	TokenResponse        = domain.TokenResponse
+	OAuthError           = domain.OAuthError
+	RateLimitStatus      = domain.RateLimitStatus
+
+	ProvisionalResult = domain.ProvisionalResult
+	ReportedResult    = domain.ReportedResult
+	ResultEmailReport = domain.ResultEmailReport
+
+	TournamentAnalytics = domain.TournamentAnalytics
+	MatchdayGoals       = domain.MatchdayGoals
+
+	TeamRating   = domain.TeamRating
+	RatingChange = domain.RatingChange
+
+	TeamOutlook     = domain.TeamOutlook
+	MatchPrediction = domain.MatchPrediction
+	MatchOdds       = domain.MatchOdds
+
+	Scoreboard      = domain.Scoreboard
+	ScoreboardMatch = domain.ScoreboardMatch
+
+	PurgeReport    = domain.PurgeReport
+	Alert          = domain.Alert
+	Tag            = domain.Tag
+	Staff          = domain.Staff
+	Guest          = domain.Guest
+	GuestPromotion = domain.GuestPromotion
+	MatchMedia     = domain.MatchMedia
+
+	TournamentRules = domain.TournamentRules
+
+	Registration = domain.Registration
+	MatchClock   = domain.MatchClock
+	MatchForfeit = domain.MatchForfeit
+	Sanction     = domain.Sanction
+	Money        = domain.Money
+	Attendance   = domain.AttendanceRestriction
+	Page         = domain.Page
+	MatchFilter  = domain.MatchFilter
+
+	Incident         = domain.Incident
+	DependencyHealth = domain.DependencyHealth
+	ServiceStatus    = domain.ServiceStatus
+
+	Fixture             = domain.Fixture
+	RoundSummary        = domain.RoundSummary
+	FixtureConflict     = domain.FixtureConflict
+	FixtureImportReport = domain.FixtureImportReport
+
+	TeamConstraint   = domain.TeamConstraint
+	TimetableOptions = domain.TimetableOptions
+	TimetableEntry   = domain.TimetableEntry
+	UnassignedMatch  = domain.UnassignedMatch
+	Timetable        = domain.Timetable
+
+	SplitGroup       = domain.SplitGroup
+	SplitOptions     = domain.SplitOptions
+	SplitGroupResult = domain.SplitGroupResult
+	SplitResult      = domain.SplitResult
+
+	Draw              = domain.Draw
+	DrawPick          = domain.DrawPick
+	DrawOptions       = domain.DrawOptions
+	SeedRanking       = domain.SeedRanking
+	SeedingSuggestion = domain.SeedingSuggestion
+)
+
+// Constantes de dominio
+const (
+	PointsWin  = domain.PointsWin
+	PointsDraw = domain.PointsDraw
+
+	CarryOverFull = domain.CarryOverFull
+	CarryOverHalf = domain.CarryOverHalf
+	CarryOverNone = domain.CarryOverNone
+
+	DrawModeGroups  = domain.DrawModeGroups
+	DrawModeBracket = domain.DrawModeBracket
+
+	EventGoal        = domain.EventGoal
+	EventPenaltyGoal = domain.EventPenaltyGoal
+	EventOwnGoal     = domain.EventOwnGoal
+	EventYellowCard  = domain.EventYellowCard
+	EventRedCard     = domain.EventRedCard
+
+	PositionGoalkeeper = domain.PositionGoalkeeper
+	PositionDefender   = domain.PositionDefender
+	PositionMidfielder = domain.PositionMidfielder
+	PositionForward    = domain.PositionForward
+
+	FootLeft  = domain.FootLeft
+	FootRight = domain.FootRight
+	FootBoth  = domain.FootBoth
+
+	RoleCaptain     = domain.RoleCaptain
+	RoleViceCaptain = domain.RoleViceCaptain
+	RoleGoalkeeper  = domain.RoleGoalkeeper
+
+	StaffCoach     = domain.StaffCoach
+	StaffAssistant = domain.StaffAssistant
+	StaffPhysio    = domain.StaffPhysio
+
+	MediaPhoto = domain.MediaPhoto
+	MediaVideo = domain.MediaVideo
+
+	ClockNotStarted = domain.ClockNotStarted
+	ClockRunning    = domain.ClockRunning
+	ClockPaused     = domain.ClockPaused
+	ClockBreak      = domain.ClockBreak
+	ClockFinished   = domain.ClockFinished
+
+	ClockActionStart     = domain.ClockActionStart
+	ClockActionPause     = domain.ClockActionPause
+	ClockActionResume    = domain.ClockActionResume
+	ClockActionStoppage  = domain.ClockActionStoppage
+	ClockActionEndPeriod = domain.ClockActionEndPeriod
+	ClockActionFinish    = domain.ClockActionFinish
+
+	IncidentMinor       = domain.IncidentMinor
+	IncidentMajor       = domain.IncidentMajor
+	IncidentMaintenance = domain.IncidentMaintenance
+
+	InjuryMuscle     = domain.InjuryMuscle
+	InjuryLigament   = domain.InjuryLigament
+	InjuryFracture   = domain.InjuryFracture
+	InjuryConcussion = domain.InjuryConcussion
+	InjuryIllness    = domain.InjuryIllness
+	InjuryOther      = domain.InjuryOther
+
+	ConflictDuplicateMatchNumber = domain.ConflictDuplicateMatchNumber
+	ConflictConcurrentEdit       = domain.ConflictConcurrentEdit
+	ConflictOpen                 = domain.ConflictOpen
+	ConflictResolved             = domain.ConflictResolved
+	ResolutionKeepServer         = domain.ResolutionKeepServer
+	ResolutionKeepClient         = domain.ResolutionKeepClient
+	ResolutionRenumber           = domain.ResolutionRenumber
+
+	RefereeRoleMain      = domain.RefereeRoleMain
+	RefereeRoleAssistant = domain.RefereeRoleAssistant
+
+	SyncOpCreateEvent = domain.SyncOpCreateEvent
+	SyncOpUpdateScore = domain.SyncOpUpdateScore
+	SyncOpCheckIn     = domain.SyncOpCheckIn
+	SyncOpApplied     = domain.SyncOpApplied
+	SyncOpRejected    = domain.SyncOpRejected
+	SyncOpAborted     = domain.SyncOpAborted
+
+	TournamentDraft            = domain.TournamentDraft
+	TournamentRegistrationOpen = domain.TournamentRegistrationOpen
+	TournamentInProgress       = domain.TournamentInProgress
+	TournamentCompleted        = domain.TournamentCompleted
+	TournamentCancelled        = domain.TournamentCancelled
+
+	ProvisionalPending   = domain.ProvisionalPending
+	ProvisionalConfirmed = domain.ProvisionalConfirmed
+	ProvisionalRejected  = domain.ProvisionalRejected
+
+	AlertMissingResult     = domain.AlertMissingResult
+	AlertShortSquad        = domain.AlertShortSquad
+	AlertUnconfirmedResult = domain.AlertUnconfirmedResult
+
+	TagEntityTeam   = domain.TagEntityTeam
+	TagEntityPlayer = domain.TagEntityPlayer
+	TagEntityMatch  = domain.TagEntityMatch
+
+	StagePending   = domain.StagePending
+	StageActive    = domain.StageActive
+	StageCompleted = domain.StageCompleted
+
+	DecidedInRegularTime = domain.DecidedInRegularTime
+	DecidedInExtraTime   = domain.DecidedInExtraTime
+	DecidedOnPenalties   = domain.DecidedOnPenalties
+	DecidedByForfeit     = domain.DecidedByForfeit
+
+	ResultPlayed        = domain.ResultPlayed
+	ResultForfeit       = domain.ResultForfeit
+	DefaultForfeitGoals = domain.DefaultForfeitGoals
+
+	SanctionFine           = domain.SanctionFine
+	SanctionPointDeduction = domain.SanctionPointDeduction
+	SanctionBan            = domain.SanctionBan
+
+	DefaultPageLimit = domain.DefaultPageLimit
+	MaxPageLimit     = domain.MaxPageLimit
+
+	MatchScheduled = domain.MatchScheduled
+	MatchLive      = domain.MatchLive
+	MatchFinished  = domain.MatchFinished
+
+	MovementPromoted  = domain.MovementPromoted
+	MovementRelegated = domain.MovementRelegated
+	MovementStayed    = domain.MovementStayed
+
+	ScopeReadMatches   = domain.ScopeReadMatches
+	ScopeReadStandings = domain.ScopeReadStandings
+
+	DefaultMatchDuration = domain.DefaultMatchDuration
+
+	TiebreakExtraTime  = domain.TiebreakExtraTime
+	TiebreakPenalties  = domain.TiebreakPenalties
+	TiebreakGoldenGoal = domain.TiebreakGoldenGoal
+)
+
+// Constructores de entidades
+var (
+	NewPlayer          = domain.NewPlayer
+	NewTeam            = domain.NewTeam
+	NewTournament      = domain.NewTournament
+	NewMatch           = domain.NewMatch
+	NewSponsor         = domain.NewSponsor
+	NewMatchEvent      = domain.NewMatchEvent
+	NewSubstitution    = domain.NewSubstitution
+	NewLineup          = domain.NewLineup
+	NewReferee         = domain.NewReferee
+	NewVenue           = domain.NewVenue
+	NewPitch           = domain.NewPitch
+	NewTag             = domain.NewTag
+	NewStaff           = domain.NewStaff
+	NewMatchMedia      = domain.NewMatchMedia
+	NewTournamentRules = domain.NewTournamentRules
+	NewRegistration    = domain.NewRegistration
+	NewMatchClock      = domain.NewMatchClock
+	NewIncident        = domain.NewIncident
+	NewSeason          = domain.NewSeason
+	NewDivision        = domain.NewDivision
+	NewStage           = domain.NewStage
+	NewSanction        = domain.NewSanction
+	NewPage            = domain.NewPage
+)
+
+// Paged es una página de un listado con el total de elementos
+type Paged[T any] = domain.Paged[T]
+
+// Contratos de almacenamiento que debe implementar quien use su propia base de datos
+type (
+	PlayerRepository            = repository.PlayerRepository
+	TeamRepository              = repository.TeamRepository
+	TournamentRepository        = repository.TournamentRepository
+	MatchRepository             = repository.MatchRepository
+	DrawRepository              = repository.DrawRepository
+	SponsorRepository           = repository.SponsorRepository
+	MatchEventRepository        = repository.MatchEventRepository
+	SubstitutionRepository      = repository.SubstitutionRepository
+	LineupRepository            = repository.LineupRepository
+	StatsRepository             = repository.StatsRepository
+	SyncConflictRepository      = repository.SyncConflictRepository
+	RefereeRepository           = repository.RefereeRepository
+	SyncRepository              = repository.SyncRepository
+	VenueRepository             = repository.VenueRepository
+	SeasonRepository            = repository.SeasonRepository
+	StageRepository             = repository.StageRepository
+	ProvisionalResultRepository = repository.ProvisionalResultRepository
+	AnalyticsRepository         = repository.AnalyticsRepository
+	RatingRepository            = repository.RatingRepository
+	TestDataRepository          = repository.TestDataRepository
+	TransferRepository          = repository.TransferRepository
+	InjuryRepository            = repository.InjuryRepository
+	PitchRepository             = repository.PitchRepository
+	AlertRepository             = repository.AlertRepository
+	TagRepository               = repository.TagRepository
+	StaffRepository             = repository.StaffRepository
+	GuestRepository             = repository.GuestRepository
+	MediaRepository             = repository.MediaRepository
+	TournamentRulesRepository   = repository.TournamentRulesRepository
+	RegistrationRepository      = repository.RegistrationRepository
+	MatchClockRepository        = repository.MatchClockRepository
+	IncidentRepository          = repository.IncidentRepository
+	StandingsSnapshotRepository = repository.StandingsSnapshotRepository
+	DivisionRepository          = repository.DivisionRepository
+	APIClientRepository         = repository.APIClientRepository
+	MatchForfeitRepository      = repository.MatchForfeitRepository
+	SanctionRepository          = repository.SanctionRepository
+)
+
+// Servicios del motor, separados en comandos y consultas
+type (
+	PlayerService interface {
+		usecase.PlayerCommands
+		usecase.PlayerQueries
+	}
+	TeamService interface {
+		usecase.TeamCommands
+		usecase.TeamQueries
+	}
+	TournamentService interface {
+		usecase.TournamentCommands
+		usecase.TournamentQueries
+	}
+	MatchService interface {
+		usecase.MatchCommands
+		usecase.MatchQueries
+	}
+	FixtureService interface {
+		usecase.FixtureCommands
+		usecase.FixtureQueries
+	}
+	DrawService interface {
+		usecase.DrawCommands
+		usecase.DrawQueries
+	}
+	SponsorService interface {
+		usecase.SponsorCommands
+		usecase.SponsorQueries
+	}
+	MatchEventService interface {
+		usecase.MatchEventCommands
+		usecase.MatchEventQueries
+	}
+	SubstitutionService interface {
+		usecase.SubstitutionCommands
+		usecase.SubstitutionQueries
+	}
+	LineupService interface {
+		usecase.LineupCommands
+		usecase.LineupQueries
+	}
+	StatsService interface {
+		usecase.StatsQueries
+	}
+	SyncConflictService interface {
+		usecase.SyncConflictCommands
+		usecase.SyncConflictQueries
+	}
+	RefereeService interface {
+		usecase.RefereeCommands
+		usecase.RefereeQueries
+	}
+	SyncService interface {
+		usecase.SyncCommands
+	}
+	VenueService interface {
+		usecase.VenueCommands
+		usecase.VenueQueries
+	}
+	SeasonService interface {
+		usecase.SeasonCommands
+		usecase.SeasonQueries
+	}
+	StageService interface {
+		usecase.StageCommands
+		usecase.StageQueries
+	}
+	ProvisionalResultService interface {
+		usecase.ProvisionalResultCommands
+		usecase.ProvisionalResultQueries
+	}
+	AnalyticsService interface {
+		usecase.AnalyticsCommands
+		usecase.AnalyticsQueries
+	}
+	RatingService interface {
+		usecase.RatingCommands
+		usecase.RatingQueries
+	}
+	InjuryService interface {
+		usecase.InjuryCommands
+		usecase.InjuryQueries
+	}
+	ScoreboardService interface {
+		usecase.ScoreboardQueries
+	}
+	PredictionService interface {
+		usecase.PredictionQueries
+	}
+	TestDataService interface {
+		usecase.TestDataCommands
+	}
+	AlertService interface {
+		usecase.AlertCommands
+		usecase.AlertQueries
+	}
+	TagService interface {
+		usecase.TagCommands
+		usecase.TagQueries
+	}
+	StaffService interface {
+		usecase.StaffCommands
+		usecase.StaffQueries
+	}
+	GuestService interface {
+		usecase.GuestCommands
+		usecase.GuestQueries
+	}
+	MediaService interface {
+		usecase.MediaCommands
+		usecase.MediaQueries
+	}
+	RegistrationService interface {
+		usecase.RegistrationCommands
+		usecase.RegistrationQueries
+	}
+	MatchClockService interface {
+		usecase.MatchClockCommands
+		usecase.MatchClockQueries
+	}
+	StatusService interface {
+		usecase.IncidentCommands
+		usecase.StatusQueries
+	}
+	DivisionService interface {
+		usecase.DivisionCommands
+		usecase.DivisionQueries
+	}
+	CompetitionService interface {
+		usecase.CompetitionQueries
+	}
+	APIClientService interface {
+		usecase.APIClientCommands
+		usecase.APIClientQueries
+	}
+	MatchForfeitService interface {
+		usecase.MatchForfeitCommands
+		usecase.MatchForfeitQueries
+	}
+	SanctionService interface {
+		usecase.SanctionCommands
+		usecase.SanctionQueries
+	}
+)